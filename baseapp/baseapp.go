@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -59,6 +60,7 @@ type BaseApp struct { // nolint: maligned
 	txDecoder         sdk.TxDecoder // unmarshal []byte into sdk.Tx
 
 	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
+	txPrioritizer  TxPrioritizer    // mempool priority of txs in CheckTx and simulations
 	initChainer    sdk.InitChainer  // initialize state with validators and state blob
 	beginBlocker   sdk.BeginBlocker // logic to run before any txs
 	endBlocker     sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
@@ -70,6 +72,7 @@ type BaseApp struct { // nolint: maligned
 	snapshotManager    *snapshots.Manager
 	snapshotInterval   uint64 // block interval between state sync snapshots
 	snapshotKeepRecent uint32 // recent state sync snapshots to keep
+	snapshotFormat     uint32 // format of new state sync snapshots, 0 for the default
 
 	// volatile states:
 	//
@@ -128,6 +131,15 @@ type BaseApp struct { // nolint: maligned
 	// indexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
+
+	// abciListeners for hooking into the ABCI message processing of the BaseApp
+	// and writeListeners for the state changes of each block, per store key
+	abciListeners  []ABCIListener
+	writeListeners map[sdk.StoreKey][]sdk.WriteListener
+
+	// queryMtx guards the queries of the multistore against its reload by
+	// ReloadLatestVersion
+	queryMtx sync.RWMutex
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -149,6 +161,7 @@ func NewBaseApp(
 		grpcQueryRouter:  NewGRPCQueryRouter(),
 		msgServiceRouter: NewMsgServiceRouter(),
 		txDecoder:        txDecoder,
+		txPrioritizer:    DefaultTxPrioritizer,
 		fauxMerkleMode:   false,
 	}
 
@@ -183,6 +196,10 @@ func (app *BaseApp) Logger() log.Logger {
 // MsgServiceRouter returns the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) MsgServiceRouter() *MsgServiceRouter { return app.msgServiceRouter }
 
+// SnapshotManager returns the snapshot manager of a BaseApp, which is nil if no snapshot store
+// is configured. Extension snapshotters can be registered with it.
+func (app *BaseApp) SnapshotManager() *snapshots.Manager { return app.snapshotManager }
+
 // MountStores mounts all IAVL or DB stores to the provided keys in the BaseApp
 // multistore.
 func (app *BaseApp) MountStores(keys ...sdk.StoreKey) {
@@ -253,6 +270,25 @@ func (app *BaseApp) LoadLatestVersion() error {
 	return app.init()
 }
 
+// ReloadLatestVersion reloads the latest application version written to the
+// database by another process, for a BaseApp which only serves queries of a
+// database it does not write. Unlike LoadLatestVersion, it may be called on a
+// running BaseApp, and queries wait for the reload to complete.
+//
+// The checkState context is reset to the latest height, so that queries see
+// the height of the state they are served from as they do on a full node.
+func (app *BaseApp) ReloadLatestVersion() error {
+	app.queryMtx.Lock()
+	defer app.queryMtx.Unlock()
+
+	if err := app.cms.LoadLatestVersion(); err != nil {
+		return fmt.Errorf("failed to reload latest version: %w", err)
+	}
+
+	app.setCheckState(tmproto.Header{Height: app.LastBlockHeight()})
+	return nil
+}
+
 // DefaultStoreLoader will be used by default and loads the latest version
 func DefaultStoreLoader(ms sdk.CommitMultiStore) error {
 	return ms.LoadLatestVersion()
@@ -302,6 +338,12 @@ func (app *BaseApp) init() error {
 		}
 	}
 
+	if app.snapshotManager != nil && app.snapshotFormat != 0 {
+		if err := app.snapshotManager.SetSnapshotFormat(app.snapshotFormat); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -672,6 +714,13 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		}
 	}
 
+	// report the mempool priority of txs which are checked or simulated, with the context of the
+	// AnteHandler
+	if err == nil && mode != runTxModeDeliver && app.txPrioritizer != nil {
+		priorityEvent := app.txPriorityEvent(ctx, tx)
+		result.Events = append(result.Events, abci.Event(priorityEvent))
+	}
+
 	return gInfo, result, err
 }
 
@@ -455,6 +455,41 @@ func testLoadVersionHelper(t *testing.T, app *BaseApp, expectedHeight int64, exp
 	require.Equal(t, expectedID, lastID)
 }
 
+// Test that ReloadLatestVersion sees the versions committed by another BaseApp
+// on the same database, and that queries run with the latest height.
+func TestReloadLatestVersion(t *testing.T) {
+	logger := defaultLogger()
+	pruningOpt := SetPruning(store.PruneNothing)
+	db := dbm.NewMemDB()
+	name := t.Name()
+	app := NewBaseApp(name, logger, db, nil, pruningOpt)
+	app.MountStores(capKey1)
+	require.NoError(t, app.LoadLatestVersion())
+
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.Commit()
+
+	secondary := NewBaseApp(name, logger, db, nil, pruningOpt)
+	secondary.MountStores(capKey1)
+	require.NoError(t, secondary.LoadLatestVersion())
+	testLoadVersionHelper(t, secondary, int64(1), app.LastCommitID())
+
+	header = tmproto.Header{Height: 2}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.Commit()
+	testLoadVersionHelper(t, secondary, int64(1), sdk.CommitID{Version: 1, Hash: secondary.LastCommitID().Hash})
+
+	require.NoError(t, secondary.ReloadLatestVersion())
+	testLoadVersionHelper(t, secondary, int64(2), app.LastCommitID())
+
+	for _, height := range []int64{0, 1, 2} {
+		ctx, err := secondary.createQueryContext(height, false)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), ctx.BlockHeight())
+	}
+}
+
 func TestOptionFunction(t *testing.T) {
 	logger := defaultLogger()
 	db := dbm.NewMemDB()
@@ -938,8 +973,11 @@ func TestCheckTx(t *testing.T) {
 		txBytes, err := codec.MarshalBinaryBare(tx)
 		require.NoError(t, err)
 		r := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
-		require.Empty(t, r.GetEvents())
 		require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+		// only the tx priority is reported, the test tx pays no fees
+		require.Len(t, r.GetEvents(), 1)
+		require.Equal(t, EventTypeTxPriority, r.GetEvents()[0].Type)
+		require.Equal(t, "0", string(r.GetEvents()[0].Attributes[0].Value))
 	}
 
 	checkStateStore := app.checkState.ctx.KVStore(capKey1)
@@ -1794,8 +1832,8 @@ func TestListSnapshots(t *testing.T) {
 		s.Metadata = nil
 	}
 	assert.Equal(t, abci.ResponseListSnapshots{Snapshots: []*abci.Snapshot{
-		{Height: 4, Format: 1, Chunks: 2},
-		{Height: 2, Format: 1, Chunks: 1},
+		{Height: 4, Format: snapshottypes.CurrentFormat, Chunks: 2},
+		{Height: 2, Format: snapshottypes.CurrentFormat, Chunks: 1},
 	}}, resp)
 }
 
@@ -1809,13 +1847,13 @@ func TestLoadSnapshotChunk(t *testing.T) {
 		chunk       uint32
 		expectEmpty bool
 	}{
-		"Existing snapshot": {2, 1, 1, false},
-		"Missing height":    {100, 1, 1, true},
-		"Missing format":    {2, 2, 1, true},
-		"Missing chunk":     {2, 1, 9, true},
-		"Zero height":       {0, 1, 1, true},
+		"Existing snapshot": {2, snapshottypes.CurrentFormat, 1, false},
+		"Missing height":    {100, snapshottypes.CurrentFormat, 1, true},
+		"Missing format":    {2, 1, 1, true},
+		"Missing chunk":     {2, snapshottypes.CurrentFormat, 9, true},
+		"Zero height":       {0, snapshottypes.CurrentFormat, 1, true},
 		"Zero format":       {2, 0, 1, true},
-		"Zero chunk":        {2, 1, 0, false},
+		"Zero chunk":        {2, snapshottypes.CurrentFormat, 0, false},
 	}
 	for name, tc := range testcases {
 		tc := tc
@@ -1853,13 +1891,13 @@ func TestOfferSnapshot_Errors(t *testing.T) {
 			Height: 1, Format: 9, Chunks: 3, Hash: hash, Metadata: metadata,
 		}, abci.ResponseOfferSnapshot_REJECT_FORMAT},
 		"incorrect chunk count": {&abci.Snapshot{
-			Height: 1, Format: 1, Chunks: 2, Hash: hash, Metadata: metadata,
+			Height: 1, Format: snapshottypes.CurrentFormat, Chunks: 2, Hash: hash, Metadata: metadata,
 		}, abci.ResponseOfferSnapshot_REJECT},
 		"no chunks": {&abci.Snapshot{
-			Height: 1, Format: 1, Chunks: 0, Hash: hash, Metadata: metadata,
+			Height: 1, Format: snapshottypes.CurrentFormat, Chunks: 0, Hash: hash, Metadata: metadata,
 		}, abci.ResponseOfferSnapshot_REJECT},
 		"invalid metadata serialization": {&abci.Snapshot{
-			Height: 1, Format: 1, Chunks: 0, Hash: hash, Metadata: []byte{3, 1, 4},
+			Height: 1, Format: snapshottypes.CurrentFormat, Chunks: 0, Hash: hash, Metadata: []byte{3, 1, 4},
 		}, abci.ResponseOfferSnapshot_REJECT},
 	}
 	for name, tc := range testcases {
@@ -1942,6 +1980,41 @@ func TestApplySnapshotChunk(t *testing.T) {
 	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
 }
 
+func TestApplySnapshotChunk_SnapshotHashMismatch(t *testing.T) {
+	source, teardown := setupBaseAppWithSnapshots(t, 2, 5)
+	defer teardown()
+
+	target, teardown := setupBaseAppWithSnapshots(t, 0, 0)
+	defer teardown()
+
+	respList := source.ListSnapshots(abci.RequestListSnapshots{})
+	require.NotEmpty(t, respList.Snapshots)
+	snapshot := *respList.Snapshots[0]
+	snapshot.Hash = []byte{1, 2, 3}
+
+	respOffer := target.OfferSnapshot(abci.RequestOfferSnapshot{Snapshot: &snapshot})
+	require.Equal(t, abci.ResponseOfferSnapshot{Result: abci.ResponseOfferSnapshot_ACCEPT}, respOffer)
+
+	// The chunks are valid, but the final one is rejected because of the snapshot hash.
+	for index := uint32(0); index < snapshot.Chunks; index++ {
+		respChunk := source.LoadSnapshotChunk(abci.RequestLoadSnapshotChunk{
+			Height: snapshot.Height,
+			Format: snapshot.Format,
+			Chunk:  index,
+		})
+		respApply := target.ApplySnapshotChunk(abci.RequestApplySnapshotChunk{
+			Index: index,
+			Chunk: respChunk.Chunk,
+		})
+		expect := abci.ResponseApplySnapshotChunk_ACCEPT
+		if index == snapshot.Chunks-1 {
+			expect = abci.ResponseApplySnapshotChunk_REJECT_SNAPSHOT
+		}
+		require.Equal(t, abci.ResponseApplySnapshotChunk{Result: expect}, respApply)
+	}
+	assert.EqualValues(t, 0, target.LastBlockHeight())
+}
+
 // NOTE: represents a new custom router for testing purposes of WithRouter()
 type testCustomRouter struct {
 	routes sync.Map
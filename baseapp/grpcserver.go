@@ -45,7 +45,9 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 
 		// Create the sdk.Context. Passing false as 2nd arg, as we can't
 		// actually support proofs with gRPC right now.
+		app.queryMtx.RLock()
 		sdkCtx, err := app.createQueryContext(height, false)
+		app.queryMtx.RUnlock()
 		if err != nil {
 			return nil, err
 		}
@@ -17,6 +17,7 @@ import (
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	typeURLRoutes     map[string]MsgServiceHandler
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -24,7 +25,8 @@ var _ gogogrpc.Server = &MsgServiceRouter{}
 // NewMsgServiceRouter creates a new MsgServiceRouter.
 func NewMsgServiceRouter() *MsgServiceRouter {
 	return &MsgServiceRouter{
-		routes: map[string]MsgServiceHandler{},
+		routes:        map[string]MsgServiceHandler{},
+		typeURLRoutes: map[string]MsgServiceHandler{},
 	}
 }
 
@@ -37,6 +39,14 @@ func (msr *MsgServiceRouter) Handler(methodName string) MsgServiceHandler {
 	return msr.routes[methodName]
 }
 
+// HandlerByTypeURL returns the MsgServiceHandler for a given request type URL
+// (ex. `/cosmos.bank.v1beta1.MsgSend`) or nil if not found. It allows routing
+// both legacy sdk.Msgs and the requests of ServiceMsgs to their Msg service
+// handler.
+func (msr *MsgServiceRouter) HandlerByTypeURL(typeURL string) MsgServiceHandler {
+	return msr.typeURLRoutes[typeURL]
+}
+
 // RegisterService implements the gRPC Server.RegisterService method. sd is a gRPC
 // service description, handler is an object which implements that gRPC service.
 //
@@ -83,7 +93,7 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 			)
 		}
 
-		msr.routes[fqMethod] = func(ctx sdk.Context, req sdk.MsgRequest) (*sdk.Result, error) {
+		msgHandler := func(ctx sdk.Context, req sdk.MsgRequest) (*sdk.Result, error) {
 			ctx = ctx.WithEventManager(sdk.NewEventManager())
 			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 				goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
@@ -103,6 +113,8 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 
 			return sdk.WrapServiceResult(ctx, resMsg, err)
 		}
+		msr.routes[fqMethod] = msgHandler
+		msr.typeURLRoutes["/"+proto.MessageName(serviceMsg)] = msgHandler
 	}
 }
 
@@ -67,6 +67,23 @@ func TestRegisterMsgServiceTwice(t *testing.T) {
 	})
 }
 
+func TestMsgServiceHandlerByTypeURL(t *testing.T) {
+	db := dbm.NewMemDB()
+	encCfg := simapp.MakeTestEncodingConfig()
+	app := baseapp.NewBaseApp("test", log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, encCfg.TxConfig.TxDecoder())
+	app.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		testdata.MsgServerImpl{},
+	)
+
+	require.NotNil(t, app.MsgServiceRouter().Handler("/testdata.Msg/CreateDog"))
+	require.NotNil(t, app.MsgServiceRouter().HandlerByTypeURL("/testdata.MsgCreateDog"))
+	require.Nil(t, app.MsgServiceRouter().HandlerByTypeURL("/testdata.Msg/CreateDog"))
+	require.Nil(t, app.MsgServiceRouter().HandlerByTypeURL("/testdata.MsgCreateCat"))
+}
+
 func TestMsgService(t *testing.T) {
 	priv, _, _ := testdata.KeyTestPubAddr()
 	encCfg := simapp.MakeTestEncodingConfig()
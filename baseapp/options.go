@@ -30,6 +30,12 @@ func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setMinGasPrices(gasPrices) }
 }
 
+// SetTxPrioritizer returns an option that sets the TxPrioritizer reporting the mempool priority
+// of txs in CheckTx and simulations.
+func SetTxPrioritizer(prioritizer TxPrioritizer) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.SetTxPrioritizer(prioritizer) }
+}
+
 // SetHaltHeight returns a BaseApp option function that sets the halt block height.
 func SetHaltHeight(blockHeight uint64) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setHaltHeight(blockHeight) }
@@ -73,6 +79,11 @@ func SetSnapshotKeepRecent(keepRecent uint32) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotKeepRecent(keepRecent) }
 }
 
+// SetSnapshotFormat sets the format of new snapshots.
+func SetSnapshotFormat(format uint32) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotFormat(format) }
+}
+
 // SetSnapshotStore sets the snapshot store.
 func SetSnapshotStore(snapshotStore *snapshots.Store) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotStore(snapshotStore) }
@@ -152,6 +163,16 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	app.anteHandler = ah
 }
 
+// SetTxPrioritizer sets the TxPrioritizer reporting the mempool priority of txs in CheckTx and
+// simulations. The DefaultTxPrioritizer is used unless set, and nil disables the reports.
+func (app *BaseApp) SetTxPrioritizer(prioritizer TxPrioritizer) {
+	if app.sealed {
+		panic("SetTxPrioritizer() on sealed BaseApp")
+	}
+
+	app.txPrioritizer = prioritizer
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -227,6 +248,31 @@ func (app *BaseApp) SetSnapshotKeepRecent(snapshotKeepRecent uint32) {
 	app.snapshotKeepRecent = snapshotKeepRecent
 }
 
+// SetSnapshotFormat sets the format of new snapshots, which determines their compression. 0
+// uses the default format.
+func (app *BaseApp) SetSnapshotFormat(snapshotFormat uint32) {
+	if app.sealed {
+		panic("SetSnapshotFormat() on sealed BaseApp")
+	}
+	app.snapshotFormat = snapshotFormat
+}
+
+// SetStreamingService registers a streaming service with the BaseApp. Its
+// WriteListeners are attached to the deliver state of each block and its
+// ABCIListener hooks are called after each BeginBlock, DeliverTx and EndBlock.
+func (app *BaseApp) SetStreamingService(s StreamingService) {
+	if app.sealed {
+		panic("SetStreamingService() on sealed BaseApp")
+	}
+	if app.writeListeners == nil {
+		app.writeListeners = make(map[sdk.StoreKey][]sdk.WriteListener)
+	}
+	for key, listeners := range s.Listeners() {
+		app.writeListeners[key] = append(app.writeListeners[key], listeners...)
+	}
+	app.abciListeners = append(app.abciListeners, s)
+}
+
 // SetInterfaceRegistry sets the InterfaceRegistry.
 func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.interfaceRegistry = registry
@@ -0,0 +1,149 @@
+package baseapp
+
+import (
+	"math"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// EventTypeTxPriority is the type of the event reporting the mempool priority and the sender of
+	// a transaction in the CheckTx and simulation results.
+	EventTypeTxPriority = "tx_priority"
+
+	AttributeKeyPriority = "priority"
+
+	// TxPriorityScale is the factor the fee per gas of a transaction is scaled by in its
+	// DefaultTxPrioritizer priority, so that fractional gas prices are told apart.
+	TxPriorityScale = 1000000
+)
+
+// maxFeePerGas is the highest fee per gas whose scaled priority fits in an int64.
+var maxFeePerGas = sdk.NewDec(math.MaxInt64).QuoInt64(TxPriorityScale)
+
+// TxPrioritizer computes the mempool priority of a transaction which passed the AnteHandler, given
+// the context the AnteHandler returned. It also identifies the sender of the transaction, so that
+// a mempool can replace a pending transaction of a sender with one of a higher priority.
+type TxPrioritizer func(ctx sdk.Context, tx sdk.Tx) (priority int64, sender string)
+
+// DefaultTxPrioritizer prioritizes a transaction by the fee it pays per unit of gas wanted in the
+// denominations with a minimum gas price on the node. It is the prioritizer returned by
+// NewFeeTxPrioritizer without a bond denomination.
+func DefaultTxPrioritizer(ctx sdk.Context, tx sdk.Tx) (int64, string) {
+	return feeTxPriority(ctx, tx, ""), txSender(tx)
+}
+
+// NewFeeTxPrioritizer returns a TxPrioritizer prioritizing a transaction by the fee it pays per unit
+// of gas wanted, multiplied by TxPriorityScale and truncated, and capped at math.MaxInt64. Only the
+// fees in the bond denomination returned by bondDenom, if not nil, and in the denominations with a
+// minimum gas price on the node are priced, as fees in other denominations may be worthless. With
+// fees in several priced denominations, the lowest fee per gas of them is used. Transactions which
+// pay no priced fee, do not implement sdk.FeeTx, or want no gas, have a priority of zero.
+func NewFeeTxPrioritizer(bondDenom func(ctx sdk.Context) string) TxPrioritizer {
+	return func(ctx sdk.Context, tx sdk.Tx) (int64, string) {
+		var denom string
+		if bondDenom != nil {
+			// the bond denomination is read out of the gas wanted by the transaction
+			denom = bondDenom(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+		}
+
+		return feeTxPriority(ctx, tx, denom), txSender(tx)
+	}
+}
+
+// feeTxPriority returns the priority of a transaction by the lowest fee per gas it pays in the bond
+// denomination or in a denomination with a minimum gas price on the node.
+func feeTxPriority(ctx sdk.Context, tx sdk.Tx, bondDenom string) int64 {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 {
+		return 0
+	}
+
+	gas := sdk.NewIntFromUint64(feeTx.GetGas())
+	minGasPrices := ctx.MinGasPrices()
+	priority, priced := int64(math.MaxInt64), false
+	for _, fee := range feeTx.GetFee() {
+		if fee.Denom != bondDenom && !minGasPrices.AmountOf(fee.Denom).IsPositive() {
+			continue
+		}
+		priced = true
+
+		feePerGas := fee.Amount.ToDec().QuoInt(gas)
+		if feePerGas.GTE(maxFeePerGas) {
+			continue
+		}
+		if scaled := feePerGas.MulInt64(TxPriorityScale).TruncateInt64(); scaled < priority {
+			priority = scaled
+		}
+	}
+
+	if !priced {
+		return 0
+	}
+
+	return priority
+}
+
+// NewTxPrioritizer returns a TxPrioritizer giving the transactions whose messages all have a
+// priority override, keyed by the message type URL, the lowest of the priorities of their
+// messages. Other transactions are prioritized by the given fallback, or by the
+// DefaultTxPrioritizer if it is nil. Overrides apply to whole transactions only, so that cheap
+// messages cannot be bundled with a message of a high priority.
+func NewTxPrioritizer(msgPriorities map[string]int64, fallback TxPrioritizer) TxPrioritizer {
+	if fallback == nil {
+		fallback = DefaultTxPrioritizer
+	}
+
+	return func(ctx sdk.Context, tx sdk.Tx) (int64, string) {
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return fallback(ctx, tx)
+		}
+
+		priority := int64(math.MaxInt64)
+		for _, msg := range msgs {
+			msgPriority, ok := msgPriorities[sdk.MsgTypeURL(msg)]
+			if !ok {
+				return fallback(ctx, tx)
+			}
+			if msgPriority < priority {
+				priority = msgPriority
+			}
+		}
+
+		return priority, txSender(tx)
+	}
+}
+
+// txSender returns the fee payer of the transaction, falling back to the first signer of its
+// first message if it does not implement sdk.FeeTx.
+func txSender(tx sdk.Tx) string {
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		if payer := feeTx.FeePayer(); !payer.Empty() {
+			return payer.String()
+		}
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return ""
+	}
+	signers := msgs[0].GetSigners()
+	if len(signers) == 0 {
+		return ""
+	}
+
+	return signers[0].String()
+}
+
+// txPriorityEvent returns the event reporting the priority and the sender of the transaction.
+func (app *BaseApp) txPriorityEvent(ctx sdk.Context, tx sdk.Tx) sdk.Event {
+	priority, sender := app.txPrioritizer(ctx, tx)
+
+	return sdk.NewEvent(
+		EventTypeTxPriority,
+		sdk.NewAttribute(AttributeKeyPriority, strconv.FormatInt(priority, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender),
+	)
+}
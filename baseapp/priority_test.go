@@ -0,0 +1,194 @@
+package baseapp
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// feeTxTest is a txTest paying fees.
+type feeTxTest struct {
+	txTest
+	Gas   uint64
+	Fee   sdk.Coins
+	Payer sdk.AccAddress
+}
+
+func (tx feeTxTest) GetGas() uint64             { return tx.Gas }
+func (tx feeTxTest) GetFee() sdk.Coins          { return tx.Fee }
+func (tx feeTxTest) FeePayer() sdk.AccAddress   { return tx.Payer }
+func (tx feeTxTest) FeeGranter() sdk.AccAddress { return nil }
+func (tx feeTxTest) GetMsgs() []sdk.Msg         { return tx.Msgs }
+func (tx feeTxTest) ValidateBasic() error       { return nil }
+
+func newFeeTxTest(gas uint64, fee sdk.Coins) feeTxTest {
+	return feeTxTest{
+		txTest: *newTxCounter(0, 0),
+		Gas:    gas,
+		Fee:    fee,
+		Payer:  sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+	}
+}
+
+func TestDefaultTxPrioritizer(t *testing.T) {
+	ctx := sdk.Context{}.WithMinGasPrices(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 3)),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 3)),
+		sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 3)),
+	))
+
+	testCases := []struct {
+		name     string
+		gas      uint64
+		fee      sdk.Coins
+		priority int64
+	}{
+		{"no fee", 1000, nil, 0},
+		{"no gas", 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), 0},
+		{"fee per gas", 1000, sdk.NewCoins(sdk.NewInt64Coin("stake", 5500)), 5500000},
+		{"fractional fee per gas", 200000, sdk.NewCoins(sdk.NewInt64Coin("uatom", 5000)), 25000},
+		{"fee per gas below the scale", 1000000000, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)), 0},
+		{"lowest fee per gas of several denoms", 1000,
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 2000), sdk.NewInt64Coin("stake", 5000)), 2000000},
+		{"fee per gas at the cap", 1,
+			sdk.NewCoins(sdk.NewInt64Coin("stake", math.MaxInt64/TxPriorityScale+1)), math.MaxInt64},
+		{"fee per gas beyond int64", 1,
+			sdk.NewCoins(sdk.NewCoin("stake", sdk.NewIntFromUint64(math.MaxUint64))), math.MaxInt64},
+		{"denom without a min gas price", 1000, sdk.NewCoins(sdk.NewInt64Coin("voucher", 5500)), 0},
+		{"denom without a min gas price beyond int64", 1,
+			sdk.NewCoins(sdk.NewCoin("voucher", sdk.NewIntFromUint64(math.MaxUint64))), 0},
+		{"denoms without a min gas price are ignored", 1000,
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 5000), sdk.NewInt64Coin("voucher", 1)), 5000000},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := newFeeTxTest(tc.gas, tc.fee)
+			priority, sender := DefaultTxPrioritizer(ctx, tx)
+			require.Equal(t, tc.priority, priority)
+			require.Equal(t, tx.Payer.String(), sender)
+		})
+	}
+
+	// txs without fees have no priority, and are sent by the first signer of their first message
+	signer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	priority, sender := DefaultTxPrioritizer(ctx, txTest{Msgs: []sdk.Msg{testdata.NewTestMsg(signer)}})
+	require.Zero(t, priority)
+	require.Equal(t, signer.String(), sender)
+
+	priority, sender = DefaultTxPrioritizer(ctx, *newTxCounter(0, 0))
+	require.Zero(t, priority)
+	require.Empty(t, sender)
+}
+
+func TestNewFeeTxPrioritizer(t *testing.T) {
+	prioritizer := NewFeeTxPrioritizer(func(ctx sdk.Context) string {
+		// the bond denom is read without consuming the gas of the transaction
+		require.False(t, ctx.GasMeter().IsPastLimit())
+		return "stake"
+	})
+	ctx := sdk.Context{}.WithGasMeter(sdk.NewGasMeter(0)).WithMinGasPrices(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 3)),
+	))
+
+	testCases := []struct {
+		name     string
+		fee      sdk.Coins
+		priority int64
+	}{
+		{"bond denom without a min gas price", sdk.NewCoins(sdk.NewInt64Coin("stake", 5500)), 5500000},
+		{"denom with a min gas price", sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000)), 2000000},
+		{"lowest fee per gas of the priced denoms",
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 5500), sdk.NewInt64Coin("uatom", 2000), sdk.NewInt64Coin("voucher", 1)), 2000000},
+		{"unpriced denom", sdk.NewCoins(sdk.NewInt64Coin("voucher", 5500)), 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := newFeeTxTest(1000, tc.fee)
+			priority, sender := prioritizer(ctx, tx)
+			require.Equal(t, tc.priority, priority)
+			require.Equal(t, tx.Payer.String(), sender)
+		})
+	}
+}
+
+func TestNewTxPrioritizer(t *testing.T) {
+	ctx := sdk.Context{}.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 3))))
+	msgPriorities := map[string]int64{sdk.MsgTypeURL(&testdata.TestMsg{}): 1000}
+	prioritizer := NewTxPrioritizer(msgPriorities, nil)
+
+	// a tx of overridden messages only has the priority of its messages
+	tx := newFeeTxTest(1000, sdk.NewCoins(sdk.NewInt64Coin("stake", 5000)))
+	tx.Msgs = []sdk.Msg{testdata.NewTestMsg(tx.Payer), testdata.NewTestMsg(tx.Payer)}
+	priority, sender := prioritizer(ctx, tx)
+	require.Equal(t, int64(1000), priority)
+	require.Equal(t, tx.Payer.String(), sender)
+
+	// a single message without an override falls back to the fee per gas
+	tx.Msgs = append(tx.Msgs, msgCounter{})
+	priority, _ = prioritizer(ctx, tx)
+	require.Equal(t, int64(5000000), priority)
+
+	// a custom fallback is used for txs without overrides
+	prioritizer = NewTxPrioritizer(msgPriorities, func(sdk.Context, sdk.Tx) (int64, string) {
+		return 7, "fallback"
+	})
+	priority, sender = prioritizer(ctx, tx)
+	require.Equal(t, int64(7), priority)
+	require.Equal(t, "fallback", sender)
+}
+
+func TestCheckTxPriority(t *testing.T) {
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		}))
+	}
+	prioritizerOpt := SetTxPrioritizer(func(ctx sdk.Context, tx sdk.Tx) (int64, string) {
+		// the prioritizer sees the context of the AnteHandler
+		require.True(t, ctx.IsCheckTx())
+		return tx.(txTest).Counter, "sender"
+	})
+
+	app := setupBaseApp(t, routerOpt, prioritizerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	txBytes, err := cdc.MarshalBinaryBare(newTxCounter(42, 0))
+	require.NoError(t, err)
+
+	for _, reqType := range []abci.CheckTxType{abci.CheckTxType_New, abci.CheckTxType_Recheck} {
+		res := app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: reqType})
+		require.True(t, res.IsOK(), res.Log)
+		require.Equal(t, []abci.Event{{
+			Type: EventTypeTxPriority,
+			Attributes: []abci.EventAttribute{
+				{Key: []byte(AttributeKeyPriority), Value: []byte("42"), Index: true},
+				{Key: []byte(sdk.AttributeKeySender), Value: []byte("sender"), Index: true},
+			},
+		}}, res.Events)
+	}
+
+	// simulations report the priority after the message events
+	_, res, err := app.Simulate(txBytes)
+	require.NoError(t, err)
+	require.Len(t, res.Events, 2)
+	require.Equal(t, EventTypeTxPriority, res.Events[1].Type)
+
+	// and it is not reported without a prioritizer
+	app = setupBaseApp(t, routerOpt, SetTxPrioritizer(nil))
+	app.InitChain(abci.RequestInitChain{})
+	checkRes := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, checkRes.IsOK(), checkRes.Log)
+	require.Empty(t, checkRes.Events)
+}
@@ -0,0 +1,34 @@
+package baseapp
+
+import (
+	"io"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ABCIListener is the interface used to hook into the ABCI message processing of the
+// BaseApp. The hooks are called once the message has been processed, so that the
+// state changes of the message have already been passed to the WriteListeners.
+type ABCIListener interface {
+	// ListenBeginBlock updates the streaming service with the latest BeginBlock messages
+	ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	// ListenEndBlock updates the streaming service with the latest EndBlock messages
+	ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	// ListenDeliverTx updates the streaming service with the latest DeliverTx messages
+	ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+}
+
+// StreamingService is the interface for streaming the state changes of each block along
+// with the ABCI messages they result from. Its WriteListeners are registered with the
+// BaseApp, and are passed the writes made while processing a block, i.e. in BeginBlock,
+// by successful DeliverTx ante handlers and messages, and in EndBlock.
+type StreamingService interface {
+	// Listeners returns the streaming service's WriteListeners per store key
+	Listeners() map[sdk.StoreKey][]sdk.WriteListener
+	// ABCIListener interface for hooking into the ABCI messages from inside the BaseApp
+	ABCIListener
+	// Closer interface
+	io.Closer
+}
@@ -0,0 +1,125 @@
+package baseapp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mockStreamingService records the state changes of each ABCI message.
+type mockStreamingService struct {
+	pending  []store.StoreKVPair
+	messages []string
+	changes  [][]store.StoreKVPair
+}
+
+var _ StreamingService = &mockStreamingService{}
+
+func (s *mockStreamingService) OnWrite(storeKey store.StoreKey, key []byte, value []byte, delete bool) error {
+	s.pending = append(s.pending, store.StoreKVPair{StoreKey: storeKey.Name(), Key: key, Value: value, Delete: delete})
+	return nil
+}
+
+func (s *mockStreamingService) Listeners() map[sdk.StoreKey][]sdk.WriteListener {
+	return map[sdk.StoreKey][]sdk.WriteListener{capKey1: {s}}
+}
+
+func (s *mockStreamingService) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	return s.record(fmt.Sprintf("begin-%d", req.Header.Height))
+}
+
+func (s *mockStreamingService) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	return s.record(fmt.Sprintf("tx-%d", res.Code))
+}
+
+func (s *mockStreamingService) ListenEndBlock(_ sdk.Context, req abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	return s.record(fmt.Sprintf("end-%d", req.Height))
+}
+
+func (s *mockStreamingService) Close() error {
+	return nil
+}
+
+func (s *mockStreamingService) record(message string) error {
+	s.messages = append(s.messages, message)
+	s.changes = append(s.changes, s.pending)
+	s.pending = nil
+	return nil
+}
+
+func TestStreamingService(t *testing.T) {
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	beginKey, endKey := []byte("begin-key"), []byte("end-key")
+
+	service := &mockStreamingService{}
+	opts := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey)))
+		bapp.SetBeginBlocker(func(ctx sdk.Context, _ abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			ctx.KVStore(capKey1).Set(beginKey, []byte("begin"))
+			return abci.ResponseBeginBlock{}
+		})
+		bapp.SetEndBlocker(func(ctx sdk.Context, _ abci.RequestEndBlock) abci.ResponseEndBlock {
+			ctx.KVStore(capKey1).Delete(beginKey)
+			ctx.KVStore(capKey2).Set(endKey, []byte("end"))
+			return abci.ResponseEndBlock{}
+		})
+		bapp.SetStreamingService(service)
+	}
+	app := setupBaseApp(t, opts)
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+	txBytes := func(tx *txTest) []byte {
+		bz, err := cdc.MarshalBinaryBare(tx)
+		require.NoError(t, err)
+		return bz
+	}
+
+	// the writes of CheckTx are not streamed
+	checkRes := app.CheckTx(abci.RequestCheckTx{Tx: txBytes(newTxCounter(0, 0))})
+	require.True(t, checkRes.IsOK(), fmt.Sprintf("%v", checkRes))
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes(newTxCounter(0, 0))})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	// only the ante handler writes of a tx failing in its message handler are streamed
+	failing := newTxCounter(1, 1)
+	failing.setFailOnHandler(true)
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes(failing)})
+	require.False(t, res.IsOK())
+
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	counter := func(i int64) []byte {
+		bz := make([]byte, 8)
+		return bz[:binary.PutVarint(bz, i)]
+	}
+	require.Equal(t, []string{"begin-1", "tx-0", fmt.Sprintf("tx-%d", res.Code), "end-1"}, service.messages)
+	require.Equal(t, [][]store.StoreKVPair{
+		{{StoreKey: capKey1.Name(), Key: beginKey, Value: []byte("begin")}},
+		{
+			{StoreKey: capKey1.Name(), Key: anteKey, Value: counter(1)},
+			{StoreKey: capKey1.Name(), Key: deliverKey, Value: counter(1)},
+		},
+		{{StoreKey: capKey1.Name(), Key: anteKey, Value: counter(2)}},
+		// capKey2 is not listened to
+		{{StoreKey: capKey1.Name(), Key: beginKey, Delete: true}},
+	}, service.changes)
+
+	// the deliver state is written to the root store on Commit without being streamed again
+	require.Empty(t, service.pending)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/spf13/viper"
 
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -116,6 +117,36 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotFormat sets the format of new state sync snapshots, which determines their
+	// compression (2 for zlib, 3 for zstd).
+	SnapshotFormat uint32 `mapstructure:"snapshot-format"`
+}
+
+// StoreConfig defines the store configuration.
+type StoreConfig struct {
+	// Streamers lists the state streaming services to enable. Only "file" is
+	// supported.
+	Streamers []string `mapstructure:"streamers"`
+}
+
+// StreamersConfig defines the configuration of the state streaming services.
+type StreamersConfig struct {
+	File FileStreamerConfig `mapstructure:"file"`
+}
+
+// FileStreamerConfig defines the configuration of the file streaming service.
+type FileStreamerConfig struct {
+	// Keys lists the store keys to stream the state changes of, or "*" for all
+	// stores.
+	Keys []string `mapstructure:"keys"`
+
+	// WriteDir is the directory the files are written to. A relative path is
+	// taken relative to the node's home directory.
+	WriteDir string `mapstructure:"write-dir"`
+
+	// Prefix is prepended to the names of the files written.
+	Prefix string `mapstructure:"prefix"`
 }
 
 // Config defines the server's top level configuration
@@ -127,6 +158,8 @@ type Config struct {
 	API       APIConfig        `mapstructure:"api"`
 	GRPC      GRPCConfig       `mapstructure:"grpc"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Store     StoreConfig      `mapstructure:"store"`
+	Streamers StreamersConfig  `mapstructure:"streamers"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -188,6 +221,16 @@ func DefaultConfig() *Config {
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
+			SnapshotFormat:     snapshottypes.CurrentFormat,
+		},
+		Store: StoreConfig{
+			Streamers: []string{},
+		},
+		Streamers: StreamersConfig{
+			File: FileStreamerConfig{
+				Keys:     []string{"*"},
+				WriteDir: "data/file_streamer",
+			},
 		},
 	}
 }
@@ -242,6 +285,17 @@ func GetConfig(v *viper.Viper) Config {
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
+			SnapshotFormat:     v.GetUint32("state-sync.snapshot-format"),
+		},
+		Store: StoreConfig{
+			Streamers: v.GetStringSlice("store.streamers"),
+		},
+		Streamers: StreamersConfig{
+			File: FileStreamerConfig{
+				Keys:     v.GetStringSlice("streamers.file.keys"),
+				WriteDir: v.GetString("streamers.file.write-dir"),
+				Prefix:   v.GetString("streamers.file.prefix"),
+			},
 		},
 	}
 }
@@ -161,6 +161,33 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+# snapshot-format specifies the format of new snapshots, which determines their compression
+# (2 for zlib, 3 for zstd). Snapshots in any supported format can be restored.
+snapshot-format = {{ .StateSync.SnapshotFormat }}
+
+###############################################################################
+###                         State Streaming Configuration                   ###
+###############################################################################
+
+# State streaming writes the state changes of each block out of the node, for example to
+# feed indexers, along with the ABCI messages they result from.
+[store]
+
+# streamers lists the streaming services to enable. Only "file" is supported.
+streamers = [{{ range .Store.Streamers }}"{{ . }}", {{ end }}]
+
+[streamers.file]
+
+# keys lists the store keys to stream the state changes of, or "*" for all stores.
+keys = [{{ range .Streamers.File.Keys }}"{{ . }}", {{ end }}]
+
+# write-dir is the directory the block files are written to. A relative path is taken relative
+# to the node's home directory.
+write-dir = "{{ .Streamers.File.WriteDir }}"
+
+# prefix is prepended to the names of the files written.
+prefix = "{{ .Streamers.File.Prefix }}"
 `
 
 var configTemplate *template.Template
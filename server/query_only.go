@@ -0,0 +1,155 @@
+package server
+
+// DONTCOVER
+
+import (
+	"context"
+	"errors"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/service"
+	"github.com/tendermint/tendermint/proxy"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// errQueryOnly is returned by the query-only client for all calls that need a Tendermint node.
+var errQueryOnly = errors.New("not supported by a query-only node, which does not run Tendermint")
+
+var _ rpcclient.Client = (*queryOnlyClient)(nil)
+
+// queryOnlyClient is the Tendermint RPC client of a query-only node. There is no Tendermint
+// node to forward calls to, so ABCI queries are served by the app directly and all other
+// calls fail.
+type queryOnlyClient struct {
+	*service.BaseService
+	app abci.Application
+}
+
+func newQueryOnlyClient(app abci.Application) *queryOnlyClient {
+	c := &queryOnlyClient{app: app}
+	c.BaseService = service.NewBaseService(nil, "QueryOnlyClient", c)
+	return c
+}
+
+func (c *queryOnlyClient) ABCIInfo(context.Context) (*ctypes.ResultABCIInfo, error) {
+	return &ctypes.ResultABCIInfo{Response: c.app.Info(proxy.RequestInfo)}, nil
+}
+
+func (c *queryOnlyClient) ABCIQuery(ctx context.Context, path string, data bytes.HexBytes) (*ctypes.ResultABCIQuery, error) {
+	return c.ABCIQueryWithOptions(ctx, path, data, rpcclient.DefaultABCIQueryOptions)
+}
+
+func (c *queryOnlyClient) ABCIQueryWithOptions(
+	_ context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+	res := c.app.Query(abci.RequestQuery{
+		Path:   path,
+		Data:   data,
+		Height: opts.Height,
+		Prove:  opts.Prove,
+	})
+	return &ctypes.ResultABCIQuery{Response: res}, nil
+}
+
+func (c *queryOnlyClient) BroadcastTxCommit(context.Context, tmtypes.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) BroadcastTxAsync(context.Context, tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) BroadcastTxSync(context.Context, tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) Subscribe(context.Context, string, string, ...int) (<-chan ctypes.ResultEvent, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) Unsubscribe(context.Context, string, string) error {
+	return errQueryOnly
+}
+
+func (c *queryOnlyClient) UnsubscribeAll(context.Context, string) error {
+	return errQueryOnly
+}
+
+func (c *queryOnlyClient) Genesis(context.Context) (*ctypes.ResultGenesis, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) BlockchainInfo(context.Context, int64, int64) (*ctypes.ResultBlockchainInfo, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) NetInfo(context.Context) (*ctypes.ResultNetInfo, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) DumpConsensusState(context.Context) (*ctypes.ResultDumpConsensusState, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) ConsensusState(context.Context) (*ctypes.ResultConsensusState, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) ConsensusParams(context.Context, *int64) (*ctypes.ResultConsensusParams, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) Health(context.Context) (*ctypes.ResultHealth, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) Block(context.Context, *int64) (*ctypes.ResultBlock, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) BlockByHash(context.Context, []byte) (*ctypes.ResultBlock, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) BlockResults(context.Context, *int64) (*ctypes.ResultBlockResults, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) Commit(context.Context, *int64) (*ctypes.ResultCommit, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) Validators(context.Context, *int64, *int, *int) (*ctypes.ResultValidators, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) Tx(context.Context, []byte, bool) (*ctypes.ResultTx, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) TxSearch(context.Context, string, bool, *int, *int, string) (*ctypes.ResultTxSearch, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) Status(context.Context) (*ctypes.ResultStatus, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) BroadcastEvidence(context.Context, tmtypes.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) UnconfirmedTxs(context.Context, *int) (*ctypes.ResultUnconfirmedTxs, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) NumUnconfirmedTxs(context.Context) (*ctypes.ResultUnconfirmedTxs, error) {
+	return nil, errQueryOnly
+}
+
+func (c *queryOnlyClient) CheckTx(context.Context, tmtypes.Tx) (*ctypes.ResultCheckTx, error) {
+	return nil, errQueryOnly
+}
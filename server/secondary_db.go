@@ -0,0 +1,430 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	dbm "github.com/tendermint/tm-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// errSecondaryDB is returned by all writes to a secondary database.
+var errSecondaryDB = errors.New("a secondary database is read-only")
+
+var _ dbm.DB = (*secondaryDB)(nil)
+
+// secondaryDB is a read-only goleveldb database opened next to the node writing it, the
+// primary. goleveldb locks the database directory, even when it is opened read-only, so the
+// secondary reads the database files through a storage that ignores the lock.
+//
+// A secondary sees the database as it was when it was opened. CatchUp reopens it to see the
+// later writes of the primary: the primary compacts and deletes table files over time, so
+// reads of a stale secondary may also fail until it catches up.
+type secondaryDB struct {
+	dir string
+
+	mtx    sync.RWMutex
+	handle *secondaryHandle
+}
+
+// secondaryHandle is an opened view of a secondary database, which is closed once all the
+// reads and iterators started on it are done.
+type secondaryHandle struct {
+	db   *leveldb.DB
+	refs sync.WaitGroup
+}
+
+// openSecondaryDB opens the application database of the node home directory as a secondary,
+// which works while a full node is running on it. Only the goleveldb backend supports this.
+func openSecondaryDB(rootDir string) (*secondaryDB, error) {
+	if sdk.DBBackend != "" && dbm.BackendType(sdk.DBBackend) != dbm.GoLevelDBBackend {
+		return nil, fmt.Errorf("the %s database backend cannot be opened as a secondary", sdk.DBBackend)
+	}
+
+	return newSecondaryDB(filepath.Join(rootDir, "data", "application.db"))
+}
+
+func newSecondaryDB(dir string) (*secondaryDB, error) {
+	db := &secondaryDB{dir: dir}
+	handle, err := db.open()
+	if err != nil {
+		return nil, err
+	}
+
+	db.handle = handle
+	return db, nil
+}
+
+func (db *secondaryDB) open() (*secondaryHandle, error) {
+	ldb, err := leveldb.Open(secondaryStorage{dir: db.dir}, &opt.Options{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the secondary database %s: %w", db.dir, err)
+	}
+	return &secondaryHandle{db: ldb}, nil
+}
+
+// acquire returns the current handle, which must be released when done.
+func (db *secondaryDB) acquire() *secondaryHandle {
+	db.mtx.RLock()
+	defer db.mtx.RUnlock()
+
+	db.handle.refs.Add(1)
+	return db.handle
+}
+
+// CatchUp reopens the database to see all the writes of the primary up to now. Reads and
+// iterators started before keep reading the previous view until they are done.
+func (db *secondaryDB) CatchUp() error {
+	handle, err := db.open()
+	if err != nil {
+		return err
+	}
+
+	db.mtx.Lock()
+	prev := db.handle
+	db.handle = handle
+	db.mtx.Unlock()
+
+	go func() {
+		prev.refs.Wait()
+		_ = prev.db.Close()
+	}()
+	return nil
+}
+
+// Get implements DB. A table file missing from a stale view has been compacted by the
+// primary, so the read is retried once on a caught up view.
+func (db *secondaryDB) Get(key []byte) ([]byte, error) {
+	value, err := db.get(key)
+	if errors.Is(err, os.ErrNotExist) {
+		if err = db.CatchUp(); err != nil {
+			return nil, err
+		}
+		value, err = db.get(key)
+	}
+	return value, err
+}
+
+func (db *secondaryDB) get(key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, errors.New("key cannot be empty")
+	}
+
+	handle := db.acquire()
+	defer handle.refs.Done()
+
+	value, err := handle.db.Get(key, nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, nil
+	}
+	return value, err
+}
+
+// Has implements DB.
+func (db *secondaryDB) Has(key []byte) (bool, error) {
+	value, err := db.Get(key)
+	if err != nil {
+		return false, err
+	}
+	return value != nil, nil
+}
+
+// Set implements DB.
+func (db *secondaryDB) Set([]byte, []byte) error {
+	return errSecondaryDB
+}
+
+// SetSync implements DB.
+func (db *secondaryDB) SetSync([]byte, []byte) error {
+	return errSecondaryDB
+}
+
+// Delete implements DB.
+func (db *secondaryDB) Delete([]byte) error {
+	return errSecondaryDB
+}
+
+// DeleteSync implements DB.
+func (db *secondaryDB) DeleteSync([]byte) error {
+	return errSecondaryDB
+}
+
+// Iterator implements DB.
+func (db *secondaryDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	return db.newIterator(start, end, false)
+}
+
+// ReverseIterator implements DB.
+func (db *secondaryDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return db.newIterator(start, end, true)
+}
+
+func (db *secondaryDB) newIterator(start, end []byte, isReverse bool) (dbm.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, errors.New("key cannot be empty")
+	}
+
+	handle := db.acquire()
+	source := handle.db.NewIterator(&util.Range{Start: start, Limit: end}, nil)
+	if isReverse {
+		source.Last()
+	} else {
+		source.First()
+	}
+
+	return &secondaryIterator{
+		source:    source,
+		handle:    handle,
+		start:     start,
+		end:       end,
+		isReverse: isReverse,
+	}, nil
+}
+
+// Close implements DB. It waits for all the reads and iterators to be done.
+func (db *secondaryDB) Close() error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	db.handle.refs.Wait()
+	return db.handle.db.Close()
+}
+
+// NewBatch implements DB.
+func (db *secondaryDB) NewBatch() dbm.Batch {
+	return secondaryBatch{}
+}
+
+// Print implements DB.
+func (db *secondaryDB) Print() error {
+	itr, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		fmt.Printf("[%X]:\t[%X]\n", itr.Key(), itr.Value())
+	}
+	return itr.Error()
+}
+
+// Stats implements DB.
+func (db *secondaryDB) Stats() map[string]string {
+	handle := db.acquire()
+	defer handle.refs.Done()
+
+	stats := make(map[string]string)
+	for _, key := range []string{"leveldb.stats", "leveldb.sstables", "leveldb.blockpool", "leveldb.cachedblock", "leveldb.openedtables"} {
+		if value, err := handle.db.GetProperty(key); err == nil {
+			stats[key] = value
+		}
+	}
+	return stats
+}
+
+var _ dbm.Iterator = (*secondaryIterator)(nil)
+
+// secondaryIterator iterates over a view of a secondary database, and releases it when closed.
+type secondaryIterator struct {
+	source    iterator.Iterator
+	handle    *secondaryHandle
+	start     []byte
+	end       []byte
+	isReverse bool
+	closed    bool
+}
+
+// Domain implements Iterator.
+func (itr *secondaryIterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+// Valid implements Iterator.
+func (itr *secondaryIterator) Valid() bool {
+	return !itr.closed && itr.source.Valid()
+}
+
+// Key implements Iterator. It returns a copy of the current key.
+func (itr *secondaryIterator) Key() []byte {
+	itr.assertIsValid()
+	return append([]byte{}, itr.source.Key()...)
+}
+
+// Value implements Iterator. It returns a copy of the current value.
+func (itr *secondaryIterator) Value() []byte {
+	itr.assertIsValid()
+	return append([]byte{}, itr.source.Value()...)
+}
+
+// Next implements Iterator.
+func (itr *secondaryIterator) Next() {
+	itr.assertIsValid()
+	if itr.isReverse {
+		itr.source.Prev()
+	} else {
+		itr.source.Next()
+	}
+}
+
+// Error implements Iterator.
+func (itr *secondaryIterator) Error() error {
+	return itr.source.Error()
+}
+
+// Close implements Iterator.
+func (itr *secondaryIterator) Close() error {
+	if !itr.closed {
+		itr.closed = true
+		itr.source.Release()
+		itr.handle.refs.Done()
+	}
+	return nil
+}
+
+func (itr *secondaryIterator) assertIsValid() {
+	if !itr.Valid() {
+		panic("iterator is invalid")
+	}
+}
+
+var _ dbm.Batch = secondaryBatch{}
+
+// secondaryBatch is the batch of a secondary database, which cannot be written.
+type secondaryBatch struct{}
+
+func (secondaryBatch) Set([]byte, []byte) error { return errSecondaryDB }
+func (secondaryBatch) Delete([]byte) error      { return errSecondaryDB }
+func (secondaryBatch) Write() error             { return errSecondaryDB }
+func (secondaryBatch) WriteSync() error         { return errSecondaryDB }
+func (secondaryBatch) Close() error             { return nil }
+
+var _ storage.Storage = secondaryStorage{}
+
+// secondaryStorage is a read-only goleveldb file storage which does not lock the database
+// directory, so that it can be read while the primary holds the lock.
+type secondaryStorage struct {
+	dir string
+}
+
+type noopLocker struct{}
+
+func (noopLocker) Unlock() {}
+
+func (s secondaryStorage) Lock() (storage.Locker, error) {
+	return noopLocker{}, nil
+}
+
+func (s secondaryStorage) Log(string) {}
+
+func (s secondaryStorage) SetMeta(storage.FileDesc) error {
+	return errSecondaryDB
+}
+
+// GetMeta returns the manifest named by the CURRENT file, which the primary replaces atomically.
+func (s secondaryStorage) GetMeta() (storage.FileDesc, error) {
+	current, err := ioutil.ReadFile(filepath.Join(s.dir, "CURRENT"))
+	if err != nil {
+		return storage.FileDesc{}, err
+	}
+
+	fd, ok := parseFileName(strings.TrimSuffix(string(current), "\n"))
+	if !ok || fd.Type != storage.TypeManifest {
+		return storage.FileDesc{}, fmt.Errorf("invalid CURRENT file of %s: %q", s.dir, current)
+	}
+	return fd, nil
+}
+
+func (s secondaryStorage) List(ft storage.FileType) ([]storage.FileDesc, error) {
+	infos, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var fds []storage.FileDesc
+	for _, info := range infos {
+		if fd, ok := parseFileName(info.Name()); ok && fd.Type&ft != 0 {
+			fds = append(fds, fd)
+		}
+	}
+	return fds, nil
+}
+
+func (s secondaryStorage) Open(fd storage.FileDesc) (storage.Reader, error) {
+	f, err := os.Open(filepath.Join(s.dir, fileName(fd)))
+	if os.IsNotExist(err) && fd.Type == storage.TypeTable {
+		// tables of older goleveldb versions have the .sst extension
+		f, err = os.Open(filepath.Join(s.dir, fmt.Sprintf("%06d.sst", fd.Num)))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (s secondaryStorage) Create(storage.FileDesc) (storage.Writer, error) {
+	return nil, errSecondaryDB
+}
+
+func (s secondaryStorage) Remove(storage.FileDesc) error {
+	return errSecondaryDB
+}
+
+func (s secondaryStorage) Rename(storage.FileDesc, storage.FileDesc) error {
+	return errSecondaryDB
+}
+
+func (s secondaryStorage) Close() error {
+	return nil
+}
+
+// fileName returns the name of a goleveldb database file.
+func fileName(fd storage.FileDesc) string {
+	switch fd.Type {
+	case storage.TypeManifest:
+		return fmt.Sprintf("MANIFEST-%06d", fd.Num)
+	case storage.TypeJournal:
+		return fmt.Sprintf("%06d.log", fd.Num)
+	case storage.TypeTable:
+		return fmt.Sprintf("%06d.ldb", fd.Num)
+	case storage.TypeTemp:
+		return fmt.Sprintf("%06d.tmp", fd.Num)
+	default:
+		panic(fmt.Sprintf("invalid goleveldb file type %d", fd.Type))
+	}
+}
+
+// parseFileName parses the name of a goleveldb database file.
+func parseFileName(name string) (fd storage.FileDesc, ok bool) {
+	var ext string
+	if _, err := fmt.Sscanf(name, "%d.%s", &fd.Num, &ext); err == nil {
+		switch ext {
+		case "log":
+			fd.Type = storage.TypeJournal
+		case "ldb", "sst":
+			fd.Type = storage.TypeTable
+		case "tmp":
+			fd.Type = storage.TypeTemp
+		default:
+			return fd, false
+		}
+		return fd, true
+	}
+
+	if n, _ := fmt.Sscanf(name, "MANIFEST-%d%s", &fd.Num, &ext); n == 1 {
+		fd.Type = storage.TypeManifest
+		return fd, true
+	}
+	return fd, false
+}
@@ -0,0 +1,174 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSecondaryDB(t *testing.T) {
+	dir := t.TempDir()
+	primary, err := dbm.NewGoLevelDB("application", dir)
+	require.NoError(t, err)
+	defer primary.Close()
+
+	require.NoError(t, primary.Set([]byte("a"), []byte("1")))
+	require.NoError(t, primary.Set([]byte("b"), []byte("2")))
+
+	// the secondary is opened while the primary holds the database lock
+	secondary, err := newSecondaryDB(filepath.Join(dir, "application.db"))
+	require.NoError(t, err)
+	defer secondary.Close()
+
+	value, err := secondary.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), value)
+	has, err := secondary.Has([]byte("c"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.Error(t, secondary.Set([]byte("c"), []byte("3")))
+	require.Error(t, secondary.Delete([]byte("a")))
+	batch := secondary.NewBatch()
+	require.Error(t, batch.Set([]byte("c"), []byte("3")))
+	require.Error(t, batch.Write())
+	require.NoError(t, batch.Close())
+
+	// the later writes of the primary are seen once the secondary catches up
+	require.NoError(t, primary.Set([]byte("c"), []byte("3")))
+	require.NoError(t, primary.Delete([]byte("a")))
+
+	itr, err := secondary.Iterator(nil, nil)
+	require.NoError(t, err)
+	value, err = secondary.Get([]byte("c"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	require.NoError(t, secondary.CatchUp())
+	value, err = secondary.Get([]byte("c"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("3"), value)
+	value, err = secondary.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	// an iterator keeps reading the view it was started on
+	require.Equal(t, [][]byte{[]byte("a"), []byte("b")}, iteratorKeys(t, itr))
+
+	itr, err = secondary.ReverseIterator([]byte("b"), nil)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("c"), []byte("b")}, iteratorKeys(t, itr))
+
+	itr, err = secondary.Iterator(nil, []byte("c"))
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("b")}, iteratorKeys(t, itr))
+}
+
+func TestSecondaryDB_Compaction(t *testing.T) {
+	dir := t.TempDir()
+	primary, err := dbm.NewGoLevelDB("application", dir)
+	require.NoError(t, err)
+	require.NoError(t, primary.Set([]byte("a"), []byte("1")))
+	require.NoError(t, primary.DB().CompactRange(util.Range{}))
+	require.NoError(t, primary.Close())
+
+	// the view of the secondary reads the value of a table
+	primary, err = dbm.NewGoLevelDB("application", dir)
+	require.NoError(t, err)
+	secondary, err := newSecondaryDB(filepath.Join(dir, "application.db"))
+	require.NoError(t, err)
+	defer secondary.Close()
+
+	// the primary compacts the table away, so the secondary catches up to read
+	require.NoError(t, primary.Set([]byte("a"), []byte("2")))
+	require.NoError(t, primary.DB().CompactRange(util.Range{}))
+	require.NoError(t, primary.Close())
+
+	value, err := secondary.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), value)
+}
+
+func TestCatchUp(t *testing.T) {
+	home := t.TempDir()
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	encCfg := simapp.MakeTestEncodingConfig()
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+
+	db, err := openDB(home)
+	require.NoError(t, err)
+	defer db.Close()
+
+	app := simapp.NewSimApp(logger, db, nil, true, map[int64]bool{}, home, 0, encCfg, simapp.EmptyAppOptions{})
+	genesisState := simapp.NewDefaultGenesisState()
+	stateBytes, err := encCfg.Amino.MarshalJSONIndent(genesisState, "", " ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	commit := func(height int64, coins sdk.Coins) {
+		header := tmproto.Header{Height: height}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		if !coins.Empty() {
+			require.NoError(t, simapp.FundAccount(app, app.NewContext(false, header), addr, coins))
+		}
+		app.Commit()
+	}
+	commit(1, nil)
+	commit(2, nil)
+
+	secondaryDB, err := openSecondaryDB(home)
+	require.NoError(t, err)
+	defer secondaryDB.Close()
+
+	secondary := simapp.NewSimApp(logger, secondaryDB, nil, true, map[int64]bool{}, home, 0, encCfg, simapp.EmptyAppOptions{})
+	require.NoError(t, secondary.ReloadLatestVersion())
+	require.Equal(t, int64(2), secondary.LastBlockHeight())
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	commit(3, coins)
+	require.Equal(t, int64(2), secondary.LastBlockHeight())
+
+	require.NoError(t, catchUp(secondaryDB, secondary))
+	require.Equal(t, int64(3), secondary.LastBlockHeight())
+	require.Equal(t, app.LastCommitID(), secondary.LastCommitID())
+
+	// queries are served at the latest and at the retained heights
+	balance := func(height int64) sdk.Coin {
+		bz, err := encCfg.Marshaler.MarshalBinaryBare(banktypes.NewQueryBalanceRequest(addr, sdk.DefaultBondDenom))
+		require.NoError(t, err)
+
+		res := secondary.Query(abci.RequestQuery{Path: "/cosmos.bank.v1beta1.Query/Balance", Data: bz, Height: height})
+		require.True(t, res.IsOK(), res.Log)
+
+		var balance banktypes.QueryBalanceResponse
+		require.NoError(t, encCfg.Marshaler.UnmarshalBinaryBare(res.Value, &balance))
+		return *balance.Balance
+	}
+	require.Equal(t, coins[0], balance(0))
+	require.Equal(t, coins[0], balance(3))
+	require.True(t, balance(2).IsZero())
+}
+
+func iteratorKeys(t *testing.T, itr dbm.Iterator) [][]byte {
+	defer itr.Close()
+
+	var keys [][]byte
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+	}
+	require.NoError(t, itr.Error())
+	return keys
+}
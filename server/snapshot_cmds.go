@@ -0,0 +1,386 @@
+package server
+
+// DONTCOVER
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	flagOutput = "output"
+
+	// snapshotMetadataEntry is the name of the tarball entry holding the snapshot metadata. It is
+	// followed by one entry per chunk, named by the chunk index.
+	snapshotMetadataEntry = "metadata"
+)
+
+// SnapshotsCmd returns the commands managing the local state sync snapshots of a node. The node
+// must not be running, since it holds a lock on the snapshot store.
+func SnapshotsCmd(appCreator types.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local state sync snapshots",
+	}
+	cmd.AddCommand(
+		ListSnapshotsCmd(),
+		ExportSnapshotCmd(),
+		ImportSnapshotCmd(),
+		RestoreSnapshotCmd(appCreator),
+		DeleteSnapshotCmd(),
+	)
+	return cmd
+}
+
+// ListSnapshotsCmd lists the local snapshots.
+func ListSnapshotsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List local snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := snapshotStoreFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+
+			list, err := store.List()
+			if err != nil {
+				return err
+			}
+			for _, snapshot := range list {
+				cmd.Printf("height: %d format: %d chunks: %d hash: %X\n",
+					snapshot.Height, snapshot.Format, snapshot.Chunks, snapshot.Hash)
+			}
+			return nil
+		},
+	}
+}
+
+// ExportSnapshotCmd exports a local snapshot to a tarball, which can be imported by other nodes.
+func ExportSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [height] [format]",
+		Short: "Export a local snapshot to a tarball",
+		Long: `Export a local snapshot to a tarball, which can be imported into the snapshot store of
+another node to restore it from without P2P state sync. The tarball is written to
+<height>-<format>.tar unless --output is given.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+			store, err := snapshotStoreFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+
+			snapshot, err := store.Get(height, format)
+			if err != nil {
+				return err
+			}
+			if snapshot == nil {
+				return fmt.Errorf("snapshot at height %d with format %d not found", height, format)
+			}
+
+			output, _ := cmd.Flags().GetString(flagOutput)
+			if output == "" {
+				output = fmt.Sprintf("%d-%d.tar", height, format)
+			}
+			file, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			if err := exportSnapshot(store, height, format, file); err != nil {
+				return err
+			}
+			return file.Close()
+		},
+	}
+	cmd.Flags().String(flagOutput, "", "Path of the tarball to write")
+	return cmd
+}
+
+// ImportSnapshotCmd imports a snapshot from a tarball into the local snapshot store.
+func ImportSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import [tarball]",
+		Short: "Import a snapshot from a tarball into the local snapshot store",
+		Long: `Import a snapshot from a tarball written by the export command into the local snapshot
+store. The chunk hashes and the snapshot hash are verified as the chunks are read. The
+application state is left untouched, run the restore command to restore it from the snapshot.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := snapshotStoreFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			snapshot, err := importSnapshot(store, file)
+			if err != nil {
+				return err
+			}
+			cmd.Printf("imported snapshot at height %d with format %d\n", snapshot.Height, snapshot.Format)
+			return nil
+		},
+	}
+}
+
+// RestoreSnapshotCmd restores the application state from a local snapshot.
+func RestoreSnapshotCmd(appCreator types.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore [height] [format]",
+		Short: "Restore the application state from a local snapshot",
+		Long: `Restore the application database of the node from a local snapshot, e.g. one imported
+with the import command, as state sync does. The application database must be empty. The
+Tendermint state of the node is not restored, it must be bootstrapped at the snapshot height
+before the node is started.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+
+			ctx := GetServerContextFromCmd(cmd)
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			if homeDir != "" {
+				ctx.Config.SetRoot(homeDir)
+			}
+			db, err := openDB(ctx.Config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			created := appCreator(ctx.Logger, db, nil, ctx.Viper)
+			defer closeApp(ctx, created)
+
+			app, ok := created.(snapshotApp)
+			if !ok || app.SnapshotManager() == nil {
+				return fmt.Errorf("the application has no snapshot store")
+			}
+			defer app.SnapshotManager().Close()
+
+			if version := app.LastCommitID().Version; version != 0 {
+				return fmt.Errorf("the application database is not empty, it holds the state at height %d", version)
+			}
+			if err := app.SnapshotManager().RestoreLocalSnapshot(height, format); err != nil {
+				return err
+			}
+			cmd.Printf("restored snapshot at height %d with format %d, app hash %X\n",
+				height, format, app.LastCommitID().Hash)
+			return nil
+		},
+	}
+}
+
+// snapshotApp is an application restoring snapshots with its snapshot manager, e.g. BaseApp.
+type snapshotApp interface {
+	SnapshotManager() *snapshots.Manager
+	LastCommitID() sdk.CommitID
+}
+
+// DeleteSnapshotCmd deletes a local snapshot.
+func DeleteSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete [height] [format]",
+		Short: "Delete a local snapshot",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseSnapshotArgs(args)
+			if err != nil {
+				return err
+			}
+			store, err := snapshotStoreFromCmd(cmd)
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+
+			return store.Delete(height, format)
+		},
+	}
+}
+
+func snapshotStoreFromCmd(cmd *cobra.Command) (*snapshots.Store, error) {
+	config := GetServerContextFromCmd(cmd).Config
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	if homeDir != "" {
+		config.SetRoot(homeDir)
+	}
+	return GetSnapshotStore(config.RootDir)
+}
+
+func parseSnapshotArgs(args []string) (uint64, uint32, error) {
+	height, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid height %q: %w", args[0], err)
+	}
+	format, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid format %q: %w", args[1], err)
+	}
+	return height, uint32(format), nil
+}
+
+// exportSnapshot writes the snapshot metadata and chunks as a tarball.
+func exportSnapshot(store *snapshots.Store, height uint64, format uint32, w io.Writer) error {
+	snapshot, chunks, err := store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return fmt.Errorf("snapshot at height %d with format %d not found", height, format)
+	}
+	defer snapshots.DrainChunks(chunks)
+
+	metadata, err := snapshot.Marshal()
+	if err != nil {
+		return err
+	}
+	tw := tar.NewWriter(w)
+	err = writeTarEntry(tw, snapshotMetadataEntry, bytes.NewReader(metadata), int64(len(metadata)))
+	if err != nil {
+		return err
+	}
+
+	index := uint32(0)
+	for chunk := range chunks {
+		// the chunk size is needed for the tar header, so chunks are read into memory one at a time
+		body, err := ioutil.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return err
+		}
+		name := strconv.FormatUint(uint64(index), 10)
+		if err := writeTarEntry(tw, name, bytes.NewReader(body), int64(len(body))); err != nil {
+			return err
+		}
+		index++
+	}
+	return tw.Close()
+}
+
+func writeTarEntry(tw *tar.Writer, name string, r io.Reader, size int64) error {
+	err := tw.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0644,
+		Size: size,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, r)
+	return err
+}
+
+// importSnapshot saves the snapshot in the tarball into the snapshot store. The chunk hashes are
+// verified as the chunks stream into the store, and the snapshot hash once all are saved. If the
+// verification fails, the partially imported snapshot is removed again.
+func importSnapshot(store *snapshots.Store, r io.Reader) (*snapshottypes.Snapshot, error) {
+	tr := tar.NewReader(r)
+	header, err := tr.Next()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to read snapshot metadata")
+	}
+	if header.Name != snapshotMetadataEntry {
+		return nil, fmt.Errorf("expected snapshot metadata entry, got %q", header.Name)
+	}
+	metadata, err := ioutil.ReadAll(tr)
+	if err != nil {
+		return nil, err
+	}
+	var expected snapshottypes.Snapshot
+	if err := expected.Unmarshal(metadata); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to decode snapshot metadata")
+	}
+	if err := snapshottypes.ValidateFormat(expected.Format); err != nil {
+		return nil, err
+	}
+	if expected.Chunks == 0 {
+		return nil, sdkerrors.Wrap(snapshottypes.ErrInvalidMetadata, "no chunks")
+	}
+	if uint32(len(expected.Metadata.ChunkHashes)) != expected.Chunks {
+		return nil, sdkerrors.Wrapf(snapshottypes.ErrInvalidMetadata,
+			"snapshot has %d chunk hashes, but %d chunks", len(expected.Metadata.ChunkHashes), expected.Chunks)
+	}
+
+	existing, err := store.Get(expected.Height, expected.Format)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrConflict,
+			"snapshot already exists for height %d format %d", expected.Height, expected.Format)
+	}
+
+	chunks := make(chan io.ReadCloser)
+	go func() {
+		defer close(chunks)
+		for index := uint32(0); index < expected.Chunks; index++ {
+			pr, pw := io.Pipe()
+			chunks <- pr
+			_ = pw.CloseWithError(copyTarChunk(tr, index, expected.Metadata.ChunkHashes[index], pw))
+		}
+	}()
+
+	snapshot, err := store.Save(expected.Height, expected.Format, chunks)
+	if err == nil && !bytes.Equal(snapshot.Hash, expected.Hash) {
+		err = sdkerrors.Wrapf(snapshottypes.ErrSnapshotHashMismatch,
+			"expected %X, got %X", expected.Hash, snapshot.Hash)
+	}
+	if err != nil {
+		_ = store.Delete(expected.Height, expected.Format)
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// copyTarChunk copies the chunk with the given index from the tarball, failing if its hash does
+// not match.
+func copyTarChunk(tr *tar.Reader, index uint32, expectedHash []byte, w io.Writer) error {
+	header, err := tr.Next()
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to read snapshot chunk %d", index)
+	}
+	if header.Name != strconv.FormatUint(uint64(index), 10) {
+		return fmt.Errorf("expected snapshot chunk %d, got %q", index, header.Name)
+	}
+	hasher := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(w, hasher), tr); err != nil {
+		return err
+	}
+	if hash := hasher.Sum(nil); !bytes.Equal(hash, expectedHash) {
+		return sdkerrors.Wrapf(snapshottypes.ErrChunkHashMismatch,
+			"chunk %d: expected %X, got %X", index, expectedHash, hash)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSnapshotsCmd_ExportImportRestore(t *testing.T) {
+	srcHome, dstHome := t.TempDir(), t.TempDir()
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	encCfg := simapp.MakeTestEncodingConfig()
+
+	// the source node takes a snapshot at height 2
+	snapshotDir := filepath.Join(srcHome, "data", "snapshots")
+	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
+	require.NoError(t, err)
+	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+	require.NoError(t, err)
+
+	app := simapp.NewSimApp(logger, dbm.NewMemDB(), nil, true, map[int64]bool{}, srcHome, 0, encCfg, simapp.EmptyAppOptions{},
+		baseapp.SetSnapshotStore(snapshotStore), baseapp.SetSnapshotInterval(2))
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   newDefaultGenesisDoc().AppState,
+	})
+	for height := int64(1); height <= 2; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.Commit()
+	}
+	appHash := app.LastCommitID().Hash
+
+	// snapshots are taken asynchronously
+	require.Eventually(t, func() bool {
+		snapshot, err := snapshotStore.Get(2, snapshottypes.CurrentFormat)
+		return err == nil && snapshot != nil
+	}, 10*time.Second, 10*time.Millisecond)
+	require.NoError(t, snapshotStore.Close())
+
+	appCreator := func(_ log.Logger, db dbm.DB, _ io.Writer, _ types.AppOptions) types.Application {
+		store, err := server.GetSnapshotStore(dstHome)
+		require.NoError(t, err)
+		return simapp.NewSimApp(logger, db, nil, true, map[int64]bool{}, dstHome, 0, encCfg, simapp.EmptyAppOptions{},
+			baseapp.SetSnapshotStore(store))
+	}
+	format := fmt.Sprintf("%d", snapshottypes.CurrentFormat)
+	tarball := filepath.Join(t.TempDir(), "snapshot.tar")
+
+	runSnapshotsCmd(t, srcHome, appCreator, "export", "2", format, "--output", tarball)
+	runSnapshotsCmd(t, dstHome, appCreator, "import", tarball)
+	output := runSnapshotsCmd(t, dstHome, appCreator, "restore", "2", format)
+	require.Contains(t, output, fmt.Sprintf("app hash %X", appHash))
+
+	// the restored application database has the app hash of the source node
+	db, err := sdk.NewLevelDB("application", filepath.Join(dstHome, "data"))
+	require.NoError(t, err)
+	restored := simapp.NewSimApp(logger, db, nil, true, map[int64]bool{}, dstHome, 0, encCfg, simapp.EmptyAppOptions{})
+	require.Equal(t, int64(2), restored.LastBlockHeight())
+	require.Equal(t, appHash, restored.LastCommitID().Hash)
+	require.NoError(t, db.Close())
+
+	// a snapshot is not restored on top of an existing state
+	_, err = executeSnapshotsCmd(dstHome, appCreator, "restore", "2", format)
+	require.EqualError(t, err, "the application database is not empty, it holds the state at height 2")
+}
+
+func runSnapshotsCmd(t *testing.T, home string, appCreator types.AppCreator, args ...string) string {
+	output, err := executeSnapshotsCmd(home, appCreator, args...)
+	require.NoError(t, err)
+
+	return output
+}
+
+func executeSnapshotsCmd(home string, appCreator types.AppCreator, args ...string) (string, error) {
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.RootDir = home
+	ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+
+	output := &bytes.Buffer{}
+	cmd := server.SnapshotsCmd(appCreator)
+	cmd.SetOut(output)
+	cmd.SetErr(ioutil.Discard)
+	cmd.SetArgs(args)
+	err := cmd.ExecuteContext(ctx)
+
+	return output.String(), err
+}
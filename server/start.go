@@ -3,7 +3,9 @@ package server
 // DONTCOVER
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"runtime/pprof"
 	"time"
@@ -25,6 +27,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 )
 
@@ -35,6 +38,8 @@ const (
 	flagTransport          = "transport"
 	flagTraceStore         = "trace-store"
 	flagCPUProfile         = "cpu-profile"
+	FlagQueryOnly          = "query-only"
+	FlagCatchUpInterval    = "catch-up-interval"
 	FlagMinGasPrices       = "minimum-gas-prices"
 	FlagHaltHeight         = "halt-height"
 	FlagHaltTime           = "halt-time"
@@ -61,6 +66,7 @@ const (
 const (
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotFormat     = "state-sync.snapshot-format"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -82,6 +88,13 @@ nothing: all historic states will be saved, nothing will be deleted (i.e. archiv
 everything: all saved states will be deleted, storing only the current state; pruning at 10 block intervals
 custom: allow pruning options to be manually specified through 'pruning-keep-recent', 'pruning-keep-every', and 'pruning-interval'
 
+With '--query-only', the node only serves the gRPC and REST queries of the application state
+on disk, at any height retained by it, without running Tendermint. The application database is
+opened as a read-only secondary, which does not take the database lock, so the query-only node
+can run next to the full node writing the database, e.g. an archive node, and serve historical
+queries without competing with consensus. It catches up with the state committed by the full node
+every '--catch-up-interval'. Only the goleveldb database backend supports this.
+
 Node halting configurations exist in the form of two flags: '--halt-height' and '--halt-time'. During
 the ABCI Commit phase, the node will check if the current block height is greater than or equal to
 the halt-height or if the current block time is greater than or equal to the halt-time. If so, the
@@ -105,6 +118,11 @@ which accepts a path for the resulting pprof file.
 			serverCtx := GetServerContextFromCmd(cmd)
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
+			if queryOnly, _ := cmd.Flags().GetBool(FlagQueryOnly); queryOnly {
+				serverCtx.Logger.Info("starting query-only node without Tendermint")
+				return startQueryOnly(serverCtx, clientCtx, appCreator)
+			}
+
 			withTM, _ := cmd.Flags().GetBool(flagWithTendermint)
 			if !withTM {
 				serverCtx.Logger.Info("starting ABCI without Tendermint")
@@ -121,6 +139,8 @@ which accepts a path for the resulting pprof file.
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.Flags().Bool(flagWithTendermint, true, "Run abci app embedded in-process with tendermint")
+	cmd.Flags().Bool(FlagQueryOnly, false, "Only serve gRPC and REST queries of the application state on disk, read next to the node writing it, without running tendermint")
+	cmd.Flags().Duration(FlagCatchUpInterval, 5*time.Second, "Interval at which a query-only node catches up with the state committed by the node writing it")
 	cmd.Flags().String(flagAddress, "tcp://0.0.0.0:26658", "Listen address")
 	cmd.Flags().String(flagTransport, "socket", "Transport protocol: socket, grpc")
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
@@ -143,6 +163,7 @@ which accepts a path for the resulting pprof file.
 
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotFormat, snapshottypes.CurrentFormat, "State sync snapshot format (2 for zlib, 3 for zstd compression)")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -183,6 +204,8 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 		if err = svr.Stop(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		closeApp(ctx, app)
 	}()
 
 	// Wait for SIGINT or SIGTERM signal
@@ -267,30 +290,10 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	var apiSrv *api.Server
 
 	if config.API.Enable {
-		genDoc, err := genDocProvider()
+		apiSrv, err = startAPIServer(ctx, clientCtx, app, config, genDocProvider)
 		if err != nil {
 			return err
 		}
-
-		clientCtx := clientCtx.
-			WithHomeDir(home).
-			WithChainID(genDoc.ChainID)
-
-		apiSrv = api.New(clientCtx, ctx.Logger.With("module", "api-server"))
-		app.RegisterAPIRoutes(apiSrv, config.API)
-		errCh := make(chan error)
-
-		go func() {
-			if err := apiSrv.Start(config); err != nil {
-				errCh <- err
-			}
-		}()
-
-		select {
-		case err := <-errCh:
-			return err
-		case <-time.After(5 * time.Second): // assume server started successfully
-		}
 	}
 
 	var grpcSrv *grpc.Server
@@ -306,6 +309,8 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			_ = tmNode.Stop()
 		}
 
+		closeApp(ctx, app)
+
 		if cpuProfileCleanup != nil {
 			cpuProfileCleanup()
 		}
@@ -324,3 +329,151 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	// Wait for SIGINT or SIGTERM signal
 	return WaitForQuitSignals()
 }
+
+// secondaryApp is an application which can serve the queries of a database written by
+// another node.
+type secondaryApp interface {
+	types.Application
+
+	ReloadLatestVersion() error
+}
+
+// startQueryOnly serves the gRPC and REST queries of the application state on disk without
+// running Tendermint. The application database is opened as a secondary, next to the full
+// node writing it if any, so the state is neither committed to nor pruned, and queries can be
+// served at any height retained on disk. The node catches up with the state committed by the
+// full node at the catch up interval.
+func startQueryOnly(ctx *Context, clientCtx client.Context, appCreator types.AppCreator) error {
+	cfg := ctx.Config
+	home := cfg.RootDir
+
+	config := config.GetConfig(ctx.Viper)
+	if !config.API.Enable && !config.GRPC.Enable {
+		return errors.New("a query-only node requires the API or gRPC server to be enabled")
+	}
+
+	catchUpInterval := ctx.Viper.GetDuration(FlagCatchUpInterval)
+	if catchUpInterval <= 0 {
+		return fmt.Errorf("invalid catch up interval %s, must be positive", catchUpInterval)
+	}
+
+	db, err := openSecondaryDB(home)
+	if err != nil {
+		return err
+	}
+
+	app, ok := appCreator(ctx.Logger, db, nil, ctx.Viper).(secondaryApp)
+	if !ok {
+		return errors.New("the application cannot serve the queries of a database written by another node")
+	}
+	if err := app.ReloadLatestVersion(); err != nil {
+		return err
+	}
+	ctx.Logger.Info("serving queries of the application state on disk",
+		"height", app.Info(proxy.RequestInfo).LastBlockHeight)
+
+	// There is no Tendermint node to query, so ABCI queries are sent to the app directly.
+	clientCtx = clientCtx.WithClient(newQueryOnlyClient(app))
+	app.RegisterTxService(clientCtx)
+
+	var apiSrv *api.Server
+	if config.API.Enable {
+		apiSrv, err = startAPIServer(ctx, clientCtx, app, config, node.DefaultGenesisDocProviderFunc(cfg))
+		if err != nil {
+			return err
+		}
+	}
+
+	var grpcSrv *grpc.Server
+	if config.GRPC.Enable {
+		grpcSrv, err = servergrpc.StartGRPCServer(app, config.GRPC.Address)
+		if err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(catchUpInterval)
+	go func() {
+		for range ticker.C {
+			if err := catchUp(db, app); err != nil {
+				ctx.Logger.Error("failed to catch up with the application state on disk", "err", err)
+			}
+		}
+	}()
+
+	defer func() {
+		ticker.Stop()
+
+		if apiSrv != nil {
+			_ = apiSrv.Close()
+		}
+
+		if grpcSrv != nil {
+			grpcSrv.Stop()
+		}
+
+		closeApp(ctx, app)
+		_ = db.Close()
+
+		ctx.Logger.Info("exiting...")
+	}()
+
+	// Wait for SIGINT or SIGTERM signal
+	return WaitForQuitSignals()
+}
+
+// closeApp closes the application on shutdown if it holds resources to release, such as the
+// files of its streaming services. It must be called once the application stopped processing
+// ABCI messages.
+func closeApp(ctx *Context, app types.Application) {
+	closer, ok := app.(io.Closer)
+	if !ok {
+		return
+	}
+	if err := closer.Close(); err != nil {
+		ctx.Logger.Error("failed to close the application", "err", err)
+	}
+}
+
+// catchUp reopens the secondary database of a query-only node, and reloads the latest state
+// committed to it.
+func catchUp(db *secondaryDB, app secondaryApp) error {
+	if err := db.CatchUp(); err != nil {
+		return err
+	}
+	return app.ReloadLatestVersion()
+}
+
+// startAPIServer starts the API server with the routes of the app, and waits for it to either
+// fail or come up.
+func startAPIServer(
+	ctx *Context, clientCtx client.Context, app types.Application, config config.Config,
+	genDocProvider node.GenesisDocProvider,
+) (*api.Server, error) {
+	genDoc, err := genDocProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	clientCtx = clientCtx.
+		WithHomeDir(ctx.Config.RootDir).
+		WithChainID(genDoc.ChainID)
+
+	apiSrv := api.New(clientCtx, ctx.Logger.With("module", "api-server"))
+	app.RegisterAPIRoutes(apiSrv, config.API)
+	errCh := make(chan error)
+
+	go func() {
+		if err := apiSrv.Start(config); err != nil {
+			errCh <- err
+		}
+	}()
+
+	select {
+	case err := <-errCh:
+		return nil, err
+	case <-time.After(5 * time.Second): // assume server started successfully
+	}
+
+	return apiSrv, nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 )
@@ -223,6 +224,7 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		flags.LineBreak,
 		tendermintCmd,
 		ExportCmd(appExport, defaultNodeHome),
+		SnapshotsCmd(appCreator),
 		flags.LineBreak,
 		version.NewVersionCommand(),
 	)
@@ -321,6 +323,16 @@ func openDB(rootDir string) (dbm.DB, error) {
 	return sdk.NewLevelDB("application", dataDir)
 }
 
+// GetSnapshotStore opens the local state sync snapshot store of the node home directory.
+func GetSnapshotStore(rootDir string) (*snapshots.Store, error) {
+	snapshotDir := filepath.Join(rootDir, "data", "snapshots")
+	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+	return snapshots.NewStore(snapshotDB, snapshotDir)
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
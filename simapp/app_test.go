@@ -3,13 +3,23 @@ package simapp
 import (
 	"encoding/json"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
-	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/streaming"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 func TestSimAppExport(t *testing.T) {
@@ -35,6 +45,25 @@ func TestSimAppExport(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+func TestSimAppStreaming(t *testing.T) {
+	home := t.TempDir()
+	appOpts := viper.New()
+	appOpts.Set(flags.FlagHome, home)
+	appOpts.Set(streaming.OptStoreStreamers, []string{streaming.FileStreamer})
+	appOpts.Set(streaming.OptStreamersFileKeys, []string{"*"})
+	appOpts.Set(streaming.OptStreamersFileWriteDir, "streaming")
+
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, home, 0, MakeTestEncodingConfig(), appOpts)
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{AppStateBytes: stateBytes})
+
+	// the node stops in the middle of the block, whose file is closed with the app
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	require.NoError(t, app.Close())
+	require.FileExists(t, filepath.Join(home, "streaming", "block-1"))
+}
+
 // ensure that blocked addresses are properly set in bank keeper
 func TestBlockedAddrs(t *testing.T) {
 	db := dbm.NewMemDB()
@@ -49,3 +78,198 @@ func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
+
+func TestRunMigrations(t *testing.T) {
+	db := dbm.NewMemDB()
+	encCfg := MakeTestEncodingConfig()
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	app := NewSimApp(logger, db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg, EmptyAppOptions{})
+
+	// Create a new baseapp and configurator for the purpose of this test.
+	bApp := baseapp.NewBaseApp(appName, logger, db, encCfg.TxConfig.TxDecoder())
+	bApp.SetCommitMultiStoreTracer(nil)
+	bApp.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	app.BaseApp = bApp
+	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+
+	// We register all modules on the Configurator, except x/bank. x/bank will
+	// serve as the test subject on which we run the migration tests.
+	//
+	// The loop below is the same as calling `RegisterServices` on
+	// ModuleManager, except that we skip x/bank.
+	for _, module := range app.mm.Modules {
+		if module.Name() == banktypes.ModuleName {
+			continue
+		}
+
+		module.RegisterServices(app.configurator)
+	}
+
+	// Initialize the chain
+	app.InitChain(abci.RequestInitChain{})
+	app.Commit()
+
+	// called counts the bank migration handlers invoked during a test case.
+	// It is shared by all registered handlers, since a migration registered
+	// in one test case stays registered on the configurator for the next ones.
+	called := 0
+
+	testCases := []struct {
+		name         string
+		moduleName   string // module to register migrations for, if any
+		regFrom      uint64 // first version to register a migration for
+		regTo        uint64 // register migrations up to (excluding) this version
+		runFrom      uint64
+		runTo        uint64
+		expRegErrMsg string // expected error while registering migration
+		expRunErrMsg string // expected error while running migration
+		expCalled    int
+	}{
+		{
+			"cannot register migration for version 0",
+			"bank", 0, 1, 0, 0,
+			"module migration versions should start at 1: invalid version", "", 0,
+		},
+		{
+			"throws error on RunMigrations if no migration registered for bank",
+			"", 0, 0, 1, 2,
+			"", "no migrations found for module bank: not found", 0,
+		},
+		{
+			"can register and run migration handler for x/bank",
+			"bank", 1, 2, 1, 2,
+			"", "", 1,
+		},
+		{
+			"cannot register migration handler for same module & fromVersion",
+			"bank", 1, 2, 0, 0,
+			"another migration for module bank and version 1 already exists: internal logic error", "", 0,
+		},
+		{
+			"throws error on RunMigrations if a migration in the range is missing",
+			"", 0, 0, 1, 3,
+			"", "no migration found for module bank from version 2 to version 3: not found", 1,
+		},
+		{
+			"can register and run migration handler for the next version",
+			"bank", 2, 3, 2, 3,
+			"", "", 1,
+		},
+		{
+			"runs migration handlers for two consecutive versions",
+			"", 0, 0, 1, 3,
+			"", "", 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			called = 0
+
+			// Since it's very hard to test actual in-place store migrations in
+			// tests (due to the difficulty of maintaining multiple versions of a
+			// module), we're just testing here that the migration logic is
+			// called.
+			for i := tc.regFrom; i < tc.regTo; i++ {
+				// Register migration for module from version `i` to `i+1`.
+				err := app.configurator.RegisterMigration(tc.moduleName, i, func(sdk.Context) error {
+					called++
+
+					return nil
+				})
+
+				if tc.expRegErrMsg != "" {
+					require.EqualError(t, err, tc.expRegErrMsg)
+
+					return
+				}
+				require.NoError(t, err)
+			}
+
+			if tc.runTo == 0 {
+				return
+			}
+
+			// Run migrations only for bank. All other modules are already
+			// at their latest ConsensusVersion.
+			fromVM := app.mm.GetVersionMap()
+			fromVM[banktypes.ModuleName] = tc.runFrom
+			toVM := app.mm.GetVersionMap()
+			toVM[banktypes.ModuleName] = tc.runTo
+
+			_, err := runMigrationsTo(app, toVM, fromVM)
+			if tc.expRunErrMsg != "" {
+				require.EqualError(t, err, tc.expRunErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			// Make sure bank's migrations are called.
+			require.Equal(t, tc.expCalled, called)
+		})
+	}
+}
+
+func TestInitGenesisOnMigration(t *testing.T) {
+	db := dbm.NewMemDB()
+	encCfg := MakeTestEncodingConfig()
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	app := NewSimApp(logger, db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg, EmptyAppOptions{})
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	// Simulate a chain that did not know about x/mint yet: it is absent
+	// from the version map, so RunMigrations must call its InitGenesis with
+	// the default genesis.
+	fromVM := app.mm.GetVersionMap()
+	delete(fromVM, minttypes.ModuleName)
+
+	toVM, err := app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	require.NoError(t, err)
+	require.Equal(t, app.mm.GetVersionMap(), toVM)
+	require.Equal(t, minttypes.DefaultGenesisState().Params, app.MintKeeper.GetParams(ctx))
+	require.NotNil(t, app.AccountKeeper.GetModuleAccount(ctx, minttypes.ModuleName))
+}
+
+func TestUpgradeStateOnGenesis(t *testing.T) {
+	encCfg := MakeTestEncodingConfig()
+	db := dbm.NewMemDB()
+	app := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg, EmptyAppOptions{})
+	genesisState := NewDefaultGenesisState()
+	stateBytes, err := json.MarshalIndent(genesisState, "", "  ")
+	require.NoError(t, err)
+
+	// Initialize the chain
+	app.InitChain(
+		abci.RequestInitChain{
+			Validators:    []abci.ValidatorUpdate{},
+			AppStateBytes: stateBytes,
+		},
+	)
+
+	// make sure the upgrade keeper has version map in state
+	ctx := app.NewContext(false, tmproto.Header{})
+	vm := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	for v, i := range app.mm.Modules {
+		require.Equal(t, vm[v], i.ConsensusVersion())
+	}
+}
+
+// runMigrationsTo runs the in-place store migrations of the app's module
+// manager, pretending the modules' latest consensus versions are the ones in
+// toVM.
+func runMigrationsTo(app *SimApp, toVM, fromVM module.VersionMap) (module.VersionMap, error) {
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	mm := module.NewManager()
+	for name, m := range app.mm.Modules {
+		mm.Modules[name] = versionedModule{AppModule: m, version: toVM[name]}
+	}
+
+	return mm.RunMigrations(ctx, app.configurator, fromVM)
+}
+
+// versionedModule overrides the ConsensusVersion of the wrapped AppModule.
+type versionedModule struct {
+	module.AppModule
+	version uint64
+}
+
+func (m versionedModule) ConsensusVersion() uint64 { return m.version }
@@ -4,7 +4,6 @@ import (
 	"context"
 	"io"
 	"os"
-	"path/filepath"
 
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
@@ -184,14 +183,13 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		panic(err)
 	}
 
-	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
-	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
-	if err != nil {
-		panic(err)
-	}
-	snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
-	if err != nil {
-		panic(err)
+	// a query-only node takes no snapshots, and must not write to the home directory
+	var snapshotStore *snapshots.Store
+	if !cast.ToBool(appOpts.Get(server.FlagQueryOnly)) {
+		snapshotStore, err = server.GetSnapshotStore(cast.ToString(appOpts.Get(flags.FlagHome)))
+		if err != nil {
+			panic(err)
+		}
 	}
 
 	return simapp.NewSimApp(
@@ -211,6 +209,7 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		baseapp.SetSnapshotStore(snapshotStore),
 		baseapp.SetSnapshotInterval(cast.ToUint64(appOpts.Get(server.FlagStateSyncSnapshotInterval))),
 		baseapp.SetSnapshotKeepRecent(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotKeepRecent))),
+		baseapp.SetSnapshotFormat(cast.ToUint32(appOpts.Get(server.FlagStateSyncSnapshotFormat))),
 	)
 }
 
@@ -3,8 +3,11 @@ package snapshots
 import (
 	"bytes"
 	"crypto/sha256"
+	"hash"
 	"io"
 	"io/ioutil"
+	"math"
+	"sort"
 	"sync"
 
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
@@ -42,24 +45,86 @@ type restoreDone struct {
 //
 // 2) io.ReadCloser streams automatically propagate IO errors, and can pass arbitrary
 //    errors via io.Pipe.CloseWithError().
+//
+// The manager owns the snapshot stream: the multistore items are written first, followed by the
+// items of each registered extension snapshotter in order of name, all of them chunked and
+// compressed as a single stream.
 type Manager struct {
-	store  *Store
-	target types.Snapshotter
-
-	mtx                sync.Mutex
-	operation          operation
-	chRestore          chan<- io.ReadCloser
-	chRestoreDone      <-chan restoreDone
-	restoreChunkHashes [][]byte
-	restoreChunkIndex  uint32
+	store      *Store
+	multistore types.Snapshotter
+	extensions map[string]types.ExtensionSnapshotter
+	format     uint32
+
+	mtx                 sync.Mutex
+	operation           operation
+	chRestore           chan<- io.ReadCloser
+	chRestoreDone       <-chan restoreDone
+	restoreChunkHashes  [][]byte
+	restoreChunkIndex   uint32
+	restoreSnapshotHash []byte
+	restoreHasher       hash.Hash
 }
 
 // NewManager creates a new manager.
-func NewManager(store *Store, target types.Snapshotter) *Manager {
+func NewManager(store *Store, multistore types.Snapshotter) *Manager {
 	return &Manager{
-		store:  store,
-		target: target,
+		store:      store,
+		multistore: multistore,
+		extensions: make(map[string]types.ExtensionSnapshotter),
+		format:     types.CurrentFormat,
+	}
+}
+
+// SetSnapshotFormat sets the format used when taking snapshots, which determines how they are
+// compressed. Snapshots in any supported format can be restored regardless of this setting.
+func (m *Manager) SetSnapshotFormat(format uint32) error {
+	if err := types.ValidateFormat(format); err != nil {
+		return err
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.format = format
+	return nil
+}
+
+// RegisterExtensions registers extension snapshotters with the manager. Extension names must be
+// unique, and each extension must be able to restore its own snapshot format.
+func (m *Manager) RegisterExtensions(extensions ...types.ExtensionSnapshotter) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	for _, extension := range extensions {
+		name := extension.SnapshotName()
+		if _, ok := m.extensions[name]; ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "duplicated snapshotter name: %s", name)
+		}
+		if !IsFormatSupported(extension, extension.SnapshotFormat()) {
+			return sdkerrors.Wrapf(types.ErrUnknownFormat,
+				"snapshotter %s does not support its own format %v", name, extension.SnapshotFormat())
+		}
+		m.extensions[name] = extension
+	}
+	return nil
+}
+
+// sortedExtensionNames returns the names of the registered extensions in sorted order, which is
+// the order they appear in the snapshot stream.
+func (m *Manager) sortedExtensionNames() []string {
+	names := make([]string, 0, len(m.extensions))
+	for name := range m.extensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsFormatSupported returns whether the extension snapshotter can restore the given format.
+func IsFormatSupported(snapshotter types.ExtensionSnapshotter, format uint32) bool {
+	for _, supported := range snapshotter.SupportedFormats() {
+		if supported == format {
+			return true
+		}
 	}
+	return false
 }
 
 // begin starts an operation, or errors if one is in progress. It manages the mutex itself.
@@ -98,6 +163,8 @@ func (m *Manager) endLocked() {
 	m.chRestoreDone = nil
 	m.restoreChunkHashes = nil
 	m.restoreChunkIndex = 0
+	m.restoreSnapshotHash = nil
+	m.restoreHasher = nil
 }
 
 // Create creates a snapshot and returns its metadata.
@@ -120,11 +187,55 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
-	chunks, err := m.target.Snapshot(height, types.CurrentFormat)
-	if err != nil {
-		return nil, err
+	m.mtx.Lock()
+	format := m.format
+	m.mtx.Unlock()
+
+	ch := make(chan io.ReadCloser)
+	go m.createSnapshot(height, format, ch)
+
+	return m.store.Save(height, format, ch)
+}
+
+// createSnapshot writes the multistore and extension snapshot items into the chunk channel. Any
+// error is passed on to the chunk reader.
+func (m *Manager) createSnapshot(height uint64, format uint32, ch chan<- io.ReadCloser) {
+	streamWriter := NewStreamWriter(ch, format)
+	if streamWriter == nil {
+		return
+	}
+	if err := m.multistore.Snapshot(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+	for _, name := range m.sortedExtensionNames() {
+		extension := m.extensions[name]
+		err := streamWriter.WriteMsg(&types.SnapshotItem{
+			Item: &types.SnapshotItem_Extension{
+				Extension: &types.SnapshotExtensionMeta{
+					Name:   name,
+					Format: extension.SnapshotFormat(),
+				},
+			},
+		})
+		if err != nil {
+			streamWriter.CloseWithError(err)
+			return
+		}
+		payloadWriter := func(payload []byte) error {
+			return types.WriteExtensionItem(streamWriter, payload)
+		}
+		if err := extension.SnapshotExtension(height, payloadWriter); err != nil {
+			streamWriter.CloseWithError(sdkerrors.Wrapf(err, "extension %s snapshot", name))
+			return
+		}
 	}
-	return m.store.Save(height, types.CurrentFormat, chunks)
+	_ = streamWriter.Close()
+}
+
+// Close closes the snapshot store of the manager. The manager must not be used afterwards.
+func (m *Manager) Close() error {
+	return m.store.Close()
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
@@ -160,6 +271,16 @@ func (m *Manager) Prune(retain uint32) (uint64, error) {
 // Restore begins an async snapshot restoration, mirroring ABCI OfferSnapshot. Chunks must be fed
 // via RestoreChunk() until the restore is complete or a chunk fails.
 func (m *Manager) Restore(snapshot types.Snapshot) error {
+	if err := types.ValidateFormat(snapshot.Format); err != nil {
+		return err
+	}
+	if snapshot.Height == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot restore snapshot at height 0")
+	}
+	if snapshot.Height > uint64(math.MaxInt64) {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata,
+			"snapshot height %v cannot exceed %v", snapshot.Height, int64(math.MaxInt64))
+	}
 	if snapshot.Chunks == 0 {
 		return sdkerrors.Wrap(types.ErrInvalidMetadata, "no chunks")
 	}
@@ -177,10 +298,9 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 
 	// Start an asynchronous snapshot restoration, passing chunks and completion status via channels.
 	chChunks := make(chan io.ReadCloser, chunkBufferSize)
-	chReady := make(chan struct{}, 1)
 	chDone := make(chan restoreDone, 1)
 	go func() {
-		err := m.target.Restore(snapshot.Height, snapshot.Format, chChunks, chReady)
+		err := m.restoreSnapshot(snapshot, chChunks)
 		chDone <- restoreDone{
 			complete: err == nil,
 			err:      err,
@@ -188,21 +308,72 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 		close(chDone)
 	}()
 
-	// Check for any initial errors from the restore, before any chunks are fed.
-	select {
-	case done := <-chDone:
-		m.endLocked()
-		if done.err != nil {
-			return done.err
-		}
-		return sdkerrors.Wrap(sdkerrors.ErrLogic, "restore ended unexpectedly")
-	case <-chReady:
-	}
-
 	m.chRestore = chChunks
 	m.chRestoreDone = chDone
 	m.restoreChunkHashes = snapshot.Metadata.ChunkHashes
 	m.restoreChunkIndex = 0
+	m.restoreSnapshotHash = snapshot.Hash
+	m.restoreHasher = sha256.New()
+	return nil
+}
+
+// restoreSnapshot restores the multistore from the chunk stream, and then passes the remaining
+// items on to the extension snapshotters named by the extension items, in stream order.
+func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
+	streamReader, err := NewStreamReader(chChunks, snapshot.Format)
+	if err != nil {
+		return err
+	}
+	defer streamReader.Close()
+
+	nextItem, err := m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	if err != nil {
+		return sdkerrors.Wrap(err, "multistore restore")
+	}
+	for nextItem.Item != nil {
+		metadata := nextItem.GetExtension()
+		if metadata == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot item %T", nextItem.Item)
+		}
+		extension, ok := m.extensions[metadata.Name]
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot extension %s", metadata.Name)
+		}
+		if !IsFormatSupported(extension, metadata.Format) {
+			return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v for extension %s",
+				metadata.Format, metadata.Name)
+		}
+
+		// The payload reader leaves the first item following the extension's payloads in nextItem,
+		// or an empty item at the end of the stream.
+		exhausted := false
+		payloadReader := func() ([]byte, error) {
+			if exhausted {
+				return nil, io.EOF
+			}
+			nextItem.Reset()
+			err := streamReader.ReadMsg(&nextItem)
+			if err == io.EOF {
+				exhausted = true
+				return nil, io.EOF
+			} else if err != nil {
+				return nil, sdkerrors.Wrap(err, "invalid protobuf message")
+			}
+			payload := nextItem.GetExtensionPayload()
+			if payload == nil {
+				exhausted = true
+				return nil, io.EOF
+			}
+			return payload.Payload, nil
+		}
+		if err := extension.RestoreExtension(snapshot.Height, metadata.Format, payloadReader); err != nil {
+			return sdkerrors.Wrapf(err, "extension %s restore", metadata.Name)
+		}
+		if !exhausted {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "extension %s did not read all of its payloads",
+				metadata.Name)
+		}
+	}
 	return nil
 }
 
@@ -235,7 +406,27 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	expected := m.restoreChunkHashes[m.restoreChunkIndex]
 	if !bytes.Equal(hash[:], expected) {
 		return false, sdkerrors.Wrapf(types.ErrChunkHashMismatch,
-			"expected %x, got %x", hash, expected)
+			"expected %x, got %x", expected, hash)
+	}
+
+	// Verify the snapshot hash as the chunks stream in. Once the final chunk arrives, the hash is
+	// checked before the chunk is passed on, so that a snapshot with a mismatching hash can never
+	// be restored completely. The restore is aborted instead.
+	m.restoreHasher.Write(chunk)
+	if int(m.restoreChunkIndex)+1 >= len(m.restoreChunkHashes) {
+		sum := m.restoreHasher.Sum(nil)
+		if !bytes.Equal(sum, m.restoreSnapshotHash) {
+			err := sdkerrors.Wrapf(types.ErrSnapshotHashMismatch,
+				"expected %x, got %x", m.restoreSnapshotHash, sum)
+			pr, pw := io.Pipe()
+			_ = pw.CloseWithError(err)
+			m.chRestore <- pr
+			close(m.chRestore)
+			m.chRestore = nil
+			<-m.chRestoreDone
+			m.endLocked()
+			return false, err
+		}
 	}
 
 	// Pass the chunk to the restore, and wait for completion if it was the final one.
@@ -257,3 +448,58 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	}
 	return false, nil
 }
+
+// RestoreLocalSnapshot restores a snapshot of the local snapshot store, e.g. one imported from
+// another node, feeding its chunks to a restore as RestoreChunk does. The chunk hashes and the
+// snapshot hash are verified the same way.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	snapshot, chunks, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot at height %v format %v", height, format)
+	}
+	defer DrainChunks(chunks)
+
+	if err := m.Restore(*snapshot); err != nil {
+		return err
+	}
+	for chunk := range chunks {
+		body, err := ioutil.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			m.abortRestore(err)
+			return err
+		}
+		done, err := m.RestoreChunk(body)
+		if err != nil {
+			m.abortRestore(err)
+			return err
+		}
+		if done {
+			return nil
+		}
+	}
+	err = sdkerrors.Wrapf(sdkerrors.ErrLogic, "snapshot at height %v format %v ended before its last chunk",
+		height, format)
+	m.abortRestore(err)
+	return err
+}
+
+// abortRestore aborts an active restore operation with the given error, so that the snapshotters
+// fail instead of committing a partial restore.
+func (m *Manager) abortRestore(err error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.operation != opRestore || m.chRestore == nil {
+		return
+	}
+	pr, pw := io.Pipe()
+	_ = pw.CloseWithError(err)
+	m.chRestore <- pr
+	close(m.chRestore)
+	m.chRestore = nil
+	<-m.chRestoreDone
+	m.endLocked()
+}
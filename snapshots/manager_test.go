@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestManager_List(t *testing.T) {
@@ -58,14 +59,21 @@ func TestManager_LoadChunk(t *testing.T) {
 func TestManager_Take(t *testing.T) {
 	store, teardown := setupStore(t)
 	defer teardown()
+	items := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
 	snapshotter := &mockSnapshotter{
-		chunks: [][]byte{
-			{1, 2, 3},
-			{4, 5, 6},
-			{7, 8, 9},
-		},
+		items: items,
 	}
+	extension := &mockExtension{
+		name:  "mock",
+		items: [][]byte{{10, 11, 12}},
+	}
+	expectChunks := snapshotItems(types.CurrentFormat, items, extension)
 	manager := snapshots.NewManager(store, snapshotter)
+	require.NoError(t, manager.RegisterExtensions(extension))
 
 	// nil manager should return error
 	_, err := (*snapshots.Manager)(nil).Create(1)
@@ -81,21 +89,17 @@ func TestManager_Take(t *testing.T) {
 	assert.Equal(t, &types.Snapshot{
 		Height: 5,
 		Format: types.CurrentFormat,
-		Chunks: 3,
-		Hash:   []uint8{0x47, 0xe4, 0xee, 0x7f, 0x21, 0x1f, 0x73, 0x26, 0x5d, 0xd1, 0x76, 0x58, 0xf6, 0xe2, 0x1c, 0x13, 0x18, 0xbd, 0x6c, 0x81, 0xf3, 0x75, 0x98, 0xe2, 0xa, 0x27, 0x56, 0x29, 0x95, 0x42, 0xef, 0xcf},
+		Chunks: 1,
+		Hash:   hash(expectChunks),
 		Metadata: types.Metadata{
-			ChunkHashes: [][]byte{
-				checksum([]byte{1, 2, 3}),
-				checksum([]byte{4, 5, 6}),
-				checksum([]byte{7, 8, 9}),
-			},
+			ChunkHashes: checksums(expectChunks),
 		},
 	}, snapshot)
 
 	storeSnapshot, chunks, err := store.Load(snapshot.Height, snapshot.Format)
 	require.NoError(t, err)
 	assert.Equal(t, snapshot, storeSnapshot)
-	assert.Equal(t, [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, readChunks(chunks))
+	assert.Equal(t, expectChunks, readChunks(chunks))
 
 	// creating a snapshot while a different snapshot is being created should error
 	manager, teardown = setupBusyManager(t)
@@ -104,6 +108,18 @@ func TestManager_Take(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestManager_RegisterExtensions(t *testing.T) {
+	store, teardown := setupStore(t)
+	defer teardown()
+	manager := snapshots.NewManager(store, &mockSnapshotter{})
+
+	require.NoError(t, manager.RegisterExtensions(&mockExtension{name: "a"}, &mockExtension{name: "b"}))
+
+	// registering an extension with a duplicate name should error
+	err := manager.RegisterExtensions(&mockExtension{name: "a"})
+	require.Error(t, err)
+}
+
 func TestManager_Prune(t *testing.T) {
 	store, teardown := setupStore(t)
 	defer teardown()
@@ -128,13 +144,18 @@ func TestManager_Restore(t *testing.T) {
 	store, teardown := setupStore(t)
 	defer teardown()
 	target := &mockSnapshotter{}
+	extension := &mockExtension{name: "mock"}
 	manager := snapshots.NewManager(store, target)
+	require.NoError(t, manager.RegisterExtensions(extension))
 
-	chunks := [][]byte{
+	items := [][]byte{
 		{1, 2, 3},
 		{4, 5, 6},
 		{7, 8, 9},
 	}
+	extensionItems := [][]byte{{10, 11, 12}}
+	chunks := snapshotItems(types.CurrentFormat, items, &mockExtension{name: "mock", items: extensionItems})
+	snapshotHash := hash(chunks)
 
 	// Restore errors on invalid format
 	err := manager.Restore(types.Snapshot{
@@ -145,16 +166,16 @@ func TestManager_Restore(t *testing.T) {
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
 	})
 	require.Error(t, err)
-	require.Equal(t, types.ErrUnknownFormat, err)
+	require.True(t, errors.Is(err, types.ErrUnknownFormat))
 
 	// Restore errors on no chunks
-	err = manager.Restore(types.Snapshot{Height: 3, Format: 1, Hash: []byte{1, 2, 3}})
+	err = manager.Restore(types.Snapshot{Height: 3, Format: types.CurrentFormat, Hash: []byte{1, 2, 3}})
 	require.Error(t, err)
 
 	// Restore errors on chunk and chunkhashes mismatch
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   1,
+		Format:   types.CurrentFormat,
 		Hash:     []byte{1, 2, 3},
 		Chunks:   4,
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
@@ -164,9 +185,9 @@ func TestManager_Restore(t *testing.T) {
 	// Starting a restore works
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   1,
-		Hash:     []byte{1, 2, 3},
-		Chunks:   3,
+		Format:   types.CurrentFormat,
+		Hash:     snapshotHash,
+		Chunks:   uint32(len(chunks)),
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
 	})
 	require.NoError(t, err)
@@ -194,28 +215,129 @@ func TestManager_Restore(t *testing.T) {
 		}
 	}
 
-	assert.Equal(t, chunks, target.chunks)
+	assert.Equal(t, items, target.items)
+	assert.Equal(t, extensionItems, extension.items)
 
-	// Starting a new restore should fail now, because the target already has contents.
+	// Restoring the snapshot again should fail now, because the target already has contents.
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   1,
-		Hash:     []byte{1, 2, 3},
-		Chunks:   3,
+		Format:   types.CurrentFormat,
+		Hash:     snapshotHash,
+		Chunks:   uint32(len(chunks)),
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
 	})
+	require.NoError(t, err)
+	_, err = manager.RestoreChunk(chunks[0])
 	require.Error(t, err)
 
 	// But if we clear out the target we should be able to start a new restore. This time we'll
 	// fail it with a checksum error. That error should stop the operation, so that we can do
 	// a prune operation right after.
-	target.chunks = nil
+	target.items = nil
 	err = manager.Restore(types.Snapshot{
 		Height:   3,
-		Format:   1,
+		Format:   types.CurrentFormat,
+		Hash:     snapshotHash,
+		Chunks:   uint32(len(chunks)),
+		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
+	})
+	require.NoError(t, err)
+}
+
+func TestManager_Restore_UnknownExtension(t *testing.T) {
+	store, teardown := setupStore(t)
+	defer teardown()
+	manager := snapshots.NewManager(store, &mockSnapshotter{})
+
+	chunks := snapshotItems(types.CurrentFormat, [][]byte{{1, 2, 3}},
+		&mockExtension{name: "unknown", items: [][]byte{{4}}})
+	err := manager.Restore(types.Snapshot{
+		Height:   3,
+		Format:   types.CurrentFormat,
+		Hash:     hash(chunks),
+		Chunks:   uint32(len(chunks)),
+		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
+	})
+	require.NoError(t, err)
+
+	_, err = manager.RestoreChunk(chunks[0])
+	require.Error(t, err)
+}
+
+func TestManager_Restore_SnapshotHashMismatch(t *testing.T) {
+	store, teardown := setupStore(t)
+	defer teardown()
+	target := &mockSnapshotter{}
+	manager := snapshots.NewManager(store, target)
+
+	chunks := snapshotItems(types.CurrentFormat, [][]byte{{1, 2, 3}, {4, 5, 6}})
+	err := manager.Restore(types.Snapshot{
+		Height:   3,
+		Format:   types.CurrentFormat,
 		Hash:     []byte{1, 2, 3},
-		Chunks:   3,
+		Chunks:   uint32(len(chunks)),
 		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
 	})
 	require.NoError(t, err)
+
+	// The final chunk is rejected because of the snapshot hash, which aborts the restore.
+	_, err = manager.RestoreChunk(chunks[len(chunks)-1])
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrSnapshotHashMismatch))
+
+	_, err = manager.Prune(1)
+	require.NoError(t, err)
+}
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	store, teardown := setupStore(t)
+	defer teardown()
+	target := &mockSnapshotter{}
+	manager := snapshots.NewManager(store, target)
+
+	items := [][]byte{{1, 2, 3}, {4, 5, 6}}
+	_, err := store.Save(4, types.CurrentFormat, makeChunks(snapshotItems(types.CurrentFormat, items)))
+	require.NoError(t, err)
+
+	err = manager.RestoreLocalSnapshot(5, types.CurrentFormat)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, sdkerrors.ErrNotFound))
+
+	require.NoError(t, manager.RestoreLocalSnapshot(4, types.CurrentFormat))
+	assert.Equal(t, items, target.items)
+
+	// the restore is over, so other operations can run
+	_, err = manager.Prune(1)
+	require.NoError(t, err)
+}
+
+func TestManager_SnapshotFormat(t *testing.T) {
+	store, teardown := setupStore(t)
+	defer teardown()
+	items := [][]byte{{1, 2, 3}, {4, 5, 6}}
+	source := snapshots.NewManager(store, &mockSnapshotter{items: items})
+
+	// unknown formats can't be used
+	require.Error(t, source.SetSnapshotFormat(0))
+	require.Error(t, source.SetSnapshotFormat(9))
+
+	require.NoError(t, source.SetSnapshotFormat(types.FormatZstd))
+	snapshot, err := source.Create(5)
+	require.NoError(t, err)
+	require.Equal(t, types.FormatZstd, snapshot.Format)
+
+	_, chunkReaders, err := store.Load(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	chunks := readChunks(chunkReaders)
+	require.Equal(t, snapshotItems(types.FormatZstd, items), chunks)
+
+	// a snapshot in any supported format can be restored, regardless of the snapshot format
+	target := &mockSnapshotter{}
+	manager := snapshots.NewManager(store, target)
+	require.NoError(t, manager.Restore(*snapshot))
+	for _, chunk := range chunks {
+		_, err := manager.RestoreChunk(chunk)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, items, target.items)
 }
@@ -48,6 +48,11 @@ func NewStore(db db.DB, dir string) (*Store, error) {
 	}, nil
 }
 
+// Close closes the snapshot database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
 // Delete deletes a snapshot.
 func (s *Store) Delete(height uint64, format uint32) error {
 	s.mtx.Lock()
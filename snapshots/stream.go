@@ -0,0 +1,161 @@
+package snapshots
+
+import (
+	"bufio"
+	"compress/zlib"
+	"io"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// Do not change chunk size without new snapshot format (must be uniform across nodes)
+	snapshotChunkSize  = uint64(10e6)
+	snapshotBufferSize = int(snapshotChunkSize)
+	// Do not lower the max message size below 64 MB, as existing snapshots may contain items
+	// up to this size (the SDK has no key/value size limit, so we set an arbitrary limit)
+	snapshotMaxItemSize = int(64e6)
+)
+
+// newCompressor returns a writer compressing the snapshot item stream as given by the format.
+// Compression settings must not change for an existing format, since snapshots of a given format
+// must be identical across nodes.
+func newCompressor(w io.Writer, format uint32) (io.WriteCloser, error) {
+	switch format {
+	case types.FormatZlib:
+		zWriter, err := zlib.NewWriterLevel(w, 7)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "zlib failure")
+		}
+		return zWriter, nil
+
+	case types.FormatZstd:
+		// a single encoder goroutine keeps the output independent of the number of CPUs
+		zWriter, err := zstd.NewWriter(w,
+			zstd.WithEncoderLevel(zstd.SpeedDefault), zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "zstd failure")
+		}
+		return zWriter, nil
+
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v", format)
+	}
+}
+
+// newDecompressor returns a reader decompressing the snapshot item stream as given by the format.
+func newDecompressor(r io.Reader, format uint32) (io.ReadCloser, error) {
+	switch format {
+	case types.FormatZlib:
+		zReader, err := zlib.NewReader(r)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "zlib failure")
+		}
+		return zReader, nil
+
+	case types.FormatZstd:
+		zReader, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "zstd failure")
+		}
+		return zReader.IOReadCloser(), nil
+
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v", format)
+	}
+}
+
+// StreamWriter set up a stream pipeline to serialize snapshot items:
+// snapshot items -> delimited Protobuf -> compression -> buffer -> chunkWriter -> chan io.ReadCloser
+type StreamWriter struct {
+	chunkWriter *ChunkWriter
+	bufWriter   *bufio.Writer
+	protoWriter protoio.WriteCloser
+}
+
+// NewStreamWriter sets up a new stream pipeline to serialize snapshot items into chunks,
+// compressed as given by the snapshot format. If the pipeline can't be set up, the chunk channel
+// is closed with an error and nil is returned.
+func NewStreamWriter(ch chan<- io.ReadCloser, format uint32) *StreamWriter {
+	chunkWriter := NewChunkWriter(ch, snapshotChunkSize)
+	bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
+	zWriter, err := newCompressor(bufWriter, format)
+	if err != nil {
+		chunkWriter.CloseWithError(err)
+		return nil
+	}
+	protoWriter := protoio.NewDelimitedWriter(zWriter)
+	return &StreamWriter{
+		chunkWriter: chunkWriter,
+		bufWriter:   bufWriter,
+		protoWriter: protoWriter,
+	}
+}
+
+// WriteMsg implements protoio.Writer.
+func (sw *StreamWriter) WriteMsg(msg proto.Message) error {
+	return sw.protoWriter.WriteMsg(msg)
+}
+
+// Close implements io.Closer, flushing and closing all stages of the pipeline.
+func (sw *StreamWriter) Close() error {
+	// closing the Protobuf writer also closes the compressing writer
+	if err := sw.protoWriter.Close(); err != nil {
+		sw.chunkWriter.CloseWithError(err)
+		return err
+	}
+	if err := sw.bufWriter.Flush(); err != nil {
+		sw.chunkWriter.CloseWithError(err)
+		return err
+	}
+	return sw.chunkWriter.Close()
+}
+
+// CloseWithError closes the stream and passes the error on to the chunk reader.
+func (sw *StreamWriter) CloseWithError(err error) {
+	sw.chunkWriter.CloseWithError(err)
+}
+
+// StreamReader set up a restore stream pipeline
+// chan io.ReadCloser -> chunkReader -> decompression -> delimited Protobuf -> snapshot items
+type StreamReader struct {
+	chunkReader *ChunkReader
+	protoReader protoio.ReadCloser
+}
+
+// NewStreamReader sets up a restore stream pipeline for chunks compressed as given by the
+// snapshot format. Note that the zlib reader reads from the stream on initialization, so this may
+// block until the first chunk is available.
+func NewStreamReader(chunks <-chan io.ReadCloser, format uint32) (*StreamReader, error) {
+	chunkReader := NewChunkReader(chunks)
+	zReader, err := newDecompressor(chunkReader, format)
+	if err != nil {
+		chunkReader.Close()
+		return nil, err
+	}
+	protoReader := protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
+	return &StreamReader{
+		chunkReader: chunkReader,
+		protoReader: protoReader,
+	}, nil
+}
+
+// ReadMsg implements protoio.Reader.
+func (sr *StreamReader) ReadMsg(msg proto.Message) error {
+	return sr.protoReader.ReadMsg(msg)
+}
+
+// Close implements io.Closer. Closing the Protobuf reader also closes the decompressor, and any
+// remaining chunks are drained by the chunk reader.
+func (sr *StreamReader) Close() error {
+	err := sr.protoReader.Close()
+	if e := sr.chunkReader.Close(); e != nil && err == nil {
+		err = e
+	}
+	return err
+}
@@ -11,6 +11,9 @@ var (
 	// ErrChunkHashMismatch is returned when chunk hash verification failed.
 	ErrChunkHashMismatch = errors.New("chunk hash verification failed")
 
+	// ErrSnapshotHashMismatch is returned when the snapshot hash verification failed.
+	ErrSnapshotHashMismatch = errors.New("snapshot hash verification failed")
+
 	// ErrInvalidMetadata is returned when the snapshot metadata is invalid.
 	ErrInvalidMetadata = errors.New("invalid snapshot metadata")
 )
@@ -1,6 +1,33 @@
 package types
 
-// CurrentFormat is the currently used format for snapshots. Snapshots using the same format
-// must be identical across all nodes for a given height, so this must be bumped when the binary
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Snapshot formats. The format determines how the stream of snapshot items is compressed, and
+// nodes can only restore snapshots in formats they support. Snapshots using the same format must
+// be identical across all nodes for a given height, so a new format must be added when the binary
 // snapshot output changes.
-const CurrentFormat uint32 = 1
+const (
+	// FormatZlib compresses the snapshot item stream with zlib.
+	FormatZlib uint32 = 2
+
+	// FormatZstd compresses the snapshot item stream with zstd.
+	FormatZstd uint32 = 3
+
+	// CurrentFormat is the default format used when taking snapshots.
+	CurrentFormat = FormatZlib
+)
+
+// SupportedFormats lists the snapshot formats that can be taken and restored.
+var SupportedFormats = []uint32{FormatZlib, FormatZstd}
+
+// ValidateFormat returns ErrUnknownFormat if the snapshot format is not supported.
+func ValidateFormat(format uint32) error {
+	for _, supported := range SupportedFormats {
+		if format == supported {
+			return nil
+		}
+	}
+	return sdkerrors.Wrapf(ErrUnknownFormat, "format %v", format)
+}
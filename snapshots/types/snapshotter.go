@@ -1,16 +1,46 @@
 package types
 
-import "io"
+import (
+	protoio "github.com/gogo/protobuf/io"
+)
 
-// Snapshotter is something that can create and restore snapshots, consisting of streamed binary
-// chunks - all of which must be read from the channel and closed. If an unsupported format is
-// given, it must return ErrUnknownFormat (possibly wrapped with fmt.Errorf).
+// Snapshotter is something that can create and restore snapshots, consisting of a stream of
+// SnapshotItem Protobuf messages. The chunking and compression of the stream is handled by the
+// snapshot manager. If an unsupported format is given, it must return ErrUnknownFormat (possibly
+// wrapped with fmt.Errorf).
 type Snapshotter interface {
-	// Snapshot creates a state snapshot, returning a channel of snapshot chunk readers.
-	Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error)
+	// Snapshot writes a state snapshot at the given height into the Protobuf writer.
+	Snapshot(height uint64, protoWriter protoio.Writer) error
 
-	// Restore restores a state snapshot, taking snapshot chunk readers as input.
-	// If the ready channel is non-nil, it returns a ready signal (by being closed) once the
-	// restorer is ready to accept chunks.
-	Restore(height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{}) error
+	// Restore restores a state snapshot from the Protobuf reader. It returns the first item it
+	// does not know how to handle (e.g. an extension item), or an empty item at the end of the
+	// stream, so that the caller can pass the rest of the stream on to extension snapshotters.
+	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
+}
+
+// ExtensionPayloadReader reads extension payloads. It returns io.EOF once the end of the stream
+// or the end of the extension's items is reached.
+type ExtensionPayloadReader func() ([]byte, error)
+
+// ExtensionPayloadWriter writes extension payloads into the snapshot stream.
+type ExtensionPayloadWriter func([]byte) error
+
+// ExtensionSnapshotter is a snapshotter for state kept outside the multistore. Its items are
+// appended to the snapshot stream after the multistore items, in order of extension name. Each
+// extension has a unique name and manages its own payload formats.
+type ExtensionSnapshotter interface {
+	// SnapshotName returns the name of the snapshotter, which must be unique within the manager.
+	SnapshotName() string
+
+	// SnapshotFormat returns the format used to encode the payloads when taking a snapshot.
+	SnapshotFormat() uint32
+
+	// SupportedFormats returns the formats that the snapshotter can restore from.
+	SupportedFormats() []uint32
+
+	// SnapshotExtension writes the extension payloads for the given height.
+	SnapshotExtension(height uint64, payloadWriter ExtensionPayloadWriter) error
+
+	// RestoreExtension restores the extension state from the payloads given by the reader.
+	RestoreExtension(height uint64, format uint32, payloadReader ExtensionPayloadReader) error
 }
@@ -0,0 +1,16 @@
+package types
+
+import (
+	protoio "github.com/gogo/protobuf/io"
+)
+
+// WriteExtensionItem writes an extension payload item into the Protobuf writer.
+func WriteExtensionItem(protoWriter protoio.Writer, payload []byte) error {
+	return protoWriter.WriteMsg(&SnapshotItem{
+		Item: &SnapshotItem_ExtensionPayload{
+			ExtensionPayload: &SnapshotExtensionPayload{
+				Payload: payload,
+			},
+		},
+	})
+}
@@ -0,0 +1,121 @@
+package listenkv_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func bz(s string) []byte { return []byte(s) }
+
+func keyFmt(i int) []byte { return bz(fmt.Sprintf("key%0.8d", i)) }
+func valFmt(i int) []byte { return bz(fmt.Sprintf("value%0.8d", i)) }
+
+var (
+	testStoreKey   = types.NewKVStoreKey("listen_test")
+	testMarshaller = codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+)
+
+func newEmptyListenKVStore(buf *bytes.Buffer) *listenkv.Store {
+	listener := types.NewStoreKVPairWriteListener(buf, testMarshaller)
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+
+	return listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{listener})
+}
+
+// readKVPairs decodes the length-prefixed StoreKVPairs written to the buffer.
+func readKVPairs(t *testing.T, buf *bytes.Buffer) []types.StoreKVPair {
+	var kvPairs []types.StoreKVPair
+	for buf.Len() > 0 {
+		var kvPair types.StoreKVPair
+		size, n := binary.Uvarint(buf.Bytes())
+		require.True(t, n > 0)
+		require.NoError(t, testMarshaller.UnmarshalBinaryBare(buf.Next(n + int(size))[n:], &kvPair))
+		kvPairs = append(kvPairs, kvPair)
+	}
+	return kvPairs
+}
+
+func TestListenKVStoreSetDelete(t *testing.T) {
+	var buf bytes.Buffer
+	store := newEmptyListenKVStore(&buf)
+
+	store.Set(keyFmt(1), valFmt(1))
+	store.Set(keyFmt(2), valFmt(2))
+	store.Delete(keyFmt(1))
+
+	require.Nil(t, store.Get(keyFmt(1)))
+	require.Equal(t, valFmt(2), store.Get(keyFmt(2)))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: keyFmt(1), Value: valFmt(1)},
+		{StoreKey: testStoreKey.Name(), Key: keyFmt(2), Value: valFmt(2)},
+		{StoreKey: testStoreKey.Name(), Key: keyFmt(1), Delete: true},
+	}, readKVPairs(t, &buf))
+}
+
+func TestListenKVStoreReadsAreNotListened(t *testing.T) {
+	var buf bytes.Buffer
+	store := newEmptyListenKVStore(&buf)
+	store.Set(keyFmt(1), valFmt(1))
+	buf.Reset()
+
+	store.Get(keyFmt(1))
+	store.Has(keyFmt(1))
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		iter.Key()
+		iter.Value()
+	}
+	require.NoError(t, iter.Close())
+
+	require.Zero(t, buf.Len())
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	var buf bytes.Buffer
+	store := newEmptyListenKVStore(&buf)
+
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set(keyFmt(2), valFmt(2))
+	cache.Set(keyFmt(1), valFmt(1))
+	cache.Set(keyFmt(3), valFmt(3))
+	cache.Delete(keyFmt(3))
+	require.Zero(t, buf.Len(), "writes to the cache must only be listened once written")
+
+	cache.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: keyFmt(1), Value: valFmt(1)},
+		{StoreKey: testStoreKey.Name(), Key: keyFmt(2), Value: valFmt(2)},
+		{StoreKey: testStoreKey.Name(), Key: keyFmt(3), Delete: true},
+	}, readKVPairs(t, &buf))
+}
+
+type failingListener struct{}
+
+func (failingListener) OnWrite(types.StoreKey, []byte, []byte, bool) error {
+	return fmt.Errorf("listener failure")
+}
+
+func TestListenKVStoreListenerError(t *testing.T) {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{failingListener{}})
+
+	require.Panics(t, func() { store.Set(keyFmt(1), valFmt(1)) })
+	require.Panics(t, func() { store.Delete(keyFmt(1)) })
+}
+
+func TestListenKVStoreGetStoreType(t *testing.T) {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := listenkv.NewStore(memDB, testStoreKey, nil)
+	require.Equal(t, memDB.GetStoreType(), store.GetStoreType())
+}
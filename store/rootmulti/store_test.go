@@ -9,6 +9,7 @@ import (
 	"io"
 	"io/ioutil"
 	"math/rand"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -16,6 +17,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
 	sdkmaps "github.com/cosmos/cosmos-sdk/store/internal/maps"
@@ -123,6 +125,48 @@ func TestHashStableWithEmptyCommit(t *testing.T) {
 	require.Equal(t, hash, cID.Hash)
 }
 
+func TestMultistoreParallelCommit(t *testing.T) {
+	seqDB, parDB := dbm.NewMemDB(), dbm.NewMemDB()
+	seqStore := newMultiStoreWithManyMounts(seqDB, 24)
+	parStore := newMultiStoreWithManyMounts(parDB, 24)
+	parStore.SetParallelCommit(true)
+
+	r := rand.New(rand.NewSource(8125471)) // Fixed seed for deterministic tests
+	for version := int64(1); version <= 5; version++ {
+		for _, name := range sortedStoreNames(seqStore) {
+			seqKV := seqStore.getStoreByName(name).(types.KVStore)
+			parKV := parStore.getStoreByName(name).(types.KVStore)
+			for i := 0; i < 20; i++ {
+				k, v := make([]byte, 8), make([]byte, 32)
+				r.Read(k)
+				r.Read(v)
+				seqKV.Set(k, v)
+				parKV.Set(k, v)
+			}
+		}
+
+		seqID := seqStore.Commit()
+		parID := parStore.Commit()
+		require.Equal(t, version, parID.Version)
+		require.Equal(t, seqID, parID)
+
+		// the persisted commit info must be byte-identical too, not just its hash
+		seqBz, err := seqDB.Get([]byte(fmt.Sprintf(commitInfoKeyFmt, version)))
+		require.NoError(t, err)
+		parBz, err := parDB.Get([]byte(fmt.Sprintf(commitInfoKeyFmt, version)))
+		require.NoError(t, err)
+		require.Equal(t, seqBz, parBz)
+	}
+
+	// the transient store is committed but not part of the commit info
+	require.Len(t, parStore.lastCommitInfo.StoreInfos, 24)
+
+	// a reloaded store must agree with the parallel commits
+	reloaded := newMultiStoreWithManyMounts(parDB, 24)
+	require.Equal(t, parStore.LastCommitID(), reloaded.LastCommitID())
+	require.Equal(t, parStore.lastCommitInfo.Hash(), reloaded.lastCommitInfo.Hash())
+}
+
 func TestMultistoreCommitLoad(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	store := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -465,6 +509,68 @@ func TestMultiStoreQuery(t *testing.T) {
 	require.Equal(t, v2, qres.Value)
 }
 
+type recordingListener struct {
+	kvPairs []types.StoreKVPair
+}
+
+func (l *recordingListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	l.kvPairs = append(l.kvPairs, types.StoreKVPair{StoreKey: storeKey.Name(), Key: key, Value: value, Delete: delete})
+	return nil
+}
+
+func TestMultiStoreListeners(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	key1, key2 := ms.keysByName["store1"], ms.keysByName["store2"]
+	rootListener := &recordingListener{}
+	ms.AddListeners(key1, []types.WriteListener{rootListener})
+	require.True(t, ms.ListeningEnabled(key1))
+	require.False(t, ms.ListeningEnabled(key2))
+
+	// direct writes to the root store are listened
+	ms.GetKVStore(key1).Set([]byte("k1"), []byte("v1"))
+	ms.GetKVStore(key2).Set([]byte("k2"), []byte("v2"))
+	require.Equal(t, []types.StoreKVPair{{StoreKey: "store1", Key: []byte("k1"), Value: []byte("v1")}}, rootListener.kvPairs)
+	rootListener.kvPairs = nil
+
+	// writes to a cache branch and its nested branches are listened by the cache
+	// listeners when they reach the branch, and by the root listeners once the
+	// branch is written
+	cms := ms.CacheMultiStore()
+	cacheListener := &recordingListener{}
+	cms.AddListeners(key1, []types.WriteListener{cacheListener})
+	require.True(t, cms.ListeningEnabled(key1))
+
+	cms.GetKVStore(key1).Set([]byte("a"), []byte("1"))
+	require.Equal(t, []types.StoreKVPair{{StoreKey: "store1", Key: []byte("a"), Value: []byte("1")}}, cacheListener.kvPairs)
+	cacheListener.kvPairs = nil
+
+	nested := cms.CacheMultiStore()
+	require.False(t, nested.ListeningEnabled(key1))
+	nested.GetKVStore(key1).Set([]byte("b"), []byte("2"))
+	nested.GetKVStore(key1).Delete([]byte("k1"))
+	require.Empty(t, cacheListener.kvPairs)
+
+	nested.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("b"), Value: []byte("2")},
+		{StoreKey: "store1", Key: []byte("k1"), Delete: true},
+	}, cacheListener.kvPairs)
+	require.Empty(t, rootListener.kvPairs)
+
+	cms.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("a"), Value: []byte("1")},
+		{StoreKey: "store1", Key: []byte("b"), Value: []byte("2")},
+		{StoreKey: "store1", Key: []byte("k1"), Delete: true},
+	}, rootListener.kvPairs)
+
+	// the cache listeners are not passed the writes again
+	require.Len(t, cacheListener.kvPairs, 2)
+}
+
 func TestMultiStore_Pruning(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -542,8 +648,8 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 func TestMultistoreSnapshot_Checksum(t *testing.T) {
 	// Chunks from different nodes must fit together, so all nodes must produce identical chunks.
 	// This checksum test makes sure that the byte stream remains identical. If the test fails
-	// without having changed the data (e.g. because the Protobuf or zlib encoding changes),
-	// snapshottypes.CurrentFormat must be bumped.
+	// without having changed the data (e.g. because the Protobuf, zlib or zstd encoding changes),
+	// a new snapshot format must be added.
 	store := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 5, 10000)
 	version := uint64(store.LastCommitID().Version)
 
@@ -551,20 +657,27 @@ func TestMultistoreSnapshot_Checksum(t *testing.T) {
 		format      uint32
 		chunkHashes []string
 	}{
-		{1, []string{
-			"503e5b51b657055b77e88169fadae543619368744ad15f1de0736c0a20482f24",
-			"e1a0daaa738eeb43e778aefd2805e3dd720798288a410b06da4b8459c4d8f72e",
-			"aa048b4ee0f484965d7b3b06822cf0772cdcaad02f3b1b9055e69f2cb365ef3c",
-			"7921eaa3ed4921341e504d9308a9877986a879fe216a099c86e8db66fcba4c63",
-			"a4a864e6c02c9fca5837ec80dc84f650b25276ed7e4820cf7516ced9f9901b86",
-			"ca2879ac6e7205d257440131ba7e72bef784cd61642e32b847729e543c1928b9",
+		{2, []string{
+			"d01aee7f62d95ce6e4220e6669adf6e0cc670b7ea52ea9f81ed4031cfbe25364",
+			"f696749162377cf15849442132e56bf403fe17806c22360b6e6b2b25b6223b43",
+			"e22342e0ffa775547cd31d1a294dc312c6929e3c92593b539b6d16cb1852b4dc",
+			"8acacdc885166707faf75a6cd8acfd834e6ffea1a9379bc4f4dbacd27513f08b",
+			"d2d3db2304d277e30eae0d1ee478d2a53ec40ef97c39ba52192f015adb97a9e3",
+			"83d9c23456adf1249630a7da7da6717a490b8a57b80883ce9bbdc0a3f97bbcf7",
+		}},
+		{3, []string{
+			"d683a52464551b43d3f51757f1d4fde27e022f2125ae3bda03e0a4d6de5a8e19",
+			"aa81f0f28b01d23764dcd7efac61c0f459c34faeb806efe84dc236c00db9b999",
+			"509d818661b28d69073d2a16a37c707aee4b0dd4f0478036a20921a9579fe282",
+			"ebfa6c11d0cc8b79c4d04e4b84cfe90d80ad9833e7b36b6301490c2323dbe249",
+			"62c3697d6f86e4af48b26372b4bc16f634ec7c92814775174e105b333160f19b",
+			"92401ea8a83b26bd67cdb555250d531ce0d917651288be4156459f2baf067a7b",
 		}},
 	}
 	for _, tc := range testcases {
 		tc := tc
 		t.Run(fmt.Sprintf("Format %v", tc.format), func(t *testing.T) {
-			chunks, err := store.Snapshot(version, tc.format)
-			require.NoError(t, err)
+			chunks := snapshotChunks(t, store, version, tc.format)
 			hashes := []string{}
 			for chunk := range chunks {
 				hasher := sha256.New()
@@ -582,23 +695,16 @@ func TestMultistoreSnapshot_Errors(t *testing.T) {
 	store := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
 
 	testcases := map[string]struct {
-		height     uint64
-		format     uint32
-		expectType error
+		height uint64
 	}{
-		"0 height":       {0, snapshottypes.CurrentFormat, nil},
-		"0 format":       {1, 0, snapshottypes.ErrUnknownFormat},
-		"unknown height": {9, snapshottypes.CurrentFormat, nil},
-		"unknown format": {1, 9, snapshottypes.ErrUnknownFormat},
+		"0 height":       {0},
+		"unknown height": {9},
 	}
 	for name, tc := range testcases {
 		tc := tc
 		t.Run(name, func(t *testing.T) {
-			_, err := store.Snapshot(tc.height, tc.format)
+			err := store.Snapshot(tc.height, nil)
 			require.Error(t, err)
-			if tc.expectType != nil {
-				assert.True(t, errors.Is(err, tc.expectType))
-			}
 		})
 	}
 }
@@ -618,7 +724,7 @@ func TestMultistoreRestore_Errors(t *testing.T) {
 	for name, tc := range testcases {
 		tc := tc
 		t.Run(name, func(t *testing.T) {
-			err := store.Restore(tc.height, tc.format, nil, nil)
+			_, err := store.Restore(tc.height, tc.format, nil)
 			require.Error(t, err)
 			if tc.expectType != nil {
 				assert.True(t, errors.Is(err, tc.expectType))
@@ -629,26 +735,29 @@ func TestMultistoreRestore_Errors(t *testing.T) {
 
 func TestMultistoreSnapshotRestore(t *testing.T) {
 	source := newMultiStoreWithMixedMountsAndBasicData(dbm.NewMemDB())
-	target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
 	version := uint64(source.LastCommitID().Version)
 	require.EqualValues(t, 3, version)
 
-	chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
-	require.NoError(t, err)
-	ready := make(chan struct{})
-	err = target.Restore(version, snapshottypes.CurrentFormat, chunks, ready)
-	require.NoError(t, err)
-	assert.EqualValues(t, struct{}{}, <-ready)
-
-	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
-	for key, sourceStore := range source.stores {
-		targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
-		switch sourceStore.GetStoreType() {
-		case types.StoreTypeTransient:
-			assert.False(t, targetStore.Iterator(nil, nil).Valid(),
-				"transient store %v not empty", key.Name())
-		default:
-			assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+	for _, format := range snapshottypes.SupportedFormats {
+		target := newMultiStoreWithMixedMounts(dbm.NewMemDB())
+		chunks := snapshotChunks(t, source, version, format)
+		streamReader, err := snapshots.NewStreamReader(chunks, format)
+		require.NoError(t, err)
+		nextItem, err := target.Restore(version, format, streamReader)
+		require.NoError(t, err)
+		require.Nil(t, nextItem.Item)
+		require.NoError(t, streamReader.Close())
+
+		assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+		for key, sourceStore := range source.stores {
+			targetStore := target.getStoreByName(key.Name()).(types.CommitKVStore)
+			switch sourceStore.GetStoreType() {
+			case types.StoreTypeTransient:
+				assert.False(t, targetStore.Iterator(nil, nil).Valid(),
+					"transient store %v not empty", key.Name())
+			default:
+				assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+			}
 		}
 	}
 }
@@ -696,8 +805,7 @@ func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 		require.NoError(b, err)
 		require.EqualValues(b, 0, target.LastCommitID().Version)
 
-		chunks, err := source.Snapshot(uint64(version), snapshottypes.CurrentFormat)
-		require.NoError(b, err)
+		chunks := snapshotChunks(b, source, uint64(version), snapshottypes.CurrentFormat)
 		for reader := range chunks {
 			_, err := io.Copy(ioutil.Discard, reader)
 			require.NoError(b, err)
@@ -723,10 +831,12 @@ func benchmarkMultistoreSnapshotRestore(b *testing.B, stores uint8, storeKeys ui
 		require.NoError(b, err)
 		require.EqualValues(b, 0, target.LastCommitID().Version)
 
-		chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
+		chunks := snapshotChunks(b, source, version, snapshottypes.CurrentFormat)
+		streamReader, err := snapshots.NewStreamReader(chunks, snapshottypes.CurrentFormat)
 		require.NoError(b, err)
-		err = target.Restore(version, snapshottypes.CurrentFormat, chunks, nil)
+		_, err = target.Restore(version, snapshottypes.CurrentFormat, streamReader)
 		require.NoError(b, err)
+		require.NoError(b, streamReader.Close())
 		require.Equal(b, source.LastCommitID(), target.LastCommitID())
 	}
 }
@@ -734,6 +844,21 @@ func benchmarkMultistoreSnapshotRestore(b *testing.B, stores uint8, storeKeys ui
 //-----------------------------------------------------------------------
 // utils
 
+// snapshotChunks snapshots the store into a stream of chunks, the same way the snapshot manager
+// does.
+func snapshotChunks(t require.TestingT, store *Store, height uint64, format uint32) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser)
+	go func() {
+		streamWriter := snapshots.NewStreamWriter(ch, format)
+		if err := store.Snapshot(height, streamWriter); err != nil {
+			streamWriter.CloseWithError(err)
+			return
+		}
+		require.NoError(t, streamWriter.Close())
+	}()
+	return ch
+}
+
 func newMultiStoreWithMounts(db dbm.DB, pruningOpts types.PruningOptions) *Store {
 	store := NewStore(db)
 	store.pruningOpts = pruningOpts
@@ -814,6 +939,28 @@ func newMultiStoreWithGeneratedData(db dbm.DB, stores uint8, storeKeys uint64) *
 	return multiStore
 }
 
+func newMultiStoreWithManyMounts(db dbm.DB, stores int) *Store {
+	store := NewStore(db)
+	for i := 0; i < stores; i++ {
+		store.MountStoreWithDB(types.NewKVStoreKey(fmt.Sprintf("store%02d", i)), types.StoreTypeIAVL, nil)
+	}
+	store.MountStoreWithDB(types.NewTransientStoreKey("trans"), types.StoreTypeTransient, nil)
+	if err := store.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+
+	return store
+}
+
+func sortedStoreNames(store *Store) []string {
+	names := make([]string, 0, len(store.keysByName))
+	for name := range store.keysByName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 func newMultiStoreWithModifiedMounts(db dbm.DB, pruningOpts types.PruningOptions) (*Store, *types.StoreUpgrades) {
 	store := NewStore(db)
 	store.pruningOpts = pruningOpts
@@ -130,6 +130,17 @@ type MultiStore interface {
 	// implied that the caller should update the context when necessary between
 	// tracing operations. The modified MultiStore is returned.
 	SetTracingContext(TraceContext) MultiStore
+
+	// ListeningEnabled returns if listening is enabled for the KVStore belonging
+	// to the provided StoreKey.
+	ListeningEnabled(key StoreKey) bool
+
+	// AddListeners adds WriteListeners for the KVStore belonging to the provided
+	// StoreKey. It appends the listeners to the current set, if one already
+	// exists. The listeners are passed every write that reaches the KVStore,
+	// i.e. writes to a cache-wrapped branch are passed once the branch is
+	// written.
+	AddListeners(key StoreKey, listeners []WriteListener)
 }
 
 // From MultiStore.CacheMultiStore()....
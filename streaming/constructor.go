@@ -0,0 +1,104 @@
+package streaming
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/streaming/file"
+)
+
+const (
+	// FileStreamer is the name of the file streaming service
+	FileStreamer = "file"
+
+	// OptStoreStreamers is the app option listing the streaming services to enable
+	OptStoreStreamers = "store.streamers"
+	// OptStreamersFileKeys is the app option listing the store keys to stream to files
+	OptStreamersFileKeys = "streamers.file.keys"
+	// OptStreamersFileWriteDir is the app option setting the directory to stream files to
+	OptStreamersFileWriteDir = "streamers.file.write-dir"
+	// OptStreamersFilePrefix is the app option setting the prefix of the streamed files
+	OptStreamersFilePrefix = "streamers.file.prefix"
+)
+
+// LoadStreamingServices creates the streaming services enabled in the app options and
+// registers them with the BaseApp. The returned services should be closed by the app on
+// shutdown.
+func LoadStreamingServices(
+	bApp *baseapp.BaseApp, appOpts servertypes.AppOptions, appCodec codec.BinaryMarshaler,
+	keys map[string]*types.KVStoreKey,
+) ([]baseapp.StreamingService, error) {
+	streamers := cast.ToStringSlice(appOpts.Get(OptStoreStreamers))
+	services := make([]baseapp.StreamingService, 0, len(streamers))
+	for _, streamer := range streamers {
+		switch streamer {
+		case FileStreamer:
+			service, err := newFileStreamingService(appOpts, appCodec, keys)
+			if err != nil {
+				return nil, err
+			}
+			bApp.SetStreamingService(service)
+			services = append(services, service)
+
+		default:
+			return nil, fmt.Errorf("unknown streaming service %q", streamer)
+		}
+	}
+	return services, nil
+}
+
+func newFileStreamingService(
+	appOpts servertypes.AppOptions, appCodec codec.BinaryMarshaler, keys map[string]*types.KVStoreKey,
+) (*file.StreamingService, error) {
+	storeKeys, err := exposedStoreKeys(cast.ToStringSlice(appOpts.Get(OptStreamersFileKeys)), keys)
+	if err != nil {
+		return nil, err
+	}
+
+	writeDir := cast.ToString(appOpts.Get(OptStreamersFileWriteDir))
+	if writeDir == "" {
+		return nil, fmt.Errorf("%s must be set for the file streaming service", OptStreamersFileWriteDir)
+	}
+	if !filepath.IsAbs(writeDir) {
+		writeDir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), writeDir)
+	}
+	if err := os.MkdirAll(writeDir, 0755); err != nil {
+		return nil, err
+	}
+
+	filePrefix := cast.ToString(appOpts.Get(OptStreamersFilePrefix))
+	return file.NewStreamingService(writeDir, filePrefix, storeKeys, appCodec)
+}
+
+// exposedStoreKeys returns the store keys with the given names, or all of them for "*", sorted
+// by name.
+func exposedStoreKeys(names []string, keys map[string]*types.KVStoreKey) ([]types.StoreKey, error) {
+	exposed := make(map[string]*types.KVStoreKey, len(names))
+	for _, name := range names {
+		if name == "*" {
+			exposed = keys
+			break
+		}
+		key, ok := keys[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown store key %q to stream", name)
+		}
+		exposed[name] = key
+	}
+
+	storeKeys := make([]types.StoreKey, 0, len(exposed))
+	for _, key := range exposed {
+		storeKeys = append(storeKeys, key)
+	}
+	sort.Slice(storeKeys, func(i, j int) bool { return storeKeys[i].Name() < storeKeys[j].Name() })
+	return storeKeys, nil
+}
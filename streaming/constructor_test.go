@@ -0,0 +1,89 @@
+package streaming
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/streaming/file"
+)
+
+var (
+	testMarshaller = codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	testKeys       = map[string]*types.KVStoreKey{
+		"acc":  types.NewKVStoreKey("acc"),
+		"bank": types.NewKVStoreKey("bank"),
+		"gov":  types.NewKVStoreKey("gov"),
+	}
+)
+
+func TestLoadStreamingServices(t *testing.T) {
+	home, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	v := viper.New()
+	v.Set(flags.FlagHome, home)
+	v.Set(OptStoreStreamers, []string{FileStreamer})
+	v.Set(OptStreamersFileKeys, []string{"gov", "acc"})
+	v.Set(OptStreamersFileWriteDir, "data/streaming")
+
+	bApp := baseapp.NewBaseApp("test", log.NewNopLogger(), dbm.NewMemDB(), nil)
+	services, err := LoadStreamingServices(bApp, v, testMarshaller, testKeys)
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+	require.IsType(t, &file.StreamingService{}, services[0])
+	require.DirExists(t, filepath.Join(home, "data", "streaming"))
+
+	listeners := services[0].Listeners()
+	require.Len(t, listeners, 2)
+	require.Contains(t, listeners, testKeys["gov"])
+	require.Contains(t, listeners, testKeys["acc"])
+}
+
+func TestLoadStreamingServices_Errors(t *testing.T) {
+	bApp := baseapp.NewBaseApp("test", log.NewNopLogger(), dbm.NewMemDB(), nil)
+
+	v := viper.New()
+	services, err := LoadStreamingServices(bApp, v, testMarshaller, testKeys)
+	require.NoError(t, err)
+	require.Empty(t, services)
+
+	v.Set(OptStoreStreamers, []string{"kafka"})
+	_, err = LoadStreamingServices(bApp, v, testMarshaller, testKeys)
+	require.Error(t, err)
+
+	v.Set(OptStoreStreamers, []string{FileStreamer})
+	v.Set(OptStreamersFileKeys, []string{"staking"})
+	_, err = LoadStreamingServices(bApp, v, testMarshaller, testKeys)
+	require.Error(t, err)
+
+	v.Set(OptStreamersFileKeys, []string{"*"})
+	_, err = LoadStreamingServices(bApp, v, testMarshaller, testKeys)
+	require.Error(t, err, "the write directory must be set")
+}
+
+func TestExposedStoreKeys(t *testing.T) {
+	storeKeys, err := exposedStoreKeys([]string{"*"}, testKeys)
+	require.NoError(t, err)
+	require.Equal(t, []types.StoreKey{testKeys["acc"], testKeys["bank"], testKeys["gov"]}, storeKeys)
+
+	storeKeys, err = exposedStoreKeys([]string{"gov", "bank"}, testKeys)
+	require.NoError(t, err)
+	require.Equal(t, []types.StoreKey{testKeys["bank"], testKeys["gov"]}, storeKeys)
+
+	storeKeys, err = exposedStoreKeys(nil, testKeys)
+	require.NoError(t, err)
+	require.Empty(t, storeKeys)
+}
@@ -0,0 +1,201 @@
+package file
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is a concrete implementation of baseapp.StreamingService that writes the
+// state changes of each block to a file, grouped by the ABCI message they result from. The
+// file of block N is named
+//
+//	{prefix}-block-{N}
+//
+// where the prefix and its dash are omitted if no prefix is configured. It holds a section for
+// the BeginBlock, for each DeliverTx and for the EndBlock of the block, in that order. A section
+// is the length-prefixed Protobuf encoded request, followed by the uvarint number of the
+// StoreKVPairs written while processing it, the length-prefixed StoreKVPairs and the
+// length-prefixed response.
+//
+// The file is opened on BeginBlock and closed on EndBlock, or on Close if the node stops in the
+// middle of a block.
+type StreamingService struct {
+	listeners  map[types.StoreKey][]types.WriteListener // the listeners registered with the BaseApp
+	filePrefix string                                   // optional prefix for each of the generated files
+	writeDir   string                                   // directory to write the files into
+	codec      codec.BinaryMarshaler                    // marshaller for the ABCI messages and StoreKVPairs
+
+	mtx        sync.Mutex
+	stateCache [][]byte      // length-prefixed StoreKVPairs in the order they were written
+	file       *os.File      // file of the current block, nil between blocks
+	writer     *bufio.Writer // buffered writer of the file of the current block
+}
+
+// NewStreamingService creates a new StreamingService writing the state changes of the given
+// stores to files in writeDir, which must be an existing directory.
+func NewStreamingService(
+	writeDir, filePrefix string, storeKeys []types.StoreKey, c codec.BinaryMarshaler,
+) (*StreamingService, error) {
+	info, err := os.Stat(writeDir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", writeDir)
+	}
+
+	s := &StreamingService{
+		listeners:  make(map[types.StoreKey][]types.WriteListener, len(storeKeys)),
+		filePrefix: filePrefix,
+		writeDir:   writeDir,
+		codec:      c,
+	}
+	// all the listeners share the same state cache, so that the cache holds the state changes
+	// of all stores in the order they were written
+	writer := &stateCacheWriter{service: s}
+	for _, key := range storeKeys {
+		s.listeners[key] = []types.WriteListener{types.NewStoreKVPairWriteListener(writer, c)}
+	}
+	return s, nil
+}
+
+// Listeners implements baseapp.StreamingService.
+func (s *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return s.listeners
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener. It opens the file of the block, and writes
+// the BeginBlock request and response to it along with the state changes of the BeginBlocker.
+func (s *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	// the file of a block which did not end is left as is
+	if err := s.closeFile(); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("block-%d", req.Header.Height)
+	if s.filePrefix != "" {
+		name = fmt.Sprintf("%s-%s", s.filePrefix, name)
+	}
+	file, err := os.OpenFile(filepath.Join(s.writeDir, name), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		s.stateCache = nil
+		return err
+	}
+	s.file, s.writer = file, bufio.NewWriter(file)
+
+	return s.writeSection(&req, &res)
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener. It writes the DeliverTx request and
+// response to the file of the block, along with the state changes of the transaction.
+func (s *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.writeSection(&req, &res)
+}
+
+// ListenEndBlock implements baseapp.ABCIListener. It writes the EndBlock request and
+// response to the file of the block, along with the state changes of the EndBlocker, and
+// closes the file.
+func (s *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if err := s.writeSection(&req, &res); err != nil {
+		_ = s.closeFile()
+		return err
+	}
+	return s.closeFile()
+}
+
+// Close implements io.Closer. It closes the file of the current block, if the node stops in
+// the middle of a block.
+func (s *StreamingService) Close() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.closeFile()
+}
+
+// writeSection writes the request, the cached state changes and the response to the file of
+// the current block, and resets the state cache. The caller must hold the lock.
+func (s *StreamingService) writeSection(req, res codec.ProtoMarshaler) error {
+	// the cache is reset even if writing fails, so that the state changes are not attributed to
+	// the next message
+	stateCache := s.stateCache
+	s.stateCache = nil
+
+	if s.file == nil {
+		return errors.New("no block is being streamed")
+	}
+
+	bz, err := s.codec.MarshalBinaryLengthPrefixed(req)
+	if err != nil {
+		return err
+	}
+	count := make([]byte, binary.MaxVarintLen64)
+	bz = append(bz, count[:binary.PutUvarint(count, uint64(len(stateCache)))]...)
+	if _, err := s.writer.Write(bz); err != nil {
+		return err
+	}
+
+	for _, stateChange := range stateCache {
+		if _, err := s.writer.Write(stateChange); err != nil {
+			return err
+		}
+	}
+
+	bz, err = s.codec.MarshalBinaryLengthPrefixed(res)
+	if err != nil {
+		return err
+	}
+	_, err = s.writer.Write(bz)
+	return err
+}
+
+// closeFile closes the file of the current block, if any. The caller must hold the lock.
+func (s *StreamingService) closeFile() error {
+	if s.file == nil {
+		return nil
+	}
+
+	err := s.writer.Flush()
+	if closeErr := s.file.Close(); err == nil {
+		err = closeErr
+	}
+	s.file, s.writer = nil, nil
+	return err
+}
+
+// stateCacheWriter is the io.Writer of the StreamingService's listeners, which caches the
+// StoreKVPairs until the ABCI message they result from is written.
+type stateCacheWriter struct {
+	service *StreamingService
+}
+
+// Write implements io.Writer.
+func (w *stateCacheWriter) Write(p []byte) (int, error) {
+	w.service.mtx.Lock()
+	defer w.service.mtx.Unlock()
+
+	w.service.stateCache = append(w.service.stateCache, append([]byte(nil), p...))
+	return len(p), nil
+}
@@ -0,0 +1,175 @@
+package file
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	testMarshaller = codec.NewProtoCodec(codecTypes.NewInterfaceRegistry())
+	mockStoreKey1  = types.NewKVStoreKey("mockStore1")
+	mockStoreKey2  = types.NewKVStoreKey("mockStore2")
+)
+
+func newTestService(t *testing.T, prefix string) (*StreamingService, string) {
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	service, err := NewStreamingService(dir, prefix, []types.StoreKey{mockStoreKey1, mockStoreKey2}, testMarshaller)
+	require.NoError(t, err)
+	return service, dir
+}
+
+func write(t *testing.T, service *StreamingService, key types.StoreKey, kvPair types.StoreKVPair) {
+	for _, listener := range service.Listeners()[key] {
+		require.NoError(t, listener.OnWrite(key, kvPair.Key, kvPair.Value, kvPair.Delete))
+	}
+}
+
+// section is a section of a block file, holding the messages of an ABCI message.
+type section struct {
+	req     []byte
+	kvPairs [][]byte
+	res     []byte
+}
+
+// readFile splits the file into its sections.
+func readFile(t *testing.T, path string) []section {
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	next := func() []byte {
+		size, n := binary.Uvarint(bz)
+		require.True(t, n > 0)
+		message := bz[n : n+int(size)]
+		bz = bz[n+int(size):]
+		return message
+	}
+
+	var sections []section
+	for len(bz) > 0 {
+		var s section
+		s.req = next()
+		count, n := binary.Uvarint(bz)
+		require.True(t, n > 0)
+		bz = bz[n:]
+		for i := uint64(0); i < count; i++ {
+			s.kvPairs = append(s.kvPairs, next())
+		}
+		s.res = next()
+		sections = append(sections, s)
+	}
+	return sections
+}
+
+func requireKVPairs(t *testing.T, expected []types.StoreKVPair, messages [][]byte) {
+	require.Len(t, messages, len(expected))
+	for i, bz := range messages {
+		var kvPair types.StoreKVPair
+		require.NoError(t, testMarshaller.UnmarshalBinaryBare(bz, &kvPair))
+		require.Equal(t, expected[i], kvPair)
+	}
+}
+
+func TestStreamingService(t *testing.T) {
+	service, dir := newTestService(t, "")
+	require.Len(t, service.Listeners(), 2)
+	ctx := sdk.Context{}
+
+	kvPair1 := types.StoreKVPair{StoreKey: mockStoreKey1.Name(), Key: []byte("k1"), Value: []byte("v1")}
+	kvPair2 := types.StoreKVPair{StoreKey: mockStoreKey2.Name(), Key: []byte("k2"), Value: []byte("v2")}
+	kvPair3 := types.StoreKVPair{StoreKey: mockStoreKey1.Name(), Key: []byte("k1"), Delete: true}
+
+	beginReq := abci.RequestBeginBlock{Header: tmproto.Header{Height: 7}}
+	beginRes := abci.ResponseBeginBlock{Events: []abci.Event{{Type: "begin"}}}
+	write(t, service, mockStoreKey1, kvPair1)
+	require.NoError(t, service.ListenBeginBlock(ctx, beginReq, beginRes))
+
+	txReqs := []abci.RequestDeliverTx{{Tx: []byte("tx0")}, {Tx: []byte("tx1")}}
+	txRess := []abci.ResponseDeliverTx{{Code: 0, Data: []byte("data")}, {Code: 5}}
+	write(t, service, mockStoreKey2, kvPair2)
+	write(t, service, mockStoreKey1, kvPair3)
+	require.NoError(t, service.ListenDeliverTx(ctx, txReqs[0], txRess[0]))
+	require.NoError(t, service.ListenDeliverTx(ctx, txReqs[1], txRess[1]))
+
+	endReq := abci.RequestEndBlock{Height: 7}
+	endRes := abci.ResponseEndBlock{Events: []abci.Event{{Type: "end"}}}
+	require.NoError(t, service.ListenEndBlock(ctx, endReq, endRes))
+
+	sections := readFile(t, filepath.Join(dir, "block-7"))
+	require.Len(t, sections, 4)
+
+	var gotBeginReq abci.RequestBeginBlock
+	require.NoError(t, testMarshaller.UnmarshalBinaryBare(sections[0].req, &gotBeginReq))
+	require.Equal(t, beginReq, gotBeginReq)
+	requireKVPairs(t, []types.StoreKVPair{kvPair1}, sections[0].kvPairs)
+	var gotBeginRes abci.ResponseBeginBlock
+	require.NoError(t, testMarshaller.UnmarshalBinaryBare(sections[0].res, &gotBeginRes))
+	require.Equal(t, beginRes, gotBeginRes)
+
+	var gotTxReq abci.RequestDeliverTx
+	require.NoError(t, testMarshaller.UnmarshalBinaryBare(sections[1].req, &gotTxReq))
+	require.Equal(t, txReqs[0], gotTxReq)
+	requireKVPairs(t, []types.StoreKVPair{kvPair2, kvPair3}, sections[1].kvPairs)
+	var gotTxRes abci.ResponseDeliverTx
+	require.NoError(t, testMarshaller.UnmarshalBinaryBare(sections[1].res, &gotTxRes))
+	require.Equal(t, txRess[0], gotTxRes)
+
+	// a tx without state changes only holds the request and response
+	require.Empty(t, sections[2].kvPairs)
+	gotTxRes = abci.ResponseDeliverTx{}
+	require.NoError(t, testMarshaller.UnmarshalBinaryBare(sections[2].res, &gotTxRes))
+	require.Equal(t, txRess[1], gotTxRes)
+
+	require.Empty(t, sections[3].kvPairs)
+	var gotEndRes abci.ResponseEndBlock
+	require.NoError(t, testMarshaller.UnmarshalBinaryBare(sections[3].res, &gotEndRes))
+	require.Equal(t, endRes, gotEndRes)
+
+	// the file of the block is closed on EndBlock, so messages cannot be written between blocks
+	require.Nil(t, service.file)
+	require.Error(t, service.ListenDeliverTx(ctx, txReqs[0], txRess[0]))
+
+	// the file of a block which did not end is closed on Close
+	require.NoError(t, service.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 8}}, beginRes))
+	require.NoError(t, service.ListenDeliverTx(ctx, txReqs[0], txRess[0]))
+	require.NotNil(t, service.file)
+	require.NoError(t, service.Close())
+	require.Nil(t, service.file)
+	require.Len(t, readFile(t, filepath.Join(dir, "block-8")), 2)
+}
+
+func TestStreamingService_Prefix(t *testing.T) {
+	service, dir := newTestService(t, "node0")
+	ctx := sdk.Context{}
+
+	require.NoError(t, service.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, service.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 1}, abci.ResponseEndBlock{}))
+
+	require.FileExists(t, filepath.Join(dir, "node0-block-1"))
+}
+
+func TestNewStreamingService_InvalidDir(t *testing.T) {
+	_, err := NewStreamingService(filepath.Join(os.TempDir(), "does-not-exist-streaming"), "", nil, testMarshaller)
+	require.Error(t, err)
+
+	file, err := ioutil.TempFile("", "streaming")
+	require.NoError(t, err)
+	defer os.Remove(file.Name())
+	file.Close()
+	_, err = NewStreamingService(file.Name(), "", nil, testMarshaller)
+	require.Error(t, err)
+}
@@ -149,6 +149,9 @@ type KVPair = types.KVPair
 // every trace operation.
 type TraceContext = types.TraceContext
 
+// WriteListener is passed every write made to the KVStore it listens to.
+type WriteListener = types.WriteListener
+
 // --------------------------------------
 
 type (
@@ -84,3 +84,13 @@ type TxDecoder func(txBytes []byte) (Tx, error)
 
 // TxEncoder marshals transaction to bytes
 type TxEncoder func(tx Tx) ([]byte, error)
+
+// MsgTypeURL returns the TypeURL of a `sdk.Msg`. For a ServiceMsg, the TypeURL
+// of the wrapped request is returned, e.g. "/cosmos.bank.v1beta1.MsgSend".
+func MsgTypeURL(msg Msg) string {
+	if svcMsg, ok := msg.(ServiceMsg); ok {
+		return "/" + proto.MessageName(svcMsg.Request)
+	}
+
+	return "/" + proto.MessageName(msg)
+}
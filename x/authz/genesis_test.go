@@ -0,0 +1,83 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper keeper.Keeper
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	suite.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	suite.keeper = app.AuthzKeeper
+}
+
+var (
+	granteePub  = secp256k1.GenPrivKey().PubKey()
+	granterPub  = secp256k1.GenPrivKey().PubKey()
+	granteeAddr = sdk.AccAddress(granteePub.Address())
+	granterAddr = sdk.AccAddress(granterPub.Address())
+)
+
+func (suite *GenesisTestSuite) TestImportExportGenesis() {
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1_000)))
+
+	now := suite.ctx.BlockTime()
+	expires := now.Add(time.Hour)
+	grant := banktypes.NewSendAuthorization(coins)
+	suite.Require().NoError(suite.keeper.SaveGrant(suite.ctx, granteeAddr, granterAddr, grant, expires))
+	genesis := authz.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Len(genesis.Authorization, 1)
+
+	// Clear keeper
+	suite.Require().NoError(suite.keeper.DeleteGrant(suite.ctx, granteeAddr, granterAddr, grant.MsgTypeURL()))
+
+	authz.InitGenesis(suite.ctx, suite.keeper, genesis)
+	newGenesis := authz.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Equal(genesis, newGenesis)
+
+	// expired grants are not imported
+	suite.Require().NoError(suite.keeper.DeleteGrant(suite.ctx, granteeAddr, granterAddr, grant.MsgTypeURL()))
+	authz.InitGenesis(suite.ctx.WithBlockTime(expires.Add(time.Second)), suite.keeper, genesis)
+	suite.Require().Empty(authz.ExportGenesis(suite.ctx, suite.keeper).Authorization)
+}
+
+func (suite *GenesisTestSuite) TestInvalidGenesis() {
+	genesis := types.NewGenesisState([]types.GrantAuthorization{
+		{Granter: "invalid", Grantee: granteeAddr.String()},
+	})
+	suite.Require().Panics(func() {
+		authz.InitGenesis(suite.ctx, suite.keeper, genesis)
+	})
+
+	// missing authorization
+	genesis = types.NewGenesisState([]types.GrantAuthorization{
+		{Granter: granterAddr.String(), Grantee: granteeAddr.String(), Expiration: suite.ctx.BlockTime().Add(time.Hour)},
+	})
+	suite.Require().Panics(func() {
+		authz.InitGenesis(suite.ctx, suite.keeper, genesis)
+	})
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestGRPCQueryGrants() {
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	expiration := suite.ctx.BlockTime().Add(time.Hour)
+	send := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	generic := types.NewGenericAuthorization("/cosmos.gov.v1beta1.MsgVote")
+
+	suite.Require().NoError(suite.app.AuthzKeeper.SaveGrant(suite.ctx, grantee, granter, send, expiration))
+	suite.Require().NoError(suite.app.AuthzKeeper.SaveGrant(suite.ctx, grantee, granter, generic, expiration))
+
+	testCases := []struct {
+		name     string
+		req      *types.QueryGrantsRequest
+		expPass  bool
+		expGrant int
+	}{
+		{
+			"fail: invalid granter",
+			&types.QueryGrantsRequest{Granter: "invalid", Grantee: grantee.String()},
+			false,
+			0,
+		},
+		{
+			"fail: invalid grantee",
+			&types.QueryGrantsRequest{Granter: granter.String(), Grantee: "invalid"},
+			false,
+			0,
+		},
+		{
+			"fail: no grant for msg type",
+			&types.QueryGrantsRequest{Granter: granter.String(), Grantee: grantee.String(), MsgTypeUrl: "/cosmos.bank.v1beta1.MsgMultiSend"},
+			false,
+			0,
+		},
+		{
+			"valid: all grants",
+			&types.QueryGrantsRequest{Granter: granter.String(), Grantee: grantee.String()},
+			true,
+			2,
+		},
+		{
+			"valid: paginated grants",
+			&types.QueryGrantsRequest{Granter: granter.String(), Grantee: grantee.String(), Pagination: &query.PageRequest{Limit: 1}},
+			true,
+			1,
+		},
+		{
+			"valid: grant for msg type",
+			&types.QueryGrantsRequest{Granter: granter.String(), Grantee: grantee.String(), MsgTypeUrl: bankSendAuthMsgType},
+			true,
+			1,
+		},
+		{
+			"valid: no grants to the granter",
+			&types.QueryGrantsRequest{Granter: grantee.String(), Grantee: granter.String()},
+			true,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.queryClient.Grants(gocontext.Background(), tc.req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Grants, tc.expGrant)
+			for _, grant := range res.Grants {
+				suite.Require().NotNil(grant.GetAuthorization())
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// Keeper manages the authorizations granted between accounts and dispatches
+// the msgs executed on behalf of a granter.
+type Keeper struct {
+	cdc      codec.BinaryMarshaler
+	storeKey sdk.StoreKey
+	router   *baseapp.MsgServiceRouter
+}
+
+// NewKeeper constructs a message authorization Keeper
+func NewKeeper(cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, router *baseapp.MsgServiceRouter) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// DispatchActions attempts to execute the provided messages via authorization
+// grants from the message signer to the grantee. The messages are routed
+// through the MsgServiceRouter with their signer, the granter, treated as the
+// sender. It returns the result data of each message.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
+	results := make([][]byte, len(msgs))
+
+	for i, msg := range msgs {
+		req := msgRequest(msg)
+		msgTypeURL := sdk.MsgTypeURL(msg)
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "authorization can be given to msg with only one signer")
+		}
+		granter := signers[0]
+
+		// if the granter is the grantee, the msg is implicitly authorized
+		if !granter.Equals(grantee) {
+			authorization, _ := k.GetCleanAuthorization(ctx, grantee, granter, msgTypeURL)
+			if authorization == nil {
+				return nil, sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "granter %s, grantee %s, msg %s", granter, grantee, msgTypeURL)
+			}
+
+			resp, err := authorization.Accept(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			if resp.Delete {
+				err = k.DeleteGrant(ctx, grantee, granter, msgTypeURL)
+			} else if resp.Updated != nil {
+				err = k.update(ctx, grantee, granter, resp.Updated)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if !resp.Accept {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "authorization rejected msg %s", msgTypeURL)
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeExec,
+					sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+					sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+					sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+				),
+			)
+		}
+
+		handler := k.router.HandlerByTypeURL(msgTypeURL)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message type: %s; message index: %d", msgTypeURL, i)
+		}
+
+		msgResp, err := handler(ctx, req)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		results[i] = msgResp.Data
+
+		// emit the events from the dispatched msg
+		events := make(sdk.Events, len(msgResp.Events))
+		for j, e := range msgResp.Events {
+			events[j] = sdk.Event(e)
+		}
+		ctx.EventManager().EmitEvents(events)
+	}
+
+	return results, nil
+}
+
+// SaveGrant stores the authorization granted by granter to grantee, replacing
+// any existing grant for the same msg type URL.
+func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization types.Authorization, expiration time.Time) error {
+	grant, err := types.NewGrant(authorization, expiration)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.MarshalBinaryBare(&grant)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAuthorizationStoreKey(grantee, granter, authorization.MsgTypeURL()), bz)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, authorization.MsgTypeURL()),
+		),
+	)
+
+	return nil
+}
+
+// DeleteGrant revokes any authorization for the provided msg type URL granted
+// to the grantee by the granter.
+func (k Keeper) DeleteGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, msgTypeURL string) error {
+	store := ctx.KVStore(k.storeKey)
+	skey := types.GetAuthorizationStoreKey(grantee, granter, msgTypeURL)
+	if !store.Has(skey) {
+		return sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "granter %s, grantee %s, msg %s", granter, grantee, msgTypeURL)
+	}
+
+	store.Delete(skey)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevoke,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+		),
+	)
+
+	return nil
+}
+
+// GetCleanAuthorization returns an authorization and its expiration time for
+// the given grantee, granter and msg type URL. If the authorization has
+// expired, it is deleted and nil is returned.
+func (k Keeper) GetCleanAuthorization(ctx sdk.Context, grantee, granter sdk.AccAddress, msgTypeURL string) (types.Authorization, time.Time) {
+	grant, found := k.getGrant(ctx, types.GetAuthorizationStoreKey(grantee, granter, msgTypeURL))
+	if !found {
+		return nil, time.Time{}
+	}
+
+	if grant.Expiration.Before(ctx.BlockHeader().Time) {
+		ctx.KVStore(k.storeKey).Delete(types.GetAuthorizationStoreKey(grantee, granter, msgTypeURL))
+		return nil, time.Time{}
+	}
+
+	return grant.GetAuthorization(), grant.Expiration
+}
+
+// IterateGrants iterates over all authorization grants. The iteration stops
+// when the handler returns true.
+func (k Keeper) IterateGrants(ctx sdk.Context, handler func(granter, grantee sdk.AccAddress, grant types.Grant) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GrantKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.Grant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+
+		granter, grantee, _ := types.ParseAuthorizationStoreKey(iter.Key())
+		if handler(granter, grantee, grant) {
+			break
+		}
+	}
+}
+
+// update replaces the authorization of an existing grant, keeping its
+// expiration time.
+func (k Keeper) update(ctx sdk.Context, grantee, granter sdk.AccAddress, updated types.Authorization) error {
+	skey := types.GetAuthorizationStoreKey(grantee, granter, updated.MsgTypeURL())
+	grant, found := k.getGrant(ctx, skey)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "granter %s, grantee %s, msg %s", granter, grantee, updated.MsgTypeURL())
+	}
+
+	grant, err := types.NewGrant(updated, grant.Expiration)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.MarshalBinaryBare(&grant)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(skey, bz)
+	return nil
+}
+
+func (k Keeper) getGrant(ctx sdk.Context, skey []byte) (grant types.Grant, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(skey)
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// msgRequest returns the request of a ServiceMsg, or the msg itself for a
+// legacy sdk.Msg.
+func msgRequest(msg sdk.Msg) sdk.MsgRequest {
+	if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+		return svcMsg.Request
+	}
+
+	return msg
+}
@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var bankSendAuthMsgType = sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	msgSrvr     types.MsgServer
+	queryClient types.QueryClient
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.AuthzKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+	suite.msgSrvr = keeper.NewMsgServerImpl(app.AuthzKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestKeeper() {
+	ctx, k := suite.ctx, suite.app.AuthzKeeper
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	now := ctx.BlockTime()
+
+	// no authorization yet
+	authorization, _ := k.GetCleanAuthorization(ctx, grantee, granter, bankSendAuthMsgType)
+	suite.Require().Nil(authorization)
+
+	// an expired authorization is cleaned up on access
+	newCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	x := banktypes.NewSendAuthorization(newCoins)
+	suite.Require().NoError(k.SaveGrant(ctx, grantee, granter, x, now.Add(-1*time.Hour)))
+	authorization, _ = k.GetCleanAuthorization(ctx, grantee, granter, bankSendAuthMsgType)
+	suite.Require().Nil(authorization)
+	suite.Require().Error(k.DeleteGrant(ctx, grantee, granter, bankSendAuthMsgType))
+
+	// a valid authorization is returned with its expiration
+	expiration := now.Add(time.Hour)
+	suite.Require().NoError(k.SaveGrant(ctx, grantee, granter, x, expiration))
+	authorization, exp := k.GetCleanAuthorization(ctx, grantee, granter, bankSendAuthMsgType)
+	suite.Require().Equal(x, authorization)
+	suite.Require().True(expiration.Equal(exp))
+
+	// the authorization is not granted to other addresses
+	authorization, _ = k.GetCleanAuthorization(ctx, granter, grantee, bankSendAuthMsgType)
+	suite.Require().Nil(authorization)
+	authorization, _ = k.GetCleanAuthorization(ctx, suite.addrs[2], granter, bankSendAuthMsgType)
+	suite.Require().Nil(authorization)
+
+	// revoked authorizations are removed
+	suite.Require().NoError(k.DeleteGrant(ctx, grantee, granter, bankSendAuthMsgType))
+	authorization, _ = k.GetCleanAuthorization(ctx, grantee, granter, bankSendAuthMsgType)
+	suite.Require().Nil(authorization)
+}
+
+func (suite *KeeperTestSuite) TestIterateGrants() {
+	ctx, k := suite.ctx, suite.app.AuthzKeeper
+	expiration := ctx.BlockTime().Add(time.Hour)
+
+	send := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	generic := types.NewGenericAuthorization("/cosmos.gov.v1beta1.MsgVote")
+	suite.Require().NoError(k.SaveGrant(ctx, suite.addrs[1], suite.addrs[0], send, expiration))
+	suite.Require().NoError(k.SaveGrant(ctx, suite.addrs[1], suite.addrs[0], generic, expiration))
+	suite.Require().NoError(k.SaveGrant(ctx, suite.addrs[2], suite.addrs[1], generic, expiration))
+
+	var msgTypes []string
+	k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.Grant) bool {
+		suite.Require().False(granter.Equals(grantee))
+		msgTypes = append(msgTypes, grant.GetAuthorization().MsgTypeURL())
+		return false
+	})
+	suite.Require().Len(msgTypes, 3)
+}
+
+func (suite *KeeperTestSuite) TestDispatchActions() {
+	ctx, k := suite.ctx, suite.app.AuthzKeeper
+	granter, grantee, recipient := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	expiration := ctx.BlockTime().Add(time.Hour)
+
+	granterBalance := suite.app.BankKeeper.GetBalance(ctx, granter, "stake")
+	recipientBalance := suite.app.BankKeeper.GetBalance(ctx, recipient, "stake")
+
+	send := func(amount int64) sdk.Msg {
+		return &banktypes.MsgSend{
+			FromAddress: granter.String(),
+			ToAddress:   recipient.String(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", amount)),
+		}
+	}
+
+	// no authorization
+	_, err := k.DispatchActions(ctx, grantee, []sdk.Msg{send(10)})
+	suite.Require().True(errors.Is(err, types.ErrNoAuthorizationFound))
+
+	suite.Require().NoError(k.SaveGrant(ctx, grantee, granter, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100))), expiration))
+
+	// legacy msgs and ServiceMsgs are both dispatched through the Msg service router
+	svcMsg := sdk.ServiceMsg{MethodName: "/cosmos.bank.v1beta1.Msg/Send", Request: send(30).(sdk.MsgRequest)}
+	results, err := k.DispatchActions(ctx, grantee, []sdk.Msg{send(20), svcMsg})
+	suite.Require().NoError(err)
+	suite.Require().Len(results, 2)
+
+	suite.Require().Equal(granterBalance.Sub(sdk.NewInt64Coin("stake", 50)), suite.app.BankKeeper.GetBalance(ctx, granter, "stake"))
+	suite.Require().Equal(recipientBalance.Add(sdk.NewInt64Coin("stake", 50)), suite.app.BankKeeper.GetBalance(ctx, recipient, "stake"))
+
+	// the spend limit was reduced
+	authorization, _ := k.GetCleanAuthorization(ctx, grantee, granter, bankSendAuthMsgType)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), authorization.(*banktypes.SendAuthorization).SpendLimit)
+
+	// exceeding the spend limit fails
+	_, err = k.DispatchActions(ctx, grantee, []sdk.Msg{send(60)})
+	suite.Require().True(errors.Is(err, sdkerrors.ErrInsufficientFunds))
+
+	// spending the remaining limit deletes the grant
+	_, err = k.DispatchActions(ctx, grantee, []sdk.Msg{send(50)})
+	suite.Require().NoError(err)
+	authorization, _ = k.GetCleanAuthorization(ctx, grantee, granter, bankSendAuthMsgType)
+	suite.Require().Nil(authorization)
+
+	// msgs signed by the grantee itself need no authorization
+	selfSend := &banktypes.MsgSend{
+		FromAddress: grantee.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	}
+	_, err = k.DispatchActions(ctx, grantee, []sdk.Msg{selfSend})
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestDispatchActionsGeneric() {
+	ctx, k := suite.ctx, suite.app.AuthzKeeper
+	granter, grantee, recipient := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+
+	suite.Require().NoError(k.SaveGrant(ctx, grantee, granter, types.NewGenericAuthorization(bankSendAuthMsgType), ctx.BlockTime().Add(time.Hour)))
+
+	msg := &banktypes.MsgSend{
+		FromAddress: granter.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	}
+	_, err := k.DispatchActions(ctx, grantee, []sdk.Msg{msg, msg})
+	suite.Require().NoError(err)
+
+	// generic authorizations are not consumed
+	authorization, _ := k.GetCleanAuthorization(ctx, grantee, granter, bankSendAuthMsgType)
+	suite.Require().NotNil(authorization)
+
+	// the grant does not cover other msg types
+	multiSend := &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{banktypes.NewInput(granter, msg.Amount)},
+		Outputs: []banktypes.Output{banktypes.NewOutput(recipient, msg.Amount)},
+	}
+	_, err = k.DispatchActions(ctx, grantee, []sdk.Msg{multiSend})
+	suite.Require().True(errors.Is(err, types.ErrNoAuthorizationFound))
+}
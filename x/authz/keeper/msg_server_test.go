@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *KeeperTestSuite) TestGrant() {
+	oneHour := suite.ctx.BlockTime().Add(time.Hour)
+	oneHourAgo := suite.ctx.BlockTime().Add(-time.Hour)
+	sendAuthz := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	testCases := []struct {
+		name      string
+		req       func() *types.MsgGrant
+		expectErr bool
+	}{
+		{
+			"valid: send authorization",
+			func() *types.MsgGrant {
+				msg, err := types.NewMsgGrant(suite.addrs[0], suite.addrs[1], sendAuthz, oneHour)
+				suite.Require().NoError(err)
+				return msg
+			},
+			false,
+		},
+		{
+			"valid: overwrite existing grant",
+			func() *types.MsgGrant {
+				msg, err := types.NewMsgGrant(suite.addrs[0], suite.addrs[1], sendAuthz, oneHour.Add(time.Hour))
+				suite.Require().NoError(err)
+				return msg
+			},
+			false,
+		},
+		{
+			"fail: expired authorization",
+			func() *types.MsgGrant {
+				msg, err := types.NewMsgGrant(suite.addrs[0], suite.addrs[1], sendAuthz, oneHourAgo)
+				suite.Require().NoError(err)
+				return msg
+			},
+			true,
+		},
+		{
+			"fail: unknown msg type",
+			func() *types.MsgGrant {
+				msg, err := types.NewMsgGrant(suite.addrs[0], suite.addrs[1], types.NewGenericAuthorization("/cosmos.bank.v1beta1.MsgUnknown"), oneHour)
+				suite.Require().NoError(err)
+				return msg
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.Grant(sdk.WrapSDKContext(suite.ctx), tc.req())
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRevoke() {
+	msg, err := types.NewMsgGrant(suite.addrs[0], suite.addrs[1], types.NewGenericAuthorization(bankSendAuthMsgType), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.Grant(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	revoke := types.NewMsgRevoke(suite.addrs[0], suite.addrs[1], bankSendAuthMsgType)
+	_, err = suite.msgSrvr.Revoke(sdk.WrapSDKContext(suite.ctx), &revoke)
+	suite.Require().NoError(err)
+
+	// revoking twice fails
+	_, err = suite.msgSrvr.Revoke(sdk.WrapSDKContext(suite.ctx), &revoke)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestExec() {
+	granter, grantee, recipient := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	send := &banktypes.MsgSend{
+		FromAddress: granter.String(),
+		ToAddress:   recipient.String(),
+		Amount:      coins,
+	}
+
+	exec, err := types.NewMsgExec(grantee, []sdk.Msg{send})
+	suite.Require().NoError(err)
+
+	// no grant
+	_, err = suite.msgSrvr.Exec(sdk.WrapSDKContext(suite.ctx), &exec)
+	suite.Require().Error(err)
+
+	grant, err := types.NewMsgGrant(granter, grantee, banktypes.NewSendAuthorization(coins), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.Grant(sdk.WrapSDKContext(suite.ctx), grant)
+	suite.Require().NoError(err)
+
+	res, err := suite.msgSrvr.Exec(sdk.WrapSDKContext(suite.ctx), &exec)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, 1)
+
+	// the grant was used up
+	_, err = suite.msgSrvr.Exec(sdk.WrapSDKContext(suite.ctx), &exec)
+	suite.Require().Error(err)
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/authz module sentinel errors
+var (
+	ErrNoAuthorizationFound  = sdkerrors.Register(ModuleName, 2, "authorization not found")
+	ErrInvalidExpirationTime = sdkerrors.Register(ModuleName, 3, "expiration time of authorization should be more than current time")
+	ErrNoMessages            = sdkerrors.Register(ModuleName, 4, "messages cannot be empty")
+	ErrInvalidAuthorization  = sdkerrors.Register(ModuleName, 5, "invalid authorization")
+)
@@ -0,0 +1,14 @@
+package types
+
+// authz module events
+const (
+	EventTypeGrant  = "grant_authorization"
+	EventTypeRevoke = "revoke_authorization"
+	EventTypeExec   = "exec_authorization"
+
+	AttributeKeyGranter    = "granter"
+	AttributeKeyGrantee    = "grantee"
+	AttributeKeyMsgTypeURL = "msg_type_url"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new genesis state for the authz module.
+func NewGenesisState(entries []GrantAuthorization) *GenesisState {
+	return &GenesisState{
+		Authorization: entries,
+	}
+}
+
+// DefaultGenesisState returns the authz module's default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Authorization: []GrantAuthorization{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, entry := range gs.Authorization {
+		if _, err := sdk.AccAddressFromBech32(entry.Granter); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address (%s)", err)
+		}
+		if _, err := sdk.AccAddressFromBech32(entry.Grantee); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+		}
+
+		authorization := entry.GetAuthorization()
+		if authorization == nil {
+			return sdkerrors.Wrap(ErrInvalidAuthorization, "authorization should not be empty")
+		}
+		if err := authorization.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, entry := range gs.Authorization {
+		if err := entry.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = &Grant{}
+	_ types.UnpackInterfacesMessage = &GrantAuthorization{}
+)
+
+// NewGrant returns a new Grant of the given authorization expiring at the
+// given time.
+func NewGrant(authorization Authorization, expiration time.Time) (Grant, error) {
+	any, err := packAuthorization(authorization)
+	if err != nil {
+		return Grant{}, err
+	}
+
+	return Grant{
+		Authorization: any,
+		Expiration:    expiration,
+	}, nil
+}
+
+// GetAuthorization returns the cached value from the Grant.Authorization if present.
+func (g Grant) GetAuthorization() Authorization {
+	return unpackAuthorization(g.Authorization)
+}
+
+// ValidateBasic performs a stateless validation of the grant.
+func (g Grant) ValidateBasic() error {
+	authorization := g.GetAuthorization()
+	if authorization == nil {
+		return sdkerrors.Wrap(ErrInvalidAuthorization, "authorization should not be empty")
+	}
+
+	return authorization.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g Grant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
+
+// GetAuthorization returns the cached value from the
+// GrantAuthorization.Authorization if present.
+func (g GrantAuthorization) GetAuthorization() Authorization {
+	return unpackAuthorization(g.Authorization)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g GrantAuthorization) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
+
+func packAuthorization(authorization Authorization) (*types.Any, error) {
+	msg, ok := authorization.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", authorization)
+	}
+
+	return types.NewAnyWithValue(msg)
+}
+
+func unpackAuthorization(any *types.Any) Authorization {
+	if any == nil {
+		return nil
+	}
+
+	authorization, ok := any.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+
+	return authorization
+}
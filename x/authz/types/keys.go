@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "authz"
+
+	// StoreKey is the store key string for authz
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for authz
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for authz
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// GrantKey is the prefix of the grants stored by (granter, grantee, msg type URL)
+	GrantKey = []byte{0x01}
+)
+
+// GetAuthorizationStoreKey returns the store key of the authorization of the
+// given msg type URL granted by granter to grantee.
+func GetAuthorizationStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgTypeURL string) []byte {
+	return append(GetGrantsByGranterGranteePrefix(granter, grantee), []byte(msgTypeURL)...)
+}
+
+// GetGrantsByGranterGranteePrefix returns a prefix to scan for all the grants
+// from granter to grantee.
+func GetGrantsByGranterGranteePrefix(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	key := append(GrantKey, granter.Bytes()...)
+	return append(key, grantee.Bytes()...)
+}
+
+// ParseAuthorizationStoreKey extracts the granter, the grantee and the msg
+// type URL from an authorization store key.
+func ParseAuthorizationStoreKey(key []byte) (granter, grantee sdk.AccAddress, msgTypeURL string) {
+	// key is of format:
+	// 0x01<granter (20 bytes)><grantee (20 bytes)><msgTypeURL>
+	if len(key) < 1+2*sdk.AddrLen {
+		panic(fmt.Sprintf("unexpected key length (%d < %d)", len(key), 1+2*sdk.AddrLen))
+	}
+
+	addrs := key[1 : 1+2*sdk.AddrLen]
+	return sdk.AccAddress(addrs[:sdk.AddrLen]), sdk.AccAddress(addrs[sdk.AddrLen:]), string(key[1+2*sdk.AddrLen:])
+}
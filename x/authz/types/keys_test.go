@@ -0,0 +1,21 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+func TestGrantStoreKey(t *testing.T) {
+	msgTypeURL := "/cosmos.bank.v1beta1.MsgSend"
+	key := types.GetAuthorizationStoreKey(grantee, granter, msgTypeURL)
+
+	g1, g2, m := types.ParseAuthorizationStoreKey(key)
+	require.Equal(t, granter, g1)
+	require.Equal(t, grantee, g2)
+	require.Equal(t, msgTypeURL, m)
+
+	require.Equal(t, types.GetGrantsByGranterGranteePrefix(granter, grantee), key[:len(key)-len(msgTypeURL)])
+}
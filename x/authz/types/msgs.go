@@ -0,0 +1,259 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// authz message types
+const (
+	TypeMsgGrant  = "grant"
+	TypeMsgRevoke = "revoke"
+	TypeMsgExec   = "exec"
+)
+
+var (
+	_, _, _ sdk.Msg                       = &MsgGrant{}, &MsgRevoke{}, &MsgExec{}
+	_, _    types.UnpackInterfacesMessage = &MsgGrant{}, &MsgExec{}
+)
+
+// NewMsgGrant creates a new MsgGrant.
+//nolint:interfacer
+func NewMsgGrant(granter sdk.AccAddress, grantee sdk.AccAddress, a Authorization, expiration time.Time) (*MsgGrant, error) {
+	grant, err := NewGrant(a, expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgGrant{
+		Granter: granter.String(),
+		Grantee: grantee.String(),
+		Grant:   grant,
+	}, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgGrant) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgGrant) Type() string { return TypeMsgGrant }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgGrant) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+	if msg.Granter == msg.Grantee {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "granter and grantee cannot be same")
+	}
+
+	return msg.Grant.ValidateBasic()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgGrant) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{granter}
+}
+
+// GetAuthorization returns the cached value from the MsgGrant.Grant if present.
+func (msg MsgGrant) GetAuthorization() Authorization {
+	return msg.Grant.GetAuthorization()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return msg.Grant.UnpackInterfaces(unpacker)
+}
+
+// NewMsgRevoke creates a new MsgRevoke.
+//nolint:interfacer
+func NewMsgRevoke(granter sdk.AccAddress, grantee sdk.AccAddress, msgTypeURL string) MsgRevoke {
+	return MsgRevoke{
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+		MsgTypeUrl: msgTypeURL,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRevoke) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRevoke) Type() string { return TypeMsgRevoke }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevoke) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+	if msg.Granter == msg.Grantee {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "granter and grantee cannot be same")
+	}
+	if msg.MsgTypeUrl == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing msg type URL")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRevoke) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRevoke) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{granter}
+}
+
+// NewMsgExec creates a new MsgExec. The msgs may be either legacy sdk.Msgs or
+// ServiceMsgs.
+//nolint:interfacer
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) (MsgExec, error) {
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		var (
+			any *types.Any
+			err error
+		)
+
+		if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+			// As per ADR-031, a ServiceMsg is packed with its method name as type URL.
+			any, err = types.NewAnyWithValue(svcMsg.Request)
+			if err != nil {
+				return MsgExec{}, err
+			}
+			any.TypeUrl = svcMsg.MethodName
+		} else {
+			any, err = types.NewAnyWithValue(msg)
+			if err != nil {
+				return MsgExec{}, err
+			}
+		}
+
+		anys[i] = any
+	}
+
+	return MsgExec{
+		Grantee: grantee.String(),
+		Msgs:    anys,
+	}, nil
+}
+
+// GetMessages returns the cached sdk.Msgs from the MsgExec. Msgs packed with a
+// service method name as type URL are returned as ServiceMsgs.
+func (msg MsgExec) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, any := range msg.Msgs {
+		if isServiceMsg(any.TypeUrl) {
+			req, ok := any.GetCachedValue().(sdk.MsgRequest)
+			if !ok {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message contains %T which is not a sdk.MsgRequest", any.GetCachedValue())
+			}
+			msgs[i] = sdk.ServiceMsg{MethodName: any.TypeUrl, Request: req}
+			continue
+		}
+
+		m, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message contains %T which is not a sdk.Msg", any.GetCachedValue())
+		}
+		msgs[i] = m
+	}
+
+	return msgs, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgExec) Type() string { return TypeMsgExec }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgExec) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address (%s)", err)
+	}
+	if len(msg.Msgs) == 0 {
+		return ErrNoMessages
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+	for _, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{grantee}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgExec) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range msg.Msgs {
+		if isServiceMsg(any.TypeUrl) {
+			var req sdk.MsgRequest
+			if err := unpacker.UnpackAny(any, &req); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(any, &m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isServiceMsg checks if a type URL corresponds to a service method name,
+// i.e. /cosmos.bank.Msg/Send vs /cosmos.bank.MsgSend
+func isServiceMsg(typeURL string) bool {
+	return strings.Count(typeURL, "/") >= 2
+}
@@ -0,0 +1,138 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	coinsPos = sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	granter  = sdk.AccAddress("_______granter______")
+	grantee  = sdk.AccAddress("________grantee_____")
+)
+
+func TestMsgExecAuthorized(t *testing.T) {
+	tests := []struct {
+		title      string
+		grantee    sdk.AccAddress
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{"nil grantee address", nil, []sdk.Msg{}, false},
+		{"zero-messages test", grantee, []sdk.Msg{}, false},
+		{"invalid msg", grantee, []sdk.Msg{
+			&banktypes.MsgSend{
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("steak", 2)),
+				FromAddress: granter.String(),
+			},
+		}, false},
+		{"valid test", grantee, []sdk.Msg{
+			&banktypes.MsgSend{
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin("steak", 2)),
+				FromAddress: granter.String(),
+				ToAddress:   grantee.String(),
+			},
+		}, true},
+		{"valid service msg", grantee, []sdk.Msg{
+			sdk.ServiceMsg{
+				MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+				Request: &banktypes.MsgSend{
+					Amount:      sdk.NewCoins(sdk.NewInt64Coin("steak", 2)),
+					FromAddress: granter.String(),
+					ToAddress:   grantee.String(),
+				},
+			},
+		}, true},
+	}
+	for _, tc := range tests {
+		msg, err := types.NewMsgExec(tc.grantee, tc.msgs)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.title)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.title)
+		}
+	}
+}
+
+func TestMsgExecGetMessages(t *testing.T) {
+	send := &banktypes.MsgSend{
+		Amount:      coinsPos,
+		FromAddress: granter.String(),
+		ToAddress:   grantee.String(),
+	}
+	svcMsg := sdk.ServiceMsg{MethodName: "/cosmos.bank.v1beta1.Msg/Send", Request: send}
+
+	msg, err := types.NewMsgExec(grantee, []sdk.Msg{send, svcMsg})
+	require.NoError(t, err)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", msg.Msgs[0].TypeUrl)
+	require.Equal(t, "/cosmos.bank.v1beta1.Msg/Send", msg.Msgs[1].TypeUrl)
+
+	msgs, err := msg.GetMessages()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{send, svcMsg}, msgs)
+	require.Equal(t, []sdk.AccAddress{grantee}, msg.GetSigners())
+}
+
+func TestMsgRevokeAuthorization(t *testing.T) {
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		msgType          string
+		expectPass       bool
+	}{
+		{"nil Granter address", nil, grantee, "hello", false},
+		{"nil Grantee address", granter, nil, "hello", false},
+		{"nil Granter and Grantee address", nil, nil, "hello", false},
+		{"same granter and grantee", granter, granter, "hello", false},
+		{"empty msg type", granter, grantee, "", false},
+		{"valid test case", granter, grantee, "hello", true},
+	}
+	for i, tc := range tests {
+		msg := types.NewMsgRevoke(tc.granter, tc.grantee, tc.msgType)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgGrantAuthorization(t *testing.T) {
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		authorization    types.Authorization
+		expiration       time.Time
+		expectErr        bool
+		expectPass       bool
+	}{
+		{"nil granter address", nil, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
+		{"nil grantee address", granter, nil, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
+		{"nil granter and grantee address", nil, nil, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now(), false, false},
+		{"nil authorization", granter, grantee, nil, time.Now(), true, false},
+		{"invalid authorization", granter, grantee, &banktypes.SendAuthorization{}, time.Now(), false, false},
+		{"empty generic authorization", granter, grantee, types.NewGenericAuthorization(""), time.Now(), false, false},
+		{"valid test case", granter, grantee, &banktypes.SendAuthorization{SpendLimit: coinsPos}, time.Now().AddDate(0, 1, 0), false, true},
+	}
+	for i, tc := range tests {
+		msg, err := types.NewMsgGrant(tc.granter, tc.grantee, tc.authorization, tc.expiration)
+		if tc.expectErr {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
@@ -13,6 +13,10 @@ type GenesisBalance interface {
 
 // SupplyI defines an inflationary supply interface for modules that handle
 // token supply.
+//
+// Deprecated: the total supply is now stored per denom by the bank keeper.
+// SupplyI is only kept to decode the legacy single-blob supply during store
+// migrations.
 type SupplyI interface {
 	GetTotal() sdk.Coins
 	SetTotal(total sdk.Coins)
@@ -31,15 +31,23 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		genState.Supply = totalSupply
 	}
 
-	k.SetSupply(ctx, types.NewSupply(genState.Supply))
+	for _, supply := range genState.Supply {
+		k.setSupply(ctx, supply)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
 func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	totalSupply := sdk.NewCoins()
+	k.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		totalSupply = append(totalSupply, coin)
+		return false
+	})
+
 	return types.NewGenesisState(
 		k.GetParams(ctx),
 		k.GetAccountsBalances(ctx),
-		k.GetSupply(ctx).GetTotal(),
+		totalSupply,
 		k.GetAllDenomMetaData(ctx),
 	)
 }
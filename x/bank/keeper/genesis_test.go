@@ -12,23 +12,20 @@ func (suite *IntegrationTestSuite) TestExportGenesis() {
 	expectedBalances := suite.getTestBalances()
 	for i := range []int{1, 2} {
 		app.BankKeeper.SetDenomMetaData(ctx, expectedMetadata[i])
-		accAddr, err1 := sdk.AccAddressFromBech32(expectedBalances[i].Address)
-		if err1 != nil {
-			panic(err1)
-		}
-		err := app.BankKeeper.SetBalances(ctx, accAddr, expectedBalances[i].Coins)
-		suite.Require().NoError(err)
 	}
 
-	totalSupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("test", 400000000)))
-	app.BankKeeper.SetSupply(ctx, totalSupply)
-	app.BankKeeper.SetParams(ctx, types.DefaultParams())
+	// the total supply is derived from the balances when not provided
+	totalSupply := sdk.NewCoins()
+	for _, balance := range expectedBalances {
+		totalSupply = totalSupply.Add(balance.Coins...)
+	}
+	app.BankKeeper.InitGenesis(ctx, *types.NewGenesisState(types.DefaultParams(), expectedBalances, nil, nil))
 
 	exportGenesis := app.BankKeeper.ExportGenesis(ctx)
 
 	suite.Require().Len(exportGenesis.Params.SendEnabled, 0)
 	suite.Require().Equal(types.DefaultParams().DefaultSendEnabled, exportGenesis.Params.DefaultSendEnabled)
-	suite.Require().Equal(totalSupply.GetTotal(), exportGenesis.Supply)
+	suite.Require().Equal(totalSupply, exportGenesis.Supply)
 	suite.Require().Equal(expectedBalances, exportGenesis.Balances)
 	suite.Require().Equal(expectedMetadata, exportGenesis.DenomMetadata)
 }
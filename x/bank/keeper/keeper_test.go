@@ -12,11 +12,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 const (
@@ -66,6 +69,13 @@ type IntegrationTestSuite struct {
 	queryClient types.QueryClient
 }
 
+func (suite *IntegrationTestSuite) getTotalSupply(ctx sdk.Context, bk keeper.Keeper) sdk.Coins {
+	totalSupply, _, err := bk.GetPaginatedTotalSupply(ctx, &query.PageRequest{})
+	suite.Require().NoError(err)
+
+	return totalSupply
+}
+
 func (suite *IntegrationTestSuite) SetupTest() {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -89,10 +99,37 @@ func (suite *IntegrationTestSuite) TestSupply() {
 	initTokens := sdk.TokensFromConsensusPower(initialPower)
 
 	totalSupply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens))
-	app.BankKeeper.SetSupply(ctx, types.NewSupply(totalSupply))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, totalSupply))
 
-	total := app.BankKeeper.GetSupply(ctx).GetTotal()
+	total := suite.getTotalSupply(ctx, app.BankKeeper)
 	suite.Require().Equal(totalSupply, total)
+	suite.Require().Equal(totalSupply[0], app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+
+	// supply of an unknown denom is zero
+	suite.Require().Equal(sdk.NewCoin("unknown", sdk.ZeroInt()), app.BankKeeper.GetSupply(ctx, "unknown"))
+}
+
+func (suite *IntegrationTestSuite) TestGetPaginatedTotalSupply() {
+	app, ctx := suite.app, suite.ctx
+
+	totalSupply := sdk.NewCoins(newBarCoin(100), newFooCoin(200), sdk.NewInt64Coin("zcoin", 300))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, totalSupply))
+
+	supply, pageRes, err := app.BankKeeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: 2, CountTotal: true})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(100), newFooCoin(200)), supply)
+	suite.Require().Equal(uint64(3), pageRes.Total)
+	suite.Require().NotNil(pageRes.NextKey)
+
+	supply, pageRes, err = app.BankKeeper.GetPaginatedTotalSupply(ctx, &query.PageRequest{Key: pageRes.NextKey})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("zcoin", 300)), supply)
+	suite.Require().Nil(pageRes.NextKey)
+
+	// burning the whole supply of a denom removes it from the total supply
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, govtypes.ModuleName, sdk.NewCoins(newBarCoin(100))))
+	suite.Require().NoError(app.BankKeeper.BurnCoins(ctx, govtypes.ModuleName, sdk.NewCoins(newBarCoin(100))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(200), sdk.NewInt64Coin("zcoin", 300)), suite.getTotalSupply(ctx, app.BankKeeper))
 }
 
 func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
@@ -120,7 +157,6 @@ func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 	baseAcc := authKeeper.NewAccountWithAddress(ctx, authtypes.NewModuleAddress("baseAcc"))
 	suite.Require().NoError(keeper.SetBalances(ctx, holderAcc.GetAddress(), initCoins))
 
-	keeper.SetSupply(ctx, types.NewSupply(initCoins))
 	authKeeper.SetModuleAccount(ctx, holderAcc)
 	authKeeper.SetModuleAccount(ctx, burnerAcc)
 	authKeeper.SetAccount(ctx, baseAcc)
@@ -185,7 +221,7 @@ func (suite *IntegrationTestSuite) TestSupply_MintCoins() {
 	authKeeper.SetModuleAccount(ctx, multiPermAcc)
 	authKeeper.SetModuleAccount(ctx, randomPermAcc)
 
-	initialSupply := keeper.GetSupply(ctx)
+	initialSupply := suite.getTotalSupply(ctx, keeper)
 
 	suite.Require().Panics(func() { keeper.MintCoins(ctx, "", initCoins) }, "no module account")                // nolint:errcheck
 	suite.Require().Panics(func() { keeper.MintCoins(ctx, authtypes.Burner, initCoins) }, "invalid permission") // nolint:errcheck
@@ -199,16 +235,16 @@ func (suite *IntegrationTestSuite) TestSupply_MintCoins() {
 	suite.Require().NoError(err)
 
 	suite.Require().Equal(initCoins, getCoinsByName(ctx, keeper, authKeeper, authtypes.Minter))
-	suite.Require().Equal(initialSupply.GetTotal().Add(initCoins...), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().Equal(initialSupply.Add(initCoins...), suite.getTotalSupply(ctx, keeper))
 
 	// test same functionality on module account with multiple permissions
-	initialSupply = keeper.GetSupply(ctx)
+	initialSupply = suite.getTotalSupply(ctx, keeper)
 
 	err = keeper.MintCoins(ctx, multiPermAcc.GetName(), initCoins)
 	suite.Require().NoError(err)
 
 	suite.Require().Equal(initCoins, getCoinsByName(ctx, keeper, authKeeper, multiPermAcc.GetName()))
-	suite.Require().Equal(initialSupply.GetTotal().Add(initCoins...), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().Equal(initialSupply.Add(initCoins...), suite.getTotalSupply(ctx, keeper))
 	suite.Require().Panics(func() { keeper.MintCoins(ctx, authtypes.Burner, initCoins) }) // nolint:errcheck
 }
 
@@ -234,37 +270,38 @@ func (suite *IntegrationTestSuite) TestSupply_BurnCoins() {
 		app.GetSubspace(types.ModuleName), make(map[string]bool),
 	)
 
-	suite.Require().NoError(keeper.SetBalances(ctx, burnerAcc.GetAddress(), initCoins))
-	keeper.SetSupply(ctx, types.NewSupply(initCoins))
 	authKeeper.SetModuleAccount(ctx, burnerAcc)
+	authKeeper.SetModuleAccount(ctx, minterAcc)
+
+	// inflate supply
+	suite.Require().NoError(keeper.MintCoins(ctx, authtypes.Minter, initCoins))
+	supplyAfterInflation := suite.getTotalSupply(ctx, keeper)
 
-	initialSupply := keeper.GetSupply(ctx)
-	initialSupply.Inflate(initCoins)
-	keeper.SetSupply(ctx, initialSupply)
+	// burner account holds the newly minted coins
+	suite.Require().NoError(keeper.SendCoinsFromModuleToModule(ctx, authtypes.Minter, authtypes.Burner, initCoins))
 
-	suite.Require().Panics(func() { keeper.BurnCoins(ctx, "", initCoins) }, "no module account")                        // nolint:errcheck
-	suite.Require().Panics(func() { keeper.BurnCoins(ctx, authtypes.Minter, initCoins) }, "invalid permission")         // nolint:errcheck
-	suite.Require().Panics(func() { keeper.BurnCoins(ctx, randomPerm, initialSupply.GetTotal()) }, "random permission") // nolint:errcheck
-	err := keeper.BurnCoins(ctx, authtypes.Burner, initialSupply.GetTotal())
+	suite.Require().Panics(func() { keeper.BurnCoins(ctx, "", initCoins) }, "no module account")                    // nolint:errcheck
+	suite.Require().Panics(func() { keeper.BurnCoins(ctx, authtypes.Minter, initCoins) }, "invalid permission")     // nolint:errcheck
+	suite.Require().Panics(func() { keeper.BurnCoins(ctx, randomPerm, supplyAfterInflation) }, "random permission") // nolint:errcheck
+	err := keeper.BurnCoins(ctx, authtypes.Burner, supplyAfterInflation.Add(initCoins...))
 	suite.Require().Error(err, "insufficient coins")
 
 	err = keeper.BurnCoins(ctx, authtypes.Burner, initCoins)
 	suite.Require().NoError(err)
 	suite.Require().Equal(sdk.Coins(nil), getCoinsByName(ctx, keeper, authKeeper, authtypes.Burner))
-	suite.Require().Equal(initialSupply.GetTotal().Sub(initCoins), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().True(supplyAfterInflation.Sub(initCoins).IsEqual(suite.getTotalSupply(ctx, keeper)))
 
 	// test same functionality on module account with multiple permissions
-	initialSupply = keeper.GetSupply(ctx)
-	initialSupply.Inflate(initCoins)
-	keeper.SetSupply(ctx, initialSupply)
-
-	suite.Require().NoError(keeper.SetBalances(ctx, multiPermAcc.GetAddress(), initCoins))
 	authKeeper.SetModuleAccount(ctx, multiPermAcc)
 
+	suite.Require().NoError(keeper.MintCoins(ctx, authtypes.Minter, initCoins))
+	supplyAfterInflation = suite.getTotalSupply(ctx, keeper)
+	suite.Require().NoError(keeper.SendCoinsFromModuleToModule(ctx, authtypes.Minter, multiPermAcc.GetName(), initCoins))
+
 	err = keeper.BurnCoins(ctx, multiPermAcc.GetName(), initCoins)
 	suite.Require().NoError(err)
 	suite.Require().Equal(sdk.Coins(nil), getCoinsByName(ctx, keeper, authKeeper, multiPermAcc.GetName()))
-	suite.Require().Equal(initialSupply.GetTotal().Sub(initCoins), keeper.GetSupply(ctx).GetTotal())
+	suite.Require().True(supplyAfterInflation.Sub(initCoins).IsEqual(suite.getTotalSupply(ctx, keeper)))
 }
 
 func (suite *IntegrationTestSuite) TestSendCoinsNewAccount() {
@@ -950,6 +987,43 @@ func (suite *IntegrationTestSuite) TestUndelegateCoins() {
 	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addrModule).Empty())
 }
 
+func (suite *IntegrationTestSuite) TestDelegateCoins_TrackVesting() {
+	app, ctx := suite.app, suite.ctx
+	now := tmtime.Now()
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: now})
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addrModule := sdk.AccAddress([]byte("moduleAcc___________"))
+
+	macc := app.AccountKeeper.NewAccountWithAddress(ctx, addrModule) // we don't need to define an actual module account bc we just need the address for testing
+	bacc := authtypes.NewBaseAccountWithAddress(addr1)
+	vacc := vesting.NewContinuousVestingAccount(bacc, origCoins, ctx.BlockHeader().Time.Unix(), endTime.Unix())
+
+	app.AccountKeeper.SetAccount(ctx, vacc)
+	app.AccountKeeper.SetAccount(ctx, macc)
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, origCoins))
+
+	// half of the coins are vested
+	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
+
+	// the delegation is tracked from the vesting coins first
+	suite.Require().NoError(app.BankKeeper.DelegateCoins(ctx, addr1, addrModule, sdk.NewCoins(sdk.NewInt64Coin("stake", 70))))
+
+	acc := app.AccountKeeper.GetAccount(ctx, addr1).(*vesting.ContinuousVestingAccount)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), acc.DelegatedVesting)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), acc.DelegatedFree)
+
+	// the undelegation is tracked from the free coins first
+	suite.Require().NoError(app.BankKeeper.UndelegateCoins(ctx, addrModule, addr1, sdk.NewCoins(sdk.NewInt64Coin("stake", 30))))
+
+	acc = app.AccountKeeper.GetAccount(ctx, addr1).(*vesting.ContinuousVestingAccount)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), acc.DelegatedVesting)
+	suite.Require().True(acc.DelegatedFree.Empty())
+}
+
 func (suite *IntegrationTestSuite) TestUndelegateCoins_Invalid() {
 	app, ctx := suite.app, suite.ctx
 
@@ -3,3 +3,10 @@ package v040
 const (
 	ModuleName = "bank"
 )
+
+// KVStore keys
+var (
+	BalancesPrefix      = []byte("balances")
+	SupplyKey           = []byte{0x00}
+	DenomMetadataPrefix = []byte{0x1}
+)
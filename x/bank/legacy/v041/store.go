@@ -0,0 +1,64 @@
+package v041
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/exported"
+	v040bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v040"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// migrateSupply migrates the supply to be stored by denom key instead in a
+// single blob.
+func migrateSupply(store sdk.KVStore, cdc codec.BinaryMarshaler) error {
+	// Old supply was stored as a single blob under the SupplyKey.
+	bz := store.Get(v040bank.SupplyKey)
+	if bz == nil {
+		return nil
+	}
+
+	var oldSupplyI exported.SupplyI
+	if err := codec.UnmarshalAny(cdc, &oldSupplyI, bz); err != nil {
+		return err
+	}
+
+	oldSupply, ok := oldSupplyI.(*types.Supply)
+	if !ok {
+		return fmt.Errorf("unexpected supply type %T", oldSupplyI)
+	}
+
+	// We delete the single key holding the whole blob.
+	store.Delete(v040bank.SupplyKey)
+
+	// We add a new key for each denom.
+	supplyStore := prefix.NewStore(store, types.SupplyKey)
+	for _, coin := range oldSupply.Total {
+		// A zero supply is never stored in the new layout.
+		if coin.IsZero() {
+			continue
+		}
+
+		coinBz, err := coin.Amount.Marshal()
+		if err != nil {
+			return err
+		}
+
+		supplyStore.Set([]byte(coin.Denom), coinBz)
+	}
+
+	return nil
+}
+
+// MigrateStore performs in-place store migrations from v0.40 to v0.41. The
+// migration includes:
+//
+// - Change the total supply to be stored per denom under the SupplyKey prefix
+// instead of a single Supply blob.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler) error {
+	store := ctx.KVStore(storeKey)
+
+	return migrateSupply(store, cdc)
+}
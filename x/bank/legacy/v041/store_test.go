@@ -0,0 +1,52 @@
+package v041_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v040bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v040"
+	v041bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v041"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSupplyMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	bankKey := sdk.NewKVStoreKey("bank")
+	ctx := testutil.DefaultContext(bankKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(bankKey)
+
+	oldFooCoin := sdk.NewCoin("foo", sdk.NewInt(100))
+	oldBarCoin := sdk.NewCoin("bar", sdk.NewInt(200))
+	oldZeroCoin := sdk.NewCoin("zero", sdk.ZeroInt())
+
+	// Old supply was stored as a single blob under the `SupplyKey`.
+	oldSupply := types.NewSupply(sdk.Coins{oldBarCoin, oldFooCoin, oldZeroCoin})
+	bz, err := codec.MarshalAny(encCfg.Marshaler, oldSupply)
+	require.NoError(t, err)
+	store.Set(v040bank.SupplyKey, bz)
+
+	// Run migration.
+	err = v041bank.MigrateStore(ctx, bankKey, encCfg.Marshaler)
+	require.NoError(t, err)
+
+	// New supply is indexed by denom.
+	supplyStore := prefix.NewStore(store, types.SupplyKey)
+	bz = supplyStore.Get([]byte("foo"))
+	var amount sdk.Int
+	require.NoError(t, amount.Unmarshal(bz))
+	require.Equal(t, oldFooCoin.Amount, amount)
+
+	bz = supplyStore.Get([]byte("bar"))
+	require.NoError(t, amount.Unmarshal(bz))
+	require.Equal(t, oldBarCoin.Amount, amount)
+
+	// Zero supplies are not stored, and the old blob is removed.
+	require.Nil(t, supplyStore.Get([]byte("zero")))
+	require.Nil(t, store.Get(v040bank.SupplyKey))
+}
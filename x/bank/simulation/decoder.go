@@ -4,34 +4,36 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
-	"github.com/cosmos/cosmos-sdk/x/bank/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
-// SupplyUnmarshaler defines the expected encoding store functions.
-type SupplyUnmarshaler interface {
-	UnmarshalSupply([]byte) (exported.SupplyI, error)
-}
-
 // NewDecodeStore returns a function closure that unmarshals the KVPair's values
 // to the corresponding types.
-func NewDecodeStore(cdc SupplyUnmarshaler) func(kvA, kvB kv.Pair) string {
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
-		case bytes.Equal(kvA.Key[:1], types.SupplyKey):
-			supplyA, err := cdc.UnmarshalSupply(kvA.Value)
-			if err != nil {
+		case bytes.HasPrefix(kvA.Key, types.SupplyKey):
+			var supplyA, supplyB sdk.Int
+			if err := supplyA.Unmarshal(kvA.Value); err != nil {
 				panic(err)
 			}
 
-			supplyB, err := cdc.UnmarshalSupply(kvB.Value)
-			if err != nil {
+			if err := supplyB.Unmarshal(kvB.Value); err != nil {
 				panic(err)
 			}
 
 			return fmt.Sprintf("%v\n%v", supplyA, supplyB)
 
+		case bytes.HasPrefix(kvA.Key, types.BalancesPrefix):
+			var balanceA, balanceB sdk.Coin
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &balanceA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &balanceB)
+
+			return fmt.Sprintf("%v\n%v", balanceA, balanceB)
+
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
@@ -14,17 +14,21 @@ import (
 )
 
 func TestDecodeStore(t *testing.T) {
-	app := simapp.Setup(false)
-	dec := simulation.NewDecodeStore(app.BankKeeper)
+	cdc, _ := simapp.MakeCodecs()
+	dec := simulation.NewDecodeStore(cdc)
 
-	totalSupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	supply := sdk.NewInt(1000)
+	supplyBz, err := supply.Marshal()
+	require.NoError(t, err)
 
-	supplyBz, err := app.BankKeeper.MarshalSupply(totalSupply)
+	balance := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	balanceBz, err := cdc.MarshalBinaryBare(&balance)
 	require.NoError(t, err)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
-			{Key: types.SupplyKey, Value: supplyBz},
+			{Key: append(types.SupplyKey, []byte(sdk.DefaultBondDenom)...), Value: supplyBz},
+			{Key: append(types.BalancesPrefix, []byte(sdk.DefaultBondDenom)...), Value: balanceBz},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -33,7 +37,8 @@ func TestDecodeStore(t *testing.T) {
 		name        string
 		expectedLog string
 	}{
-		{"Supply", fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
+		{"Supply", fmt.Sprintf("%v\n%v", supply, supply)},
+		{"Balance", fmt.Sprintf("%v\n%v", balance, balance)},
 		{"other", ""},
 	}
 
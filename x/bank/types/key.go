@@ -22,7 +22,9 @@ const (
 
 // KVStore keys
 var (
-	BalancesPrefix      = []byte("balances")
+	BalancesPrefix = []byte("balances")
+	// SupplyKey is the prefix under which the total supply of each denom is
+	// stored, keyed by denom.
 	SupplyKey           = []byte{0x00}
 	DenomMetadataPrefix = []byte{0x1}
 )
@@ -219,6 +219,8 @@ func (m *QueryAllBalancesResponse) GetPagination() *query.PageResponse {
 // QueryTotalSupplyRequest is the request type for the Query/TotalSupply RPC
 // method.
 type QueryTotalSupplyRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryTotalSupplyRequest) Reset()         { *m = QueryTotalSupplyRequest{} }
@@ -254,11 +256,20 @@ func (m *QueryTotalSupplyRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryTotalSupplyRequest proto.InternalMessageInfo
 
+func (m *QueryTotalSupplyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryTotalSupplyResponse is the response type for the Query/TotalSupply RPC
 // method
 type QueryTotalSupplyResponse struct {
 	// supply is the supply of the coins
 	Supply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=supply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"supply"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryTotalSupplyResponse) Reset()         { *m = QueryTotalSupplyResponse{} }
@@ -301,6 +312,13 @@ func (m *QueryTotalSupplyResponse) GetSupply() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+func (m *QueryTotalSupplyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QuerySupplyOfRequest is the request type for the Query/SupplyOf RPC method.
 type QuerySupplyOfRequest struct {
 	// denom is the coin denom to query balances for.
@@ -491,50 +509,50 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 675 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xf6, 0x15, 0x9a, 0x96, 0xcb, 0x76, 0x0d, 0x22, 0x75, 0xc1, 0x41, 0xae, 0xa0, 0x49, 0x69,
-	0x7d, 0x24, 0x1d, 0x2a, 0xd8, 0x48, 0x25, 0x18, 0x18, 0x1a, 0x0c, 0x13, 0xdb, 0x25, 0x39, 0x8c,
-	0x55, 0xc7, 0xe7, 0xe6, 0x1c, 0x44, 0x54, 0x55, 0x20, 0x24, 0x24, 0x26, 0x40, 0x62, 0x60, 0x60,
-	0xe9, 0xcc, 0x2f, 0xe9, 0xc0, 0x50, 0x89, 0x85, 0x09, 0x50, 0x02, 0x12, 0x3f, 0x03, 0xe5, 0xee,
-	0x6c, 0x9c, 0xc6, 0x75, 0xb2, 0x30, 0xc5, 0xf7, 0xee, 0xbd, 0xef, 0x7d, 0xdf, 0xbb, 0xef, 0x2e,
-	0xb0, 0xd4, 0x62, 0xbc, 0xc3, 0x38, 0x6e, 0x12, 0x7f, 0x0f, 0x3f, 0xab, 0x36, 0x69, 0x48, 0xaa,
-	0x78, 0xbf, 0x47, 0xbb, 0x7d, 0x2b, 0xe8, 0xb2, 0x90, 0xa1, 0x25, 0x99, 0x60, 0x8d, 0x12, 0x2c,
-	0x95, 0xa0, 0xaf, 0xc7, 0x55, 0x9c, 0xca, 0xec, 0xb8, 0x36, 0x20, 0x8e, 0xeb, 0x93, 0xd0, 0x65,
-	0xbe, 0x04, 0xd0, 0x0b, 0x0e, 0x73, 0x98, 0xf8, 0xc4, 0xa3, 0x2f, 0x15, 0xbd, 0xec, 0x30, 0xe6,
-	0x78, 0x14, 0x93, 0xc0, 0xc5, 0xc4, 0xf7, 0x59, 0x28, 0x4a, 0xb8, 0xda, 0x35, 0x92, 0xf8, 0x11,
-	0x72, 0x8b, 0xb9, 0xfe, 0xc4, 0x7e, 0x82, 0xb5, 0x60, 0x28, 0xf6, 0xcd, 0x5d, 0xb8, 0xf4, 0x60,
-	0xc4, 0xaa, 0x4e, 0x3c, 0xe2, 0xb7, 0xa8, 0x4d, 0xf7, 0x7b, 0x94, 0x87, 0xa8, 0x08, 0x17, 0x48,
-	0xbb, 0xdd, 0xa5, 0x9c, 0x17, 0xc1, 0x55, 0x50, 0xbe, 0x60, 0x47, 0x4b, 0x54, 0x80, 0xf3, 0x6d,
-	0xea, 0xb3, 0x4e, 0x71, 0x4e, 0xc4, 0xe5, 0xe2, 0xf6, 0xe2, 0x9b, 0xa3, 0x92, 0xf6, 0xe7, 0xa8,
-	0xa4, 0x99, 0xf7, 0x61, 0x61, 0x1c, 0x90, 0x07, 0xcc, 0xe7, 0x14, 0x6d, 0xc1, 0x85, 0xa6, 0x0c,
-	0x09, 0xc4, 0x7c, 0x6d, 0xd9, 0x8a, 0xe7, 0xc5, 0x69, 0x34, 0x2f, 0x6b, 0x87, 0xb9, 0xbe, 0x1d,
-	0x65, 0x9a, 0xaf, 0x01, 0xbc, 0x24, 0xd0, 0xee, 0x78, 0x9e, 0x02, 0xe4, 0xd3, 0x29, 0xde, 0x85,
-	0xf0, 0xdf, 0x6c, 0x05, 0xcf, 0x7c, 0xed, 0xfa, 0x58, 0x37, 0x79, 0x6c, 0x51, 0xcf, 0x06, 0x71,
-	0x22, 0xe1, 0x76, 0xa2, 0x32, 0x21, 0xea, 0x0b, 0x80, 0xc5, 0x49, 0x1e, 0x4a, 0x99, 0x03, 0x17,
-	0x15, 0xdf, 0x11, 0x93, 0x73, 0x99, 0xd2, 0xea, 0x37, 0x8f, 0xbf, 0x97, 0xb4, 0xcf, 0x3f, 0x4a,
-	0x65, 0xc7, 0x0d, 0x9f, 0xf6, 0x9a, 0x56, 0x8b, 0x75, 0xb0, 0x3a, 0x22, 0xf9, 0xb3, 0xc9, 0xdb,
-	0x7b, 0x38, 0xec, 0x07, 0x94, 0x8b, 0x02, 0x6e, 0xc7, 0xe0, 0xe8, 0x5e, 0x8a, 0xae, 0xb5, 0xa9,
-	0xba, 0x24, 0xcb, 0xa4, 0x30, 0x73, 0x59, 0x4d, 0xf5, 0x11, 0x0b, 0x89, 0xf7, 0xb0, 0x17, 0x04,
-	0x5e, 0x5f, 0xe9, 0x37, 0x5f, 0x28, 0xa1, 0x63, 0x5b, 0x4a, 0x68, 0x0b, 0xe6, 0xb8, 0x88, 0xfc,
-	0x0f, 0x99, 0x0a, 0xda, 0xdc, 0x50, 0xfe, 0x91, 0xbd, 0x77, 0x9f, 0x44, 0xc7, 0x1d, 0xfb, 0x0e,
-	0x24, 0x7c, 0x67, 0x36, 0xe0, 0xc5, 0x53, 0xd9, 0x8a, 0xeb, 0x36, 0xcc, 0x91, 0x0e, 0xeb, 0xf9,
-	0xe1, 0x54, 0xb7, 0xd5, 0xcf, 0x8f, 0xb8, 0xda, 0x2a, 0xdd, 0x2c, 0x40, 0x24, 0x10, 0x1b, 0xa4,
-	0x4b, 0x3a, 0x91, 0xd9, 0xcc, 0x86, 0xba, 0x26, 0x51, 0x54, 0x75, 0xb9, 0x05, 0x73, 0x81, 0x88,
-	0xa8, 0x2e, 0x2b, 0x56, 0xca, 0x1b, 0x60, 0xc9, 0xa2, 0xa8, 0x8f, 0x2c, 0xa8, 0xfd, 0x9e, 0x87,
-	0xf3, 0x02, 0x12, 0x7d, 0x04, 0x70, 0x41, 0x99, 0x0a, 0x95, 0x53, 0x01, 0x52, 0x6e, 0xa8, 0x5e,
-	0x99, 0x21, 0x53, 0xb2, 0x34, 0xb7, 0x5f, 0x7d, 0xfd, 0xf5, 0x61, 0xae, 0x8a, 0x30, 0x4e, 0x7f,
-	0x0c, 0xa4, 0xbd, 0xf0, 0x81, 0xba, 0x3f, 0x87, 0xf8, 0x40, 0x0c, 0xf7, 0x10, 0x7d, 0x02, 0x30,
-	0x9f, 0x70, 0x3c, 0xda, 0x38, 0xbb, 0xe7, 0xe4, 0x05, 0xd5, 0x37, 0x67, 0xcc, 0x56, 0x2c, 0xb1,
-	0x60, 0x59, 0x41, 0x6b, 0x33, 0xb2, 0x44, 0xef, 0x00, 0xcc, 0x27, 0x6c, 0x9a, 0xc5, 0x6e, 0xd2,
-	0xe8, 0x59, 0xec, 0x52, 0xbc, 0x6f, 0xae, 0x0a, 0x76, 0x57, 0xd0, 0x4a, 0x2a, 0x3b, 0xe9, 0x5d,
-	0xf4, 0x16, 0xc0, 0xc5, 0xc8, 0x89, 0x28, 0xe3, 0x80, 0x4e, 0x79, 0x5b, 0x5f, 0x9f, 0x25, 0x55,
-	0x11, 0xb9, 0x21, 0x88, 0x5c, 0x43, 0xab, 0x19, 0x44, 0xe2, 0x03, 0x7c, 0x09, 0x60, 0x4e, 0xba,
-	0x0f, 0xad, 0x9d, 0xdd, 0x63, 0xcc, 0xea, 0x7a, 0x79, 0x7a, 0xe2, 0x4c, 0x33, 0x91, 0x3e, 0xaf,
-	0xef, 0x1c, 0x0f, 0x0c, 0x70, 0x32, 0x30, 0xc0, 0xcf, 0x81, 0x01, 0xde, 0x0f, 0x0d, 0xed, 0x64,
-	0x68, 0x68, 0xdf, 0x86, 0x86, 0xf6, 0xb8, 0x92, 0xf9, 0x36, 0x3c, 0x97, 0x68, 0xe2, 0x89, 0x68,
-	0xe6, 0xc4, 0x9f, 0xd5, 0xd6, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x31, 0x99, 0x37, 0x9b, 0x84,
-	0x07, 0x00, 0x00,
+	// 681 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0x15, 0x9a, 0x96, 0xcb, 0x76, 0x0d, 0x22, 0xb8, 0xe0, 0x20, 0x57, 0xd0, 0xb4, 0xb4,
+	0x3e, 0xda, 0x0e, 0x15, 0x6c, 0xa4, 0x12, 0x0c, 0x0c, 0x0d, 0x86, 0x89, 0xed, 0x92, 0x1c, 0xc6,
+	0xaa, 0xe3, 0x73, 0x73, 0x0e, 0xa2, 0xaa, 0x2a, 0x21, 0x24, 0x24, 0x26, 0x40, 0x62, 0x60, 0x60,
+	0xe9, 0xcc, 0x5f, 0xd2, 0x81, 0xa1, 0x12, 0x0b, 0x13, 0xa0, 0x16, 0x24, 0xfe, 0x0c, 0xe4, 0xfb,
+	0x61, 0xec, 0xc6, 0x4d, 0x2c, 0x04, 0x53, 0xec, 0xf3, 0xf7, 0xbe, 0xf7, 0xbd, 0xef, 0xde, 0x7b,
+	0x81, 0xf5, 0x0e, 0xe3, 0x3d, 0xc6, 0x71, 0x9b, 0x04, 0x5b, 0xf8, 0xe9, 0x4a, 0x9b, 0x46, 0x64,
+	0x05, 0x6f, 0x0f, 0x68, 0x7f, 0xc7, 0x0e, 0xfb, 0x2c, 0x62, 0x68, 0x46, 0x02, 0xec, 0x18, 0x60,
+	0x2b, 0x80, 0xb1, 0x98, 0x44, 0x71, 0x2a, 0xd1, 0x49, 0x6c, 0x48, 0x5c, 0x2f, 0x20, 0x91, 0xc7,
+	0x02, 0x49, 0x60, 0x54, 0x5d, 0xe6, 0x32, 0xf1, 0x88, 0xe3, 0x27, 0x75, 0x7a, 0xc9, 0x65, 0xcc,
+	0xf5, 0x29, 0x26, 0xa1, 0x87, 0x49, 0x10, 0xb0, 0x48, 0x84, 0x70, 0xf5, 0xd5, 0x4c, 0xf3, 0x6b,
+	0xe6, 0x0e, 0xf3, 0x82, 0xa1, 0xef, 0x29, 0xd5, 0xf1, 0x8b, 0xfc, 0x6e, 0x6d, 0xc2, 0x99, 0xfb,
+	0xb1, 0xaa, 0x26, 0xf1, 0x49, 0xd0, 0xa1, 0x0e, 0xdd, 0x1e, 0x50, 0x1e, 0xa1, 0x1a, 0x9c, 0x22,
+	0xdd, 0x6e, 0x9f, 0x72, 0x5e, 0x03, 0x57, 0x40, 0xe3, 0x9c, 0xa3, 0x5f, 0x51, 0x15, 0x4e, 0x76,
+	0x69, 0xc0, 0x7a, 0xb5, 0x09, 0x71, 0x2e, 0x5f, 0x6e, 0x4d, 0xbf, 0xda, 0xaf, 0x97, 0x7e, 0xed,
+	0xd7, 0x4b, 0xd6, 0x3d, 0x58, 0xcd, 0x12, 0xf2, 0x90, 0x05, 0x9c, 0xa2, 0x35, 0x38, 0xd5, 0x96,
+	0x47, 0x82, 0xb1, 0xb2, 0x7a, 0xd1, 0x4e, 0xfc, 0xe2, 0x54, 0xfb, 0x65, 0x6f, 0x30, 0x2f, 0x70,
+	0x34, 0xd2, 0x7a, 0x09, 0xe0, 0x05, 0xc1, 0x76, 0xdb, 0xf7, 0x15, 0x21, 0x1f, 0x2f, 0xf1, 0x0e,
+	0x84, 0x7f, 0xbc, 0x15, 0x3a, 0x2b, 0xab, 0xd7, 0x32, 0xd9, 0xe4, 0xb5, 0xe9, 0x9c, 0x2d, 0xe2,
+	0xea, 0xc2, 0x9d, 0x54, 0x64, 0xaa, 0xa8, 0x4f, 0x00, 0xd6, 0x86, 0x75, 0xa8, 0xca, 0x5c, 0x38,
+	0xad, 0xf4, 0xc6, 0x4a, 0xce, 0x8c, 0x2c, 0xad, 0x79, 0xe3, 0xe0, 0x6b, 0xbd, 0xf4, 0xf1, 0x5b,
+	0xbd, 0xe1, 0x7a, 0xd1, 0x93, 0x41, 0xdb, 0xee, 0xb0, 0x1e, 0x56, 0x57, 0x24, 0x7f, 0x96, 0x79,
+	0x77, 0x0b, 0x47, 0x3b, 0x21, 0xe5, 0x22, 0x80, 0x3b, 0x09, 0x39, 0xba, 0x9b, 0x53, 0xd7, 0xfc,
+	0xd8, 0xba, 0xa4, 0xca, 0x74, 0x61, 0x16, 0x51, 0xae, 0x3e, 0x64, 0x11, 0xf1, 0x1f, 0x0c, 0xc2,
+	0xd0, 0xdf, 0xd1, 0xae, 0x66, 0xbd, 0x03, 0x7f, 0xeb, 0x9d, 0x75, 0xa0, 0x1d, 0xcb, 0xe4, 0x50,
+	0x8e, 0x75, 0x60, 0x99, 0x8b, 0x93, 0xff, 0xe1, 0x97, 0xa2, 0xfe, 0x77, 0x6e, 0x2d, 0xa9, 0x8e,
+	0x96, 0x45, 0x6c, 0x3e, 0xd6, 0x56, 0x25, 0x93, 0x00, 0x52, 0x93, 0x60, 0xb5, 0xe0, 0xf9, 0x13,
+	0x68, 0x55, 0xf4, 0x3a, 0x2c, 0x93, 0x1e, 0x1b, 0x04, 0xd1, 0xd8, 0xfe, 0x6f, 0x9e, 0x8d, 0x8b,
+	0x76, 0x14, 0xdc, 0xaa, 0x42, 0x24, 0x18, 0x5b, 0xa4, 0x4f, 0x7a, 0xba, 0xfd, 0xad, 0x16, 0x9c,
+	0xc9, 0x9c, 0xaa, 0x2c, 0x37, 0x61, 0x39, 0x14, 0x27, 0x2a, 0xcb, 0xac, 0x9d, 0xb3, 0x95, 0x6c,
+	0x19, 0xa4, 0xf3, 0xc8, 0x80, 0xd5, 0x9f, 0x93, 0x70, 0x52, 0x50, 0xa2, 0xf7, 0x00, 0x4e, 0xa9,
+	0x36, 0x47, 0x8d, 0x5c, 0x82, 0x9c, 0x9d, 0x61, 0x2c, 0x14, 0x40, 0x4a, 0x95, 0xd6, 0xfa, 0x8b,
+	0xcf, 0x3f, 0xde, 0x4d, 0xac, 0x20, 0x8c, 0xf3, 0xd7, 0x93, 0x40, 0x73, 0xbc, 0xab, 0x26, 0x7a,
+	0x0f, 0xef, 0x0a, 0x73, 0xf7, 0xd0, 0x07, 0x00, 0x2b, 0xa9, 0x19, 0x44, 0x4b, 0xa7, 0xe7, 0x1c,
+	0x5e, 0x19, 0xc6, 0x72, 0x41, 0xb4, 0x52, 0x89, 0x85, 0xca, 0x05, 0x34, 0x5f, 0x50, 0x25, 0x7a,
+	0x03, 0x60, 0x25, 0xd5, 0xef, 0xa3, 0xd4, 0x0d, 0x8f, 0x9e, 0xb1, 0x5c, 0x10, 0xad, 0xd4, 0xcd,
+	0x09, 0x75, 0x97, 0xd1, 0x6c, 0xae, 0x3a, 0x35, 0x04, 0xaf, 0x01, 0x9c, 0xd6, 0x9d, 0x88, 0x46,
+	0x5c, 0xd0, 0x89, 0xde, 0x36, 0x16, 0x8b, 0x40, 0x95, 0x90, 0xeb, 0x42, 0xc8, 0x55, 0x34, 0x37,
+	0x42, 0x48, 0x72, 0x81, 0xcf, 0x01, 0x2c, 0xcb, 0xee, 0x43, 0xf3, 0xa7, 0xe7, 0xc8, 0xb4, 0xba,
+	0xd1, 0x18, 0x0f, 0x2c, 0xe4, 0x89, 0xec, 0xf3, 0xe6, 0xc6, 0xc1, 0x91, 0x09, 0x0e, 0x8f, 0x4c,
+	0xf0, 0xfd, 0xc8, 0x04, 0x6f, 0x8f, 0xcd, 0xd2, 0xe1, 0xb1, 0x59, 0xfa, 0x72, 0x6c, 0x96, 0x1e,
+	0x2d, 0x8c, 0x5c, 0x32, 0xcf, 0x24, 0x9b, 0xd8, 0x35, 0xed, 0xb2, 0xf8, 0xfb, 0x5c, 0xfb, 0x3d,
+	0x00, 0x73, 0xfc, 0x5d, 0xa3, 0x16, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -954,6 +972,18 @@ func (m *QueryTotalSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -977,6 +1007,18 @@ func (m *QueryTotalSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Supply) > 0 {
 		for iNdEx := len(m.Supply) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1196,6 +1238,10 @@ func (m *QueryTotalSupplyRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1211,6 +1257,10 @@ func (m *QueryTotalSupplyResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1743,6 +1793,42 @@ func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryTotalSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1830,6 +1916,42 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
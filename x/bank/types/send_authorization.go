@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+var _ authztypes.Authorization = &SendAuthorization{}
+
+// NewSendAuthorization creates a new SendAuthorization object.
+func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+	return &SendAuthorization{
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a SendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSend{})
+}
+
+// Accept implements Authorization.Accept.
+func (a SendAuthorization) Accept(ctx sdk.Context, msg sdk.MsgRequest) (authztypes.AcceptResponse, error) {
+	mSend, ok := msg.(*MsgSend)
+	if !ok {
+		return authztypes.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", &MsgSend{}, msg)
+	}
+
+	limitLeft, isNegative := a.SpendLimit.SafeSub(mSend.Amount)
+	if isNegative {
+		return authztypes.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "requested amount %s is more than spend limit %s", mSend.Amount, a.SpendLimit)
+	}
+	if limitLeft.IsZero() {
+		return authztypes.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authztypes.AcceptResponse{Accept: true, Updated: &SendAuthorization{SpendLimit: limitLeft}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a SendAuthorization) ValidateBasic() error {
+	if a.SpendLimit == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit cannot be nil")
+	}
+	if !a.SpendLimit.IsValid() || !a.SpendLimit.IsAllPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit must be valid and positive: %s", a.SpendLimit)
+	}
+
+	return nil
+}
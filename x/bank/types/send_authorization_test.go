@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	coins1000 = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	coins500  = sdk.NewCoins(sdk.NewInt64Coin("stake", 500))
+	fromAddr  = sdk.AccAddress("_____from _____")
+	toAddr    = sdk.AccAddress("_______to________")
+)
+
+func TestSendAuthorization(t *testing.T) {
+	ctx := testutil.DefaultContext(sdk.NewKVStoreKey("test"), sdk.NewTransientStoreKey("transient_test"))
+	authorization := types.NewSendAuthorization(coins1000)
+
+	t.Log("verify authorization returns valid method name")
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", authorization.MsgTypeURL())
+	require.NoError(t, authorization.ValidateBasic())
+
+	send := types.NewMsgSend(fromAddr, toAddr, coins1000)
+
+	t.Log("verify updated authorization returns nil")
+	resp, err := authorization.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	authorization = types.NewSendAuthorization(coins1000)
+	send = types.NewMsgSend(fromAddr, toAddr, coins500)
+	t.Log("verify updated authorization returns remaining spent limit")
+	resp, err = authorization.Accept(ctx, send)
+	require.NoError(t, err)
+	require.False(t, resp.Delete)
+	require.NotNil(t, resp.Updated)
+	sendAuth := types.NewSendAuthorization(coins500)
+	require.Equal(t, sendAuth.String(), resp.Updated.String())
+
+	t.Log("expect updated authorization nil after spending remaining amount")
+	resp, err = resp.Updated.Accept(ctx, send)
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	t.Log("expect error when the amount exceeds the spend limit")
+	_, err = sendAuth.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins1000))
+	require.Error(t, err)
+
+	t.Log("expect error for other msg types")
+	_, err = sendAuth.Accept(ctx, types.NewMsgMultiSend(nil, nil))
+	require.Error(t, err)
+
+	t.Log("expect invalid spend limits to fail validation")
+	require.Error(t, types.NewSendAuthorization(nil).ValidateBasic())
+	require.Error(t, types.NewSendAuthorization(sdk.Coins{sdk.NewInt64Coin("stake", 0)}).ValidateBasic())
+}
@@ -13,6 +13,8 @@ import (
 var _ exported.SupplyI = (*Supply)(nil)
 
 // NewSupply creates a new Supply instance
+//
+// Deprecated: the total supply is now stored per denom.
 func NewSupply(total sdk.Coins) *Supply {
 	return &Supply{total}
 }
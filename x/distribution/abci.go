@@ -35,4 +35,10 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
+
+	// restake the rewards of the delegations which opted in
+	k.ProcessAutoRestakes(ctx)
+
+	// pay the installments of the community pool budgets
+	k.ProcessBudgets(ctx)
 }
@@ -32,6 +32,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryDelegatorAutoRestakes(),
+		GetCmdQueryBudgets(),
+		GetCmdQueryBudget(),
 	)
 
 	return distQueryCmd
@@ -325,3 +328,140 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryDelegatorAutoRestakes returns the command for fetching the
+// validators a delegator restakes the rewards of.
+func GetCmdQueryDelegatorAutoRestakes() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "auto-restakes [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the validators a delegator auto restakes the rewards of",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators whose delegation rewards are automatically restaked for a delegator.
+
+Example:
+$ %s query distribution auto-restakes %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorAutoRestakes(
+				context.Background(),
+				&types.QueryDelegatorAutoRestakesRequest{DelegatorAddress: delegatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBudgets returns the command for fetching the active community
+// pool budgets.
+func GetCmdQueryBudgets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "budgets",
+		Args:  cobra.NoArgs,
+		Short: "Query the active community pool budgets",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the community pool budgets which are not fully paid yet.
+
+Example:
+$ %s query distribution budgets
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Budgets(
+				context.Background(),
+				&types.QueryBudgetsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "budgets")
+	return cmd
+}
+
+// GetCmdQueryBudget returns the command for fetching a community pool budget.
+func GetCmdQueryBudget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "budget [budget-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a community pool budget",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a community pool budget by id.
+
+Example:
+$ %s query distribution budget 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			budgetID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("budget-id %s not a valid uint, please input a valid budget-id", args[0])
+			}
+
+			res, err := queryClient.Budget(
+				context.Background(),
+				&types.QueryBudgetRequest{BudgetId: budgetID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.Budget)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -22,3 +22,19 @@ func ParseCommunityPoolSpendProposalWithDeposit(cdc codec.JSONMarshaler, proposa
 
 	return proposal, nil
 }
+
+// ParseCommunityPoolBudgetProposalWithDeposit reads and parses a CommunityPoolBudgetProposalWithDeposit from a file.
+func ParseCommunityPoolBudgetProposalWithDeposit(cdc codec.JSONMarshaler, proposalFile string) (types.CommunityPoolBudgetProposalWithDeposit, error) {
+	proposal := types.CommunityPoolBudgetProposalWithDeposit{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -6,7 +6,13 @@ import (
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
 )
 
-// ProposalHandler is the community spend proposal handler.
 var (
+	// ProposalHandler is the community spend proposal handler.
 	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	// BudgetProposalHandler is the community pool budget proposal handler.
+	BudgetProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitBudgetProposal, rest.BudgetProposalRESTHandler)
+	// CancelBudgetProposalHandler is the community pool budget cancel proposal handler.
+	CancelBudgetProposalHandler = govclient.NewProposalHandler(
+		cli.GetCmdSubmitCancelBudgetProposal, rest.CancelBudgetProposalRESTHandler,
+	)
 )
@@ -1,6 +1,8 @@
 package rest
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 )
@@ -17,4 +19,30 @@ type (
 		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
 		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
 	}
+
+	// CommunityPoolBudgetProposalReq defines a community pool budget proposal request body.
+	CommunityPoolBudgetProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title           string         `json:"title" yaml:"title"`
+		Description     string         `json:"description" yaml:"description"`
+		Recipient       sdk.AccAddress `json:"recipient" yaml:"recipient"`
+		TotalAmount     sdk.Coins      `json:"total_amount" yaml:"total_amount"`
+		StartTime       time.Time      `json:"start_time" yaml:"start_time"`
+		Period          time.Duration  `json:"period" yaml:"period"`
+		AmountPerPeriod sdk.Coins      `json:"amount_per_period" yaml:"amount_per_period"`
+		Proposer        sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit         sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// CancelCommunityPoolBudgetProposalReq defines a community pool budget cancel proposal request body.
+	CancelCommunityPoolBudgetProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		BudgetID    uint64         `json:"budget_id" yaml:"budget_id"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
 )
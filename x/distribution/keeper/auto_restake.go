@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// autoRestakesPageSize is the number of auto restakes loaded at once while
+// processing them.
+const autoRestakesPageSize = 100
+
+// SetAutoRestake enables the restake of the rewards of a delegation.
+func (k Keeper) SetAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoRestakeKey(delAddr, valAddr), []byte{0x01})
+}
+
+// DeleteAutoRestake disables the restake of the rewards of a delegation.
+func (k Keeper) DeleteAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoRestakeKey(delAddr, valAddr))
+}
+
+// HasAutoRestake returns whether the rewards of a delegation are restaked.
+func (k Keeper) HasAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoRestakeKey(delAddr, valAddr))
+}
+
+// GetDelegatorAutoRestakes returns the validators a delegator restakes the
+// rewards of.
+func (k Keeper) GetDelegatorAutoRestakes(ctx sdk.Context, delAddr sdk.AccAddress) (valAddrs []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDelegatorAutoRestakesPrefix(delAddr))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, valAddr := types.GetAutoRestakeAddresses(iter.Key())
+		valAddrs = append(valAddrs, valAddr)
+	}
+	return valAddrs
+}
+
+// IterateAutoRestakes iterates over the delegations whose rewards are restaked.
+func (k Keeper) IterateAutoRestakes(ctx sdk.Context, handler func(delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoRestakePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(types.GetAutoRestakeAddresses(iter.Key())) {
+			break
+		}
+	}
+}
+
+// ProcessAutoRestakes withdraws the rewards of the delegations which opted in
+// to be restaked and delegates them back to their validator, provided they
+// are above the AutoRestakeThreshold. The delegations are processed in store
+// order, starting where the previous block stopped, until the
+// AutoRestakeGasBudget is consumed or they have all been processed once.
+func (k Keeper) ProcessAutoRestakes(ctx sdk.Context) {
+	budget := k.GetAutoRestakeGasBudget(ctx)
+	if budget == 0 {
+		return
+	}
+
+	gasMeter := sdk.NewInfiniteGasMeter()
+	restakeCtx := ctx.WithGasMeter(gasMeter)
+
+	start := k.getAutoRestakeCursor(ctx)
+	for {
+		keys := k.getAutoRestakeKeys(ctx, start, autoRestakesPageSize+1)
+		for i, key := range keys {
+			if i == autoRestakesPageSize {
+				start = key
+				break
+			}
+
+			if gasMeter.GasConsumed() >= budget {
+				k.setAutoRestakeCursor(ctx, key)
+				return
+			}
+
+			delAddr, valAddr := types.GetAutoRestakeAddresses(key)
+			cacheCtx, write := restakeCtx.CacheContext()
+			if err := k.restake(cacheCtx, delAddr, valAddr); err != nil {
+				k.Logger(ctx).Debug(fmt.Sprintf("delegation of %s to %s not restaked: %s", delAddr, valAddr, err))
+				continue
+			}
+
+			write()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+
+		if len(keys) <= autoRestakesPageSize {
+			// all the auto restakes were processed, the next block starts over
+			k.deleteAutoRestakeCursor(ctx)
+			return
+		}
+	}
+}
+
+// restake withdraws the rewards of a delegation and delegates them back to its
+// validator.
+func (k Keeper) restake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorExists
+	}
+
+	delegation := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if delegation == nil {
+		return types.ErrNoDelegationExists
+	}
+
+	// the rewards can only be delegated back if the delegator receives them
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return types.ErrAutoRestakeWithdrawAddr
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	threshold := k.GetAutoRestakeThreshold(ctx)
+	endingPeriod := k.IncrementValidatorPeriod(ctx, validator)
+	rewards := k.CalculateDelegationRewards(ctx, validator, delegation, endingPeriod)
+	if rewards.AmountOf(bondDenom).TruncateInt().LT(threshold) {
+		return fmt.Errorf("rewards %s below the auto restake threshold %s", rewards, threshold)
+	}
+
+	withdrawn, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	amount := withdrawn.AmountOf(bondDenom)
+	if !amount.IsPositive() {
+		return fmt.Errorf("no %s rewards to restake", bondDenom)
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoRestake,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+
+	return nil
+}
+
+// getAutoRestakeKeys returns up to limit auto restake keys, starting at start
+// or at the first one if start is nil.
+func (k Keeper) getAutoRestakeKeys(ctx sdk.Context, start []byte, limit int) (keys [][]byte) {
+	if start == nil {
+		start = types.AutoRestakePrefix
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AutoRestakePrefix))
+	defer iter.Close()
+	for ; iter.Valid() && len(keys) < limit; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	return keys
+}
+
+// getAutoRestakeCursor returns the key of the next auto restake to process,
+// nil to start from the first one.
+func (k Keeper) getAutoRestakeCursor(ctx sdk.Context) []byte {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.AutoRestakeCursorKey)
+}
+
+func (k Keeper) setAutoRestakeCursor(ctx sdk.Context, key []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoRestakeCursorKey, key)
+}
+
+func (k Keeper) deleteAutoRestakeCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoRestakeCursorKey)
+}
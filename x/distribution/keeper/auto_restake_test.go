@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// countAutoRestakeEvents returns the number of auto restake events emitted so
+// far in ctx.
+func countAutoRestakeEvents(ctx sdk.Context) (count int) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeAutoRestake {
+			count++
+		}
+	}
+	return count
+}
+
+func TestProcessAutoRestakes(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.TokensFromConsensusPower(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1000)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator without commission and delegate the same amount to it
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.DelegateWithPower(addrs[1], valAddrs[0], 100)
+	tstaking.DelegateWithPower(addrs[2], valAddrs[0], 100)
+
+	// end block to bond validator and start new block
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// only the first delegator opts in, the second one has another withdraw
+	// address
+	app.DistrKeeper.SetAutoRestake(ctx, addrs[1], valAddrs[0])
+	app.DistrKeeper.SetAutoRestake(ctx, addrs[2], valAddrs[0])
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addrs[2], addrs[0]))
+	require.Equal(t, []sdk.ValAddress{valAddrs[0]}, app.DistrKeeper.GetDelegatorAutoRestakes(ctx, addrs[1]))
+	require.Empty(t, app.DistrKeeper.GetDelegatorAutoRestakes(ctx, addrs[0]))
+
+	// allocate rewards below the threshold
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	threshold := app.DistrKeeper.GetAutoRestakeThreshold(ctx)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, threshold.MulRaw(3).ToDec().QuoInt64(2))))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Zero(t, countAutoRestakeEvents(ctx))
+
+	// allocate rewards above the threshold
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, threshold.MulRaw(3).ToDec())))
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[1], valAddrs[0])
+	require.True(t, found)
+	balance := app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom)
+
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, 1, countAutoRestakeEvents(ctx))
+
+	// the rewards of the first delegator are delegated back
+	val = app.StakingKeeper.Validator(ctx, valAddrs[0])
+	restaked, found := app.StakingKeeper.GetDelegation(ctx, addrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, threshold.MulRaw(3).QuoRaw(2), val.TokensFromShares(restaked.Shares.Sub(delegation.Shares)).TruncateInt())
+	require.Equal(t, balance, app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom))
+
+	// the rewards of the second delegator are left untouched
+	rewards := app.DistrKeeper.CalculateDelegationRewards(ctx, val, app.StakingKeeper.Delegation(ctx, addrs[2], valAddrs[0]), app.DistrKeeper.IncrementValidatorPeriod(ctx, val))
+	require.Equal(t, threshold.MulRaw(3).QuoRaw(2), rewards.AmountOf(sdk.DefaultBondDenom).TruncateInt())
+
+	// removing the delegation disables its auto restake
+	tstaking.Ctx = ctx
+	tstaking.Undelegate(addrs[1], valAddrs[0], val.TokensFromShares(restaked.Shares).TruncateInt(), true)
+	require.False(t, app.DistrKeeper.HasAutoRestake(ctx, addrs[1], valAddrs[0]))
+	require.True(t, app.DistrKeeper.HasAutoRestake(ctx, addrs[2], valAddrs[0]))
+}
+
+func TestProcessAutoRestakesGasBudget(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 4, sdk.TokensFromConsensusPower(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1000)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	for _, addr := range addrs[1:] {
+		tstaking.DelegateWithPower(addr, valAddrs[0], 100)
+		app.DistrKeeper.SetAutoRestake(ctx, addr, valAddrs[0])
+	}
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// a budget of one gas unit lets a single delegation be restaked per block
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoRestakeThreshold = sdk.OneInt()
+	params.AutoRestakeGasBudget = 1
+	app.DistrKeeper.SetParams(ctx, params)
+
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(4))))
+
+	for i := 1; i <= 3; i++ {
+		app.DistrKeeper.ProcessAutoRestakes(ctx)
+		require.Equal(t, i, countAutoRestakeEvents(ctx))
+	}
+
+	// all the delegations were restaked once, the next pass starts over but
+	// there is nothing left to restake
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, 3, countAutoRestakeEvents(ctx))
+
+	// a zero budget disables the auto restakes
+	params.AutoRestakeGasBudget = 0
+	app.DistrKeeper.SetParams(ctx, params)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(4))))
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, 3, countAutoRestakeEvents(ctx))
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetBudget returns a community pool budget by id.
+func (k Keeper) GetBudget(ctx sdk.Context, id uint64) (budget types.Budget, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBudgetKey(id))
+	if bz == nil {
+		return budget, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &budget)
+	return budget, true
+}
+
+// SetBudget sets a community pool budget.
+func (k Keeper) SetBudget(ctx sdk.Context, budget types.Budget) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&budget)
+	store.Set(types.GetBudgetKey(budget.Id), bz)
+}
+
+// DeleteBudget deletes a community pool budget.
+func (k Keeper) DeleteBudget(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBudgetKey(id))
+}
+
+// IterateBudgets iterates over the community pool budgets by id.
+func (k Keeper) IterateBudgets(ctx sdk.Context, handler func(budget types.Budget) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.BudgetPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var budget types.Budget
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &budget)
+		if handler(budget) {
+			break
+		}
+	}
+}
+
+// GetBudgets returns all the community pool budgets.
+func (k Keeper) GetBudgets(ctx sdk.Context) (budgets []types.Budget) {
+	k.IterateBudgets(ctx, func(budget types.Budget) (stop bool) {
+		budgets = append(budgets, budget)
+		return false
+	})
+	return budgets
+}
+
+// GetNextBudgetID returns the id of the next community pool budget.
+func (k Keeper) GetNextBudgetID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextBudgetIDKey)
+	if bz == nil {
+		return types.DefaultStartingBudgetID
+	}
+	return types.GetBudgetIDFromBytes(bz)
+}
+
+// SetNextBudgetID sets the id of the next community pool budget.
+func (k Keeper) SetNextBudgetID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextBudgetIDKey, types.GetBudgetIDBytes(id))
+}
+
+// CreateBudget creates a community pool budget paid to a recipient and
+// returns its id.
+func (k Keeper) CreateBudget(
+	ctx sdk.Context, recipient sdk.AccAddress, totalAmount sdk.Coins, startTime time.Time, period time.Duration,
+	amountPerPeriod sdk.Coins,
+) uint64 {
+	id := k.GetNextBudgetID(ctx)
+	k.SetBudget(ctx, types.NewBudget(id, recipient, totalAmount, startTime, period, amountPerPeriod))
+	k.SetNextBudgetID(ctx, id+1)
+	return id
+}
+
+// ProcessBudgets pays the installments of the community pool budgets due at the
+// block time from the community pool. An installment the community pool can't
+// cover is paid at a later block, once the pool has been funded. The budgets
+// whose total amount has been paid are deleted.
+func (k Keeper) ProcessBudgets(ctx sdk.Context) {
+	for _, budget := range k.GetBudgets(ctx) {
+		due := budget.DueAmount(ctx.BlockTime())
+		if due.IsZero() {
+			continue
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(budget.Recipient)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.DistributeFromFeePool(ctx, due, recipient); err != nil {
+			k.Logger(ctx).Debug(fmt.Sprintf("installment %s of budget %d not paid: %s", due, budget.Id, err))
+			continue
+		}
+
+		budget.PaidAmount = budget.PaidAmount.Add(due...)
+		if budget.IsComplete() {
+			k.DeleteBudget(ctx, budget.Id)
+		} else {
+			k.SetBudget(ctx, budget)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBudgetPayout,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, due.String()),
+				sdk.NewAttribute(types.AttributeKeyBudgetID, fmt.Sprintf("%d", budget.Id)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, budget.Recipient),
+			),
+		)
+	}
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestProcessBudgets(t *testing.T) {
+	app := simapp.Setup(false)
+	startTime := time.Unix(1000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: startTime.Add(-time.Second)})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(250))
+	recipient, funder := addrs[0], addrs[1]
+	pool := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 125))
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, pool, funder))
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, pool, funder))
+
+	total := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 350))
+	perPeriod := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	id := app.DistrKeeper.CreateBudget(ctx, recipient, total, startTime, time.Hour, perPeriod)
+	require.Equal(t, uint64(1), id)
+	require.Equal(t, uint64(2), app.DistrKeeper.GetNextBudgetID(ctx))
+
+	// the recipient starts with 250 tokens
+	balanceOf := func(ctx sdk.Context) int64 {
+		return app.BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom).Amount.Int64() - 250
+	}
+
+	// nothing is due before the start time
+	app.DistrKeeper.ProcessBudgets(ctx)
+	require.Equal(t, int64(0), balanceOf(ctx))
+
+	// the first installment is due at the start time
+	ctx = ctx.WithBlockTime(startTime)
+	app.DistrKeeper.ProcessBudgets(ctx)
+	require.Equal(t, int64(100), balanceOf(ctx))
+	app.DistrKeeper.ProcessBudgets(ctx)
+	require.Equal(t, int64(100), balanceOf(ctx))
+
+	// missed installments are caught up
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour + time.Minute))
+	app.DistrKeeper.ProcessBudgets(ctx)
+	require.Equal(t, int64(200), balanceOf(ctx))
+
+	// the installment is not paid while the community pool can't cover it
+	ctx = ctx.WithBlockTime(startTime.Add(2 * time.Hour))
+	app.DistrKeeper.ProcessBudgets(ctx)
+	require.Equal(t, int64(200), balanceOf(ctx))
+	budget, found := app.DistrKeeper.GetBudget(ctx, id)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)), budget.PaidAmount)
+
+	// once funded, the remaining amount is paid and the budget is deleted
+	require.NoError(t, simapp.FundAccount(app, ctx, funder, pool))
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, pool, funder))
+	ctx = ctx.WithBlockTime(startTime.Add(10 * time.Hour))
+	app.DistrKeeper.ProcessBudgets(ctx)
+	require.Equal(t, int64(350), balanceOf(ctx))
+	_, found = app.DistrKeeper.GetBudget(ctx, id)
+	require.False(t, found)
+}
+
@@ -626,3 +626,58 @@ func TestCalculateRewardsMultiDelegatorMultWithdraw(t *testing.T) {
 	// commission should be zero
 	require.True(t, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission.IsZero())
 }
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.TokensFromConsensusPower(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1000)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.OneDec()
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// create validator with 0% commission and a delegation of the same power
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.DelegateWithPower(addr[1], valAddrs[0], 100)
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// tokenize half of the delegation, the rewards going to a third account
+	_, err := app.StakingKeeper.TokenizeShares(ctx, addr[1], valAddrs[0], sdk.TokensFromConsensusPower(50), addr[2])
+	require.NoError(t, err)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(20))}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	// the owner of the record gets the rewards of the tokenized quarter of the stake
+	rewards, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[2])
+	require.NoError(t, err)
+
+	expRewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5)))
+	require.Equal(t, expRewards, rewards)
+	require.Equal(t,
+		expRewards.Add(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1000))),
+		app.BankKeeper.GetAllBalances(ctx, addr[2]),
+	)
+
+	// nothing is left to withdraw
+	rewards, err = app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[2])
+	require.NoError(t, err)
+	require.True(t, rewards.IsZero())
+}
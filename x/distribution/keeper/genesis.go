@@ -85,6 +85,23 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, restake := range data.AutoRestakes {
+		delegatorAddress, err := sdk.AccAddressFromBech32(restake.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		valAddr, err := sdk.ValAddressFromBech32(restake.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetAutoRestake(ctx, delegatorAddress, valAddr)
+	}
+	for _, budget := range data.Budgets {
+		k.SetBudget(ctx, budget)
+	}
+	if data.NextBudgetId != 0 {
+		k.SetNextBudgetID(ctx, data.NextBudgetId)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -191,5 +208,26 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	restakes := make([]types.AutoRestake, 0)
+	k.IterateAutoRestakes(ctx,
+		func(del sdk.AccAddress, val sdk.ValAddress) (stop bool) {
+			restakes = append(restakes, types.AutoRestake{
+				DelegatorAddress: del.String(),
+				ValidatorAddress: val.String(),
+			})
+			return false
+		},
+	)
+
+	budgets := make([]types.Budget, 0)
+	k.IterateBudgets(ctx,
+		func(budget types.Budget) (stop bool) {
+			budgets = append(budgets, budget)
+			return false
+		},
+	)
+
+	return types.NewGenesisState(
+		params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, restakes, budgets, k.GetNextBudgetID(ctx),
+	)
 }
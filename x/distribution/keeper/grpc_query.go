@@ -248,3 +248,70 @@ func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRe
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// DelegatorAutoRestakes queries the validators a delegator restakes the rewards of
+func (k Keeper) DelegatorAutoRestakes(c context.Context, req *types.QueryDelegatorAutoRestakesRequest) (*types.QueryDelegatorAutoRestakesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	valAddrs := k.GetDelegatorAutoRestakes(ctx, delAdr)
+
+	validators := make([]string, len(valAddrs))
+	for i, valAddr := range valAddrs {
+		validators[i] = valAddr.String()
+	}
+
+	return &types.QueryDelegatorAutoRestakesResponse{Validators: validators}, nil
+}
+
+// Budgets queries the active community pool budgets
+func (k Keeper) Budgets(c context.Context, req *types.QueryBudgetsRequest) (*types.QueryBudgetsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	budgetsStore := prefix.NewStore(store, types.BudgetPrefix)
+
+	var budgets []types.Budget
+	pageRes, err := query.Paginate(budgetsStore, req.Pagination, func(key []byte, value []byte) error {
+		var budget types.Budget
+		if err := k.cdc.UnmarshalBinaryBare(value, &budget); err != nil {
+			return err
+		}
+
+		budgets = append(budgets, budget)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBudgetsResponse{Budgets: budgets, Pagination: pageRes}, nil
+}
+
+// Budget queries a community pool budget by id
+func (k Keeper) Budget(c context.Context, req *types.QueryBudgetRequest) (*types.QueryBudgetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	budget, found := k.GetBudget(ctx, req.BudgetId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "budget %d doesn't exist", req.BudgetId)
+	}
+
+	return &types.QueryBudgetResponse{Budget: budget}, nil
+}
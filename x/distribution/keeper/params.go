@@ -41,3 +41,17 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
 	return enabled
 }
+
+// GetAutoRestakeThreshold returns the minimum amount of bond denom rewards a
+// delegation must have accrued to be restaked.
+func (k Keeper) GetAutoRestakeThreshold(ctx sdk.Context) (threshold sdk.Int) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyAutoRestakeThreshold, &threshold)
+	return threshold
+}
+
+// GetAutoRestakeGasBudget returns the gas the delegations restake can consume
+// per block.
+func (k Keeper) GetAutoRestakeGasBudget(ctx sdk.Context) (budget uint64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyAutoRestakeGasBudget, &budget)
+	return budget
+}
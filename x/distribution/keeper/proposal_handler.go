@@ -26,3 +26,31 @@ func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p *types.Commun
 	logger.Info(fmt.Sprintf("transferred %s from the community pool to recipient %s", p.Amount, p.Recipient))
 	return nil
 }
+
+// HandleCommunityPoolBudgetProposal is a handler for executing a passed community pool budget proposal
+func HandleCommunityPoolBudgetProposal(ctx sdk.Context, k Keeper, p *types.CommunityPoolBudgetProposal) error {
+	if k.blockedAddrs[p.Recipient] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", p.Recipient)
+	}
+	recipient, addrErr := sdk.AccAddressFromBech32(p.Recipient)
+	if addrErr != nil {
+		return addrErr
+	}
+	id := k.CreateBudget(ctx, recipient, p.TotalAmount, p.StartTime, p.Period, p.AmountPerPeriod)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("created budget %d of %s from the community pool to recipient %s", id, p.TotalAmount, p.Recipient))
+	return nil
+}
+
+// HandleCancelCommunityPoolBudgetProposal is a handler for executing a passed community pool budget cancel proposal
+func HandleCancelCommunityPoolBudgetProposal(ctx sdk.Context, k Keeper, p *types.CancelCommunityPoolBudgetProposal) error {
+	if _, found := k.GetBudget(ctx, p.BudgetId); !found {
+		return sdkerrors.Wrapf(types.ErrNoBudgetExists, "budget %d", p.BudgetId)
+	}
+	k.DeleteBudget(ctx, p.BudgetId)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("cancelled budget %d", p.BudgetId))
+	return nil
+}
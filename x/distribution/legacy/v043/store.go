@@ -0,0 +1,23 @@
+package v043
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateParams performs in-place params migrations from v0.42 to v0.43. The
+// migration includes:
+//
+// - Set the auto restake threshold and gas budget to their default value.
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	if !paramSpace.Has(ctx, types.ParamStoreKeyAutoRestakeThreshold) {
+		paramSpace.Set(ctx, types.ParamStoreKeyAutoRestakeThreshold, types.DefaultAutoRestakeThreshold)
+	}
+
+	if !paramSpace.Has(ctx, types.ParamStoreKeyAutoRestakeGasBudget) {
+		paramSpace.Set(ctx, types.ParamStoreKeyAutoRestakeGasBudget, types.DefaultAutoRestakeGasBudget)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package v043_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v043distribution "github.com/cosmos/cosmos-sdk/x/distribution/legacy/v043"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestParamsMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, paramsTKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// Old params don't have the auto restake params.
+	params := types.DefaultParams()
+	paramSpace.Set(ctx, types.ParamStoreKeyCommunityTax, params.CommunityTax)
+	paramSpace.Set(ctx, types.ParamStoreKeyBaseProposerReward, params.BaseProposerReward)
+	paramSpace.Set(ctx, types.ParamStoreKeyBonusProposerReward, params.BonusProposerReward)
+	paramSpace.Set(ctx, types.ParamStoreKeyWithdrawAddrEnabled, params.WithdrawAddrEnabled)
+	require.Panics(t, func() {
+		var params types.Params
+		paramSpace.GetParamSet(ctx, &params)
+	})
+
+	// Run migration.
+	require.NoError(t, v043distribution.MigrateParams(ctx, paramSpace))
+
+	var migrated types.Params
+	paramSpace.GetParamSet(ctx, &migrated)
+	require.Equal(t, params, migrated)
+
+	// Running the migration again leaves the params untouched.
+	paramSpace.Set(ctx, types.ParamStoreKeyAutoRestakeGasBudget, uint64(0))
+	require.NoError(t, v043distribution.MigrateParams(ctx, paramSpace))
+	var budget uint64
+	paramSpace.Get(ctx, types.ParamStoreKeyAutoRestakeGasBudget, &budget)
+	require.Zero(t, budget)
+}
@@ -2,6 +2,7 @@ package distribution_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -71,3 +72,31 @@ func TestProposalHandlerFailed(t *testing.T) {
 	balances := app.BankKeeper.GetAllBalances(ctx, recipient)
 	require.True(t, balances.IsZero())
 }
+
+func TestBudgetProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	hdlr := distribution.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)
+
+	bp := types.NewCommunityPoolBudgetProposal("Test", "description", delAddr1, amount, time.Unix(0, 0).UTC(), time.Hour, amount)
+	require.NoError(t, hdlr(ctx, bp))
+
+	budget, found := app.DistrKeeper.GetBudget(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, delAddr1.String(), budget.Recipient)
+	require.Equal(t, amount, budget.TotalAmount)
+	require.True(t, budget.PaidAmount.IsZero())
+
+	// blocked addresses can't be budget recipients
+	macc := app.DistrKeeper.GetDistributionAccount(ctx)
+	bp = types.NewCommunityPoolBudgetProposal("Test", "description", macc.GetAddress(), amount, time.Unix(0, 0).UTC(), time.Hour, amount)
+	require.Error(t, hdlr(ctx, bp))
+
+	cp := types.NewCancelCommunityPoolBudgetProposal("Test", "description", 1)
+	require.NoError(t, hdlr(ctx, cp))
+	_, found = app.DistrKeeper.GetBudget(ctx, 1)
+	require.False(t, found)
+
+	// the budget was already cancelled
+	require.Error(t, hdlr(ctx, cp))
+}
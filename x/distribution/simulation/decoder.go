@@ -63,6 +63,21 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.AutoRestakePrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoRestakeCursorKey):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.BudgetPrefix):
+			var budgetA, budgetB types.Budget
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &budgetA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &budgetB)
+			return fmt.Sprintf("%v\n%v", budgetA, budgetB)
+
+		case bytes.Equal(kvA.Key[:1], types.NextBudgetIDKey):
+			return fmt.Sprintf("%d\n%d", types.GetBudgetIDFromBytes(kvA.Value), types.GetBudgetIDFromBytes(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
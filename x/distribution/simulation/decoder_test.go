@@ -3,6 +3,7 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -34,6 +35,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, 100)
 	currentRewards := types.NewValidatorCurrentRewards(decCoins, 5)
 	slashEvent := types.NewValidatorSlashEvent(10, sdk.OneDec())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	budget := types.NewBudget(1, delAddr1, coins, time.Unix(0, 0).UTC(), time.Hour, coins)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -46,6 +49,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&currentRewards)},
 			{Key: types.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&commission)},
 			{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshalBinaryBare(&slashEvent)},
+			{Key: types.GetAutoRestakeKey(delAddr1, valAddr1), Value: []byte{0x01}},
+			{Key: types.AutoRestakeCursorKey, Value: types.GetAutoRestakeKey(delAddr1, valAddr1)},
+			{Key: types.GetBudgetKey(1), Value: cdc.MustMarshalBinaryBare(&budget)},
+			{Key: types.NextBudgetIDKey, Value: types.GetBudgetIDBytes(2)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -63,6 +70,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"AutoRestake", fmt.Sprintf("%v\n%v", []byte{0x01}, []byte{0x01})},
+		{"AutoRestakeCursor", fmt.Sprintf("%X\n%X", types.GetAutoRestakeKey(delAddr1, valAddr1), types.GetAutoRestakeKey(delAddr1, valAddr1))},
+		{"Budget", fmt.Sprintf("%v\n%v", budget, budget)},
+		{"NextBudgetID", "2\n2"},
 		{"other", ""},
 	}
 	for i, tt := range tests {
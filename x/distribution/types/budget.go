@@ -0,0 +1,97 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultStartingBudgetID is the id of the first community pool budget.
+const DefaultStartingBudgetID uint64 = 1
+
+// NewBudget creates a new community pool budget, with nothing paid yet.
+//nolint:interfacer
+func NewBudget(
+	id uint64, recipient sdk.AccAddress, totalAmount sdk.Coins, startTime time.Time, period time.Duration,
+	amountPerPeriod sdk.Coins,
+) Budget {
+	return Budget{
+		Id:              id,
+		Recipient:       recipient.String(),
+		TotalAmount:     totalAmount,
+		PaidAmount:      sdk.Coins{},
+		StartTime:       startTime,
+		Period:          period,
+		AmountPerPeriod: amountPerPeriod,
+	}
+}
+
+// DueAmount returns the amount of the budget due to the recipient at the given
+// time and not paid yet. An installment is due at the start time and at the
+// end of every period after it, until the total amount is paid. Amounts paid
+// ahead of the installments, e.g. in an imported genesis, are never due back.
+func (b Budget) DueAmount(blockTime time.Time) sdk.Coins {
+	if blockTime.Before(b.StartTime) {
+		return sdk.Coins{}
+	}
+
+	periods := sdk.NewInt(int64(blockTime.Sub(b.StartTime)/b.Period) + 1)
+
+	due := sdk.Coins{}
+	for _, coin := range b.AmountPerPeriod {
+		// the total amount is owed once enough periods elapsed to pay it, so
+		// that the owed amount does not grow with the elapsed periods
+		total := b.TotalAmount.AmountOf(coin.Denom)
+		owed := total
+		if periods.LTE(total.Quo(coin.Amount)) {
+			owed = coin.Amount.Mul(periods)
+		}
+
+		if amount := owed.Sub(b.PaidAmount.AmountOf(coin.Denom)); amount.IsPositive() {
+			due = due.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return due
+}
+
+// IsComplete returns whether the total amount of the budget has been paid.
+func (b Budget) IsComplete() bool {
+	return b.PaidAmount.IsAllGTE(b.TotalAmount)
+}
+
+// ValidateBudgetAmounts validates the amounts and period of a community pool
+// budget. The installments must be paid in the denominations of the total
+// amount, and in all of them.
+func ValidateBudgetAmounts(totalAmount, amountPerPeriod sdk.Coins, period time.Duration) error {
+	if totalAmount.Empty() || !totalAmount.IsValid() {
+		return fmt.Errorf("invalid total amount: %s", totalAmount)
+	}
+	if amountPerPeriod.Empty() || !amountPerPeriod.IsValid() {
+		return fmt.Errorf("invalid amount per period: %s", amountPerPeriod)
+	}
+	if !amountPerPeriod.DenomsSubsetOf(totalAmount) || !totalAmount.DenomsSubsetOf(amountPerPeriod) {
+		return fmt.Errorf("amount per period %s and total amount %s must have the same denominations", amountPerPeriod, totalAmount)
+	}
+	if period <= 0 {
+		return fmt.Errorf("period must be positive: %s", period)
+	}
+
+	return nil
+}
+
+// ValidateGenesis validates a community pool budget for a genesis state
+func (b Budget) ValidateGenesis() error {
+	if _, err := sdk.AccAddressFromBech32(b.Recipient); err != nil {
+		return err
+	}
+	if err := ValidateBudgetAmounts(b.TotalAmount, b.AmountPerPeriod, b.Period); err != nil {
+		return err
+	}
+	if !b.PaidAmount.IsValid() || !b.TotalAmount.IsAllGTE(b.PaidAmount) {
+		return fmt.Errorf("invalid paid amount %s of budget %d with total amount %s", b.PaidAmount, b.Id, b.TotalAmount)
+	}
+
+	return nil
+}
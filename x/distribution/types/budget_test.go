@@ -0,0 +1,64 @@
+package types
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBudgetDueAmount(t *testing.T) {
+	startTime := time.Unix(1000, 0).UTC()
+	recipient := sdk.AccAddress([]byte("recipient___________"))
+	budget := NewBudget(
+		1, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 10)), startTime, time.Hour,
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 30), sdk.NewInt64Coin("atom", 3)),
+	)
+
+	testCases := []struct {
+		name       string
+		blockTime  time.Time
+		paidAmount sdk.Coins
+		expDue     sdk.Coins
+	}{
+		{"before the start time", startTime.Add(-time.Second), sdk.Coins{}, sdk.Coins{}},
+		{"first installment", startTime, sdk.Coins{}, sdk.NewCoins(sdk.NewInt64Coin("stake", 30), sdk.NewInt64Coin("atom", 3))},
+		{"installments not paid yet", startTime.Add(2 * time.Hour), sdk.NewCoins(sdk.NewInt64Coin("stake", 30), sdk.NewInt64Coin("atom", 3)),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 60), sdk.NewInt64Coin("atom", 6))},
+		{"capped at the total amount", startTime.Add(10 * time.Hour), sdk.NewCoins(sdk.NewInt64Coin("stake", 90), sdk.NewInt64Coin("atom", 9)),
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("atom", 1))},
+		{"most periods elapsed", startTime.Add(time.Duration(math.MaxInt64)), sdk.Coins{},
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 10))},
+		{"paid ahead of the installments", startTime, sdk.NewCoins(sdk.NewInt64Coin("stake", 60), sdk.NewInt64Coin("atom", 1)),
+			sdk.NewCoins(sdk.NewInt64Coin("atom", 2))},
+		{"fully paid", startTime.Add(10 * time.Hour), budget.TotalAmount, sdk.Coins{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			budget.PaidAmount = tc.paidAmount
+			require.NotPanics(t, func() {
+				require.Equal(t, tc.expDue, budget.DueAmount(tc.blockTime))
+			})
+		})
+	}
+}
+
+func TestBudgetValidateGenesis(t *testing.T) {
+	recipient := sdk.AccAddress([]byte("recipient___________"))
+	totalAmount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	budget := NewBudget(1, recipient, totalAmount, time.Unix(1000, 0).UTC(), time.Hour, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)))
+	require.NoError(t, budget.ValidateGenesis())
+
+	budget.PaidAmount = totalAmount
+	require.NoError(t, budget.ValidateGenesis())
+
+	budget.PaidAmount = sdk.NewCoins(sdk.NewInt64Coin("stake", 101))
+	require.Error(t, budget.ValidateGenesis())
+
+	budget.PaidAmount = sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	require.Error(t, budget.ValidateGenesis())
+}
@@ -18,4 +18,7 @@ var (
 	ErrEmptyProposalRecipient  = sdkerrors.Register(ModuleName, 11, "invalid community pool spend proposal recipient")
 	ErrNoValidatorExists       = sdkerrors.Register(ModuleName, 12, "validator does not exist")
 	ErrNoDelegationExists      = sdkerrors.Register(ModuleName, 13, "delegation does not exist")
+	ErrAutoRestakeWithdrawAddr = sdkerrors.Register(ModuleName, 14, "rewards withdrawn to another address cannot be restaked")
+	ErrInvalidProposalBudget   = sdkerrors.Register(ModuleName, 15, "invalid community pool budget proposal")
+	ErrNoBudgetExists          = sdkerrors.Register(ModuleName, 16, "community pool budget does not exist")
 )
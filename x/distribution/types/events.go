@@ -2,15 +2,24 @@ package types
 
 // distribution module event types
 const (
-	EventTypeSetWithdrawAddress = "set_withdraw_address"
-	EventTypeRewards            = "rewards"
-	EventTypeCommission         = "commission"
-	EventTypeWithdrawRewards    = "withdraw_rewards"
-	EventTypeWithdrawCommission = "withdraw_commission"
-	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetWithdrawAddress          = "set_withdraw_address"
+	EventTypeRewards                     = "rewards"
+	EventTypeCommission                  = "commission"
+	EventTypeWithdrawRewards             = "withdraw_rewards"
+	EventTypeWithdrawCommission          = "withdraw_commission"
+	EventTypeProposerReward              = "proposer_reward"
+	EventTypeWithdrawTokenizeShareReward = "withdraw_tokenize_share_reward"
+	EventTypeSetAutoRestake              = "set_auto_restake"
+	EventTypeAutoRestake                 = "auto_restake"
+	EventTypeBudgetPayout                = "budget_payout"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyOwner           = "owner"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyBudgetID        = "budget_id"
+	AttributeKeyRecipient       = "recipient"
 
 	AttributeValueCategory = ModuleName
 )
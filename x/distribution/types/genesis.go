@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,6 +11,7 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	restakes []AutoRestake, budgets []Budget, nextBudgetID uint64,
 ) *GenesisState {
 
 	return &GenesisState{
@@ -22,6 +25,9 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		AutoRestakes:                    restakes,
+		Budgets:                         budgets,
+		NextBudgetId:                    nextBudgetID,
 	}
 }
 
@@ -38,6 +44,9 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoRestakes:                    []AutoRestake{},
+		Budgets:                         []Budget{},
+		NextBudgetId:                    DefaultStartingBudgetID,
 	}
 }
 
@@ -46,5 +55,50 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	if err := validateAutoRestakes(gs.AutoRestakes); err != nil {
+		return err
+	}
+	if err := validateBudgets(gs.Budgets, gs.NextBudgetId); err != nil {
+		return err
+	}
 	return gs.FeePool.ValidateGenesis()
 }
+
+func validateAutoRestakes(restakes []AutoRestake) error {
+	seen := make(map[string]bool)
+	for _, restake := range restakes {
+		if _, err := sdk.AccAddressFromBech32(restake.DelegatorAddress); err != nil {
+			return err
+		}
+		if _, err := sdk.ValAddressFromBech32(restake.ValidatorAddress); err != nil {
+			return err
+		}
+
+		key := restake.DelegatorAddress + restake.ValidatorAddress
+		if seen[key] {
+			return fmt.Errorf("duplicate auto restake of delegator %s to validator %s", restake.DelegatorAddress, restake.ValidatorAddress)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+func validateBudgets(budgets []Budget, nextBudgetID uint64) error {
+	seen := make(map[uint64]bool)
+	for _, budget := range budgets {
+		if budget.Id >= nextBudgetID {
+			return fmt.Errorf("budget id %d must be lower than the next budget id %d", budget.Id, nextBudgetID)
+		}
+		if seen[budget.Id] {
+			return fmt.Errorf("duplicate budget id %d", budget.Id)
+		}
+		seen[budget.Id] = true
+
+		if err := budget.ValidateGenesis(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
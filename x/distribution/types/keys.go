@@ -40,6 +40,14 @@ const (
 // - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
 //
 // - 0x08<valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddr_Bytes><valAddr_Bytes>: AutoRestake
+//
+// - 0x0A: AutoRestake cursor
+//
+// - 0x0B<budgetID_Bytes>: Budget
+//
+// - 0x0C: next Budget ID
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -51,6 +59,10 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	AutoRestakePrefix                    = []byte{0x09} // key for delegations whose rewards are restaked
+	AutoRestakeCursorKey                 = []byte{0x0A} // key for the next auto restake to process
+	BudgetPrefix                         = []byte{0x0B} // key for community pool budgets
+	NextBudgetIDKey                      = []byte{0x0C} // key for the id of the next community pool budget
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -191,3 +203,40 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 	prefix := GetValidatorSlashEventKeyPrefix(v, height)
 	return append(prefix, periodBz...)
 }
+
+// gets the addresses from an auto restake key
+func GetAutoRestakeAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if len(key) != 1+2*sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	delAddr = sdk.AccAddress(key[1 : 1+sdk.AddrLen])
+	valAddr = sdk.ValAddress(key[1+sdk.AddrLen:])
+	return
+}
+
+// gets the prefix key for the auto restakes of a delegator
+func GetDelegatorAutoRestakesPrefix(delAddr sdk.AccAddress) []byte {
+	return append(AutoRestakePrefix, delAddr.Bytes()...)
+}
+
+// gets the key for the auto restake of a delegation
+func GetAutoRestakeKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetDelegatorAutoRestakesPrefix(delAddr), valAddr.Bytes()...)
+}
+
+// gets the key for a community pool budget
+func GetBudgetKey(id uint64) []byte {
+	return append(BudgetPrefix, GetBudgetIDBytes(id)...)
+}
+
+// gets the big endian bytes of a community pool budget id
+func GetBudgetIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// gets a community pool budget id from its big endian bytes
+func GetBudgetIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
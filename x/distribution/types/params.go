@@ -11,10 +11,18 @@ import (
 
 // Parameter keys
 var (
-	ParamStoreKeyCommunityTax        = []byte("communitytax")
-	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
-	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
-	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyCommunityTax         = []byte("communitytax")
+	ParamStoreKeyBaseProposerReward   = []byte("baseproposerreward")
+	ParamStoreKeyBonusProposerReward  = []byte("bonusproposerreward")
+	ParamStoreKeyWithdrawAddrEnabled  = []byte("withdrawaddrenabled")
+	ParamStoreKeyAutoRestakeThreshold = []byte("autorestakethreshold")
+	ParamStoreKeyAutoRestakeGasBudget = []byte("autorestakegasbudget")
+)
+
+// Default parameter values
+var (
+	DefaultAutoRestakeThreshold        = sdk.NewInt(1000000)
+	DefaultAutoRestakeGasBudget uint64 = 2000000
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -25,10 +33,12 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        sdk.NewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
-		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
-		WithdrawAddrEnabled: true,
+		CommunityTax:         sdk.NewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:   sdk.NewDecWithPrec(1, 2), // 1%
+		BonusProposerReward:  sdk.NewDecWithPrec(4, 2), // 4%
+		WithdrawAddrEnabled:  true,
+		AutoRestakeThreshold: DefaultAutoRestakeThreshold,
+		AutoRestakeGasBudget: DefaultAutoRestakeGasBudget,
 	}
 }
 
@@ -44,6 +54,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoRestakeThreshold, &p.AutoRestakeThreshold, validateAutoRestakeThreshold),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoRestakeGasBudget, &p.AutoRestakeGasBudget, validateAutoRestakeGasBudget),
 	}
 }
 
@@ -69,6 +81,11 @@ func (p Params) ValidateBasic() error {
 			"sum of base and bonus proposer reward cannot greater than one: %s", v,
 		)
 	}
+	if p.AutoRestakeThreshold.IsNil() || p.AutoRestakeThreshold.IsNegative() {
+		return fmt.Errorf(
+			"auto restake threshold should be non-negative: %s", p.AutoRestakeThreshold,
+		)
+	}
 
 	return nil
 }
@@ -138,3 +155,28 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateAutoRestakeThreshold(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("auto restake threshold must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("auto restake threshold must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateAutoRestakeGasBudget(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
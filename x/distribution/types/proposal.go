@@ -3,22 +3,36 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
 	// ProposalTypeCommunityPoolSpend defines the type for a CommunityPoolSpendProposal
 	ProposalTypeCommunityPoolSpend = "CommunityPoolSpend"
+	// ProposalTypeCommunityPoolBudget defines the type for a CommunityPoolBudgetProposal
+	ProposalTypeCommunityPoolBudget = "CommunityPoolBudget"
+	// ProposalTypeCancelCommunityPoolBudget defines the type for a CancelCommunityPoolBudgetProposal
+	ProposalTypeCancelCommunityPoolBudget = "CancelCommunityPoolBudget"
 )
 
-// Assert CommunityPoolSpendProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = &CommunityPoolSpendProposal{}
+// Assert the distribution proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &CommunityPoolSpendProposal{}
+	_ govtypes.Content = &CommunityPoolBudgetProposal{}
+	_ govtypes.Content = &CancelCommunityPoolBudgetProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCommunityPoolSpend)
 	govtypes.RegisterProposalTypeCodec(&CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolBudget)
+	govtypes.RegisterProposalTypeCodec(&CommunityPoolBudgetProposal{}, "cosmos-sdk/CommunityPoolBudgetProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelCommunityPoolBudget)
+	govtypes.RegisterProposalTypeCodec(&CancelCommunityPoolBudgetProposal{}, "cosmos-sdk/CancelCommunityPoolBudgetProposal")
 }
 
 // NewCommunityPoolSpendProposal creates a new community pool spned proposal.
@@ -66,3 +80,94 @@ func (csp CommunityPoolSpendProposal) String() string {
 `, csp.Title, csp.Description, csp.Recipient, csp.Amount))
 	return b.String()
 }
+
+// NewCommunityPoolBudgetProposal creates a new community pool budget proposal.
+//nolint:interfacer
+func NewCommunityPoolBudgetProposal(
+	title, description string, recipient sdk.AccAddress, totalAmount sdk.Coins, startTime time.Time,
+	period time.Duration, amountPerPeriod sdk.Coins,
+) *CommunityPoolBudgetProposal {
+	return &CommunityPoolBudgetProposal{title, description, recipient.String(), totalAmount, startTime, period, amountPerPeriod}
+}
+
+// GetTitle returns the title of a community pool budget proposal.
+func (cbp *CommunityPoolBudgetProposal) GetTitle() string { return cbp.Title }
+
+// GetDescription returns the description of a community pool budget proposal.
+func (cbp *CommunityPoolBudgetProposal) GetDescription() string { return cbp.Description }
+
+// ProposalRoute returns the routing key of a community pool budget proposal.
+func (cbp *CommunityPoolBudgetProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool budget proposal.
+func (cbp *CommunityPoolBudgetProposal) ProposalType() string { return ProposalTypeCommunityPoolBudget }
+
+// ValidateBasic runs basic stateless validity checks
+func (cbp *CommunityPoolBudgetProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(cbp)
+	if err != nil {
+		return err
+	}
+	if cbp.Recipient == "" {
+		return ErrEmptyProposalRecipient
+	}
+	if cbp.StartTime.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidProposalBudget, "start time cannot be empty")
+	}
+	if err := ValidateBudgetAmounts(cbp.TotalAmount, cbp.AmountPerPeriod, cbp.Period); err != nil {
+		return sdkerrors.Wrap(ErrInvalidProposalBudget, err.Error())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (cbp CommunityPoolBudgetProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Community Pool Budget Proposal:
+  Title:             %s
+  Description:       %s
+  Recipient:         %s
+  Total Amount:      %s
+  Start Time:        %s
+  Period:            %s
+  Amount Per Period: %s
+`, cbp.Title, cbp.Description, cbp.Recipient, cbp.TotalAmount, cbp.StartTime, cbp.Period, cbp.AmountPerPeriod))
+	return b.String()
+}
+
+// NewCancelCommunityPoolBudgetProposal creates a new community pool budget
+// cancel proposal.
+func NewCancelCommunityPoolBudgetProposal(title, description string, budgetID uint64) *CancelCommunityPoolBudgetProposal {
+	return &CancelCommunityPoolBudgetProposal{title, description, budgetID}
+}
+
+// GetTitle returns the title of a community pool budget cancel proposal.
+func (ccp *CancelCommunityPoolBudgetProposal) GetTitle() string { return ccp.Title }
+
+// GetDescription returns the description of a community pool budget cancel proposal.
+func (ccp *CancelCommunityPoolBudgetProposal) GetDescription() string { return ccp.Description }
+
+// ProposalRoute returns the routing key of a community pool budget cancel proposal.
+func (ccp *CancelCommunityPoolBudgetProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool budget cancel proposal.
+func (ccp *CancelCommunityPoolBudgetProposal) ProposalType() string {
+	return ProposalTypeCancelCommunityPoolBudget
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (ccp *CancelCommunityPoolBudgetProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(ccp)
+}
+
+// String implements the Stringer interface.
+func (ccp CancelCommunityPoolBudgetProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Cancel Community Pool Budget Proposal:
+  Title:       %s
+  Description: %s
+  Budget ID:   %d
+`, ccp.Title, ccp.Description, ccp.BudgetId))
+	return b.String()
+}
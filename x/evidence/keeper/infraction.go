@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
 // HandleEquivocationEvidence implements an equivocation evidence handler. Assuming the
@@ -70,6 +71,13 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		return
 	}
 
+	// The validator may have rotated its consensus public key since the
+	// infraction, its signing info is kept by its current consensus address.
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		panic(err)
+	}
+
 	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
 		panic(fmt.Sprintf("expected signing info for validator %s but not found", consAddr))
 	}
@@ -104,7 +112,7 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	// to/by Tendermint. This value is validator.Tokens as sent to Tendermint via
 	// ABCI, and now received as evidence. The fraction is passed in to separately
 	// to slash unbonding and rebonding delegations.
-	k.slashingKeeper.Slash(
+	slashAmount := k.slashingKeeper.Slash(
 		ctx,
 		consAddr,
 		k.slashingKeeper.SlashFractionDoubleSign(ctx),
@@ -117,6 +125,10 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		k.slashingKeeper.Jail(ctx, consAddr)
 	}
 
+	k.slashingKeeper.AddJailEvent(ctx, consAddr, slashingtypes.NewJailEvent(
+		ctx.BlockHeight(), ctx.BlockTime(), slashingtypes.AttributeValueDoubleSign, slashAmount,
+	))
+
 	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
 	k.slashingKeeper.Tombstone(ctx, consAddr)
 }
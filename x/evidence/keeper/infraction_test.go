@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 )
@@ -49,11 +50,18 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign() {
 	newTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
 	suite.True(newTokens.LT(oldTokens))
 
+	// the burned tokens should have been recorded in the jail history
+	jailEvents := suite.app.SlashingKeeper.GetValidatorJailEvents(ctx, sdk.ConsAddress(val.Address()))
+	suite.Len(jailEvents, 1)
+	suite.Equal(slashingtypes.AttributeValueDoubleSign, jailEvents[0].Reason)
+	suite.Equal(oldTokens.Sub(newTokens), jailEvents[0].SlashAmount)
+
 	// submit duplicate evidence
 	suite.app.EvidenceKeeper.HandleEquivocationEvidence(ctx, evidence)
 
 	// tokens should be the same (capped slash)
 	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens().Equal(newTokens))
+	suite.Len(suite.app.SlashingKeeper.GetValidatorJailEvents(ctx, sdk.ConsAddress(val.Address())), 1)
 
 	// jump to past the unbonding period
 	ctx = ctx.WithBlockTime(time.Unix(1, 0).Add(stakingParams.UnbondingTime))
@@ -71,6 +79,37 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign() {
 	tstaking.Undelegate(sdk.AccAddress(operatorAddr), operatorAddr, totalBond, true)
 }
 
+func (suite *KeeperTestSuite) TestHandleDoubleSignAfterConsPubKeyRotation() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1)
+	suite.populateValidators(ctx)
+
+	power := int64(100)
+	operatorAddr, oldPk, newPk := valAddresses[0], pubkeys[0], pubkeys[1]
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, oldPk, power, true)
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), selfDelegation.Int64(), true)
+
+	suite.NoError(suite.app.StakingKeeper.RotateConsPubKey(ctx, operatorAddr, newPk))
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+
+	// double sign with the old key
+	oldTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	evidence := &types.Equivocation{
+		Height:           0,
+		Time:             time.Unix(0, 0),
+		Power:            power,
+		ConsensusAddress: sdk.ConsAddress(oldPk.Address()).String(),
+	}
+	suite.app.EvidenceKeeper.HandleEquivocationEvidence(ctx, evidence)
+
+	// the rotated validator is punished
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(newPk.Address())))
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+}
+
 func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now())
 	suite.populateValidators(ctx)
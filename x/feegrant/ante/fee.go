@@ -0,0 +1,81 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+)
+
+// DeductGrantedFeeDecorator deducts fees from the fee payer of the tx, or from
+// the fee granter if one is set and it has granted the fee payer an allowance
+// covering the fees. It is a replacement for the auth module's
+// DeductFeeDecorator and RejectFeeGranterDecorator for chains that support
+// fee grants.
+// If the fee payer or granter does not have the funds to pay for the fees,
+// return with InsufficientFunds error.
+// Call next AnteHandler if fees successfully deducted.
+// CONTRACT: Tx must implement FeeTx interface to use DeductGrantedFeeDecorator
+type DeductGrantedFeeDecorator struct {
+	ak authante.AccountKeeper
+	bk authtypes.BankKeeper
+	k  keeper.Keeper
+}
+
+// NewDeductGrantedFeeDecorator returns a new DeductGrantedFeeDecorator.
+func NewDeductGrantedFeeDecorator(ak authante.AccountKeeper, bk authtypes.BankKeeper, k keeper.Keeper) DeductGrantedFeeDecorator {
+	return DeductGrantedFeeDecorator{
+		ak: ak,
+		bk: bk,
+		k:  k,
+	}
+}
+
+var _ sdk.AnteDecorator = DeductGrantedFeeDecorator{}
+
+// AnteHandle implements the sdk.AnteDecorator interface. If the tx sets a fee
+// granter, the fees are paid from the granter's account as long as its grant
+// to the fee payer accepts them, otherwise they are paid by the fee payer.
+func (d DeductGrantedFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if addr := d.ak.GetModuleAddress(authtypes.FeeCollectorName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", authtypes.FeeCollectorName))
+	}
+
+	fee := feeTx.GetFee()
+	feePayer := feeTx.FeePayer()
+	feeGranter := feeTx.FeeGranter()
+
+	deductFeesFrom := feePayer
+
+	// if a fee granter is set, the grant to the fee payer must accept the fee
+	if feeGranter != nil && !feeGranter.Equals(feePayer) {
+		err := d.k.UseGrantedFees(ctx, feeGranter, feePayer, fee, tx.GetMsgs())
+		if err != nil {
+			return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees from %s", feePayer, feeGranter)
+		}
+
+		deductFeesFrom = feeGranter
+	}
+
+	deductFeesFromAcc := d.ak.GetAccount(ctx, deductFeesFrom)
+	if deductFeesFromAcc == nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
+	}
+
+	// deduct the fees
+	if !fee.IsZero() {
+		if err := authante.DeductFees(d.bk, ctx, deductFeesFromAcc, fee); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,136 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/ante"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+type AnteTestSuite struct {
+	suite.Suite
+
+	app      *simapp.SimApp
+	ctx      sdk.Context
+	txConfig client.TxConfig
+}
+
+func TestAnteTestSuite(t *testing.T) {
+	suite.Run(t, new(AnteTestSuite))
+}
+
+func (suite *AnteTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.txConfig = simapp.MakeTestEncodingConfig().TxConfig
+}
+
+func (suite *AnteTestSuite) TestDeductGrantedFees() {
+	app := suite.app
+
+	_, _, granter := testdata.KeyTestPubAddr()
+	_, _, grantee := testdata.KeyTestPubAddr()
+	_, _, stranger := testdata.KeyTestPubAddr()
+	_, _, poorGranter := testdata.KeyTestPubAddr()
+
+	initCoins := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	suite.Require().NoError(simapp.FundAccount(app, suite.ctx, granter, initCoins))
+	suite.Require().NoError(simapp.FundAccount(app, suite.ctx, grantee, initCoins))
+	app.AccountKeeper.SetAccount(suite.ctx, app.AccountKeeper.NewAccountWithAddress(suite.ctx, poorGranter))
+
+	allowance := &types.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 500))}
+	suite.Require().NoError(app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, grantee, allowance))
+	suite.Require().NoError(app.FeeGrantKeeper.GrantAllowance(suite.ctx, poorGranter, grantee, allowance))
+
+	dgfd := ante.NewDeductGrantedFeeDecorator(app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper)
+	antehandler := sdk.ChainAnteDecorators(dgfd)
+
+	testCases := []struct {
+		name       string
+		signer     sdk.AccAddress
+		feeGranter sdk.AccAddress
+		fee        int64
+		expPass    bool
+		postRun    func(ctx sdk.Context)
+	}{
+		{
+			"paid by the fee payer without a granter",
+			grantee,
+			nil,
+			100,
+			true,
+			func(ctx sdk.Context) {
+				suite.Require().Equal(int64(900), app.BankKeeper.GetBalance(ctx, grantee, "atom").Amount.Int64())
+				suite.Require().Equal(int64(1000), app.BankKeeper.GetBalance(ctx, granter, "atom").Amount.Int64())
+			},
+		},
+		{
+			"paid by the granter",
+			grantee,
+			granter,
+			100,
+			true,
+			func(ctx sdk.Context) {
+				suite.Require().Equal(int64(1000), app.BankKeeper.GetBalance(ctx, grantee, "atom").Amount.Int64())
+				suite.Require().Equal(int64(900), app.BankKeeper.GetBalance(ctx, granter, "atom").Amount.Int64())
+
+				remaining, err := app.FeeGrantKeeper.GetAllowance(ctx, granter, grantee)
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 400)), remaining.(*types.BasicAllowance).SpendLimit)
+			},
+		},
+		{
+			"fee above the allowance",
+			grantee,
+			granter,
+			600,
+			false,
+			nil,
+		},
+		{
+			"no allowance for the fee payer",
+			stranger,
+			granter,
+			100,
+			false,
+			nil,
+		},
+		{
+			"granter cannot cover the fee",
+			grantee,
+			poorGranter,
+			100,
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+
+			txBuilder := suite.txConfig.NewTxBuilder()
+			suite.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(tc.signer)))
+			txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", tc.fee)))
+			txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			txBuilder.SetFeeGranter(tc.feeGranter)
+
+			_, err := antehandler(ctx, txBuilder.GetTx(), false)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.postRun(ctx)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper keeper.Keeper
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	suite.ctx = app.BaseApp.NewContext(checkTx, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	suite.keeper = app.FeeGrantKeeper
+}
+
+var (
+	granteePub  = secp256k1.GenPrivKey().PubKey()
+	granterPub  = secp256k1.GenPrivKey().PubKey()
+	granteeAddr = sdk.AccAddress(granteePub.Address())
+	granterAddr = sdk.AccAddress(granterPub.Address())
+)
+
+func (suite *GenesisTestSuite) TestImportExportGenesis() {
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1_000)))
+	now := suite.ctx.BlockTime()
+	oneYear := now.AddDate(1, 0, 0)
+	allowance := &types.BasicAllowance{SpendLimit: coins, Expiration: &oneYear}
+
+	err := suite.keeper.GrantAllowance(suite.ctx, granterAddr, granteeAddr, allowance)
+	suite.Require().NoError(err)
+
+	genesis := feegrant.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Len(genesis.Allowances, 1)
+
+	// revoke fee allowance
+	err = suite.keeper.RevokeAllowance(suite.ctx, granterAddr, granteeAddr)
+	suite.Require().NoError(err)
+
+	feegrant.InitGenesis(suite.ctx, suite.keeper, genesis)
+
+	newGenesis := feegrant.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Require().Equal(genesis, newGenesis)
+
+	// the imported grant is pruned once it expires
+	feegrant.EndBlocker(suite.ctx.WithBlockTime(oneYear), suite.keeper)
+	suite.Require().Empty(feegrant.ExportGenesis(suite.ctx, suite.keeper).Allowances)
+}
+
+func (suite *GenesisTestSuite) TestInitGenesisInvalid() {
+	testCases := []struct {
+		msg     string
+		genesis *types.GenesisState
+	}{
+		{
+			"invalid granter",
+			&types.GenesisState{Allowances: []types.Grant{{Granter: "invalid", Grantee: granteeAddr.String()}}},
+		},
+		{
+			"invalid grantee",
+			&types.GenesisState{Allowances: []types.Grant{{Granter: granterAddr.String(), Grantee: "invalid"}}},
+		},
+		{
+			"missing allowance",
+			&types.GenesisState{Allowances: []types.Grant{{Granter: granterAddr.String(), Grantee: granteeAddr.String()}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.Require().Error(tc.genesis.Validate())
+			suite.Require().Panics(func() {
+				feegrant.InitGenesis(suite.ctx, suite.keeper, tc.genesis)
+			})
+		})
+	}
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func (suite *KeeperTestSuite) TestFeeAllowance() {
+	testCases := []struct {
+		name      string
+		req       *types.QueryAllowanceRequest
+		expectErr bool
+		preRun    func()
+		postRun   func(_ *types.QueryAllowanceResponse)
+	}{
+		{
+			"fail: invalid granter",
+			&types.QueryAllowanceRequest{
+				Granter: "invalid_granter",
+				Grantee: suite.addrs[0].String(),
+			},
+			true,
+			func() {},
+			func(*types.QueryAllowanceResponse) {},
+		},
+		{
+			"fail: invalid grantee",
+			&types.QueryAllowanceRequest{
+				Granter: suite.addrs[0].String(),
+				Grantee: "invalid_grantee",
+			},
+			true,
+			func() {},
+			func(*types.QueryAllowanceResponse) {},
+		},
+		{
+			"fail: no grants",
+			&types.QueryAllowanceRequest{
+				Granter: suite.addrs[0].String(),
+				Grantee: suite.addrs[1].String(),
+			},
+			true,
+			func() {},
+			func(*types.QueryAllowanceResponse) {},
+		},
+		{
+			"valid query: expect single grant",
+			&types.QueryAllowanceRequest{
+				Granter: suite.addrs[0].String(),
+				Grantee: suite.addrs[1].String(),
+			},
+			false,
+			func() {
+				suite.grantFeeAllowance()
+			},
+			func(response *types.QueryAllowanceResponse) {
+				suite.Require().Equal(response.Allowance.Granter, suite.addrs[0].String())
+				suite.Require().Equal(response.Allowance.Grantee, suite.addrs[1].String())
+
+				allowance, err := response.Allowance.GetGrant()
+				suite.Require().NoError(err)
+				suite.Require().Equal(&types.BasicAllowance{SpendLimit: suite.atom(555)}, allowance)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.preRun()
+			resp, err := suite.queryClient.Allowance(gocontext.Background(), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+			tc.postRun(resp)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFeeAllowances() {
+	testCases := []struct {
+		name      string
+		req       *types.QueryAllowancesRequest
+		expectErr bool
+		preRun    func()
+		postRun   func(_ *types.QueryAllowancesResponse)
+	}{
+		{
+			"fail: invalid grantee",
+			&types.QueryAllowancesRequest{
+				Grantee: "invalid_grantee",
+			},
+			true,
+			func() {},
+			func(*types.QueryAllowancesResponse) {},
+		},
+		{
+			"no grants",
+			&types.QueryAllowancesRequest{
+				Grantee: suite.addrs[1].String(),
+			},
+			false,
+			func() {},
+			func(resp *types.QueryAllowancesResponse) {
+				suite.Require().Equal(len(resp.Allowances), 0)
+			},
+		},
+		{
+			"valid query: expect single grant",
+			&types.QueryAllowancesRequest{
+				Grantee: suite.addrs[1].String(),
+			},
+			false,
+			func() {
+				suite.grantFeeAllowance()
+			},
+			func(resp *types.QueryAllowancesResponse) {
+				suite.Require().Equal(len(resp.Allowances), 1)
+				suite.Require().Equal(resp.Allowances[0].Granter, suite.addrs[0].String())
+				suite.Require().Equal(resp.Allowances[0].Grantee, suite.addrs[1].String())
+			},
+		},
+		{
+			"valid query: paginated grants",
+			&types.QueryAllowancesRequest{
+				Grantee:    suite.addrs[1].String(),
+				Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+			},
+			false,
+			func() {
+				suite.grantFeeAllowance()
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, suite.addrs[2], suite.addrs[1], &types.BasicAllowance{})
+				suite.Require().NoError(err)
+			},
+			func(resp *types.QueryAllowancesResponse) {
+				suite.Require().Equal(len(resp.Allowances), 1)
+				suite.Require().Equal(uint64(2), resp.Pagination.Total)
+				suite.Require().NotNil(resp.Pagination.NextKey)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.preRun()
+			resp, err := suite.queryClient.Allowances(gocontext.Background(), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+			tc.postRun(resp)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestNilRequests() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.app.FeeGrantKeeper.Allowance(ctx, nil)
+	suite.Require().Error(err)
+
+	_, err = suite.app.FeeGrantKeeper.Allowances(ctx, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) grantFeeAllowance() {
+	err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, suite.addrs[0], suite.addrs[1], &types.BasicAllowance{
+		SpendLimit: suite.atom(555),
+	})
+	suite.Require().NoError(err)
+}
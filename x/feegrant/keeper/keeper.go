@@ -0,0 +1,273 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// Keeper manages state of all fee grants, as well as calculating approval.
+// It must have a codec with all available allowances registered.
+type Keeper struct {
+	cdc        codec.BinaryMarshaler
+	storeKey   sdk.StoreKey
+	authKeeper types.AccountKeeper
+}
+
+// NewKeeper creates a fee grant Keeper
+func NewKeeper(cdc codec.BinaryMarshaler, storeKey sdk.StoreKey, ak types.AccountKeeper) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		authKeeper: ak,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GrantAllowance creates a new grant, replacing any existing grant from
+// granter to grantee. The grantee account is created if it does not exist yet,
+// so that it is able to sign transactions paid for by the granter.
+func (k Keeper) GrantAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance types.FeeAllowanceI) error {
+	// create the account if it is not in account state
+	granteeAcc := k.authKeeper.GetAccount(ctx, grantee)
+	if granteeAcc == nil {
+		granteeAcc = k.authKeeper.NewAccountWithAddress(ctx, grantee)
+		k.authKeeper.SetAccount(ctx, granteeAcc)
+	}
+
+	if err := k.setAllowance(ctx, granter, grantee, feeAllowance); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// RevokeAllowance removes an existing grant
+func (k Keeper) RevokeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
+	if err := k.removeAllowance(ctx, granter, grantee); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetAllowance returns the allowance between the granter and grantee. It
+// returns an ErrNoAllowance error if there is none.
+func (k Keeper) GetAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (types.FeeAllowanceI, error) {
+	grant, err := k.getGrant(ctx, granter, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return grant.GetGrant()
+}
+
+// IterateAllFeeAllowances iterates over all the grants in the store.
+// Callback to get all data, returns true to stop, false to keep reading
+// Calling this without pagination is very expensive and only designed for export genesis
+func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(grant types.Grant) bool) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.FeeAllowanceKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.Grant
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &grant); err != nil {
+			return err
+		}
+
+		if cb(grant) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// UseGrantedFees will try to pay the given fee from the granter's account as requested by the grantee
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	f, err := k.getGrant(ctx, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	grant, err := f.GetGrant()
+	if err != nil {
+		return err
+	}
+
+	remove, err := grant.Accept(ctx, fee, msgs)
+	if remove {
+		// The grant was loaded above, so removing it cannot fail with
+		// ErrNoAllowance; the result of Accept decides whether the fee is paid.
+		_ = k.removeAllowance(ctx, granter, grantee)
+		if err != nil {
+			return err
+		}
+
+		emitUseGrantEvent(ctx, granter.String(), grantee.String())
+
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	emitUseGrantEvent(ctx, granter.String(), grantee.String())
+
+	// if fee allowance is accepted, store the updated state of the allowance
+	return k.setAllowance(ctx, granter, grantee, grant)
+}
+
+// RemoveExpiredAllowances removes all the grants whose expiration time is at
+// or before the current block time.
+func (k Keeper) RemoveExpiredAllowances(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(
+		types.FeeAllowanceQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.FeeAllowanceByExpTimeKey(ctx.BlockTime())),
+	)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+
+		granter, grantee := types.ParseAddressesFromFeeAllowanceQueueKey(iter.Key())
+		store.Delete(types.FeeAllowanceKey(granter, grantee))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePruneFeeGrant,
+				sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+				sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			),
+		)
+	}
+}
+
+// getGrant returns the grant between the granter and grantee, or an
+// ErrNoAllowance error if there is none.
+func (k Keeper) getGrant(ctx sdk.Context, granter sdk.AccAddress, grantee sdk.AccAddress) (*types.Grant, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeeAllowanceKey(granter, grantee))
+	if len(bz) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrNoAllowance, "allowance not found")
+	}
+
+	var feegrant types.Grant
+	if err := k.cdc.UnmarshalBinaryBare(bz, &feegrant); err != nil {
+		return nil, err
+	}
+
+	return &feegrant, nil
+}
+
+// setAllowance stores the allowance and keeps the expiration queue in sync
+// with it.
+func (k Keeper) setAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance types.FeeAllowanceI) error {
+	if existing, err := k.getGrant(ctx, granter, grantee); err == nil {
+		if err := k.removeFromFeeAllowanceQueue(ctx, granter, grantee, *existing); err != nil {
+			return err
+		}
+	}
+
+	grant, err := types.NewGrant(granter, grantee, feeAllowance)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.MarshalBinaryBare(&grant)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FeeAllowanceKey(granter, grantee), bz)
+
+	exp, err := feeAllowance.ExpiresAt()
+	if err != nil {
+		return err
+	}
+	if exp != nil {
+		k.insertFeeAllowanceQueue(ctx, *exp, granter, grantee)
+	}
+
+	return nil
+}
+
+// removeAllowance deletes the grant and its expiration queue entry.
+func (k Keeper) removeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
+	grant, err := k.getGrant(ctx, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	if err := k.removeFromFeeAllowanceQueue(ctx, granter, grantee, *grant); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FeeAllowanceKey(granter, grantee))
+
+	return nil
+}
+
+func (k Keeper) insertFeeAllowanceQueue(ctx sdk.Context, exp time.Time, granter, grantee sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FeeAllowanceQueueKey(exp, granter, grantee), []byte{})
+}
+
+func (k Keeper) removeFromFeeAllowanceQueue(ctx sdk.Context, granter, grantee sdk.AccAddress, grant types.Grant) error {
+	allowance, err := grant.GetGrant()
+	if err != nil {
+		return err
+	}
+
+	exp, err := allowance.ExpiresAt()
+	if err != nil {
+		return err
+	}
+	if exp != nil {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.FeeAllowanceQueueKey(*exp, granter, grantee))
+	}
+
+	return nil
+}
+
+func emitUseGrantEvent(ctx sdk.Context, granter, grantee string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUseFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee),
+		),
+	)
+}
@@ -0,0 +1,289 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	msgSrvr     types.MsgServer
+	queryClient types.QueryClient
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeGrantKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(30000000))
+	suite.msgSrvr = keeper.NewMsgServerImpl(app.FeeGrantKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestKeeperCrud() {
+	ctx, k := suite.ctx, suite.app.FeeGrantKeeper
+
+	// some helpers
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	exp := ctx.BlockTime().AddDate(1, 0, 0)
+	basic := &types.BasicAllowance{
+		SpendLimit: suite.atom(555),
+		Expiration: &exp,
+	}
+	basic2 := &types.BasicAllowance{
+		SpendLimit: eth,
+		Expiration: &exp,
+	}
+
+	// let's set up some initial state here
+	suite.Require().NoError(k.GrantAllowance(ctx, suite.addrs[0], suite.addrs[1], basic))
+	suite.Require().NoError(k.GrantAllowance(ctx, suite.addrs[0], suite.addrs[2], basic2))
+	suite.Require().NoError(k.GrantAllowance(ctx, suite.addrs[1], suite.addrs[2], basic))
+	suite.Require().NoError(k.GrantAllowance(ctx, suite.addrs[1], suite.addrs[3], basic))
+	suite.Require().NoError(k.GrantAllowance(ctx, suite.addrs[3], suite.addrs[0], basic2))
+
+	// remove some, overwrite other
+	suite.Require().NoError(k.RevokeAllowance(ctx, suite.addrs[0], suite.addrs[1]))
+	suite.Require().NoError(k.RevokeAllowance(ctx, suite.addrs[0], suite.addrs[2]))
+	suite.Require().NoError(k.GrantAllowance(ctx, suite.addrs[0], suite.addrs[2], basic))
+	suite.Require().NoError(k.GrantAllowance(ctx, suite.addrs[1], suite.addrs[2], basic2))
+
+	// revoking a missing grant fails
+	suite.Require().Error(k.RevokeAllowance(ctx, suite.addrs[0], suite.addrs[1]))
+
+	// end state:
+	// addr -> addr3 (basic)
+	// addr2 -> addr3 (basic2), addr4(basic)
+	// addr4 -> addr (basic2)
+
+	// then lots of queries
+	cases := map[string]struct {
+		grantee   sdk.AccAddress
+		granter   sdk.AccAddress
+		allowance types.FeeAllowanceI
+	}{
+		"addr revoked": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+		},
+		"addr revoked and added": {
+			granter:   suite.addrs[0],
+			grantee:   suite.addrs[2],
+			allowance: basic,
+		},
+		"addr never there": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[3],
+		},
+		"addr modified": {
+			granter:   suite.addrs[1],
+			grantee:   suite.addrs[2],
+			allowance: basic2,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			allow, err := k.GetAllowance(ctx, tc.granter, tc.grantee)
+			if tc.allowance == nil {
+				suite.Require().Nil(allow)
+				suite.Require().True(errors.Is(err, types.ErrNoAllowance))
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.allowance, allow)
+		})
+	}
+
+	var grants []types.Grant
+	err := k.IterateAllFeeAllowances(ctx, func(grant types.Grant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(grants, 4)
+}
+
+func (suite *KeeperTestSuite) TestGrantAllowanceCreatesGranteeAccount() {
+	ctx, k := suite.ctx, suite.app.FeeGrantKeeper
+	grantee := sdk.AccAddress("new_grantee_________")
+
+	suite.Require().Nil(suite.app.AccountKeeper.GetAccount(ctx, grantee))
+	suite.Require().NoError(k.GrantAllowance(ctx, suite.addrs[0], grantee, &types.BasicAllowance{}))
+	suite.Require().NotNil(suite.app.AccountKeeper.GetAccount(ctx, grantee))
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFee() {
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
+	blockTime := suite.ctx.BlockTime()
+	oneYear := blockTime.AddDate(1, 0, 0)
+
+	future := &types.BasicAllowance{
+		SpendLimit: suite.atom(5000),
+		Expiration: &oneYear,
+	}
+
+	// for testing limits of the contract
+	hugeAtom := suite.atom(9999)
+	smallAtom := suite.atom(1)
+	futureAfterSmall := &types.BasicAllowance{
+		SpendLimit: suite.atom(4999),
+		Expiration: &oneYear,
+	}
+
+	// then lots of queries
+	cases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		fee     sdk.Coins
+		allowed bool
+		final   types.FeeAllowanceI
+	}{
+		"use entire pot": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+			fee:     suite.atom(5000),
+			allowed: true,
+			final:   nil,
+		},
+		"too high": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+			fee:     hugeAtom,
+			allowed: false,
+			final:   future,
+		},
+		"use a little": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+			fee:     smallAtom,
+			allowed: true,
+			final:   futureAfterSmall,
+		},
+		"wrong denom": {
+			granter: suite.addrs[0],
+			grantee: suite.addrs[1],
+			fee:     eth,
+			allowed: false,
+			final:   future,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			k := suite.app.FeeGrantKeeper
+
+			err := k.GrantAllowance(ctx, suite.addrs[0], suite.addrs[1], future)
+			suite.Require().NoError(err)
+
+			err = k.UseGrantedFees(ctx, tc.granter, tc.grantee, tc.fee, []sdk.Msg{})
+			if tc.allowed {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			loaded, _ := k.GetAllowance(ctx, tc.granter, tc.grantee)
+			if tc.final == nil {
+				suite.Require().Nil(loaded)
+				return
+			}
+
+			suite.Require().Equal(tc.final, loaded)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRemoveExpiredAllowances() {
+	ctx, k := suite.ctx, suite.app.FeeGrantKeeper
+	blockTime := ctx.BlockTime()
+	oneDay := blockTime.AddDate(0, 0, 1)
+	oneYear := blockTime.AddDate(1, 0, 0)
+
+	expiresSoon := &types.BasicAllowance{SpendLimit: suite.atom(10), Expiration: &oneDay}
+	expiresLater := &types.BasicAllowance{SpendLimit: suite.atom(10), Expiration: &oneYear}
+	periodic := &types.PeriodicAllowance{
+		Basic:            *expiresSoon,
+		Period:           time.Hour,
+		PeriodSpendLimit: suite.atom(5),
+	}
+	never := &types.BasicAllowance{SpendLimit: suite.atom(10)}
+
+	suite.Require().NoError(k.GrantAllowance(ctx, suite.addrs[0], suite.addrs[1], expiresSoon))
+	suite.Require().NoError(k.GrantAllowance(ctx, suite.addrs[0], suite.addrs[2], periodic))
+	suite.Require().NoError(k.GrantAllowance(ctx, suite.addrs[0], suite.addrs[3], expiresLater))
+	suite.Require().NoError(k.GrantAllowance(ctx, suite.addrs[1], suite.addrs[2], never))
+
+	// extending an allowance moves it in the expiration queue
+	suite.Require().NoError(k.GrantAllowance(ctx, suite.addrs[1], suite.addrs[3], expiresSoon))
+	suite.Require().NoError(k.GrantAllowance(ctx, suite.addrs[1], suite.addrs[3], expiresLater))
+
+	// nothing is pruned before the expiration
+	k.RemoveExpiredAllowances(ctx)
+	suite.Require().Len(suite.allGrants(ctx), 5)
+
+	ctx = ctx.WithBlockTime(oneDay)
+	k.RemoveExpiredAllowances(ctx)
+
+	grants := suite.allGrants(ctx)
+	suite.Require().Len(grants, 3)
+
+	_, err := k.GetAllowance(ctx, suite.addrs[0], suite.addrs[1])
+	suite.Require().True(errors.Is(err, types.ErrNoAllowance))
+	_, err = k.GetAllowance(ctx, suite.addrs[0], suite.addrs[2])
+	suite.Require().True(errors.Is(err, types.ErrNoAllowance))
+
+	allowance, err := k.GetAllowance(ctx, suite.addrs[1], suite.addrs[3])
+	suite.Require().NoError(err)
+	suite.Require().Equal(expiresLater, allowance)
+
+	ctx = ctx.WithBlockTime(oneYear.Add(time.Second))
+	k.RemoveExpiredAllowances(ctx)
+
+	grants = suite.allGrants(ctx)
+	suite.Require().Len(grants, 1)
+	suite.Require().Equal(suite.addrs[1].String(), grants[0].Granter)
+	suite.Require().Equal(suite.addrs[2].String(), grants[0].Grantee)
+}
+
+func (suite *KeeperTestSuite) allGrants(ctx sdk.Context) []types.Grant {
+	var grants []types.Grant
+	err := suite.app.FeeGrantKeeper.IterateAllFeeAllowances(ctx, func(grant types.Grant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	suite.Require().NoError(err)
+
+	return grants
+}
+
+func (suite *KeeperTestSuite) atom(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("atom", amount))
+}
@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func (suite *KeeperTestSuite) TestGrantAllowance() {
+	oneYear := suite.ctx.BlockTime().AddDate(1, 0, 0)
+	yesterday := suite.ctx.BlockTime().AddDate(0, 0, -1)
+
+	testCases := []struct {
+		name      string
+		req       func() *types.MsgGrantAllowance
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"valid: basic fee allowance",
+			func() *types.MsgGrantAllowance {
+				msg, err := types.NewMsgGrantAllowance(&types.BasicAllowance{
+					SpendLimit: suite.atom(555),
+					Expiration: &oneYear,
+				}, suite.addrs[0], suite.addrs[1])
+				suite.Require().NoError(err)
+				return msg
+			},
+			false,
+			"",
+		},
+		{
+			"fail: fee allowance exists",
+			func() *types.MsgGrantAllowance {
+				msg, err := types.NewMsgGrantAllowance(&types.BasicAllowance{
+					SpendLimit: suite.atom(555),
+					Expiration: &oneYear,
+				}, suite.addrs[0], suite.addrs[1])
+				suite.Require().NoError(err)
+				return msg
+			},
+			true,
+			"fee allowance already exists",
+		},
+		{
+			"fail: expiration in the past",
+			func() *types.MsgGrantAllowance {
+				msg, err := types.NewMsgGrantAllowance(&types.BasicAllowance{
+					SpendLimit: suite.atom(555),
+					Expiration: &yesterday,
+				}, suite.addrs[1], suite.addrs[2])
+				suite.Require().NoError(err)
+				return msg
+			},
+			true,
+			"expiration is before current block time",
+		},
+		{
+			"valid: periodic fee allowance",
+			func() *types.MsgGrantAllowance {
+				msg, err := types.NewMsgGrantAllowance(&types.PeriodicAllowance{
+					Basic: types.BasicAllowance{
+						SpendLimit: suite.atom(555),
+						Expiration: &oneYear,
+					},
+					Period:           time.Hour,
+					PeriodSpendLimit: suite.atom(10),
+					PeriodCanSpend:   suite.atom(10),
+				}, suite.addrs[1], suite.addrs[2])
+				suite.Require().NoError(err)
+				return msg
+			},
+			false,
+			"",
+		},
+		{
+			"valid: allowed messages fee allowance",
+			func() *types.MsgGrantAllowance {
+				allowance, err := types.NewAllowedMsgAllowance(&types.BasicAllowance{
+					SpendLimit: suite.atom(555),
+				}, []string{"/cosmos.bank.v1beta1.MsgSend"})
+				suite.Require().NoError(err)
+
+				msg, err := types.NewMsgGrantAllowance(allowance, suite.addrs[2], suite.addrs[3])
+				suite.Require().NoError(err)
+				return msg
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.GrantAllowance(sdk.WrapSDKContext(suite.ctx), tc.req())
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRevokeAllowance() {
+	testCases := []struct {
+		name      string
+		request   *types.MsgRevokeAllowance
+		preRun    func()
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"error: invalid granter",
+			&types.MsgRevokeAllowance{
+				Granter: "invalid-granter",
+				Grantee: suite.addrs[1].String(),
+			},
+			func() {},
+			true,
+			"decoding bech32 failed",
+		},
+		{
+			"error: invalid grantee",
+			&types.MsgRevokeAllowance{
+				Granter: suite.addrs[0].String(),
+				Grantee: "invalid-grantee",
+			},
+			func() {},
+			true,
+			"decoding bech32 failed",
+		},
+		{
+			"error: fee allowance not found",
+			&types.MsgRevokeAllowance{
+				Granter: suite.addrs[0].String(),
+				Grantee: suite.addrs[1].String(),
+			},
+			func() {},
+			true,
+			"allowance not found",
+		},
+		{
+			"success: revoke fee allowance",
+			&types.MsgRevokeAllowance{
+				Granter: suite.addrs[0].String(),
+				Grantee: suite.addrs[1].String(),
+			},
+			func() {
+				suite.grantFeeAllowance()
+			},
+			false,
+			"",
+		},
+		{
+			"error: check fee allowance revoked",
+			&types.MsgRevokeAllowance{
+				Granter: suite.addrs[0].String(),
+				Grantee: suite.addrs[1].String(),
+			},
+			func() {},
+			true,
+			"allowance not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.preRun()
+			_, err := suite.msgSrvr.RevokeAllowance(sdk.WrapSDKContext(suite.ctx), tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package types
+
+// feegrant module events
+const (
+	EventTypeUseFeeGrant    = "use_feegrant"
+	EventTypeRevokeFeeGrant = "revoke_feegrant"
+	EventTypeSetFeeGrant    = "set_feegrant"
+	EventTypePruneFeeGrant  = "prune_feegrant"
+
+	AttributeKeyGranter = "granter"
+	AttributeKeyGrantee = "grantee"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,110 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestFilteredFeeValidAllow(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{
+		Time: time.Now(),
+	})
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+	oneHour := ctx.BlockTime().Add(1 * time.Hour)
+
+	sendMsg := &banktypes.MsgSend{}
+	voteMsg := &govtypes.MsgVote{}
+	sendURL := "/cosmos.bank.v1beta1.MsgSend"
+
+	cases := map[string]struct {
+		allowance   *types.BasicAllowance
+		allowedMsgs []string
+		msgs        []sdk.Msg
+		valid       bool
+		accept      bool
+		remove      bool
+		remains     sdk.Coins
+	}{
+		"no allowed messages": {
+			allowance:   &types.BasicAllowance{SpendLimit: atom},
+			allowedMsgs: []string{},
+			valid:       false,
+		},
+		"allowed message": {
+			allowance:   &types.BasicAllowance{SpendLimit: atom, Expiration: &oneHour},
+			allowedMsgs: []string{sendURL},
+			msgs:        []sdk.Msg{sendMsg},
+			valid:       true,
+			accept:      true,
+			remains:     leftAtom,
+		},
+		"allowed service message": {
+			allowance:   &types.BasicAllowance{SpendLimit: atom},
+			allowedMsgs: []string{sendURL},
+			msgs: []sdk.Msg{sdk.ServiceMsg{
+				MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+				Request:    sendMsg,
+			}},
+			valid:   true,
+			accept:  true,
+			remains: leftAtom,
+		},
+		"message not allowed": {
+			allowance:   &types.BasicAllowance{SpendLimit: atom},
+			allowedMsgs: []string{sendURL},
+			msgs:        []sdk.Msg{sendMsg, voteMsg},
+			valid:       true,
+			accept:      false,
+		},
+		"allowed message uses up the allowance": {
+			allowance:   &types.BasicAllowance{SpendLimit: smallAtom},
+			allowedMsgs: []string{sendURL},
+			msgs:        []sdk.Msg{sendMsg},
+			valid:       true,
+			accept:      true,
+			remove:      true,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			allowance, err := types.NewAllowedMsgAllowance(tc.allowance, tc.allowedMsgs)
+			require.NoError(t, err)
+
+			err = allowance.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			removed, err := allowance.Accept(ctx, smallAtom, tc.msgs)
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.remove, removed)
+			if !removed {
+				inner, err := allowance.GetAllowance()
+				require.NoError(t, err)
+				require.Equal(t, tc.remains, inner.(*types.BasicAllowance).SpendLimit)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "feegrant"
+
+	// StoreKey is the store key string for feegrant
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for feegrant
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for feegrant
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// FeeAllowanceKeyPrefix is the set of the kvstore for fee allowance data
+	FeeAllowanceKeyPrefix = []byte{0x00}
+
+	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance
+	// keys ordered by their expiration time
+	FeeAllowanceQueueKeyPrefix = []byte{0x01}
+)
+
+// FeeAllowanceKey is the canonical key to store a grant from granter to grantee
+// We store by grantee first to allow searching by everyone who granted to you
+func FeeAllowanceKey(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	return append(FeeAllowancePrefixByGrantee(grantee), granter.Bytes()...)
+}
+
+// FeeAllowancePrefixByGrantee returns a prefix to scan for all grants to this given address.
+func FeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
+	return append(FeeAllowanceKeyPrefix, grantee.Bytes()...)
+}
+
+// FeeAllowanceByExpTimeKey returns the prefix of the expiration queue for
+// grants expiring at the given time.
+func FeeAllowanceByExpTimeKey(exp time.Time) []byte {
+	return append(FeeAllowanceQueueKeyPrefix, sdk.FormatTimeBytes(exp)...)
+}
+
+// FeeAllowanceQueueKey returns the expiration queue key of the grant from
+// granter to grantee expiring at the given time.
+func FeeAllowanceQueueKey(exp time.Time, granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	key := append(FeeAllowanceByExpTimeKey(exp), grantee.Bytes()...)
+	return append(key, granter.Bytes()...)
+}
+
+// ParseAddressesFromFeeAllowanceQueueKey extracts the granter and grantee
+// addresses from an expiration queue key.
+func ParseAddressesFromFeeAllowanceQueueKey(key []byte) (granter, grantee sdk.AccAddress) {
+	// key is of format:
+	// 0x01<expiration (time bytes)><grantee (20 bytes)><granter (20 bytes)>
+	if len(key) < 1+2*sdk.AddrLen {
+		panic(fmt.Sprintf("unexpected key length (%d < %d)", len(key), 1+2*sdk.AddrLen))
+	}
+
+	addrs := key[len(key)-2*sdk.AddrLen:]
+	return sdk.AccAddress(addrs[sdk.AddrLen:]), sdk.AccAddress(addrs[:sdk.AddrLen])
+}
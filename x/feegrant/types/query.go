@@ -0,0 +1,26 @@
+package types
+
+import codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+func (m *QueryAllowanceResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Allowance != nil {
+		return m.Allowance.UnpackInterfaces(unpacker)
+	}
+
+	return nil
+}
+
+func (m *QueryAllowancesResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, grant := range m.Allowances {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var (
+	_ codectypes.UnpackInterfacesMessage = &QueryAllowanceResponse{}
+	_ codectypes.UnpackInterfacesMessage = &QueryAllowancesResponse{}
+)
@@ -33,7 +33,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
 				proposal.ProposalId,
 				proposal.GetTitle(),
-				keeper.GetDepositParams(ctx).MinDeposit,
+				keeper.GetDepositParams(ctx).GetMinDeposit(proposal.Expedited),
 				proposal.TotalDeposit,
 			),
 		)
@@ -44,7 +44,45 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
 		var tagValue, logMsg string
 
-		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+		// The votes of an expedited proposal are kept by its tally, as they are
+		// tallied again if the proposal is converted to a regular one.
+		tally := keeper.Tally
+		if proposal.Expedited {
+			tally = keeper.TallyKeepVotes
+		}
+		passes, burnDeposits, tallyResults := tally(ctx, proposal)
+
+		// An expedited proposal which does not pass is converted to a regular
+		// proposal: its voting period is extended to the regular length, and its
+		// votes are tallied again by the regular rules once the period ends. Its
+		// deposits are kept until then.
+		if proposal.Expedited && !passes {
+			proposal.Expedited = false
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+			proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+			logger.Info(
+				fmt.Sprintf(
+					"expedited proposal %d (%s) tallied; result: not passed, converted to a regular proposal ending at %s",
+					proposal.ProposalId, proposal.GetTitle(), proposal.VotingEndTime,
+				),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
+		if proposal.Expedited {
+			keeper.DeleteVotes(ctx, proposal.ProposalId)
+		}
 
 		if burnDeposits {
 			keeper.DeleteDeposits(ctx, proposal.ProposalId)
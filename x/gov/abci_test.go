@@ -11,6 +11,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -292,7 +294,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))}
@@ -307,7 +309,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	deposits := initialModuleAccCoins.Add(proposal.TotalDeposit...).Add(proposalCoins...)
 	require.True(t, moduleAccCoins.IsEqual(deposits))
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.OptionYes)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -321,6 +323,149 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
 }
 
+func TestExpeditedProposalEndblocker(t *testing.T) {
+	testcases := []struct {
+		name         string
+		option       types.VoteOption
+		expectPassed bool
+	}{
+		{"expedited proposal passes", types.OptionYes, true},
+		{"expedited proposal is converted to a regular proposal", types.OptionNo, false},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 10, valTokens)
+
+			SortAddresses(addrs)
+
+			handler := gov.NewHandler(app.GovKeeper)
+			stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddr := sdk.ValAddress(addrs[0])
+
+			createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, true)
+			require.NoError(t, err)
+			require.True(t, proposal.Expedited)
+
+			// The regular minimum deposit doesn't activate an expedited proposal.
+			depositCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(25))}
+			handleAndCheck(t, handler, ctx, types.NewMsgDeposit(addrs[1], proposal.ProposalId, depositCoins))
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+			require.Equal(t, types.StatusDepositPeriod, proposal.Status)
+
+			handleAndCheck(t, handler, ctx, types.NewMsgDeposit(addrs[2], proposal.ProposalId, depositCoins))
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+			require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+
+			votingParams := app.GovKeeper.GetVotingParams(ctx)
+			require.Equal(t, proposal.VotingStartTime.Add(votingParams.ExpeditedVotingPeriod), proposal.VotingEndTime)
+
+			err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(tc.option))
+			require.NoError(t, err)
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+
+			if tc.expectPassed {
+				require.Equal(t, types.StatusPassed, proposal.Status)
+				return
+			}
+
+			// The proposal stays in voting period until the end of the regular
+			// voting period, and its deposits are kept.
+			require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+			require.False(t, proposal.Expedited)
+			require.Equal(t, proposal.VotingStartTime.Add(votingParams.VotingPeriod), proposal.VotingEndTime)
+			require.Len(t, app.GovKeeper.GetDeposits(ctx, proposal.ProposalId), 2)
+
+			newHeader.Time = proposal.VotingEndTime
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+			require.Equal(t, types.StatusRejected, proposal.Status)
+		})
+	}
+}
+
+func TestExpeditedProposalPassesAsRegular(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 10, valTokens)
+
+	SortAddresses(addrs)
+
+	handler := gov.NewHandler(app.GovKeeper)
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])}
+
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{6, 4})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, true)
+	require.NoError(t, err)
+
+	depositCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(25))}
+	handleAndCheck(t, handler, ctx, types.NewMsgDeposit(addrs[2], proposal.ProposalId, depositCoins))
+	handleAndCheck(t, handler, ctx, types.NewMsgDeposit(addrs[3], proposal.ProposalId, depositCoins))
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+
+	// 60% of Yes votes is below the expedited threshold, but above the regular one.
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	// The votes are kept for the tally at the end of the regular voting period.
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+	require.False(t, proposal.Expedited)
+	require.Len(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalId), 2)
+
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Empty(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalId))
+	require.Empty(t, app.GovKeeper.GetDeposits(ctx, proposal.ProposalId))
+}
+
 func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -340,7 +485,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
@@ -348,7 +493,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 
 	handleAndCheck(t, gov.NewHandler(app.GovKeeper), ctx, newDepositMsg)
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.OptionYes)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -362,3 +507,73 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
+
+func TestExecMsgsProposalPassedEndblocker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+	SortAddresses(addrs)
+
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+
+	// messages of other signers than the governance module account are rejected
+	content, err := types.NewExecMsgsProposal("title", "description", []sdk.Msg{
+		distrtypes.NewMsgSetWithdrawAddress(addrs[0], addrs[1]),
+	})
+	require.NoError(t, err)
+	require.Error(t, content.ValidateBasic())
+
+	// as are messages failing on execution
+	content, err = types.NewExecMsgsProposal("title", "description", []sdk.Msg{
+		banktypes.NewMsgSend(govAddr, addrs[1], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, valTokens))),
+	})
+	require.NoError(t, err)
+	require.NoError(t, content.ValidateBasic())
+	_, err = app.GovKeeper.SubmitProposal(ctx, content, false)
+	require.Error(t, err)
+
+	// the governance module account spends funds it holds besides deposits
+	govCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1)))
+	require.NoError(t, simapp.FundModuleAccount(app, ctx, types.ModuleName, govCoins))
+	initialBalance := app.BankKeeper.GetAllBalances(ctx, addrs[1])
+
+	content, err = types.NewExecMsgsProposal("title", "description", []sdk.Msg{
+		banktypes.NewMsgSend(govAddr, addrs[1], govCoins),
+	})
+	require.NoError(t, err)
+	require.NoError(t, content.ValidateBasic())
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, content, false)
+	require.NoError(t, err)
+
+	// the messages are not executed on submission
+	require.Equal(t, initialBalance, app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
+	newDepositMsg := types.NewMsgDeposit(addrs[0], proposal.ProposalId, proposalCoins)
+
+	handleAndCheck(t, gov.NewHandler(app.GovKeeper), ctx, newDepositMsg)
+
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod).Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Equal(t, initialBalance.Add(govCoins...), app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+}
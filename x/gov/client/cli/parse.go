@@ -7,7 +7,10 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
@@ -21,6 +24,7 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 		proposal.Description, _ = fs.GetString(FlagDescription)
 		proposal.Type = govutils.NormalizeProposalType(proposalType)
 		proposal.Deposit, _ = fs.GetString(FlagDeposit)
+		proposal.Expedited, _ = fs.GetBool(FlagExpedited)
 		return proposal, nil
 	}
 
@@ -40,5 +44,45 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 		return nil, err
 	}
 
+	if expedited, _ := fs.GetBool(FlagExpedited); expedited {
+		proposal.Expedited = true
+	}
+
 	return proposal, nil
 }
+
+// parseExecMsgsProposal reads an ExecMsgsProposal and its deposit from a JSON
+// file. The messages of the proposal are decoded as protobuf JSON Anys.
+func parseExecMsgsProposal(cdc codec.JSONMarshaler, proposalFile string) (*types.ExecMsgsProposal, sdk.Coins, error) {
+	var proposal execMsgsProposal
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return nil, nil, err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	contentBz, err := json.Marshal(map[string]interface{}{
+		"title":       proposal.Title,
+		"description": proposal.Description,
+		"messages":    proposal.Messages,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content := &types.ExecMsgsProposal{}
+	if err := cdc.UnmarshalJSON(contentBz, content); err != nil {
+		return nil, nil, fmt.Errorf("invalid proposal messages: %w", err)
+	}
+
+	return content, deposit, nil
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -22,6 +23,7 @@ const (
 	FlagDescription  = "description"
 	FlagProposalType = "type"
 	FlagDeposit      = "deposit"
+	FlagExpedited    = "expedited"
 	flagVoter        = "voter"
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
@@ -33,6 +35,14 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	Expedited   bool
+}
+
+type execMsgsProposal struct {
+	Title       string
+	Description string
+	Messages    []json.RawMessage
+	Deposit     string
 }
 
 // ProposalFlags defines the core required fields of a proposal. It is used to
@@ -60,6 +70,7 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 	}
 
 	cmdSubmitProp := NewCmdSubmitProposal()
+	cmdSubmitProp.AddCommand(NewCmdSubmitExecMsgsProposal())
 	for _, propCmd := range propCmds {
 		flags.AddTxFlagsToCmd(propCmd)
 		cmdSubmitProp.AddCommand(propCmd)
@@ -68,6 +79,7 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 	govTxCmd.AddCommand(
 		NewCmdDeposit(),
 		NewCmdVote(),
+		NewCmdWeightedVote(),
 		cmdSubmitProp,
 	)
 
@@ -98,6 +110,10 @@ Where proposal.json contains:
 Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+Expedited proposals have a shorter voting period, but require a higher deposit and a
+higher threshold to pass. They are submitted with the --expedited flag, or with
+"expedited": true in the proposal JSON file.
 `,
 				version.AppName, version.AppName,
 			),
@@ -125,6 +141,7 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.Expedited = proposal.Expedited
 
 			if err = msg.ValidateBasic(); err != nil {
 				return fmt.Errorf("message validation failed: %w", err)
@@ -139,6 +156,79 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(FlagProposalType, "", "The proposal Type")
 	cmd.Flags().String(FlagDeposit, "", "The proposal deposit")
 	cmd.Flags().String(FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().Bool(FlagExpedited, false, "Submit an expedited proposal")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitExecMsgsProposal implements submitting a proposal whose messages
+// are executed by the governance module account when it passes.
+func NewCmdSubmitExecMsgsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec-msgs [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal executing messages signed by the governance module account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal executing messages along with an initial deposit.
+The messages are executed in order when the proposal passes, and must all have the
+governance module account as their only signer. The proposal details must be supplied
+via a JSON file, where the messages are given in their protobuf JSON encoding.
+
+Example:
+$ %s tx gov submit-proposal exec-msgs <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community spend",
+  "description": "Send tokens held by the governance module account",
+  "messages": [
+    {
+      "@type": "/cosmos.bank.v1beta1.MsgSend",
+      "from_address": "<governance module account address>",
+      "to_address": "<recipient address>",
+      "amount": [{"denom": "stake", "amount": "10"}]
+    }
+  ],
+  "deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			content, deposit, err := parseExecMsgsProposal(clientCtx.JSONMarshaler, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to parse proposal: %w", err)
+			}
+
+			expedited, err := cmd.Flags().GetBool(FlagExpedited)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+			msg.Expedited = expedited
+
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagExpedited, false, "Submit an expedited proposal")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -251,3 +341,59 @@ $ %s tx gov vote 1 yes --from mykey
 
 	return cmd
 }
+
+// NewCmdWeightedVote implements creating a new weighted vote command.
+func NewCmdWeightedVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal, with the voting power split
+across several options, as comma separated option=weight pairs whose weights sum up to 1.
+You can find the proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Get voter address
+			from := clientCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Figure out which vote options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
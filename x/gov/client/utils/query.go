@@ -78,36 +78,33 @@ func QueryDepositsByTxQuery(clientCtx client.Context, params types.QueryProposal
 // marshalled result or any error that occurred.
 func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVotesParams) ([]byte, error) {
 	var (
-		events = []string{
-			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
-			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
-		}
 		votes      []types.Vote
-		nextTxPage = defaultPage
 		totalLimit = params.Limit * params.Page
 	)
-	// query interrupted either if we collected enough votes or tx indexer run out of relevant txs
-	for len(votes) < totalLimit {
-		searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, nextTxPage, defaultLimit, "")
-		if err != nil {
-			return nil, err
+	// single and weighted votes are searched for separately, since the events of a query must all match
+	for _, msgType := range []string{types.TypeMsgVote, types.TypeMsgVoteWeighted} {
+		events := []string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, msgType),
+			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 		}
-		nextTxPage++
-		for _, info := range searchResult.Txs {
-			for _, msg := range info.GetTx().GetMsgs() {
-				if msg.Type() == types.TypeMsgVote {
-					voteMsg := msg.(*types.MsgVote)
-
-					votes = append(votes, types.Vote{
-						Voter:      voteMsg.Voter,
-						ProposalId: params.ProposalID,
-						Option:     voteMsg.Option,
-					})
+		nextTxPage := defaultPage
+		// query interrupted either if we collected enough votes or tx indexer run out of relevant txs
+		for len(votes) < totalLimit {
+			searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, nextTxPage, defaultLimit, "")
+			if err != nil {
+				return nil, err
+			}
+			nextTxPage++
+			for _, info := range searchResult.Txs {
+				for _, msg := range info.GetTx().GetMsgs() {
+					if vote, ok := voteFromMsg(msg, params.ProposalID); ok {
+						votes = append(votes, vote)
+					}
 				}
 			}
-		}
-		if len(searchResult.Txs) != defaultLimit {
-			break
+			if len(searchResult.Txs) != defaultLimit {
+				break
+			}
 		}
 	}
 	start, end := client.Paginate(len(votes), params.Page, params.Limit, 100)
@@ -127,36 +124,30 @@ func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVot
 
 // QueryVoteByTxQuery will query for a single vote via a direct txs tags query.
 func QueryVoteByTxQuery(clientCtx client.Context, params types.QueryVoteParams) ([]byte, error) {
-	events := []string{
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
-		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
-	}
-
-	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
-	// support configurable pagination.
-	searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, defaultPage, defaultLimit, "")
-	if err != nil {
-		return nil, err
-	}
-	for _, info := range searchResult.Txs {
-		for _, msg := range info.GetTx().GetMsgs() {
-			// there should only be a single vote under the given conditions
-			if msg.Type() == types.TypeMsgVote {
-				voteMsg := msg.(*types.MsgVote)
-
-				vote := types.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalId: params.ProposalID,
-					Option:     voteMsg.Option,
-				}
+	for _, msgType := range []string{types.TypeMsgVote, types.TypeMsgVoteWeighted} {
+		events := []string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, msgType),
+			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
+		}
 
-				bz, err := clientCtx.JSONMarshaler.MarshalJSON(&vote)
-				if err != nil {
-					return nil, err
+		// NOTE: SearchTxs is used to facilitate the txs query which does not currently
+		// support configurable pagination.
+		searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, defaultPage, defaultLimit, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range searchResult.Txs {
+			for _, msg := range info.GetTx().GetMsgs() {
+				// there should only be a single vote under the given conditions
+				if vote, ok := voteFromMsg(msg, params.ProposalID); ok {
+					bz, err := clientCtx.JSONMarshaler.MarshalJSON(&vote)
+					if err != nil {
+						return nil, err
+					}
+
+					return bz, nil
 				}
-
-				return bz, nil
 			}
 		}
 	}
@@ -164,6 +155,29 @@ func QueryVoteByTxQuery(clientCtx client.Context, params types.QueryVoteParams)
 	return nil, fmt.Errorf("address '%s' did not vote on proposalID %d", params.Voter, params.ProposalID)
 }
 
+// voteFromMsg returns the vote cast by a MsgVote or MsgVoteWeighted.
+func voteFromMsg(msg sdk.Msg, proposalID uint64) (types.Vote, bool) {
+	switch msg := msg.(type) {
+	case *types.MsgVote:
+		return types.Vote{
+			Voter:      msg.Voter,
+			ProposalId: proposalID,
+			Option:     msg.Option,
+			Options:    types.NewNonSplitVoteOption(msg.Option),
+		}, true
+
+	case *types.MsgVoteWeighted:
+		return types.Vote{
+			Voter:      msg.Voter,
+			ProposalId: proposalID,
+			Options:    msg.Options,
+		}, true
+
+	default:
+		return types.Vote{}, false
+	}
+}
+
 // QueryDepositByTxQuery will query for a single deposit via a direct txs tags
 // query.
 func QueryDepositByTxQuery(clientCtx client.Context, params types.QueryDepositParams) ([]byte, error) {
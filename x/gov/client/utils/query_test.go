@@ -2,6 +2,8 @@ package utils_test
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -19,6 +21,7 @@ import (
 )
 
 type TxSearchMock struct {
+	txConfig client.TxConfig
 	mock.Client
 	txs []tmtypes.Tx
 }
@@ -32,12 +35,27 @@ func (mock TxSearchMock) TxSearch(ctx context.Context, query string, prove bool,
 		*perPage = 0
 	}
 
-	start, end := client.Paginate(len(mock.txs), *page, *perPage, 100)
+	// only the txs with a message of the queried action match
+	matchingTxs := make([]tmtypes.Tx, 0, len(mock.txs))
+	for _, txBz := range mock.txs {
+		tx, err := mock.txConfig.TxDecoder()(txBz)
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range tx.GetMsgs() {
+			if strings.Contains(query, fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, msg.Type())) {
+				matchingTxs = append(matchingTxs, txBz)
+				break
+			}
+		}
+	}
+
+	start, end := client.Paginate(len(matchingTxs), *page, *perPage, 100)
 	if start < 0 || end < 0 {
 		// nil result with nil error crashes utils.QueryTxsByEvents
 		return &ctypes.ResultTxSearch{}, nil
 	}
-	txs := mock.txs[start:end]
+	txs := matchingTxs[start:end]
 	rst := &ctypes.ResultTxSearch{Txs: make([]*ctypes.ResultTx, len(txs)), TotalCount: len(txs)}
 	for i := range txs {
 		rst.Txs[i] = &ctypes.ResultTx{Tx: txs[i]}
@@ -77,6 +95,16 @@ func TestGetPaginatedVotes(t *testing.T) {
 		types.NewMsgVote(acc2, 0, types.OptionYes),
 		types.NewMsgVote(acc2, 0, types.OptionYes),
 	}
+	weightedOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(7, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(3, 1)),
+	}
+	// votes cast with MsgVote also have the deprecated option set
+	nonSplitVote := func(voter sdk.AccAddress) types.Vote {
+		vote := types.NewVote(0, voter, types.NewNonSplitVoteOption(types.OptionYes))
+		vote.Option = types.OptionYes
+		return vote
+	}
 	for _, tc := range []testCase{
 		{
 			description: "1MsgPerTxAll",
@@ -87,8 +115,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				acc2Msgs[:1],
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				nonSplitVote(acc1),
+				nonSplitVote(acc2)},
 		},
 		{
 			description: "2MsgPerTx1Chunk",
@@ -99,8 +127,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				acc2Msgs,
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc1, types.OptionYes)},
+				nonSplitVote(acc1),
+				nonSplitVote(acc1)},
 		},
 		{
 			description: "2MsgPerTx2Chunk",
@@ -111,8 +139,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				acc2Msgs,
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc2, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				nonSplitVote(acc2),
+				nonSplitVote(acc2)},
 		},
 		{
 			description: "IncompleteSearchTx",
@@ -121,7 +149,19 @@ func TestGetPaginatedVotes(t *testing.T) {
 			msgs: [][]sdk.Msg{
 				acc1Msgs[:1],
 			},
-			votes: []types.Vote{types.NewVote(0, acc1, types.OptionYes)},
+			votes: []types.Vote{nonSplitVote(acc1)},
+		},
+		{
+			description: "SingleAndWeightedVotes",
+			page:        1,
+			limit:       2,
+			msgs: [][]sdk.Msg{
+				{types.NewMsgVoteWeighted(acc1, 0, weightedOptions)},
+				acc2Msgs[:1],
+			},
+			votes: []types.Vote{
+				nonSplitVote(acc2),
+				types.NewVote(0, acc1, weightedOptions)},
 		},
 		{
 			description: "InvalidPage",
@@ -148,7 +188,7 @@ func TestGetPaginatedVotes(t *testing.T) {
 			)
 
 			encodingConfig := simapp.MakeTestEncodingConfig()
-			cli := TxSearchMock{txs: marshalled}
+			cli := TxSearchMock{txConfig: encodingConfig.TxConfig, txs: marshalled}
 			clientCtx := client.Context{}.
 				WithLegacyAmino(cdc).
 				WithClient(cli).
@@ -171,7 +211,7 @@ func TestGetPaginatedVotes(t *testing.T) {
 			require.NoError(t, clientCtx.LegacyAmino.UnmarshalJSON(votesData, &votes))
 			require.Equal(t, len(tc.votes), len(votes))
 			for i := range votes {
-				require.Equal(t, tc.votes[i], votes[i])
+				require.Equal(t, tc.votes[i].String(), votes[i].String())
 			}
 		})
 	}
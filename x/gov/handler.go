@@ -1,6 +1,7 @@
 package gov
 
 import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
@@ -27,8 +28,56 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.Vote(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgVoteWeighted:
+			res, err := msgServer.VoteWeighted(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
 	}
 }
+
+// NewProposalHandler creates a governance proposal Handler for the proposals
+// of the gov module. TextProposals have no effect, and the messages of an
+// ExecMsgsProposal are executed in order through the given MsgServiceRouter,
+// failing the proposal as a whole if any of them fails.
+func NewProposalHandler(router *baseapp.MsgServiceRouter) types.Handler {
+	return func(ctx sdk.Context, content types.Content) error {
+		switch c := content.(type) {
+		case *types.ExecMsgsProposal:
+			return handleExecMsgsProposal(ctx, router, c)
+
+		default:
+			return types.ProposalHandler(ctx, content)
+		}
+	}
+}
+
+func handleExecMsgsProposal(ctx sdk.Context, router *baseapp.MsgServiceRouter, p *types.ExecMsgsProposal) error {
+	msgs, err := p.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		handler := router.HandlerByTypeURL(sdk.MsgTypeURL(msg))
+		if handler == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message type: %s; message index: %d", sdk.MsgTypeURL(msg), i)
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		// emit the events of the executed message
+		events := make(sdk.Events, len(res.Events))
+		for j, e := range res.Events {
+			events[j] = sdk.Event(e)
+		}
+		ctx.EventManager().EmitEvents(events)
+	}
+
+	return nil
+}
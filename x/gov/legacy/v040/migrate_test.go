@@ -59,6 +59,7 @@ func TestMigrate(t *testing.T) {
 	// - TextProposal and CommunityPoolSpendProposal have correct any JSON.
 	expected := `{
   "deposit_params": {
+    "expedited_min_deposit": [],
     "max_deposit_period": "0s",
     "min_deposit": []
   },
@@ -71,6 +72,7 @@ func TestMigrate(t *testing.T) {
         "title": "foo_test"
       },
       "deposit_end_time": "0001-01-01T00:00:00Z",
+      "expedited": false,
       "final_tally_result": {
         "abstain": "0",
         "no": "0",
@@ -98,6 +100,7 @@ func TestMigrate(t *testing.T) {
         "title": "foo_community"
       },
       "deposit_end_time": "0001-01-01T00:00:00Z",
+      "expedited": false,
       "final_tally_result": {
         "abstain": "0",
         "no": "0",
@@ -114,12 +117,15 @@ func TestMigrate(t *testing.T) {
   ],
   "starting_proposal_id": "0",
   "tally_params": {
+    "expedited_threshold": "0",
+    "proposal_overrides": [],
     "quorum": "0",
     "threshold": "0",
     "veto_threshold": "0"
   },
   "votes": [],
   "voting_params": {
+    "expedited_voting_period": "0s",
     "voting_period": "0s"
   }
 }`
@@ -0,0 +1,48 @@
+package v041
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// migrateVotes migrates the votes cast for a single option to weighted votes
+// casting all of their voting power for that option.
+func migrateVotes(store sdk.KVStore, cdc codec.BinaryMarshaler) error {
+	iterator := sdk.KVStorePrefixIterator(store, types.VotesKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.Vote
+		if err := cdc.UnmarshalBinaryBare(iterator.Value(), &vote); err != nil {
+			return err
+		}
+
+		// Weighted votes are already in the new layout.
+		if len(vote.Options) != 0 {
+			continue
+		}
+
+		vote.Options = types.NewNonSplitVoteOption(vote.Option)
+		vote.Option = types.OptionEmpty
+
+		bz, err := cdc.MarshalBinaryBare(&vote)
+		if err != nil {
+			return err
+		}
+
+		store.Set(iterator.Key(), bz)
+	}
+
+	return nil
+}
+
+// MigrateStore performs in-place store migrations from v0.40 to v0.41. The
+// migration includes:
+//
+// - Change the single option of the stored votes to weighted vote options.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler) error {
+	store := ctx.KVStore(storeKey)
+
+	return migrateVotes(store, cdc)
+}
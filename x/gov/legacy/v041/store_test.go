@@ -0,0 +1,50 @@
+package v041_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v041gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v041"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestVotesMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	govKey := sdk.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(govKey)
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	// Old votes were cast for a single option.
+	oldVote := types.Vote{ProposalId: 1, Voter: addr1.String(), Option: types.OptionNoWithVeto}
+	store.Set(types.VoteKey(1, addr1), encCfg.Marshaler.MustMarshalBinaryBare(&oldVote))
+
+	weightedOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(7, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(3, 1)),
+	}
+	weightedVote := types.NewVote(1, addr2, weightedOptions)
+	store.Set(types.VoteKey(1, addr2), encCfg.Marshaler.MustMarshalBinaryBare(&weightedVote))
+
+	// Run migration.
+	err := v041gov.MigrateStore(ctx, govKey, encCfg.Marshaler)
+	require.NoError(t, err)
+
+	// The old vote casts all of its voting power for its option.
+	var vote types.Vote
+	encCfg.Marshaler.MustUnmarshalBinaryBare(store.Get(types.VoteKey(1, addr1)), &vote)
+	require.Equal(t, types.OptionEmpty, vote.Option)
+	require.Equal(t, types.NewNonSplitVoteOption(types.OptionNoWithVeto).String(), types.WeightedVoteOptions(vote.Options).String())
+
+	// Weighted votes are left untouched.
+	var migratedWeightedVote types.Vote
+	encCfg.Marshaler.MustUnmarshalBinaryBare(store.Get(types.VoteKey(1, addr2)), &migratedWeightedVote)
+	require.Equal(t, weightedOptions.String(), types.WeightedVoteOptions(migratedWeightedVote.Options).String())
+}
@@ -0,0 +1,96 @@
+package v042
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// expeditedMinDepositMultiplier is the factor applied to the stored minimum
+// deposit to derive the expedited minimum deposit.
+const expeditedMinDepositMultiplier = 5
+
+// migrateDepositParams sets the expedited minimum deposit to a multiple of the
+// stored minimum deposit.
+func migrateDepositParams(ctx sdk.Context, paramSpace types.ParamSubspace) {
+	var depositParams types.DepositParams
+	paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+
+	if !depositParams.ExpeditedMinDeposit.Empty() {
+		return
+	}
+
+	expeditedMinDeposit := make(sdk.Coins, len(depositParams.MinDeposit))
+	for i, coin := range depositParams.MinDeposit {
+		expeditedMinDeposit[i] = sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(expeditedMinDepositMultiplier))
+	}
+
+	depositParams.ExpeditedMinDeposit = expeditedMinDeposit
+	paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+}
+
+// migrateVotingParams sets the expedited voting period to the default one,
+// capped at half of the stored voting period.
+func migrateVotingParams(ctx sdk.Context, paramSpace types.ParamSubspace) {
+	var votingParams types.VotingParams
+	paramSpace.Get(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+
+	if votingParams.ExpeditedVotingPeriod > 0 {
+		return
+	}
+
+	expeditedVotingPeriod := types.DefaultExpeditedPeriod
+	if half := votingParams.VotingPeriod / 2; half < expeditedVotingPeriod {
+		expeditedVotingPeriod = half
+	}
+	if expeditedVotingPeriod < time.Second {
+		expeditedVotingPeriod = time.Second
+	}
+
+	votingParams.ExpeditedVotingPeriod = expeditedVotingPeriod
+	paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+}
+
+// migrateTallyParams sets the expedited threshold to the default one, raised
+// above the stored threshold if needed. It fails if the stored threshold
+// leaves no room for a greater expedited threshold.
+func migrateTallyParams(ctx sdk.Context, paramSpace types.ParamSubspace) error {
+	var tallyParams types.TallyParams
+	paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+
+	if !tallyParams.ExpeditedThreshold.IsNil() && tallyParams.ExpeditedThreshold.IsPositive() {
+		return nil
+	}
+
+	if tallyParams.Threshold.GTE(sdk.OneDec()) {
+		return fmt.Errorf("vote threshold %s leaves no room for a greater expedited threshold", tallyParams.Threshold)
+	}
+
+	expeditedThreshold := types.DefaultExpeditedThreshold
+	if expeditedThreshold.LTE(tallyParams.Threshold) {
+		expeditedThreshold = sdk.OneDec()
+	}
+
+	tallyParams.ExpeditedThreshold = expeditedThreshold
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+
+	return nil
+}
+
+// MigrateParams performs in-place params migrations from v0.41 to v0.42. The
+// migration includes:
+//
+// - Set the expedited minimum deposit to 5 times the minimum deposit.
+// - Set the expedited voting period, at most half of the voting period.
+// - Set the expedited threshold, above the vote threshold.
+func MigrateParams(ctx sdk.Context, paramSpace types.ParamSubspace) error {
+	if err := migrateTallyParams(ctx, paramSpace); err != nil {
+		return err
+	}
+	migrateDepositParams(ctx, paramSpace)
+	migrateVotingParams(ctx, paramSpace)
+
+	return nil
+}
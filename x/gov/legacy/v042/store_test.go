@@ -0,0 +1,94 @@
+package v042_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v042gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v042"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestParamsMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, paramsTKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// Old params don't have any expedited value.
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &types.DepositParams{
+		MinDeposit: minDeposit, MaxDepositPeriod: types.DefaultPeriod,
+	})
+	paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, &types.VotingParams{
+		VotingPeriod: time.Hour,
+	})
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &types.TallyParams{
+		Quorum: types.DefaultQuorum, Threshold: sdk.NewDecWithPrec(7, 1), VetoThreshold: types.DefaultVetoThreshold,
+	})
+
+	// Run migration.
+	err := v042gov.MigrateParams(ctx, paramSpace)
+	require.NoError(t, err)
+
+	var depositParams types.DepositParams
+	paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)), depositParams.ExpeditedMinDeposit)
+
+	// The expedited voting period is capped at half of the voting period.
+	var votingParams types.VotingParams
+	paramSpace.Get(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+	require.Equal(t, 30*time.Minute, votingParams.ExpeditedVotingPeriod)
+
+	// The expedited threshold must stay above the threshold.
+	var tallyParams types.TallyParams
+	paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+	require.Equal(t, sdk.OneDec(), tallyParams.ExpeditedThreshold)
+
+	require.NoError(t, types.ValidateGenesis(types.NewGenesisState(types.DefaultStartingProposalID, depositParams, votingParams, tallyParams)))
+
+	// Running the migration again leaves the params untouched.
+	require.NoError(t, v042gov.MigrateParams(ctx, paramSpace))
+	var migratedVotingParams types.VotingParams
+	paramSpace.Get(ctx, types.ParamStoreKeyVotingParams, &migratedVotingParams)
+	require.Equal(t, votingParams, migratedVotingParams)
+}
+
+func TestParamsMigrationMaxThreshold(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, paramsTKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &types.DepositParams{
+		MinDeposit: minDeposit, MaxDepositPeriod: types.DefaultPeriod,
+	})
+	paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, &types.VotingParams{
+		VotingPeriod: time.Hour,
+	})
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &types.TallyParams{
+		Quorum: types.DefaultQuorum, Threshold: sdk.OneDec(), VetoThreshold: types.DefaultVetoThreshold,
+	})
+
+	// No expedited threshold can be greater than a threshold of 1.
+	require.Error(t, v042gov.MigrateParams(ctx, paramSpace))
+
+	// The params are left untouched.
+	var depositParams types.DepositParams
+	paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+	require.True(t, depositParams.ExpeditedMinDeposit.Empty())
+
+	var tallyParams types.TallyParams
+	paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+	require.True(t, tallyParams.ExpeditedThreshold.IsNil() || tallyParams.ExpeditedThreshold.IsZero())
+}
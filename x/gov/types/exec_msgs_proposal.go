@@ -0,0 +1,113 @@
+package types
+
+import (
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var (
+	_ Content                       = &ExecMsgsProposal{}
+	_ types.UnpackInterfacesMessage = &ExecMsgsProposal{}
+)
+
+// NewExecMsgsProposal creates an ExecMsgsProposal executing the given msgs,
+// which must all have the governance module account as their only signer.
+func NewExecMsgsProposal(title, description string, msgs []sdk.Msg) (*ExecMsgsProposal, error) {
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return &ExecMsgsProposal{Title: title, Description: description, Messages: anys}, nil
+}
+
+// GetTitle returns the proposal title
+func (p *ExecMsgsProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the proposal description
+func (p *ExecMsgsProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the proposal router key
+func (p *ExecMsgsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType is "ExecMsgs"
+func (p *ExecMsgsProposal) ProposalType() string { return ProposalTypeExecMsgs }
+
+// GetMsgs returns the unpacked messages of the proposal.
+func (p *ExecMsgsProposal) GetMsgs() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(p.Messages))
+	for i, any := range p.Messages {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalidProposalMsg, "message %d contains %T which is not a sdk.Msg", i, any.GetCachedValue())
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// ValidateBasic validates the title and description of the proposal, and its
+// messages, which must pass their own validation and be signed by the
+// governance module account only.
+func (p *ExecMsgsProposal) ValidateBasic() error {
+	if err := ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.Messages) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, "proposal has no messages")
+	}
+
+	msgs, err := p.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	govAddr := authtypes.NewModuleAddress(ModuleName)
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAddr) {
+			return sdkerrors.Wrapf(ErrInvalidProposalMsg, "message %d must have the governance module account %s as its only signer", i, govAddr)
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid message %d", i)
+		}
+	}
+
+	return nil
+}
+
+// String implements Stringer interface
+func (p ExecMsgsProposal) String() string {
+	msgs := make([]string, len(p.Messages))
+	for i, any := range p.Messages {
+		msgs[i] = any.TypeUrl
+	}
+
+	out, _ := yaml.Marshal(struct {
+		Title       string
+		Description string
+		Messages    []string
+	}{p.Title, p.Description, msgs})
+	return string(out)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p ExecMsgsProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range p.Messages {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -61,6 +61,29 @@ func ValidateGenesis(data *GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	expeditedThreshold := data.TallyParams.ExpeditedThreshold
+	if !expeditedThreshold.GT(threshold) || expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance expedited vote threshold should be greater than the vote threshold and less or equal to one, is %s",
+			expeditedThreshold.String())
+	}
+
+	for _, override := range data.TallyParams.ProposalOverrides {
+		if err := override.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if !data.DepositParams.ExpeditedMinDeposit.IsValid() || !data.DepositParams.ExpeditedMinDeposit.IsAllGT(data.DepositParams.MinDeposit) {
+		return fmt.Errorf("governance expedited deposit amount must be a valid sdk.Coins amount greater than the deposit amount, is %s",
+			data.DepositParams.ExpeditedMinDeposit.String())
+	}
+
+	expeditedVotingPeriod := data.VotingParams.ExpeditedVotingPeriod
+	if expeditedVotingPeriod <= 0 || expeditedVotingPeriod >= data.VotingParams.VotingPeriod {
+		return fmt.Errorf("governance expedited voting period should be positive and shorter than the voting period, is %s",
+			expeditedVotingPeriod.String())
+	}
+
 	return nil
 }
 
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	yaml "gopkg.in/yaml.v2"
@@ -12,15 +13,18 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.TokensFromConsensusPower(10)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens          = sdk.TokensFromConsensusPower(10)
+	DefaultExpeditedMinDepositTokens = DefaultMinDepositTokens.MulRaw(5)
+	DefaultQuorum                    = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                 = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
+	DefaultVetoThreshold             = sdk.NewDecWithPrec(334, 3)
 )
 
 // Parameter store key
@@ -40,10 +44,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration, expeditedMinDeposit sdk.Coins) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: maxDepositPeriod,
+		MinDeposit:          minDeposit,
+		MaxDepositPeriod:    maxDepositPeriod,
+		ExpeditedMinDeposit: expeditedMinDeposit,
 	}
 }
 
@@ -52,9 +57,19 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultExpeditedMinDepositTokens)),
 	)
 }
 
+// GetMinDeposit returns the minimum deposit for a proposal to enter its voting
+// period, depending on whether it is expedited.
+func (dp DepositParams) GetMinDeposit(expedited bool) sdk.Coins {
+	if expedited {
+		return dp.ExpeditedMinDeposit
+	}
+	return dp.MinDeposit
+}
+
 // String implements stringer insterface
 func (dp DepositParams) String() string {
 	out, _ := yaml.Marshal(dp)
@@ -63,7 +78,8 @@ func (dp DepositParams) String() string {
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.ExpeditedMinDeposit.IsEqual(dp2.ExpeditedMinDeposit)
 }
 
 func validateDepositParams(i interface{}) error {
@@ -78,27 +94,65 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if !v.ExpeditedMinDeposit.IsValid() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", v.ExpeditedMinDeposit)
+	}
+	if !v.ExpeditedMinDeposit.IsAllGT(v.MinDeposit) {
+		return fmt.Errorf("expedited minimum deposit %s must be greater than the minimum deposit %s", v.ExpeditedMinDeposit, v.MinDeposit)
+	}
 
 	return nil
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, vetoThreshold sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, vetoThreshold, expeditedThreshold sdk.Dec, overrides ...ProposalTallyParams) TallyParams {
 	return TallyParams{
-		Quorum:        quorum,
-		Threshold:     threshold,
-		VetoThreshold: vetoThreshold,
+		Quorum:             quorum,
+		Threshold:          threshold,
+		VetoThreshold:      vetoThreshold,
+		ExpeditedThreshold: expeditedThreshold,
+		ProposalOverrides:  overrides,
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, DefaultExpeditedThreshold)
+}
+
+// GetQuorumAndThreshold returns the quorum and the threshold of a proposal of
+// the given content type. The overrides for the proposal type replace the
+// quorum and threshold, and expedited proposals need at least the expedited
+// threshold.
+func (tp TallyParams) GetQuorumAndThreshold(proposalType string, expedited bool) (quorum, threshold sdk.Dec) {
+	quorum, threshold = tp.Quorum, tp.Threshold
+	for _, override := range tp.ProposalOverrides {
+		if override.ProposalType == proposalType {
+			quorum, threshold = override.Quorum, override.Threshold
+			break
+		}
+	}
+
+	if expedited && tp.ExpeditedThreshold.GT(threshold) {
+		threshold = tp.ExpeditedThreshold
+	}
+
+	return quorum, threshold
 }
 
 // Equal checks equality of TallyParams
 func (tp TallyParams) Equal(other TallyParams) bool {
-	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.VetoThreshold.Equal(other.VetoThreshold)
+	if len(tp.ProposalOverrides) != len(other.ProposalOverrides) {
+		return false
+	}
+	for i, override := range tp.ProposalOverrides {
+		if !override.Equal(other.ProposalOverrides[i]) {
+			return false
+		}
+	}
+
+	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.VetoThreshold.Equal(other.VetoThreshold) &&
+		tp.ExpeditedThreshold.Equal(other.ExpeditedThreshold)
 }
 
 // String implements stringer insterface
@@ -131,25 +185,87 @@ func validateTallyParams(i interface{}) error {
 	if v.VetoThreshold.GT(sdk.OneDec()) {
 		return fmt.Errorf("veto threshold too large: %s", v)
 	}
+	if !v.ExpeditedThreshold.GT(v.Threshold) {
+		return fmt.Errorf("expedited vote threshold %s must be greater than the vote threshold %s", v.ExpeditedThreshold, v.Threshold)
+	}
+	if v.ExpeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", v)
+	}
+
+	proposalTypes := make(map[string]bool, len(v.ProposalOverrides))
+	for _, override := range v.ProposalOverrides {
+		if err := override.Validate(); err != nil {
+			return err
+		}
+		if proposalTypes[override.ProposalType] {
+			return fmt.Errorf("duplicate tally params of proposal type %s", override.ProposalType)
+		}
+		proposalTypes[override.ProposalType] = true
+	}
+
+	return nil
+}
+
+// NewProposalTallyParams creates a new ProposalTallyParams object
+func NewProposalTallyParams(proposalType string, quorum, threshold sdk.Dec) ProposalTallyParams {
+	return ProposalTallyParams{
+		ProposalType: proposalType,
+		Quorum:       quorum,
+		Threshold:    threshold,
+	}
+}
+
+// Equal checks equality of ProposalTallyParams
+func (ptp ProposalTallyParams) Equal(other ProposalTallyParams) bool {
+	return ptp.ProposalType == other.ProposalType && ptp.Quorum.Equal(other.Quorum) && ptp.Threshold.Equal(other.Threshold)
+}
+
+// String implements stringer insterface
+func (ptp ProposalTallyParams) String() string {
+	out, _ := yaml.Marshal(ptp)
+	return string(out)
+}
+
+// Validate validates the quorum and the threshold of the proposal type.
+func (ptp ProposalTallyParams) Validate() error {
+	if strings.TrimSpace(ptp.ProposalType) == "" {
+		return fmt.Errorf("proposal type of tally params cannot be blank")
+	}
+	if ptp.Quorum.IsNil() || ptp.Quorum.IsNegative() || ptp.Quorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid quorum of proposal type %s: %s", ptp.ProposalType, ptp.Quorum)
+	}
+	if ptp.Threshold.IsNil() || !ptp.Threshold.IsPositive() || ptp.Threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid vote threshold of proposal type %s: %s", ptp.ProposalType, ptp.Threshold)
+	}
 
 	return nil
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration) VotingParams {
 	return VotingParams{
-		VotingPeriod: votingPeriod,
+		VotingPeriod:          votingPeriod,
+		ExpeditedVotingPeriod: expeditedVotingPeriod,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	return NewVotingParams(DefaultPeriod, DefaultExpeditedPeriod)
+}
+
+// GetVotingPeriod returns the voting period of a proposal, depending on
+// whether it is expedited.
+func (vp VotingParams) GetVotingPeriod(expedited bool) time.Duration {
+	if expedited {
+		return vp.ExpeditedVotingPeriod
+	}
+	return vp.VotingPeriod
 }
 
 // Equal checks equality of TallyParams
 func (vp VotingParams) Equal(other VotingParams) bool {
-	return vp.VotingPeriod == other.VotingPeriod
+	return vp.VotingPeriod == other.VotingPeriod && vp.ExpeditedVotingPeriod == other.ExpeditedVotingPeriod
 }
 
 // String implements stringer interface
@@ -167,6 +283,12 @@ func validateVotingParams(i interface{}) error {
 	if v.VotingPeriod <= 0 {
 		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
 	}
+	if v.ExpeditedVotingPeriod <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", v.ExpeditedVotingPeriod)
+	}
+	if v.ExpeditedVotingPeriod >= v.VotingPeriod {
+		return fmt.Errorf("expedited voting period %s must be shorter than the voting period %s", v.ExpeditedVotingPeriod, v.VotingPeriod)
+	}
 
 	return nil
 }
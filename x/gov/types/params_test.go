@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGetQuorumAndThreshold(t *testing.T) {
+	override := NewProposalTallyParams(ProposalTypeText, sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(8, 1))
+	tp := NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, DefaultExpeditedThreshold, override)
+
+	quorum, threshold := tp.GetQuorumAndThreshold("Other", false)
+	require.Equal(t, DefaultQuorum, quorum)
+	require.Equal(t, DefaultThreshold, threshold)
+
+	quorum, threshold = tp.GetQuorumAndThreshold("Other", true)
+	require.Equal(t, DefaultQuorum, quorum)
+	require.Equal(t, DefaultExpeditedThreshold, threshold)
+
+	quorum, threshold = tp.GetQuorumAndThreshold(ProposalTypeText, false)
+	require.Equal(t, override.Quorum, quorum)
+	require.Equal(t, override.Threshold, threshold)
+
+	// The override threshold is higher than the expedited threshold.
+	quorum, threshold = tp.GetQuorumAndThreshold(ProposalTypeText, true)
+	require.Equal(t, override.Quorum, quorum)
+	require.Equal(t, override.Threshold, threshold)
+}
+
+func TestValidateParams(t *testing.T) {
+	require.NoError(t, validateDepositParams(DefaultDepositParams()))
+	require.NoError(t, validateVotingParams(DefaultVotingParams()))
+	require.NoError(t, validateTallyParams(DefaultTallyParams()))
+
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	require.Error(t, validateDepositParams(NewDepositParams(minDeposit, DefaultPeriod, minDeposit)))
+
+	require.Error(t, validateVotingParams(NewVotingParams(time.Hour, 0)))
+	require.Error(t, validateVotingParams(NewVotingParams(time.Hour, time.Hour)))
+
+	require.Error(t, validateTallyParams(NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, DefaultThreshold)))
+	require.Error(t, validateTallyParams(NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, sdk.NewDec(2))))
+
+	override := NewProposalTallyParams(ProposalTypeText, DefaultQuorum, DefaultThreshold)
+	require.NoError(t, validateTallyParams(NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, DefaultExpeditedThreshold, override)))
+	require.Error(t, validateTallyParams(NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, DefaultExpeditedThreshold, override, override)))
+	require.Error(t, validateTallyParams(NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, DefaultExpeditedThreshold,
+		NewProposalTallyParams(ProposalTypeText, DefaultQuorum, sdk.ZeroDec()))))
+}
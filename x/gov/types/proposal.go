@@ -181,7 +181,8 @@ func (status ProposalStatus) Format(s fmt.State, verb rune) {
 
 // Proposal types
 const (
-	ProposalTypeText string = "Text"
+	ProposalTypeText     string = "Text"
+	ProposalTypeExecMsgs string = "ExecMsgs"
 )
 
 // Implements Content Interface
@@ -214,7 +215,8 @@ func (tp TextProposal) String() string {
 }
 
 var validProposalTypes = map[string]struct{}{
-	ProposalTypeText: {},
+	ProposalTypeText:     {},
+	ProposalTypeExecMsgs: {},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
@@ -5,6 +5,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func TestProposalStatus_Format(t *testing.T) {
@@ -22,3 +26,32 @@ func TestProposalStatus_Format(t *testing.T) {
 		require.Equal(t, tt.expectedStringOutput, got)
 	}
 }
+
+func TestExecMsgsProposal_ValidateBasic(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(ModuleName)
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+
+	tests := []struct {
+		title      string
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{"title", []sdk.Msg{testdata.NewTestMsg(govAddr)}, true},
+		{"title", []sdk.Msg{testdata.NewTestMsg(govAddr), testdata.NewTestMsg(govAddr)}, true},
+		{"", []sdk.Msg{testdata.NewTestMsg(govAddr)}, false},
+		{"title", []sdk.Msg{}, false},
+		{"title", []sdk.Msg{testdata.NewTestMsg(addr)}, false},
+		{"title", []sdk.Msg{testdata.NewTestMsg(govAddr, addr)}, false},
+		{"title", []sdk.Msg{testdata.NewTestMsg(govAddr), testdata.NewTestMsg(addr)}, false},
+	}
+
+	for i, tc := range tests {
+		content, err := NewExecMsgsProposal(tc.title, "description", tc.msgs)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.NoError(t, content.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, content.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
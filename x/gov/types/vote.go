@@ -2,16 +2,18 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewVote creates a new Vote instance
 //nolint:interfacer
-func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter.String(), option}
+func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	return Vote{ProposalId: proposalID, Voter: voter.String(), Options: options}
 }
 
 func (v Vote) String() string {
@@ -43,7 +45,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalId)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, WeightedVoteOptions(vot.Options))
 	}
 	return out
 }
@@ -53,6 +55,104 @@ func (v Vote) Empty() bool {
 	return v.String() == Vote{}.String()
 }
 
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{Option: option, Weight: weight}
+}
+
+func (w WeightedVoteOption) String() string {
+	out, _ := yaml.Marshal(w)
+	return string(out)
+}
+
+// WeightedVoteOptions describes array of WeightedVoteOptions
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewNonSplitVoteOption creates the weighted options of a vote casting all of the voting power
+// for a single option
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{{Option: option, Weight: sdk.OneDec()}}
+}
+
+func (v WeightedVoteOptions) String() (out string) {
+	for _, opt := range v {
+		out += opt.String() + "\n"
+	}
+
+	return strings.TrimSpace(out)
+}
+
+// ValidWeightedVoteOption returns true if the sub vote is valid and false otherwise.
+func ValidWeightedVoteOption(option WeightedVoteOption) bool {
+	if !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+		return false
+	}
+	return ValidVoteOption(option.Option)
+}
+
+// ValidateWeightedVoteOptions returns an error unless the options are valid, distinct and their
+// weights sum up to 1.
+func ValidateWeightedVoteOptions(options WeightedVoteOptions) error {
+	if len(options) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVote, "no vote options")
+	}
+
+	usedOptions := make(map[VoteOption]bool)
+	totalWeight := sdk.ZeroDec()
+	for _, option := range options {
+		if !ValidWeightedVoteOption(option) {
+			return sdkerrors.Wrap(ErrInvalidVote, option.String())
+		}
+		if usedOptions[option.Option] {
+			return sdkerrors.Wrapf(ErrInvalidVote, "duplicated vote option %s", option.Option)
+		}
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidVote, "total weight of vote options is %s, not 1", totalWeight)
+	}
+
+	return nil
+}
+
+// WeightedVoteOptionsFromString returns weighted vote options from a string of comma separated
+// option=weight pairs, like "VOTE_OPTION_YES=0.6,VOTE_OPTION_NO=0.4". A single option without a
+// weight is a vote of the full weight for it. It returns an error if the string is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	options := WeightedVoteOptions{}
+	str = strings.TrimSpace(str)
+	if !strings.Contains(str, ",") && !strings.Contains(str, "=") {
+		voteOption, err := VoteOptionFromString(str)
+		if err != nil {
+			return options, err
+		}
+		return NewNonSplitVoteOption(voteOption), nil
+	}
+
+	for _, option := range strings.Split(str, ",") {
+		fields := strings.Split(option, "=")
+		if len(fields) != 2 {
+			return options, fmt.Errorf("'%s' is not a valid weighted vote option, expected option=weight", option)
+		}
+
+		voteOption, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return options, err
+		}
+
+		weight, err := sdk.NewDecFromStr(strings.TrimSpace(fields[1]))
+		if err != nil {
+			return options, fmt.Errorf("invalid weight of vote option '%s': %w", fields[0], err)
+		}
+
+		options = append(options, NewWeightedVoteOption(voteOption, weight))
+	}
+
+	return options, nil
+}
+
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
 // if the string is invalid.
 func VoteOptionFromString(str string) (VoteOption, error) {
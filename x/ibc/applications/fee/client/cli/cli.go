@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// GetQueryCmd returns the query commands for the IBC fee middleware
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "ibc-fee",
+		Short:                      "IBC relayer incentivization query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdIncentivizedPackets(),
+		GetCmdIncentivizedPacketsForChannel(),
+		GetCmdIncentivizedPacket(),
+		GetCmdCounterpartyAddress(),
+		GetCmdFeeEnabledChannel(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the transaction commands for the IBC fee middleware
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "ibc-fee",
+		Short:                      "IBC relayer incentivization transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterCounterpartyAddressCmd(),
+		NewPayPacketFeeAsyncCmd(),
+	)
+
+	return txCmd
+}
@@ -0,0 +1,214 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/fee/types"
+)
+
+// GetCmdIncentivizedPackets defines the command to query the fees escrowed
+// for all the packets which are not acknowledged or timed out yet.
+func GetCmdIncentivizedPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "incentivized-packets",
+		Short:   "Query the incentivized packets",
+		Long:    "Query the fees escrowed for all the packets which are not acknowledged or timed out yet",
+		Example: fmt.Sprintf("%s query ibc-fee incentivized-packets", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryIncentivizedPacketsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.IncentivizedPackets(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "incentivized packets")
+
+	return cmd
+}
+
+// GetCmdIncentivizedPacketsForChannel defines the command to query the fees
+// escrowed for the packets of a channel which are not acknowledged or timed
+// out yet.
+func GetCmdIncentivizedPacketsForChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-incentivized-packets [port-id] [channel-id]",
+		Short:   "Query the incentivized packets of a channel",
+		Long:    "Query the fees escrowed for the packets of a channel which are not acknowledged or timed out yet",
+		Example: fmt.Sprintf("%s query ibc-fee channel-incentivized-packets [port-id] [channel-id]", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryIncentivizedPacketsForChannelRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.IncentivizedPacketsForChannel(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channel incentivized packets")
+
+	return cmd
+}
+
+// GetCmdIncentivizedPacket defines the command to query the fees escrowed for
+// a packet.
+func GetCmdIncentivizedPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "incentivized-packet [port-id] [channel-id] [sequence]",
+		Short:   "Query the fees escrowed for a packet",
+		Long:    "Query the fees escrowed for a packet by the port and channel it is sent from and its sequence",
+		Example: fmt.Sprintf("%s query ibc-fee incentivized-packet [port-id] [channel-id] [sequence]", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryIncentivizedPacketRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  sequence,
+			}
+
+			res, err := queryClient.IncentivizedPacket(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(&res.IncentivizedPacket)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCounterpartyAddress defines the command to query the counterparty
+// address a relayer registered on a channel.
+func GetCmdCounterpartyAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "counterparty-address [relayer-address] [port-id] [channel-id]",
+		Short:   "Query the counterparty address a relayer registered on a channel",
+		Long:    "Query the address on the counterparty chain of a channel which the recv fees of a relayer are paid to",
+		Example: fmt.Sprintf("%s query ibc-fee counterparty-address [relayer-address] [port-id] [channel-id]", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCounterpartyAddressRequest{
+				Address:   args[0],
+				PortId:    args[1],
+				ChannelId: args[2],
+			}
+
+			res, err := queryClient.CounterpartyAddress(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFeeEnabledChannel defines the command to query whether a channel is
+// fee enabled.
+func GetCmdFeeEnabledChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-enabled-channel [port-id] [channel-id]",
+		Short:   "Query whether a channel is fee enabled",
+		Long:    "Query whether a channel is fee enabled",
+		Example: fmt.Sprintf("%s query ibc-fee fee-enabled-channel [port-id] [channel-id]", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFeeEnabledChannelRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.FeeEnabledChannel(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/fee/types"
+)
+
+const (
+	flagRecvFee    = "recv-fee"
+	flagAckFee     = "ack-fee"
+	flagTimeoutFee = "timeout-fee"
+)
+
+// NewRegisterCounterpartyAddressCmd returns the command to create a
+// MsgRegisterCounterpartyAddress transaction
+func NewRegisterCounterpartyAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-counterparty-address [port-id] [channel-id] [counterparty-address]",
+		Short: "Register the address the recv fees of a relayer are paid to on the counterparty chain",
+		Long: strings.TrimSpace(`Register the address on the counterparty chain of a fee enabled channel which the
+recv fees of the packets relayed by the sender over the channel are paid to.`),
+		Example: fmt.Sprintf("%s tx ibc-fee register-counterparty-address transfer channel-0 cosmos1... --from=[relayer]", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterCounterpartyAddress(clientCtx.GetFromAddress(), args[2], args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewPayPacketFeeAsyncCmd returns the command to create a MsgPayPacketFeeAsync
+// transaction
+func NewPayPacketFeeAsyncCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pay-packet-fee [src-port] [src-channel] [sequence]",
+		Short: "Pay a fee to the relayers of a sent packet",
+		Long: strings.TrimSpace(`Escrow a fee for a packet which is sent but not acknowledged or timed out yet.
+The recv fee is paid to the relayer which delivers the packet, the ack fee to the relayer which delivers
+its acknowledgement and the timeout fee to the relayer which times it out. The unpaid part of the fee is
+refunded to the sender.`),
+		Example: fmt.Sprintf("%s tx ibc-fee pay-packet-fee transfer channel-0 1 --recv-fee 10stake --ack-fee 10stake --timeout-fee 10stake", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var fees [3]sdk.Coins
+			for i, flag := range []string{flagRecvFee, flagAckFee, flagTimeoutFee} {
+				feeStr, err := cmd.Flags().GetString(flag)
+				if err != nil {
+					return err
+				}
+
+				fees[i], err = sdk.ParseCoinsNormalized(feeStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			packetID := types.NewPacketId(args[0], args[1], sequence)
+			fee := types.NewFee(fees[0], fees[1], fees[2])
+
+			msg := types.NewMsgPayPacketFeeAsync(packetID, fee, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagRecvFee, "", "Fee paid to the relayer which delivers the packet")
+	cmd.Flags().String(flagAckFee, "", "Fee paid to the relayer which delivers the acknowledgement of the packet")
+	cmd.Flags().String(flagTimeoutFee, "", "Fee paid to the relayer which times out the packet")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
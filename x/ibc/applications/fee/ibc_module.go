@@ -0,0 +1,324 @@
+package fee
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/fee/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/fee/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	porttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/05-port/types"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule implements the ICS-26 callbacks of the fee middleware. It wraps the
+// callbacks of an application, such as transfer, and is routed to in its place
+// by the IBC router.
+//
+// A channel is fee enabled if its version is the fee metadata, which carries
+// the version of the application. The application only sees the version it
+// is wrapped with, and the channels which are not fee enabled are passed
+// through to it unchanged. The acknowledgements of the packets received on a
+// fee enabled channel are wrapped with the address the forward relayer is paid
+// to on the sending chain, and the fees escrowed for the packets sent on a fee
+// enabled channel are paid to the relayers once they are acknowledged or timed
+// out.
+type IBCModule struct {
+	keeper keeper.Keeper
+	app    porttypes.IBCModule
+}
+
+// NewIBCModule creates a new fee middleware IBCModule wrapping an application.
+func NewIBCModule(k keeper.Keeper, app porttypes.IBCModule) IBCModule {
+	return IBCModule{
+		keeper: k,
+		app:    app,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	metadata, err := types.MetadataFromVersion(version)
+	if err != nil {
+		return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+	}
+
+	if err := im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, metadata.AppVersion); err != nil {
+		return err
+	}
+
+	im.keeper.SetFeeEnabled(ctx, portID, channelID)
+	return nil
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version,
+	counterpartyVersion string,
+) error {
+	metadata, err := types.MetadataFromVersion(version)
+	if err != nil {
+		return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version, counterpartyVersion)
+	}
+
+	counterpartyMetadata, err := types.MetadataFromVersion(counterpartyVersion)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid counterparty version")
+	}
+
+	if err := im.app.OnChanOpenTry(
+		ctx, order, connectionHops, portID, channelID, chanCap, counterparty,
+		metadata.AppVersion, counterpartyMetadata.AppVersion,
+	); err != nil {
+		return err
+	}
+
+	im.keeper.SetFeeEnabled(ctx, portID, channelID)
+	return nil
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyVersion string,
+) error {
+	if !im.keeper.IsFeeEnabled(ctx, portID, channelID) {
+		return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyVersion)
+	}
+
+	counterpartyMetadata, err := types.MetadataFromVersion(counterpartyVersion)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid counterparty version")
+	}
+
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyMetadata.AppVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface. The fees escrowed for
+// the packets of the channel are refunded.
+func (im IBCModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	if err := im.app.OnChanCloseInit(ctx, portID, channelID); err != nil {
+		return err
+	}
+
+	im.keeper.RefundFeesOnChannel(ctx, portID, channelID)
+	return nil
+}
+
+// OnChanCloseConfirm implements the IBCModule interface. The fees escrowed
+// for the packets of the channel are refunded.
+func (im IBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	if err := im.app.OnChanCloseConfirm(ctx, portID, channelID); err != nil {
+		return err
+	}
+
+	im.keeper.RefundFeesOnChannel(ctx, portID, channelID)
+	return nil
+}
+
+// OnChanUpgradeInit implements the IBCModule interface. A channel is upgraded
+// to be fee enabled, or to no longer be, by proposing a version which is, or
+// is not, the fee metadata.
+func (im IBCModule) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) error {
+	metadata, err := types.MetadataFromVersion(version)
+	if err != nil {
+		return im.app.OnChanUpgradeInit(ctx, portID, channelID, order, connectionHops, version)
+	}
+
+	return im.app.OnChanUpgradeInit(ctx, portID, channelID, order, connectionHops, metadata.AppVersion)
+}
+
+// OnChanUpgradeTry implements the IBCModule interface
+func (im IBCModule) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version,
+	counterpartyVersion string,
+) error {
+	metadata, err := types.MetadataFromVersion(version)
+	if err != nil {
+		return im.app.OnChanUpgradeTry(ctx, portID, channelID, order, connectionHops, version, counterpartyVersion)
+	}
+
+	counterpartyMetadata, err := types.MetadataFromVersion(counterpartyVersion)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid counterparty version")
+	}
+
+	return im.app.OnChanUpgradeTry(ctx, portID, channelID, order, connectionHops, metadata.AppVersion, counterpartyMetadata.AppVersion)
+}
+
+// OnChanUpgradeAck implements the IBCModule interface. The channel is fee
+// enabled if the counterparty version it is upgraded to is the fee metadata.
+func (im IBCModule) OnChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyVersion string,
+) error {
+	appVersion := counterpartyVersion
+	metadata, err := types.MetadataFromVersion(counterpartyVersion)
+	feeEnabled := err == nil
+	if feeEnabled {
+		appVersion = metadata.AppVersion
+	}
+
+	if err := im.app.OnChanUpgradeAck(ctx, portID, channelID, appVersion); err != nil {
+		return err
+	}
+
+	im.setFeeEnabled(ctx, portID, channelID, feeEnabled)
+	return nil
+}
+
+// OnChanUpgradeConfirm implements the IBCModule interface. The channel is fee
+// enabled if the version it is upgraded to is the fee metadata.
+func (im IBCModule) OnChanUpgradeConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	if err := im.app.OnChanUpgradeConfirm(ctx, portID, channelID); err != nil {
+		return err
+	}
+
+	_, err := types.MetadataFromVersion(im.keeper.GetChannelVersion(ctx, portID, channelID))
+	im.setFeeEnabled(ctx, portID, channelID, err == nil)
+	return nil
+}
+
+// OnChanUpgradeRestore implements the IBCModule interface
+func (im IBCModule) OnChanUpgradeRestore(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanUpgradeRestore(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. The acknowledgement of the
+// application is wrapped with the counterparty address the relayer
+// registered on a fee enabled channel, which the recv fees are paid to.
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) (*sdk.Result, []byte, error) {
+	if !im.keeper.IsFeeEnabled(ctx, packet.GetDestPort(), packet.GetDestChannel()) {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	result, ack, err := im.app.OnRecvPacket(ctx, packet, relayer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if ack == nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrUnsupportedAsyncAcknowledgement, "port %s, channel %s", packet.GetDestPort(), packet.GetDestChannel())
+	}
+
+	forwardRelayer, _ := im.keeper.GetCounterpartyAddress(ctx, relayer.String(), packet.GetDestPort(), packet.GetDestChannel())
+
+	return result, types.NewIncentivizedAcknowledgement(ack, forwardRelayer).GetBytes(), nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The fees
+// escrowed for a packet of a fee enabled channel are paid before the
+// acknowledgement of the application is passed to it.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) (*sdk.Result, error) {
+	if !im.keeper.IsFeeEnabled(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) {
+		return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	}
+
+	var ack types.IncentivizedAcknowledgement
+	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAcknowledgement, "cannot unmarshal ICS-29 incentivized packet acknowledgement: %v", err)
+	}
+
+	packetID := types.NewPacketId(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	im.keeper.DistributePacketFeesOnAcknowledgement(ctx, ack.ForwardRelayerAddress, relayer, packetID)
+
+	return im.app.OnAcknowledgementPacket(ctx, packet, ack.AppAcknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The fees escrowed for a
+// packet of a fee enabled channel are paid before the timeout is passed to
+// the application.
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) (*sdk.Result, error) {
+	if im.keeper.IsFeeEnabled(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) {
+		packetID := types.NewPacketId(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		im.keeper.DistributePacketFeesOnTimeout(ctx, relayer, packetID)
+	}
+
+	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+}
+
+// setFeeEnabled sets whether an upgraded channel is fee enabled. The fees
+// escrowed for the packets of a channel which is no longer fee enabled are
+// refunded.
+func (im IBCModule) setFeeEnabled(ctx sdk.Context, portID, channelID string, feeEnabled bool) {
+	if feeEnabled {
+		im.keeper.SetFeeEnabled(ctx, portID, channelID)
+		return
+	}
+
+	if im.keeper.IsFeeEnabled(ctx, portID, channelID) {
+		im.keeper.RefundFeesOnChannel(ctx, portID, channelID)
+		im.keeper.DeleteFeeEnabled(ctx, portID, channelID)
+	}
+}
@@ -0,0 +1,238 @@
+package fee_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/fee/types"
+	transfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+var (
+	coin = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	fee  = types.NewFee(
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(20))),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(30))),
+	)
+	timeoutHeight  = clienttypes.NewHeight(0, 110)
+	feeVersion     = types.NewMetadata(transfertypes.Version).Version()
+	upgradeTimeout = clienttypes.NewHeight(0, 1000)
+)
+
+type FeeTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func (suite *FeeTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+}
+
+// createFeeChannel opens a fee enabled transfer channel between chainA and
+// chainB on a connection.
+func (suite *FeeTestSuite) createFeeChannel(connA, connB *ibctesting.TestConnection) (ibctesting.TestChannel, ibctesting.TestChannel) {
+	connA.NextChannelVersion = feeVersion
+	connB.NextChannelVersion = feeVersion
+
+	channelA, channelB := suite.coordinator.CreateChannel(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED)
+
+	connA.NextChannelVersion = ibctesting.DefaultChannelVersion
+	connB.NextChannelVersion = ibctesting.DefaultChannelVersion
+
+	return channelA, channelB
+}
+
+// transferPacket returns the packet of a transfer of coin from the sender of
+// chainA to the sender of chainB.
+func (suite *FeeTestSuite) transferPacket(channelA, channelB ibctesting.TestChannel, sequence uint64, timeoutHeight clienttypes.Height) channeltypes.Packet {
+	packetData := transfertypes.NewFungibleTokenPacketData(coin.Denom, coin.Amount.Uint64(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+	return channeltypes.NewPacket(packetData.GetBytes(), sequence, channelA.PortID, channelA.ID, channelB.PortID, channelB.ID, timeoutHeight, 0)
+}
+
+// test paying the relayers of a transfer on a fee enabled channel, from
+// escrowing the fee on chainA to distributing it once the transfer is
+// acknowledged
+func (suite *FeeTestSuite) TestFeeTransfer() {
+	clientA, clientB, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	channelA, channelB := suite.createFeeChannel(connA, connB)
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	originalBalance := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	// the relayer of chainB registers the address its recv fees are paid to
+	// on chainA
+	forwardAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msgRegister := types.NewMsgRegisterCounterpartyAddress(suite.chainB.SenderAccount.GetAddress(), forwardAddr.String(), channelB.PortID, channelB.ID)
+	err := suite.coordinator.SendMsg(suite.chainB, suite.chainA, clientA, msgRegister)
+	suite.Require().NoError(err) // message committed
+
+	// the fee is paid in the tx of the transfer
+	msgs := []sdk.Msg{
+		types.NewMsgPayPacketFee(fee, channelA.PortID, channelA.ID, sender),
+		transfertypes.NewMsgTransfer(channelA.PortID, channelA.ID, coin, sender, suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, ""),
+	}
+	err = suite.coordinator.SendMsgs(suite.chainA, suite.chainB, clientB, msgs)
+	suite.Require().NoError(err) // messages committed
+
+	packetID := types.NewPacketId(channelA.PortID, channelA.ID, 1)
+	identifiedFees, found := suite.chainA.App.IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+	suite.Require().True(found)
+	suite.Require().Equal([]types.PacketFee{types.NewPacketFee(fee, sender)}, identifiedFees.PacketFees)
+
+	// the acknowledgement of transfer is wrapped with the forward relayer
+	// address
+	packet := suite.transferPacket(channelA, channelB, 1, timeoutHeight)
+	ack := types.NewIncentivizedAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).GetBytes(), forwardAddr.String())
+	err = suite.coordinator.RelayPacket(suite.chainA, suite.chainB, clientA, clientB, packet, ack.GetBytes())
+	suite.Require().NoError(err) // relay committed
+
+	// the recv fee is paid to the forward relayer, and the sender of chainA,
+	// which relayed the acknowledgement, is paid the ack fee and refunded the
+	// timeout fee
+	suite.Require().Equal(sdk.NewCoins(fee.RecvFee...), suite.chainA.App.BankKeeper.GetAllBalances(suite.chainA.GetContext(), forwardAddr))
+	expBalance := originalBalance.Sub(coin).Sub(fee.RecvFee[0])
+	suite.Require().Equal(expBalance, suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+
+	_, found = suite.chainA.App.IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+	suite.Require().False(found)
+
+	// the transfer is executed on chainB
+	voucherDenomTrace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(channelB.PortID, channelB.ID, sdk.DefaultBondDenom))
+	balance := suite.chainB.App.BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenomTrace.IBCDenom())
+	suite.Require().Equal(coin.Amount, balance.Amount)
+}
+
+// test refunding the recv and ack fees of a transfer on a fee enabled channel
+// once it timed out
+func (suite *FeeTestSuite) TestFeeTransferTimeout() {
+	_, clientB, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	channelA, channelB := suite.createFeeChannel(connA, connB)
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	originalBalance := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	msgs := []sdk.Msg{
+		types.NewMsgPayPacketFee(fee, channelA.PortID, channelA.ID, sender),
+		transfertypes.NewMsgTransfer(channelA.PortID, channelA.ID, coin, sender, suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, ""),
+	}
+	err := suite.coordinator.SendMsgs(suite.chainA, suite.chainB, clientB, msgs)
+	suite.Require().NoError(err) // messages committed
+
+	expBalance := originalBalance.Sub(coin).Sub(sdk.NewCoin(sdk.DefaultBondDenom, fee.Total().AmountOf(sdk.DefaultBondDenom)))
+	suite.Require().Equal(expBalance, suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+
+	// prove the packet was not received on chainB once it timed out
+	err = suite.coordinator.UpdateClient(suite.chainA, suite.chainB, channelA.ClientID, exported.Tendermint)
+	suite.Require().NoError(err)
+
+	packet := suite.transferPacket(channelA, channelB, 1, timeoutHeight)
+	proof, proofHeight := suite.chainB.QueryProof(host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	_, err = suite.chainA.SendMsgs(channeltypes.NewMsgTimeout(packet, 1, proof, proofHeight, sender))
+	suite.Require().NoError(err)
+
+	// the sender of chainA is refunded the transfer and the recv and ack
+	// fees, and is paid the timeout fee as the relayer of the timeout
+	suite.Require().Equal(originalBalance, suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+
+	_, found := suite.chainA.App.IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), types.NewPacketId(channelA.PortID, channelA.ID, 1))
+	suite.Require().False(found)
+}
+
+// test relaying a transfer on a fee enabled channel without fees and without
+// a registered forward relayer address
+func (suite *FeeTestSuite) TestTransferWithoutFee() {
+	clientA, clientB, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	channelA, channelB := suite.createFeeChannel(connA, connB)
+
+	msg := transfertypes.NewMsgTransfer(channelA.PortID, channelA.ID, coin, suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	err := suite.coordinator.SendMsg(suite.chainA, suite.chainB, clientB, msg)
+	suite.Require().NoError(err) // message committed
+
+	// the acknowledgement is wrapped with an empty forward relayer address
+	packet := suite.transferPacket(channelA, channelB, 1, timeoutHeight)
+	ack := types.NewIncentivizedAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).GetBytes(), "")
+	err = suite.coordinator.RelayPacket(suite.chainA, suite.chainB, clientA, clientB, packet, ack.GetBytes())
+	suite.Require().NoError(err) // relay committed
+}
+
+// test the acknowledgements of a channel which is not fee enabled are not
+// wrapped, and fees can't be paid for its packets
+func (suite *FeeTestSuite) TestTransferNotFeeEnabled() {
+	clientA, clientB, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	channelA, channelB := suite.coordinator.CreateTransferChannels(suite.chainA, suite.chainB, connA, connB, channeltypes.UNORDERED)
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	_, err := suite.chainA.App.IBCFeeKeeper.PayPacketFee(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgPayPacketFee(fee, channelA.PortID, channelA.ID, sender))
+	suite.Require().Error(err)
+
+	msg := transfertypes.NewMsgTransfer(channelA.PortID, channelA.ID, coin, sender, suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	err = suite.coordinator.SendMsg(suite.chainA, suite.chainB, clientB, msg)
+	suite.Require().NoError(err) // message committed
+
+	packet := suite.transferPacket(channelA, channelB, 1, timeoutHeight)
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	err = suite.coordinator.RelayPacket(suite.chainA, suite.chainB, clientA, clientB, packet, ack.GetBytes())
+	suite.Require().NoError(err) // relay committed
+}
+
+// test a transfer channel is fee enabled by upgrading its version to the fee
+// metadata, and no longer is once it is upgraded back
+func (suite *FeeTestSuite) TestUpgradeFeeEnabled() {
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	channelA, channelB := suite.coordinator.CreateTransferChannels(suite.chainA, suite.chainB, connA, connB, channeltypes.UNORDERED)
+
+	upgrade := func(version string) {
+		err := suite.coordinator.ChanUpgradeInit(suite.chainA, suite.chainB, channelA, channeltypes.UNORDERED, connA.ID, version, upgradeTimeout, 0)
+		suite.Require().NoError(err)
+		err = suite.coordinator.ChanUpgradeTry(suite.chainB, suite.chainA, channelB, channelA, channeltypes.UNORDERED, connB.ID, version)
+		suite.Require().NoError(err)
+		err = suite.coordinator.ChanUpgradeAck(suite.chainA, suite.chainB, channelA, channelB)
+		suite.Require().NoError(err)
+		err = suite.coordinator.ChanUpgradeConfirm(suite.chainB, suite.chainA, channelB, channelA)
+		suite.Require().NoError(err)
+	}
+
+	upgrade(feeVersion)
+
+	suite.Require().Equal(feeVersion, suite.chainA.GetChannel(channelA).Version)
+	suite.Require().True(suite.chainA.App.IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), channelA.PortID, channelA.ID))
+	suite.Require().True(suite.chainB.App.IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), channelB.PortID, channelB.ID))
+
+	// a fee escrowed for the next packet of the channel, which can't be in
+	// flight during an upgrade, is refunded once the channel is no longer fee
+	// enabled
+	sender := suite.chainA.SenderAccount.GetAddress()
+	originalBalance := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+	err := suite.coordinator.SendMsg(suite.chainA, suite.chainB, channelB.ClientID, types.NewMsgPayPacketFee(fee, channelA.PortID, channelA.ID, sender))
+	suite.Require().NoError(err) // message committed
+	suite.Require().Len(suite.chainA.App.IBCFeeKeeper.GetAllIdentifiedPacketFees(suite.chainA.GetContext()), 1)
+
+	upgrade(transfertypes.Version)
+
+	suite.Require().Equal(transfertypes.Version, suite.chainA.GetChannel(channelA).Version)
+	suite.Require().False(suite.chainA.App.IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), channelA.PortID, channelA.ID))
+	suite.Require().False(suite.chainB.App.IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), channelB.PortID, channelB.ID))
+	suite.Require().Empty(suite.chainA.App.IBCFeeKeeper.GetAllIdentifiedPacketFees(suite.chainA.GetContext()))
+	suite.Require().Equal(originalBalance, suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+}
+
+func TestFeeTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeTestSuite))
+}
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/fee/types"
+)
+
+// EscrowPacketFee escrows a fee for a packet of a fee enabled channel in the
+// fee module account. The fees a packet is paid by several payers are kept
+// apart, so that each payer is refunded the unpaid part of its own fee.
+func (k Keeper) EscrowPacketFee(ctx sdk.Context, packetID types.PacketId, packetFee types.PacketFee) error {
+	if !k.IsFeeEnabled(ctx, packetID.PortId, packetID.ChannelId) {
+		return sdkerrors.Wrapf(types.ErrFeeNotEnabled, "port %s, channel %s", packetID.PortId, packetID.ChannelId)
+	}
+
+	refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, packetFee.Fee.Total()); err != nil {
+		return err
+	}
+
+	identifiedFees, found := k.GetFeesInEscrow(ctx, packetID)
+	if !found {
+		identifiedFees = types.NewIdentifiedPacketFees(packetID, []types.PacketFee{})
+	}
+	identifiedFees.PacketFees = append(identifiedFees.PacketFees, packetFee)
+	k.SetFeesInEscrow(ctx, identifiedFees)
+
+	recvFee, ackFee, timeoutFee := sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins()
+	for _, packetFee := range identifiedFees.PacketFees {
+		recvFee = recvFee.Add(packetFee.Fee.RecvFee...)
+		ackFee = ackFee.Add(packetFee.Fee.AckFee...)
+		timeoutFee = timeoutFee.Add(packetFee.Fee.TimeoutFee...)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIncentivizedPacket,
+			sdk.NewAttribute(types.AttributeKeyPortID, packetID.PortId),
+			sdk.NewAttribute(types.AttributeKeyChannelID, packetID.ChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packetID.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyRecvFee, recvFee.String()),
+			sdk.NewAttribute(types.AttributeKeyAckFee, ackFee.String()),
+			sdk.NewAttribute(types.AttributeKeyTimeoutFee, timeoutFee.String()),
+		),
+	)
+
+	return nil
+}
+
+// DistributePacketFeesOnAcknowledgement pays the fees escrowed for an
+// acknowledged packet. The recv fees are paid to the forward relayer, whose
+// address on this chain is carried by the acknowledgement, and the ack fees
+// to the reverse relayer, which relayed the acknowledgement. The timeout fees
+// are refunded, and so are the recv fees if the forward relayer did not
+// register an address on this chain.
+func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRelayer string, reverseRelayer sdk.AccAddress, packetID types.PacketId) {
+	identifiedFees, found := k.GetFeesInEscrow(ctx, packetID)
+	if !found {
+		return
+	}
+
+	forwardAddr, forwardAddrErr := sdk.AccAddressFromBech32(forwardRelayer)
+
+	for _, packetFee := range identifiedFees.PacketFees {
+		refundAddr, _ := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+
+		recvFeeReceiver := forwardAddr
+		if forwardAddrErr != nil {
+			recvFeeReceiver = refundAddr
+		}
+
+		k.distributeFee(ctx, recvFeeReceiver, refundAddr, packetFee.Fee.RecvFee)
+		k.distributeFee(ctx, reverseRelayer, refundAddr, packetFee.Fee.AckFee)
+		k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.TimeoutFee)
+	}
+
+	k.DeleteFeesInEscrow(ctx, packetID)
+}
+
+// DistributePacketFeesOnTimeout pays the timeout fees escrowed for a timed out
+// packet to the relayer which timed it out, and refunds its recv and ack
+// fees.
+func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sdk.AccAddress, packetID types.PacketId) {
+	identifiedFees, found := k.GetFeesInEscrow(ctx, packetID)
+	if !found {
+		return
+	}
+
+	for _, packetFee := range identifiedFees.PacketFees {
+		refundAddr, _ := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+
+		k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.RecvFee.Add(packetFee.Fee.AckFee...))
+		k.distributeFee(ctx, timeoutRelayer, refundAddr, packetFee.Fee.TimeoutFee)
+	}
+
+	k.DeleteFeesInEscrow(ctx, packetID)
+}
+
+// RefundFeesOnChannel refunds all the fees escrowed for the packets of a
+// channel, which is closed or no longer fee enabled.
+func (k Keeper) RefundFeesOnChannel(ctx sdk.Context, portID, channelID string) {
+	for _, identifiedFees := range k.GetIdentifiedPacketFeesForChannel(ctx, portID, channelID) {
+		for _, packetFee := range identifiedFees.PacketFees {
+			refundAddr, _ := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+			k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.Total())
+		}
+
+		k.DeleteFeesInEscrow(ctx, identifiedFees.PacketId)
+	}
+}
+
+// distributeFee sends a fee from the fee module account to its receiver. The
+// fee is refunded if it can't be sent to the receiver, and stays in the fee
+// module account if it can't be refunded either, so that paying relayers
+// never fails the acknowledgement or timeout of a packet.
+func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAddr sdk.AccAddress, fee sdk.Coins) {
+	if fee.IsZero() {
+		return
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, receiver, fee)
+	if err != nil && !receiver.Equals(refundAddr) {
+		k.Logger(ctx).Error("failed to pay fee, refunding it", "receiver", receiver.String(), "fee", fee.String(), "error", err.Error())
+
+		receiver = refundAddr
+		cacheCtx, writeFn = ctx.CacheContext()
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, receiver, fee)
+	}
+	if err != nil {
+		k.Logger(ctx).Error("failed to refund fee", "receiver", receiver.String(), "fee", fee.String(), "error", err.Error())
+		return
+	}
+
+	writeFn()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributeFee,
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+	)
+}
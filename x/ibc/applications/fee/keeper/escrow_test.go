@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/fee/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+const testChannel = "channel-0"
+
+func (suite *KeeperTestSuite) TestEscrowPacketFee() {
+	var (
+		packetID  types.PacketId
+		packetFee types.PacketFee
+		payer     sdk.AccAddress
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"success with recv fee only", func() {
+			packetFee = types.NewPacketFee(types.NewFee(coins, nil, nil), payer)
+		}, true},
+		{"success with a fee already escrowed", func() {
+			suite.Require().NoError(suite.chainA.App.IBCFeeKeeper.EscrowPacketFee(suite.chainA.GetContext(), packetID, packetFee))
+		}, true},
+		{"fee not enabled", func() {
+			suite.chainA.App.IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), ibctesting.TransferPort, testChannel)
+		}, false},
+		{"insufficient funds", func() {
+			packetFee = types.NewPacketFee(types.NewFee(suite.chainA.App.BankKeeper.GetAllBalances(suite.chainA.GetContext(), payer).Add(coins...), nil, nil), payer)
+		}, false},
+		{"invalid refund address", func() {
+			packetFee.RefundAddress = "refund"
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			ctx := suite.chainA.GetContext()
+			suite.chainA.App.IBCFeeKeeper.SetFeeEnabled(ctx, ibctesting.TransferPort, testChannel)
+
+			payer = suite.fundAccount(fee.Total().Add(fee.Total()...))
+			packetID = types.NewPacketId(ibctesting.TransferPort, testChannel, 1)
+			packetFee = types.NewPacketFee(fee, payer)
+
+			tc.malleate()
+
+			escrowed, _ := suite.chainA.App.IBCFeeKeeper.GetFeesInEscrow(ctx, packetID)
+			payerBalance := suite.chainA.App.BankKeeper.GetAllBalances(ctx, payer)
+
+			err := suite.chainA.App.IBCFeeKeeper.EscrowPacketFee(ctx, packetID, packetFee)
+
+			identifiedFees, found := suite.chainA.App.IBCFeeKeeper.GetFeesInEscrow(ctx, packetID)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(append(escrowed.PacketFees, packetFee), identifiedFees.PacketFees)
+				suite.Require().Equal(payerBalance.Sub(packetFee.Fee.Total()), suite.chainA.App.BankKeeper.GetAllBalances(ctx, payer))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(found)
+				suite.Require().Equal(payerBalance, suite.chainA.App.BankKeeper.GetAllBalances(ctx, payer))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributePacketFeesOnAcknowledgement() {
+	var (
+		forwardRelayer string
+		expRecvFeePaid bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{"success", func() {}},
+		{"empty forward relayer address", func() {
+			forwardRelayer = ""
+			expRecvFeePaid = false
+		}},
+		{"invalid forward relayer address", func() {
+			forwardRelayer = "relayer"
+			expRecvFeePaid = false
+		}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			ctx := suite.chainA.GetContext()
+			suite.chainA.App.IBCFeeKeeper.SetFeeEnabled(ctx, ibctesting.TransferPort, testChannel)
+
+			// two payers pay a fee for the packet
+			payers := []sdk.AccAddress{suite.fundAccount(fee.Total()), suite.fundAccount(fee.Total())}
+			packetID := types.NewPacketId(ibctesting.TransferPort, testChannel, 1)
+			for _, payer := range payers {
+				suite.Require().NoError(suite.chainA.App.IBCFeeKeeper.EscrowPacketFee(ctx, packetID, types.NewPacketFee(fee, payer)))
+			}
+
+			forwardAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			reverseAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			forwardRelayer = forwardAddr.String()
+			expRecvFeePaid = true
+
+			tc.malleate()
+
+			suite.chainA.App.IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(ctx, forwardRelayer, reverseAddr, packetID)
+
+			expPayerBalance := fee.TimeoutFee
+			expForwardBalance := fee.RecvFee.Add(fee.RecvFee...)
+			if !expRecvFeePaid {
+				expPayerBalance = expPayerBalance.Add(fee.RecvFee...)
+				expForwardBalance = sdk.NewCoins()
+			}
+
+			for _, payer := range payers {
+				suite.Require().Equal(expPayerBalance, suite.chainA.App.BankKeeper.GetAllBalances(ctx, payer))
+			}
+			suite.Require().Equal(expForwardBalance, suite.chainA.App.BankKeeper.GetAllBalances(ctx, forwardAddr))
+			suite.Require().Equal(fee.AckFee.Add(fee.AckFee...), suite.chainA.App.BankKeeper.GetAllBalances(ctx, reverseAddr))
+
+			// the fees are no longer escrowed
+			_, found := suite.chainA.App.IBCFeeKeeper.GetFeesInEscrow(ctx, packetID)
+			suite.Require().False(found)
+			moduleAddr := suite.chainA.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+			suite.Require().True(suite.chainA.App.BankKeeper.GetAllBalances(ctx, moduleAddr).IsZero())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
+	ctx := suite.chainA.GetContext()
+	suite.chainA.App.IBCFeeKeeper.SetFeeEnabled(ctx, ibctesting.TransferPort, testChannel)
+
+	payer := suite.fundAccount(fee.Total())
+	packetID := types.NewPacketId(ibctesting.TransferPort, testChannel, 1)
+	suite.Require().NoError(suite.chainA.App.IBCFeeKeeper.EscrowPacketFee(ctx, packetID, types.NewPacketFee(fee, payer)))
+
+	timeoutRelayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	suite.chainA.App.IBCFeeKeeper.DistributePacketFeesOnTimeout(ctx, timeoutRelayer, packetID)
+
+	suite.Require().Equal(fee.RecvFee.Add(fee.AckFee...), suite.chainA.App.BankKeeper.GetAllBalances(ctx, payer))
+	suite.Require().Equal(fee.TimeoutFee, suite.chainA.App.BankKeeper.GetAllBalances(ctx, timeoutRelayer))
+
+	_, found := suite.chainA.App.IBCFeeKeeper.GetFeesInEscrow(ctx, packetID)
+	suite.Require().False(found)
+
+	// distributing the fees of a packet without escrowed fees is a no-op
+	suite.Require().NotPanics(func() {
+		suite.chainA.App.IBCFeeKeeper.DistributePacketFeesOnTimeout(ctx, timeoutRelayer, packetID)
+	})
+	suite.Require().Equal(fee.TimeoutFee, suite.chainA.App.BankKeeper.GetAllBalances(ctx, timeoutRelayer))
+}
+
+func (suite *KeeperTestSuite) TestRefundFeesOnChannel() {
+	ctx := suite.chainA.GetContext()
+	suite.chainA.App.IBCFeeKeeper.SetFeeEnabled(ctx, ibctesting.TransferPort, testChannel)
+	suite.chainA.App.IBCFeeKeeper.SetFeeEnabled(ctx, ibctesting.TransferPort, "channel-1")
+
+	payer := suite.fundAccount(fee.Total().Add(fee.Total()...).Add(fee.Total()...))
+	for _, packetID := range []types.PacketId{
+		types.NewPacketId(ibctesting.TransferPort, testChannel, 1),
+		types.NewPacketId(ibctesting.TransferPort, testChannel, 2),
+		types.NewPacketId(ibctesting.TransferPort, "channel-1", 1),
+	} {
+		suite.Require().NoError(suite.chainA.App.IBCFeeKeeper.EscrowPacketFee(ctx, packetID, types.NewPacketFee(fee, payer)))
+	}
+
+	suite.chainA.App.IBCFeeKeeper.RefundFeesOnChannel(ctx, ibctesting.TransferPort, testChannel)
+
+	// only the fees escrowed for the packets of the channel are refunded
+	suite.Require().Equal(fee.Total().Add(fee.Total()...), suite.chainA.App.BankKeeper.GetAllBalances(ctx, payer))
+	suite.Require().Empty(suite.chainA.App.IBCFeeKeeper.GetIdentifiedPacketFeesForChannel(ctx, ibctesting.TransferPort, testChannel))
+	suite.Require().Len(suite.chainA.App.IBCFeeKeeper.GetIdentifiedPacketFeesForChannel(ctx, ibctesting.TransferPort, "channel-1"), 1)
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/fee/types"
+)
+
+// InitGenesis initializes the ibc fee middleware state.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	for _, identifiedFees := range state.IdentifiedFees {
+		k.SetFeesInEscrow(ctx, identifiedFees)
+	}
+
+	for _, channel := range state.FeeEnabledChannels {
+		k.SetFeeEnabled(ctx, channel.PortId, channel.ChannelId)
+	}
+
+	for _, registered := range state.CounterpartyAddresses {
+		k.SetCounterpartyAddress(ctx, registered.Address, registered.CounterpartyAddress, registered.PortId, registered.ChannelId)
+	}
+}
+
+// ExportGenesis exports ibc fee middleware's escrowed fees, fee enabled
+// channels and registered counterparty addresses into its genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(
+		k.GetAllIdentifiedPacketFees(ctx),
+		k.GetAllFeeEnabledChannels(ctx),
+		k.GetAllCounterpartyAddresses(ctx),
+	)
+}
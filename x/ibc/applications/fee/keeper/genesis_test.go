@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/fee/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	channelA, _ := suite.createFeeChannel(connA, connB)
+
+	relayer := suite.chainA.SenderAccount.GetAddress()
+	counterpartyAddress := suite.chainB.SenderAccount.GetAddress().String()
+	_, err := suite.chainA.SendMsgs(
+		types.NewMsgRegisterCounterpartyAddress(relayer, counterpartyAddress, channelA.PortID, channelA.ID),
+		types.NewMsgPayPacketFee(fee, channelA.PortID, channelA.ID, relayer),
+	)
+	suite.Require().NoError(err)
+
+	genesis := suite.chainA.App.IBCFeeKeeper.ExportGenesis(suite.chainA.GetContext())
+
+	packetID := types.NewPacketId(channelA.PortID, channelA.ID, 1)
+	suite.Require().Equal([]types.IdentifiedPacketFees{types.NewIdentifiedPacketFees(packetID, []types.PacketFee{types.NewPacketFee(fee, relayer)})}, genesis.IdentifiedFees)
+	suite.Require().Equal([]types.FeeEnabledChannel{{PortId: channelA.PortID, ChannelId: channelA.ID}}, genesis.FeeEnabledChannels)
+	suite.Require().Equal([]types.RegisteredCounterpartyAddress{{Address: relayer.String(), CounterpartyAddress: counterpartyAddress, PortId: channelA.PortID, ChannelId: channelA.ID}}, genesis.CounterpartyAddresses)
+	suite.Require().NoError(genesis.Validate())
+
+	// the exported state is imported on a fresh chain
+	suite.SetupTest()
+	suite.Require().NotPanics(func() {
+		suite.chainA.App.IBCFeeKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
+	})
+	suite.Require().Equal(genesis, suite.chainA.App.IBCFeeKeeper.ExportGenesis(suite.chainA.GetContext()))
+}
@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/fee/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// IncentivizedPackets implements the Query/IncentivizedPackets gRPC method
+func (q Keeper) IncentivizedPackets(c context.Context, req *types.QueryIncentivizedPacketsRequest) (*types.QueryIncentivizedPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	packets, pageRes, err := q.paginateIdentifiedPacketFees(ctx, types.FeesInEscrowKeyPrefix, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryIncentivizedPacketsResponse{
+		IncentivizedPackets: packets,
+		Pagination:          pageRes,
+	}, nil
+}
+
+// IncentivizedPacketsForChannel implements the
+// Query/IncentivizedPacketsForChannel gRPC method
+func (q Keeper) IncentivizedPacketsForChannel(c context.Context, req *types.QueryIncentivizedPacketsForChannelRequest) (*types.QueryIncentivizedPacketsForChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateGRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	packets, pageRes, err := q.paginateIdentifiedPacketFees(ctx, types.FeesInEscrowChannelPrefix(req.PortId, req.ChannelId), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryIncentivizedPacketsForChannelResponse{
+		IncentivizedPackets: packets,
+		Pagination:          pageRes,
+	}, nil
+}
+
+// IncentivizedPacket implements the Query/IncentivizedPacket gRPC method
+func (q Keeper) IncentivizedPacket(c context.Context, req *types.QueryIncentivizedPacketRequest) (*types.QueryIncentivizedPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateGRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	identifiedFees, found := q.GetFeesInEscrow(ctx, types.NewPacketId(req.PortId, req.ChannelId, req.Sequence))
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrFeeNotFound, "packet %d of port %s, channel %s", req.Sequence, req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	return &types.QueryIncentivizedPacketResponse{
+		IncentivizedPacket: identifiedFees,
+	}, nil
+}
+
+// CounterpartyAddress implements the Query/CounterpartyAddress gRPC method
+func (q Keeper) CounterpartyAddress(c context.Context, req *types.QueryCounterpartyAddressRequest) (*types.QueryCounterpartyAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid relayer address %s, %s", req.Address, err))
+	}
+
+	if err := validateGRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	counterpartyAddress, found := q.GetCounterpartyAddress(ctx, req.Address, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			fmt.Sprintf("no counterparty address registered by relayer %s on port %s, channel %s", req.Address, req.PortId, req.ChannelId),
+		)
+	}
+
+	return &types.QueryCounterpartyAddressResponse{
+		CounterpartyAddress: counterpartyAddress,
+	}, nil
+}
+
+// FeeEnabledChannel implements the Query/FeeEnabledChannel gRPC method
+func (q Keeper) FeeEnabledChannel(c context.Context, req *types.QueryFeeEnabledChannelRequest) (*types.QueryFeeEnabledChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateGRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryFeeEnabledChannelResponse{
+		FeeEnabled: q.IsFeeEnabled(ctx, req.PortId, req.ChannelId),
+	}, nil
+}
+
+func (q Keeper) paginateIdentifiedPacketFees(ctx sdk.Context, keyPrefix []byte, pageReq *query.PageRequest) ([]types.IdentifiedPacketFees, *query.PageResponse, error) {
+	packets := []types.IdentifiedPacketFees{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), keyPrefix)
+
+	pageRes, err := query.Paginate(store, pageReq, func(_, value []byte) error {
+		var identifiedFees types.IdentifiedPacketFees
+		if err := q.cdc.UnmarshalBinaryBare(value, &identifiedFees); err != nil {
+			return err
+		}
+
+		packets = append(packets, identifiedFees)
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return packets, pageRes, nil
+}
+
+func validateGRPCRequest(portID, channelID string) error {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return nil
+}
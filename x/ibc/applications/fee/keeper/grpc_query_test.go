@@ -0,0 +1,228 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/fee/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+// setIncentivizedPackets escrows a fee for the first packets of two channels
+// of chainA, and returns the identified fees in store order.
+func (suite *KeeperTestSuite) setIncentivizedPackets() []types.IdentifiedPacketFees {
+	packetFees := []types.PacketFee{types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress())}
+	identifiedFees := []types.IdentifiedPacketFees{
+		types.NewIdentifiedPacketFees(types.NewPacketId(ibctesting.TransferPort, "channelidone", 1), packetFees),
+		types.NewIdentifiedPacketFees(types.NewPacketId(ibctesting.TransferPort, "channelidone", 2), packetFees),
+		types.NewIdentifiedPacketFees(types.NewPacketId(ibctesting.TransferPort, "channelidtwo", 1), packetFees),
+	}
+
+	for _, fees := range identifiedFees {
+		suite.chainA.App.IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), fees)
+	}
+
+	return identifiedFees
+}
+
+func (suite *KeeperTestSuite) TestQueryIncentivizedPackets() {
+	identifiedFees := suite.setIncentivizedPackets()
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+	res, err := suite.queryClient.IncentivizedPackets(ctx, &types.QueryIncentivizedPacketsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(identifiedFees, res.IncentivizedPackets)
+
+	res, err = suite.queryClient.IncentivizedPackets(ctx, &types.QueryIncentivizedPacketsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(identifiedFees[:2], res.IncentivizedPackets)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestQueryIncentivizedPacketsForChannel() {
+	var (
+		req    *types.QueryIncentivizedPacketsForChannelRequest
+		expRes []types.IdentifiedPacketFees
+	)
+
+	identifiedFees := suite.setIncentivizedPackets()
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryIncentivizedPacketsForChannelRequest{PortId: "", ChannelId: "channelidone"}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryIncentivizedPacketsForChannelRequest{PortId: ibctesting.TransferPort, ChannelId: ""}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				req = &types.QueryIncentivizedPacketsForChannelRequest{PortId: ibctesting.TransferPort, ChannelId: "channelidone"}
+				expRes = identifiedFees[:2]
+			},
+			true,
+		},
+		{
+			"success without incentivized packets",
+			func() {
+				req = &types.QueryIncentivizedPacketsForChannelRequest{PortId: ibctesting.TransferPort, ChannelId: "channelidthree"}
+				expRes = nil
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.IncentivizedPacketsForChannel(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res.IncentivizedPackets)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryIncentivizedPacket() {
+	var req *types.QueryIncentivizedPacketRequest
+
+	identifiedFees := suite.setIncentivizedPackets()
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryIncentivizedPacketRequest{PortId: ibctesting.TransferPort, ChannelId: "", Sequence: 1}
+			},
+			false,
+		},
+		{
+			"not found",
+			func() {
+				req = &types.QueryIncentivizedPacketRequest{PortId: ibctesting.TransferPort, ChannelId: "channelidone", Sequence: 3}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				req = &types.QueryIncentivizedPacketRequest{PortId: ibctesting.TransferPort, ChannelId: "channelidone", Sequence: 2}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.IncentivizedPacket(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(identifiedFees[1], res.IncentivizedPacket)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryCounterpartyAddress() {
+	var req *types.QueryCounterpartyAddressRequest
+
+	relayer := suite.chainA.SenderAccount.GetAddress().String()
+	counterpartyAddress := suite.chainB.SenderAccount.GetAddress().String()
+	suite.chainA.App.IBCFeeKeeper.SetCounterpartyAddress(suite.chainA.GetContext(), relayer, counterpartyAddress, ibctesting.TransferPort, "channelidone")
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid relayer address",
+			func() {
+				req = &types.QueryCounterpartyAddressRequest{Address: "relayer", PortId: ibctesting.TransferPort, ChannelId: "channelidone"}
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryCounterpartyAddressRequest{Address: relayer, PortId: "", ChannelId: "channelidone"}
+			},
+			false,
+		},
+		{
+			"not found",
+			func() {
+				req = &types.QueryCounterpartyAddressRequest{Address: relayer, PortId: ibctesting.TransferPort, ChannelId: "channelidtwo"}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				req = &types.QueryCounterpartyAddressRequest{Address: relayer, PortId: ibctesting.TransferPort, ChannelId: "channelidone"}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.CounterpartyAddress(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(counterpartyAddress, res.CounterpartyAddress)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryFeeEnabledChannel() {
+	suite.chainA.App.IBCFeeKeeper.SetFeeEnabled(suite.chainA.GetContext(), ibctesting.TransferPort, "channelidone")
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+	res, err := suite.queryClient.FeeEnabledChannel(ctx, &types.QueryFeeEnabledChannelRequest{PortId: ibctesting.TransferPort, ChannelId: "channelidone"})
+	suite.Require().NoError(err)
+	suite.Require().True(res.FeeEnabled)
+
+	res, err = suite.queryClient.FeeEnabledChannel(ctx, &types.QueryFeeEnabledChannelRequest{PortId: ibctesting.TransferPort, ChannelId: "channelidtwo"})
+	suite.Require().NoError(err)
+	suite.Require().False(res.FeeEnabled)
+
+	_, err = suite.queryClient.FeeEnabledChannel(ctx, &types.QueryFeeEnabledChannelRequest{PortId: ibctesting.TransferPort, ChannelId: ""})
+	suite.Require().Error(err)
+}
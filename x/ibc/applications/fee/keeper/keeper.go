@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/fee/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+)
+
+// Keeper defines the IBC fee middleware keeper. It escrows the fees paid for
+// the packets sent on fee enabled channels and pays them to the relayers once
+// the packets are acknowledged or timed out.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryMarshaler
+
+	channelKeeper types.ChannelKeeper
+	authKeeper    types.AccountKeeper
+	bankKeeper    types.BankKeeper
+}
+
+// NewKeeper creates a new IBC fee middleware Keeper instance
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey,
+	channelKeeper types.ChannelKeeper, authKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
+) Keeper {
+
+	// ensure ibc fee module account is set
+	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the IBC fee module account has not been set")
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		channelKeeper: channelKeeper,
+		authKeeper:    authKeeper,
+		bankKeeper:    bankKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, types.ModuleName))
+}
+
+// GetChannelVersion returns the version of a channel, or an empty version if
+// the channel does not exist.
+func (k Keeper) GetChannelVersion(ctx sdk.Context, portID, channelID string) string {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return ""
+	}
+
+	return channel.Version
+}
+
+// IsFeeEnabled returns whether a channel is fee enabled.
+func (k Keeper) IsFeeEnabled(ctx sdk.Context, portID, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.FeeEnabledKey(portID, channelID))
+}
+
+// SetFeeEnabled marks a channel as fee enabled.
+func (k Keeper) SetFeeEnabled(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FeeEnabledKey(portID, channelID), []byte{0x01})
+}
+
+// DeleteFeeEnabled removes the fee enabled mark of a channel.
+func (k Keeper) DeleteFeeEnabled(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FeeEnabledKey(portID, channelID))
+}
+
+// GetAllFeeEnabledChannels returns the fee enabled channels.
+func (k Keeper) GetAllFeeEnabledChannels(ctx sdk.Context) []types.FeeEnabledChannel {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeEnabledKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	channels := []types.FeeEnabledChannel{}
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID := types.SplitPortChannelKey(iterator.Key())
+		channels = append(channels, types.FeeEnabledChannel{
+			PortId:    portID,
+			ChannelId: channelID,
+		})
+	}
+
+	return channels
+}
+
+// GetCounterpartyAddress returns the counterparty address a relayer
+// registered on a channel.
+func (k Keeper) GetCounterpartyAddress(ctx sdk.Context, address, portID, channelID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CounterpartyAddressKey(address, portID, channelID))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// SetCounterpartyAddress sets the counterparty address a relayer registered
+// on a channel.
+func (k Keeper) SetCounterpartyAddress(ctx sdk.Context, address, counterpartyAddress, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CounterpartyAddressKey(address, portID, channelID), []byte(counterpartyAddress))
+}
+
+// GetAllCounterpartyAddresses returns the counterparty addresses registered
+// by the relayers.
+func (k Keeper) GetAllCounterpartyAddresses(ctx sdk.Context) []types.RegisteredCounterpartyAddress {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CounterpartyAddressKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	addresses := []types.RegisteredCounterpartyAddress{}
+	for ; iterator.Valid(); iterator.Next() {
+		address, portID, channelID := types.SplitCounterpartyAddressKey(iterator.Key())
+		addresses = append(addresses, types.RegisteredCounterpartyAddress{
+			Address:             address,
+			CounterpartyAddress: string(iterator.Value()),
+			PortId:              portID,
+			ChannelId:           channelID,
+		})
+	}
+
+	return addresses
+}
+
+// GetFeesInEscrow returns the fees escrowed for a packet.
+func (k Keeper) GetFeesInEscrow(ctx sdk.Context, packetID types.PacketId) (types.IdentifiedPacketFees, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeesInEscrowKey(packetID))
+	if bz == nil {
+		return types.IdentifiedPacketFees{}, false
+	}
+
+	var identifiedFees types.IdentifiedPacketFees
+	k.cdc.MustUnmarshalBinaryBare(bz, &identifiedFees)
+	return identifiedFees, true
+}
+
+// SetFeesInEscrow sets the fees escrowed for a packet.
+func (k Keeper) SetFeesInEscrow(ctx sdk.Context, identifiedFees types.IdentifiedPacketFees) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&identifiedFees)
+	store.Set(types.FeesInEscrowKey(identifiedFees.PacketId), bz)
+}
+
+// DeleteFeesInEscrow deletes the fees escrowed for a packet.
+func (k Keeper) DeleteFeesInEscrow(ctx sdk.Context, packetID types.PacketId) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FeesInEscrowKey(packetID))
+}
+
+// GetIdentifiedPacketFeesForChannel returns the fees escrowed for the packets
+// of a channel.
+func (k Keeper) GetIdentifiedPacketFeesForChannel(ctx sdk.Context, portID, channelID string) []types.IdentifiedPacketFees {
+	return k.getIdentifiedPacketFees(ctx, types.FeesInEscrowChannelPrefix(portID, channelID))
+}
+
+// GetAllIdentifiedPacketFees returns the fees escrowed for all the packets.
+func (k Keeper) GetAllIdentifiedPacketFees(ctx sdk.Context) []types.IdentifiedPacketFees {
+	return k.getIdentifiedPacketFees(ctx, types.FeesInEscrowKeyPrefix)
+}
+
+func (k Keeper) getIdentifiedPacketFees(ctx sdk.Context, keyPrefix []byte) []types.IdentifiedPacketFees {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	identifiedFees := []types.IdentifiedPacketFees{}
+	for ; iterator.Valid(); iterator.Next() {
+		var packetFees types.IdentifiedPacketFees
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &packetFees)
+		identifiedFees = append(identifiedFees, packetFees)
+	}
+
+	return identifiedFees
+}
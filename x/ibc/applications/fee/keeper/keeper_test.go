@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/fee/types"
+	transfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+var (
+	coins = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	fee   = types.NewFee(
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(200))),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(300))),
+	)
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.App.IBCFeeKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+// createFeeChannel opens a fee enabled transfer channel between chainA and
+// chainB on a connection.
+func (suite *KeeperTestSuite) createFeeChannel(connA, connB *ibctesting.TestConnection) (ibctesting.TestChannel, ibctesting.TestChannel) {
+	connA.NextChannelVersion = types.NewMetadata(transfertypes.Version).Version()
+	connB.NextChannelVersion = types.NewMetadata(transfertypes.Version).Version()
+
+	channelA, channelB := suite.coordinator.CreateChannel(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED)
+
+	connA.NextChannelVersion = ibctesting.DefaultChannelVersion
+	connB.NextChannelVersion = ibctesting.DefaultChannelVersion
+
+	return channelA, channelB
+}
+
+// fundAccount funds a new account of chainA and returns its address.
+func (suite *KeeperTestSuite) fundAccount(amount sdk.Coins) sdk.AccAddress {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	suite.Require().NoError(simapp.FundAccount(suite.chainA.App, suite.chainA.GetContext(), addr, amount))
+	return addr
+}
+
+func (suite *KeeperTestSuite) TestFeeEnabledChannel() {
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	channelA, channelB := suite.createFeeChannel(connA, connB)
+
+	// the channel is fee enabled on both ends, and transfer sees its own
+	// version
+	suite.Require().True(suite.chainA.App.IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), channelA.PortID, channelA.ID))
+	suite.Require().True(suite.chainB.App.IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), channelB.PortID, channelB.ID))
+	suite.Require().Equal(channeltypes.OPEN, suite.chainA.GetChannel(channelA).State)
+	suite.Require().Equal(channeltypes.OPEN, suite.chainB.GetChannel(channelB).State)
+
+	// a transfer channel opened with the transfer version is not fee enabled
+	channelA, channelB = suite.coordinator.CreateChannel(suite.chainA, suite.chainB, connA, connB, ibctesting.TransferPort, ibctesting.TransferPort, channeltypes.UNORDERED)
+	suite.Require().False(suite.chainA.App.IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), channelA.PortID, channelA.ID))
+	suite.Require().False(suite.chainB.App.IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), channelB.PortID, channelB.ID))
+	suite.Require().Equal(channeltypes.OPEN, suite.chainA.GetChannel(channelA).State)
+}
+
+func (suite *KeeperTestSuite) TestGetAllFeeEnabledChannels() {
+	ctx := suite.chainA.GetContext()
+
+	suite.chainA.App.IBCFeeKeeper.SetFeeEnabled(ctx, ibctesting.TransferPort, "channel-1")
+	suite.chainA.App.IBCFeeKeeper.SetFeeEnabled(ctx, ibctesting.TransferPort, "channel-0")
+
+	expChannels := []types.FeeEnabledChannel{
+		{PortId: ibctesting.TransferPort, ChannelId: "channel-0"},
+		{PortId: ibctesting.TransferPort, ChannelId: "channel-1"},
+	}
+	suite.Require().Equal(expChannels, suite.chainA.App.IBCFeeKeeper.GetAllFeeEnabledChannels(ctx))
+
+	suite.chainA.App.IBCFeeKeeper.DeleteFeeEnabled(ctx, ibctesting.TransferPort, "channel-0")
+	suite.Require().Equal(expChannels[1:], suite.chainA.App.IBCFeeKeeper.GetAllFeeEnabledChannels(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGetAllCounterpartyAddresses() {
+	ctx := suite.chainA.GetContext()
+	relayer := sdk.AccAddress([]byte("relayer")).String()
+
+	suite.chainA.App.IBCFeeKeeper.SetCounterpartyAddress(ctx, relayer, "counterparty1", ibctesting.TransferPort, "channel-1")
+	suite.chainA.App.IBCFeeKeeper.SetCounterpartyAddress(ctx, relayer, "counterparty0", ibctesting.TransferPort, "channel-0")
+
+	counterpartyAddress, found := suite.chainA.App.IBCFeeKeeper.GetCounterpartyAddress(ctx, relayer, ibctesting.TransferPort, "channel-0")
+	suite.Require().True(found)
+	suite.Require().Equal("counterparty0", counterpartyAddress)
+
+	_, found = suite.chainA.App.IBCFeeKeeper.GetCounterpartyAddress(ctx, relayer, ibctesting.TransferPort, "channel-2")
+	suite.Require().False(found)
+
+	expAddresses := []types.RegisteredCounterpartyAddress{
+		{Address: relayer, CounterpartyAddress: "counterparty0", PortId: ibctesting.TransferPort, ChannelId: "channel-0"},
+		{Address: relayer, CounterpartyAddress: "counterparty1", PortId: ibctesting.TransferPort, ChannelId: "channel-1"},
+	}
+	suite.Require().Equal(expAddresses, suite.chainA.App.IBCFeeKeeper.GetAllCounterpartyAddresses(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGetIdentifiedPacketFees() {
+	ctx := suite.chainA.GetContext()
+	packetFees := []types.PacketFee{types.NewPacketFee(fee, sdk.AccAddress([]byte("refund")))}
+
+	expFees := []types.IdentifiedPacketFees{
+		types.NewIdentifiedPacketFees(types.NewPacketId(ibctesting.TransferPort, "channel-0", 2), packetFees),
+		types.NewIdentifiedPacketFees(types.NewPacketId(ibctesting.TransferPort, "channel-0", 256), packetFees),
+		types.NewIdentifiedPacketFees(types.NewPacketId(ibctesting.TransferPort, "channel-1", 1), packetFees),
+	}
+	for i := len(expFees) - 1; i >= 0; i-- {
+		suite.chainA.App.IBCFeeKeeper.SetFeesInEscrow(ctx, expFees[i])
+	}
+
+	// the fees are ordered by channel and sequence
+	suite.Require().Equal(expFees, suite.chainA.App.IBCFeeKeeper.GetAllIdentifiedPacketFees(ctx))
+	suite.Require().Equal(expFees[:2], suite.chainA.App.IBCFeeKeeper.GetIdentifiedPacketFeesForChannel(ctx, ibctesting.TransferPort, "channel-0"))
+
+	identifiedFees, found := suite.chainA.App.IBCFeeKeeper.GetFeesInEscrow(ctx, expFees[2].PacketId)
+	suite.Require().True(found)
+	suite.Require().Equal(expFees[2], identifiedFees)
+
+	suite.chainA.App.IBCFeeKeeper.DeleteFeesInEscrow(ctx, expFees[2].PacketId)
+	_, found = suite.chainA.App.IBCFeeKeeper.GetFeesInEscrow(ctx, expFees[2].PacketId)
+	suite.Require().False(found)
+	suite.Require().Equal(expFees[:2], suite.chainA.App.IBCFeeKeeper.GetAllIdentifiedPacketFees(ctx))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/fee/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// RegisterCounterpartyAddress defines a rpc handler method for
+// MsgRegisterCounterpartyAddress. A relayer registers the address its recv
+// fees are paid to on the counterparty chain of a fee enabled channel, which
+// is carried by the acknowledgements of the packets it delivers.
+func (k Keeper) RegisterCounterpartyAddress(goCtx context.Context, msg *types.MsgRegisterCounterpartyAddress) (*types.MsgRegisterCounterpartyAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsFeeEnabled(ctx, msg.PortId, msg.ChannelId) {
+		return nil, sdkerrors.Wrapf(types.ErrFeeNotEnabled, "port %s, channel %s", msg.PortId, msg.ChannelId)
+	}
+
+	k.SetCounterpartyAddress(ctx, msg.Address, msg.CounterpartyAddress, msg.PortId, msg.ChannelId)
+
+	k.Logger(ctx).Info("counterparty address registered", "address", msg.Address, "counterparty-address", msg.CounterpartyAddress, "port-id", msg.PortId, "channel-id", msg.ChannelId)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterCounterpartyAddress,
+			sdk.NewAttribute(types.AttributeKeyRelayer, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyAddress, msg.CounterpartyAddress),
+			sdk.NewAttribute(types.AttributeKeyPortID, msg.PortId),
+			sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelId),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+
+	return &types.MsgRegisterCounterpartyAddressResponse{}, nil
+}
+
+// PayPacketFee defines a rpc handler method for MsgPayPacketFee. The fee is
+// escrowed for the next packet sent from the port and channel, which is sent
+// by a later msg of the same tx.
+func (k Keeper) PayPacketFee(goCtx context.Context, msg *types.MsgPayPacketFee) (*types.MsgPayPacketFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, msg.SourcePortId, msg.SourceChannelId)
+	if !found {
+		return nil, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", msg.SourcePortId, msg.SourceChannelId,
+		)
+	}
+
+	packetID := types.NewPacketId(msg.SourcePortId, msg.SourceChannelId, sequence)
+	if err := k.EscrowPacketFee(ctx, packetID, types.NewPacketFee(msg.Fee, signer)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgPayPacketFeeResponse{Sequence: sequence}, nil
+}
+
+// PayPacketFeeAsync defines a rpc handler method for MsgPayPacketFeeAsync. The
+// fee is escrowed for a packet which is sent but not acknowledged or timed out
+// yet.
+func (k Keeper) PayPacketFeeAsync(goCtx context.Context, msg *types.MsgPayPacketFeeAsync) (*types.MsgPayPacketFeeAsyncResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	packetID := msg.PacketId
+	if commitment := k.channelKeeper.GetPacketCommitment(ctx, packetID.PortId, packetID.ChannelId, packetID.Sequence); len(commitment) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrPacketNotFound, "packet %d of port %s, channel %s", packetID.Sequence, packetID.PortId, packetID.ChannelId)
+	}
+
+	if err := k.EscrowPacketFee(ctx, packetID, types.NewPacketFee(msg.Fee, signer)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgPayPacketFeeAsyncResponse{}, nil
+}
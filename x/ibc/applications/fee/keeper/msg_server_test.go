@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/fee/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func (suite *KeeperTestSuite) TestRegisterCounterpartyAddress() {
+	var (
+		msg      *types.MsgRegisterCounterpartyAddress
+		channelA ibctesting.TestChannel
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"success overwriting a registered address", func() {
+			suite.chainA.App.IBCFeeKeeper.SetCounterpartyAddress(suite.chainA.GetContext(), msg.Address, "counterparty", channelA.PortID, channelA.ID)
+		}, true},
+		{"fee not enabled", func() {
+			suite.chainA.App.IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), channelA.PortID, channelA.ID)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+			channelA, _ = suite.createFeeChannel(connA, connB)
+
+			relayer := suite.chainA.SenderAccount.GetAddress()
+			counterpartyAddress := suite.chainB.SenderAccount.GetAddress().String()
+			msg = types.NewMsgRegisterCounterpartyAddress(relayer, counterpartyAddress, channelA.PortID, channelA.ID)
+
+			tc.malleate()
+
+			_, err := suite.chainA.App.IBCFeeKeeper.RegisterCounterpartyAddress(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			address, found := suite.chainA.App.IBCFeeKeeper.GetCounterpartyAddress(suite.chainA.GetContext(), relayer.String(), channelA.PortID, channelA.ID)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(counterpartyAddress, address)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPayPacketFee() {
+	var (
+		msg      *types.MsgPayPacketFee
+		channelA ibctesting.TestChannel
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"success for a later packet", func() {
+			suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), channelA.PortID, channelA.ID, 5)
+		}, true},
+		{"channel not found", func() {
+			msg.SourceChannelId = "channel-100"
+		}, false},
+		{"fee not enabled", func() {
+			suite.chainA.App.IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), channelA.PortID, channelA.ID)
+		}, false},
+		{"insufficient funds", func() {
+			msg.Fee = types.NewFee(suite.chainA.App.BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress()).Add(coins...), nil, nil)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+			channelA, _ = suite.createFeeChannel(connA, connB)
+			msg = types.NewMsgPayPacketFee(fee, channelA.PortID, channelA.ID, suite.chainA.SenderAccount.GetAddress())
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			sequence, _ := suite.chainA.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, msg.SourcePortId, msg.SourceChannelId)
+
+			res, err := suite.chainA.App.IBCFeeKeeper.PayPacketFee(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(sequence, res.Sequence)
+
+				// the fee is escrowed for the next packet sent
+				identifiedFees, found := suite.chainA.App.IBCFeeKeeper.GetFeesInEscrow(ctx, types.NewPacketId(channelA.PortID, channelA.ID, sequence))
+				suite.Require().True(found)
+				suite.Require().Equal([]types.PacketFee{types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress())}, identifiedFees.PacketFees)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Empty(suite.chainA.App.IBCFeeKeeper.GetAllIdentifiedPacketFees(ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
+	var (
+		msg      *types.MsgPayPacketFeeAsync
+		channelA ibctesting.TestChannel
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"packet not sent", func() {
+			msg.PacketId.Sequence = 2
+		}, false},
+		{"fee not enabled", func() {
+			suite.chainA.App.IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), channelA.PortID, channelA.ID)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+			channelA, _ = suite.createFeeChannel(connA, connB)
+			suite.chainA.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), channelA.PortID, channelA.ID, 1, []byte("commitment"))
+
+			packetID := types.NewPacketId(channelA.PortID, channelA.ID, 1)
+			msg = types.NewMsgPayPacketFeeAsync(packetID, fee, suite.chainA.SenderAccount.GetAddress())
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.App.IBCFeeKeeper.PayPacketFeeAsync(sdk.WrapSDKContext(ctx), msg)
+
+			identifiedFees, found := suite.chainA.App.IBCFeeKeeper.GetFeesInEscrow(ctx, msg.PacketId)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal([]types.PacketFee{types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress())}, identifiedFees.PacketFees)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
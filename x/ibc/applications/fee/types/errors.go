@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBC fee middleware sentinel errors
+var (
+	ErrInvalidVersion                  = sdkerrors.Register(ModuleName, 2, "invalid ICS29 middleware version")
+	ErrInvalidFee                      = sdkerrors.Register(ModuleName, 3, "invalid packet fee")
+	ErrFeeNotEnabled                   = sdkerrors.Register(ModuleName, 4, "fee module is not enabled for this channel")
+	ErrPacketNotFound                  = sdkerrors.Register(ModuleName, 5, "packet is not sent or already acknowledged or timed out")
+	ErrFeeNotFound                     = sdkerrors.Register(ModuleName, 6, "no fee escrowed for this packet")
+	ErrCounterpartyAddressEmpty        = sdkerrors.Register(ModuleName, 7, "counterparty address must not be empty")
+	ErrInvalidAcknowledgement          = sdkerrors.Register(ModuleName, 8, "invalid incentivized acknowledgement")
+	ErrUnsupportedAsyncAcknowledgement = sdkerrors.Register(ModuleName, 9, "asynchronous acknowledgements are not supported on fee enabled channels")
+)
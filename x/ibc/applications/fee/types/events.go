@@ -0,0 +1,19 @@
+package types
+
+// IBC fee middleware events
+const (
+	EventTypeIncentivizedPacket          = "incentivized_ibc_packet"
+	EventTypeRegisterCounterpartyAddress = "register_counterparty_address"
+	EventTypeDistributeFee               = "distribute_fee"
+
+	AttributeKeyPortID              = "port_id"
+	AttributeKeyChannelID           = "channel_id"
+	AttributeKeySequence            = "packet_sequence"
+	AttributeKeyRecvFee             = "recv_fee"
+	AttributeKeyAckFee              = "ack_fee"
+	AttributeKeyTimeoutFee          = "timeout_fee"
+	AttributeKeyRelayer             = "relayer"
+	AttributeKeyCounterpartyAddress = "counterparty_address"
+	AttributeKeyReceiver            = "receiver"
+	AttributeKeyFee                 = "fee"
+)
@@ -0,0 +1,152 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+)
+
+// NewFee creates a new Fee instance.
+func NewFee(recvFee, ackFee, timeoutFee sdk.Coins) Fee {
+	return Fee{
+		RecvFee:    recvFee,
+		AckFee:     ackFee,
+		TimeoutFee: timeoutFee,
+	}
+}
+
+// Total returns the sum of the recv, ack and timeout fees.
+func (f Fee) Total() sdk.Coins {
+	return f.RecvFee.Add(f.AckFee...).Add(f.TimeoutFee...)
+}
+
+// Validate performs a basic validation of the fee. Each of the recv, ack and
+// timeout fees must be valid coins, which may be empty, but the fee can't be
+// empty altogether.
+func (f Fee) Validate() error {
+	if err := f.RecvFee.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidFee, "invalid recv fee: %s", err)
+	}
+	if err := f.AckFee.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidFee, "invalid ack fee: %s", err)
+	}
+	if err := f.TimeoutFee.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidFee, "invalid timeout fee: %s", err)
+	}
+	if f.Total().IsZero() {
+		return sdkerrors.Wrap(ErrInvalidFee, "recv, ack and timeout fees cannot all be empty")
+	}
+
+	return nil
+}
+
+// NewPacketId creates a new PacketId instance.
+func NewPacketId(portID, channelID string, sequence uint64) PacketId {
+	return PacketId{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  sequence,
+	}
+}
+
+// Validate performs a basic validation of the packet identifiers.
+func (p PacketId) Validate() error {
+	if err := host.PortIdentifierValidator(p.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	if p.Sequence == 0 {
+		return sdkerrors.Wrap(channeltypes.ErrInvalidPacket, "packet sequence cannot be 0")
+	}
+
+	return nil
+}
+
+// NewPacketFee creates a new PacketFee instance.
+//nolint:interfacer
+func NewPacketFee(fee Fee, refundAddress sdk.AccAddress) PacketFee {
+	return PacketFee{
+		Fee:           fee,
+		RefundAddress: refundAddress.String(),
+	}
+}
+
+// Validate performs a basic validation of the fee and refund address.
+func (p PacketFee) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.RefundAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return p.Fee.Validate()
+}
+
+// NewIdentifiedPacketFees creates a new IdentifiedPacketFees instance.
+func NewIdentifiedPacketFees(packetID PacketId, packetFees []PacketFee) IdentifiedPacketFees {
+	return IdentifiedPacketFees{
+		PacketId:   packetID,
+		PacketFees: packetFees,
+	}
+}
+
+// Validate performs a basic validation of the packet identifiers and fees.
+func (p IdentifiedPacketFees) Validate() error {
+	if err := p.PacketId.Validate(); err != nil {
+		return err
+	}
+	if len(p.PacketFees) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidFee, "no fee escrowed for packet %d of port %s, channel %s", p.PacketId.Sequence, p.PacketId.PortId, p.PacketId.ChannelId)
+	}
+	for _, packetFee := range p.PacketFees {
+		if err := packetFee.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewIncentivizedAcknowledgement creates a new IncentivizedAcknowledgement
+// instance.
+func NewIncentivizedAcknowledgement(appAcknowledgement []byte, forwardRelayerAddress string) IncentivizedAcknowledgement {
+	return IncentivizedAcknowledgement{
+		AppAcknowledgement:    appAcknowledgement,
+		ForwardRelayerAddress: forwardRelayerAddress,
+	}
+}
+
+// GetBytes returns the sorted JSON encoding of the acknowledgement, which is
+// the acknowledgement written for the packet.
+func (ack IncentivizedAcknowledgement) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ack))
+}
+
+// NewMetadata creates a new Metadata instance with the current fee version.
+func NewMetadata(appVersion string) Metadata {
+	return Metadata{
+		FeeVersion: Version,
+		AppVersion: appVersion,
+	}
+}
+
+// MetadataFromVersion returns the metadata a fee enabled channel version is
+// encoded from. It returns an error if the version is not the metadata of the
+// current fee version, in which case the channel is not fee enabled.
+func MetadataFromVersion(version string) (Metadata, error) {
+	var metadata Metadata
+	if err := ModuleCdc.UnmarshalJSON([]byte(version), &metadata); err != nil {
+		return Metadata{}, sdkerrors.Wrapf(ErrInvalidVersion, "version %s is not fee metadata: %s", version, err)
+	}
+	if metadata.FeeVersion != Version {
+		return Metadata{}, sdkerrors.Wrapf(ErrInvalidVersion, "expected %s, got %s", Version, metadata.FeeVersion)
+	}
+
+	return metadata, nil
+}
+
+// Version returns the channel version the metadata is encoded to.
+func (m Metadata) Version() string {
+	return string(sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m)))
+}
@@ -0,0 +1,108 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/fee/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+)
+
+var (
+	refundAddr  = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	relayerAddr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+)
+
+func TestFeeTotal(t *testing.T) {
+	fee := types.NewFee(
+		sdk.NewCoins(sdk.NewInt64Coin("atom", 1)),
+		sdk.NewCoins(sdk.NewInt64Coin("atom", 2), sdk.NewInt64Coin("stake", 3)),
+		nil,
+	)
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 3), sdk.NewInt64Coin("stake", 3)), fee.Total())
+}
+
+func TestIdentifiedPacketFeesValidate(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	packetFee := types.NewPacketFee(types.NewFee(coins, coins, coins), refundAddr)
+
+	testCases := []struct {
+		name           string
+		identifiedFees types.IdentifiedPacketFees
+		expPass        bool
+	}{
+		{
+			"valid fees",
+			types.NewIdentifiedPacketFees(types.NewPacketId("transfer", "channelidone", 1), []types.PacketFee{packetFee, packetFee}),
+			true,
+		},
+		{
+			"zero sequence",
+			types.NewIdentifiedPacketFees(types.NewPacketId("transfer", "channelidone", 0), []types.PacketFee{packetFee}),
+			false,
+		},
+		{
+			"no fees",
+			types.NewIdentifiedPacketFees(types.NewPacketId("transfer", "channelidone", 1), nil),
+			false,
+		},
+		{
+			"invalid refund address",
+			types.NewIdentifiedPacketFees(types.NewPacketId("transfer", "channelidone", 1), []types.PacketFee{{Fee: packetFee.Fee, RefundAddress: "refund"}}),
+			false,
+		},
+		{
+			"empty fee",
+			types.NewIdentifiedPacketFees(types.NewPacketId("transfer", "channelidone", 1), []types.PacketFee{types.NewPacketFee(types.Fee{}, refundAddr)}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		err := tc.identifiedFees.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestIncentivizedAcknowledgement(t *testing.T) {
+	appAck := channeltypes.NewResultAcknowledgement([]byte{1}).GetBytes()
+	ack := types.NewIncentivizedAcknowledgement(appAck, relayerAddr.String())
+
+	var decoded types.IncentivizedAcknowledgement
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(ack.GetBytes(), &decoded))
+	require.Equal(t, ack, decoded)
+}
+
+func TestMetadataFromVersion(t *testing.T) {
+	testCases := []struct {
+		name    string
+		version string
+		expPass bool
+	}{
+		{"fee metadata", types.NewMetadata("ics20-1").Version(), true},
+		{"fee metadata without app version", types.NewMetadata("").Version(), true},
+		{"app version", "ics20-1", false},
+		{"other fee version", types.Metadata{FeeVersion: "ics29-2", AppVersion: "ics20-1"}.Version(), false},
+		{"empty version", "", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		metadata, err := types.MetadataFromVersion(tc.version)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.version, metadata.Version(), tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+)
+
+// NewGenesisState creates a new ibc fee middleware GenesisState instance.
+func NewGenesisState(
+	identifiedFees []IdentifiedPacketFees, feeEnabledChannels []FeeEnabledChannel,
+	counterpartyAddresses []RegisteredCounterpartyAddress,
+) *GenesisState {
+	return &GenesisState{
+		IdentifiedFees:        identifiedFees,
+		FeeEnabledChannels:    feeEnabledChannels,
+		CounterpartyAddresses: counterpartyAddresses,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState without escrowed fees, fee
+// enabled channels or counterparty addresses.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		IdentifiedFees:        []IdentifiedPacketFees{},
+		FeeEnabledChannels:    []FeeEnabledChannel{},
+		CounterpartyAddresses: []RegisteredCounterpartyAddress{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, identifiedFees := range gs.IdentifiedFees {
+		if err := identifiedFees.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, channel := range gs.FeeEnabledChannels {
+		if err := validatePortAndChannel(channel.PortId, channel.ChannelId); err != nil {
+			return err
+		}
+	}
+
+	for _, registered := range gs.CounterpartyAddresses {
+		if _, err := sdk.AccAddressFromBech32(registered.Address); err != nil {
+			return err
+		}
+		if registered.CounterpartyAddress == "" {
+			return fmt.Errorf("empty counterparty address of relayer %s on port %s, channel %s", registered.Address, registered.PortId, registered.ChannelId)
+		}
+		if err := validatePortAndChannel(registered.PortId, registered.ChannelId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validatePortAndChannel(portID, channelID string) error {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return err
+	}
+
+	return host.ChannelIdentifierValidator(channelID)
+}
@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/fee/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	relayer := relayerAddr.String()
+	packetFee := types.NewPacketFee(types.NewFee(coins, coins, coins), refundAddr)
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			name:     "default",
+			genState: types.DefaultGenesisState(),
+			expPass:  true,
+		},
+		{
+			"valid genesis",
+			types.NewGenesisState(
+				[]types.IdentifiedPacketFees{types.NewIdentifiedPacketFees(types.NewPacketId("transfer", "channelidone", 1), []types.PacketFee{packetFee})},
+				[]types.FeeEnabledChannel{{PortId: "transfer", ChannelId: "channelidone"}},
+				[]types.RegisteredCounterpartyAddress{{Address: relayer, CounterpartyAddress: "cosmos1...", PortId: "transfer", ChannelId: "channelidone"}},
+			),
+			true,
+		},
+		{
+			"invalid identified fees",
+			&types.GenesisState{
+				IdentifiedFees: []types.IdentifiedPacketFees{types.NewIdentifiedPacketFees(types.NewPacketId("transfer", "channelidone", 1), nil)},
+			},
+			false,
+		},
+		{
+			"invalid fee enabled channel",
+			&types.GenesisState{
+				FeeEnabledChannels: []types.FeeEnabledChannel{{PortId: "transfer", ChannelId: "(invalidchannel)"}},
+			},
+			false,
+		},
+		{
+			"invalid relayer address",
+			&types.GenesisState{
+				CounterpartyAddresses: []types.RegisteredCounterpartyAddress{{Address: "relayer", CounterpartyAddress: "cosmos1...", PortId: "transfer", ChannelId: "channelidone"}},
+			},
+			false,
+		},
+		{
+			"empty counterparty address",
+			&types.GenesisState{
+				CounterpartyAddresses: []types.RegisteredCounterpartyAddress{{Address: relayer, PortId: "transfer", ChannelId: "channelidone"}},
+			},
+			false,
+		},
+		{
+			"invalid counterparty address port",
+			&types.GenesisState{
+				CounterpartyAddresses: []types.RegisteredCounterpartyAddress{{Address: relayer, CounterpartyAddress: "cosmos1...", PortId: "(invalidport)", ChannelId: "channelidone"}},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
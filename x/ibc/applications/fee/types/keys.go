@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the IBC fee middleware name
+	ModuleName = "feeibc"
+
+	// Version defines the current version the IBC fee middleware supports
+	Version = "ics29-1"
+
+	// StoreKey is the store key string for the IBC fee middleware
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the IBC fee middleware
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the IBC fee middleware
+	QuerierRoute = ModuleName
+)
+
+var (
+	// FeeEnabledKeyPrefix defines the key prefix to store the fee enabled
+	// channels in store
+	FeeEnabledKeyPrefix = []byte{0x01}
+	// CounterpartyAddressKeyPrefix defines the key prefix to store the
+	// counterparty addresses registered by the relayers in store
+	CounterpartyAddressKeyPrefix = []byte{0x02}
+	// FeesInEscrowKeyPrefix defines the key prefix to store the fees escrowed
+	// for the packets in store
+	FeesInEscrowKeyPrefix = []byte{0x03}
+)
+
+// FeeEnabledKey returns the key of a fee enabled channel.
+func FeeEnabledKey(portID, channelID string) []byte {
+	return append(FeeEnabledKeyPrefix, []byte(fmt.Sprintf("%s/%s", portID, channelID))...)
+}
+
+// CounterpartyAddressKey returns the key of the counterparty address a
+// relayer registered on a channel.
+func CounterpartyAddressKey(address, portID, channelID string) []byte {
+	return append(CounterpartyAddressKeyPrefix, []byte(fmt.Sprintf("%s/%s/%s", address, portID, channelID))...)
+}
+
+// FeesInEscrowChannelPrefix returns the key prefix of the fees escrowed for
+// the packets of a channel.
+func FeesInEscrowChannelPrefix(portID, channelID string) []byte {
+	return append(FeesInEscrowKeyPrefix, []byte(fmt.Sprintf("%s/%s/", portID, channelID))...)
+}
+
+// FeesInEscrowKey returns the key of the fees escrowed for a packet.
+func FeesInEscrowKey(packetID PacketId) []byte {
+	return append(FeesInEscrowChannelPrefix(packetID.PortId, packetID.ChannelId), sdk.Uint64ToBigEndian(packetID.Sequence)...)
+}
+
+// SplitPortChannelKey returns the port and channel ids of a key without its
+// prefix.
+func SplitPortChannelKey(key []byte) (portID, channelID string) {
+	keySplit := strings.SplitN(string(key), "/", 2)
+	if len(keySplit) != 2 {
+		panic(fmt.Sprintf("invalid port and channel key %s", key))
+	}
+
+	return keySplit[0], keySplit[1]
+}
+
+// SplitCounterpartyAddressKey returns the relayer address, port and channel
+// ids of a counterparty address key without its prefix.
+func SplitCounterpartyAddressKey(key []byte) (address, portID, channelID string) {
+	keySplit := strings.SplitN(string(key), "/", 3)
+	if len(keySplit) != 3 {
+		panic(fmt.Sprintf("invalid counterparty address key %s", key))
+	}
+
+	return keySplit[0], keySplit[1], keySplit[2]
+}
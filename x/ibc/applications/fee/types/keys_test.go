@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/fee/types"
+)
+
+func TestSplitPortChannelKey(t *testing.T) {
+	key := types.FeeEnabledKey("transfer", "channel-0")
+
+	portID, channelID := types.SplitPortChannelKey(key[len(types.FeeEnabledKeyPrefix):])
+	require.Equal(t, "transfer", portID)
+	require.Equal(t, "channel-0", channelID)
+
+	require.Panics(t, func() {
+		types.SplitPortChannelKey([]byte("transfer"))
+	})
+}
+
+func TestSplitCounterpartyAddressKey(t *testing.T) {
+	relayer := relayerAddr.String()
+	key := types.CounterpartyAddressKey(relayer, "transfer", "channel-0")
+
+	address, portID, channelID := types.SplitCounterpartyAddressKey(key[len(types.CounterpartyAddressKeyPrefix):])
+	require.Equal(t, relayer, address)
+	require.Equal(t, "transfer", portID)
+	require.Equal(t, "channel-0", channelID)
+
+	require.Panics(t, func() {
+		types.SplitCounterpartyAddressKey([]byte(relayer + "/transfer"))
+	})
+}
+
+func TestFeesInEscrowKey(t *testing.T) {
+	prefix := types.FeesInEscrowChannelPrefix("transfer", "channel-0")
+
+	// the keys of the packets of a channel share its prefix, and are ordered
+	// by sequence
+	key1 := types.FeesInEscrowKey(types.NewPacketId("transfer", "channel-0", 1))
+	key256 := types.FeesInEscrowKey(types.NewPacketId("transfer", "channel-0", 256))
+	require.Equal(t, prefix, key1[:len(prefix)])
+	require.Equal(t, prefix, key256[:len(prefix)])
+	require.Equal(t, -1, bytes.Compare(key1, key256))
+
+	// the prefix of a channel is not a prefix of another channel's keys
+	otherKey := types.FeesInEscrowKey(types.NewPacketId("transfer", "channel-01", 1))
+	require.NotEqual(t, prefix, otherKey[:len(prefix)])
+}
@@ -0,0 +1,177 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+)
+
+// msg types
+const (
+	TypeMsgRegisterCounterpartyAddress = "register_counterparty_address"
+	TypeMsgPayPacketFee                = "pay_packet_fee"
+	TypeMsgPayPacketFeeAsync           = "pay_packet_fee_async"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterCounterpartyAddress{}
+	_ sdk.Msg = &MsgPayPacketFee{}
+	_ sdk.Msg = &MsgPayPacketFeeAsync{}
+)
+
+// NewMsgRegisterCounterpartyAddress creates a new
+// MsgRegisterCounterpartyAddress instance
+//nolint:interfacer
+func NewMsgRegisterCounterpartyAddress(address sdk.AccAddress, counterpartyAddress, portID, channelID string) *MsgRegisterCounterpartyAddress {
+	return &MsgRegisterCounterpartyAddress{
+		Address:             address.String(),
+		CounterpartyAddress: counterpartyAddress,
+		PortId:              portID,
+		ChannelId:           channelID,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgRegisterCounterpartyAddress) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgRegisterCounterpartyAddress) Type() string {
+	return TypeMsgRegisterCounterpartyAddress
+}
+
+// ValidateBasic performs a basic check of the MsgRegisterCounterpartyAddress
+// fields. The counterparty address is not checked further than being non
+// empty, since it is an address of the counterparty chain.
+func (msg MsgRegisterCounterpartyAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if strings.TrimSpace(msg.CounterpartyAddress) == "" {
+		return ErrCounterpartyAddressEmpty
+	}
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg. The function will panic since it is used
+// for amino transaction verification which IBC does not support.
+func (msg MsgRegisterCounterpartyAddress) GetSignBytes() []byte {
+	panic("IBC messages do not support amino")
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRegisterCounterpartyAddress) GetSigners() []sdk.AccAddress {
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{address}
+}
+
+// NewMsgPayPacketFee creates a new MsgPayPacketFee instance
+//nolint:interfacer
+func NewMsgPayPacketFee(fee Fee, sourcePortID, sourceChannelID string, signer sdk.AccAddress) *MsgPayPacketFee {
+	return &MsgPayPacketFee{
+		Fee:             fee,
+		SourcePortId:    sourcePortID,
+		SourceChannelId: sourceChannelID,
+		Signer:          signer.String(),
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgPayPacketFee) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgPayPacketFee) Type() string {
+	return TypeMsgPayPacketFee
+}
+
+// ValidateBasic performs a basic check of the MsgPayPacketFee fields.
+func (msg MsgPayPacketFee) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.SourcePortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Fee.Validate()
+}
+
+// GetSignBytes implements sdk.Msg. The function will panic since it is used
+// for amino transaction verification which IBC does not support.
+func (msg MsgPayPacketFee) GetSignBytes() []byte {
+	panic("IBC messages do not support amino")
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgPayPacketFee) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgPayPacketFeeAsync creates a new MsgPayPacketFeeAsync instance
+//nolint:interfacer
+func NewMsgPayPacketFeeAsync(packetID PacketId, fee Fee, signer sdk.AccAddress) *MsgPayPacketFeeAsync {
+	return &MsgPayPacketFeeAsync{
+		PacketId: packetID,
+		Fee:      fee,
+		Signer:   signer.String(),
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgPayPacketFeeAsync) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgPayPacketFeeAsync) Type() string {
+	return TypeMsgPayPacketFeeAsync
+}
+
+// ValidateBasic performs a basic check of the MsgPayPacketFeeAsync fields.
+func (msg MsgPayPacketFeeAsync) ValidateBasic() error {
+	if err := msg.PacketId.Validate(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Fee.Validate()
+}
+
+// GetSignBytes implements sdk.Msg. The function will panic since it is used
+// for amino transaction verification which IBC does not support.
+func (msg MsgPayPacketFeeAsync) GetSignBytes() []byte {
+	panic("IBC messages do not support amino")
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgPayPacketFeeAsync) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
@@ -0,0 +1,134 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// define constants used for testing
+const (
+	validPort      = "transfer"
+	invalidPort    = "(invalidport1)"
+	validChannel   = "testchannel"
+	invalidChannel = "(invalidchannel1)"
+)
+
+var (
+	addr1     = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2     = sdk.AccAddress("testaddr2")
+	emptyAddr sdk.AccAddress
+
+	coins    = sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(100)))
+	fee      = NewFee(coins, coins, coins)
+	emptyFee = NewFee(sdk.Coins{}, sdk.Coins{}, sdk.Coins{})
+)
+
+// TestMsgRegisterCounterpartyAddressValidation tests ValidateBasic for
+// MsgRegisterCounterpartyAddress
+func TestMsgRegisterCounterpartyAddressValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *MsgRegisterCounterpartyAddress
+		expPass bool
+	}{
+		{"valid msg", NewMsgRegisterCounterpartyAddress(addr1, addr2.String(), validPort, validChannel), true},
+		{"missing address", NewMsgRegisterCounterpartyAddress(emptyAddr, addr2.String(), validPort, validChannel), false},
+		{"empty counterparty address", NewMsgRegisterCounterpartyAddress(addr1, " ", validPort, validChannel), false},
+		{"invalid port id", NewMsgRegisterCounterpartyAddress(addr1, addr2.String(), invalidPort, validChannel), false},
+		{"invalid channel id", NewMsgRegisterCounterpartyAddress(addr1, addr2.String(), validPort, invalidChannel), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMsgRegisterCounterpartyAddressGetSigners tests GetSigners for
+// MsgRegisterCounterpartyAddress
+func TestMsgRegisterCounterpartyAddressGetSigners(t *testing.T) {
+	msg := NewMsgRegisterCounterpartyAddress(addr1, addr2.String(), validPort, validChannel)
+
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgRegisterCounterpartyAddress, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+}
+
+// TestMsgPayPacketFeeValidation tests ValidateBasic for MsgPayPacketFee
+func TestMsgPayPacketFeeValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *MsgPayPacketFee
+		expPass bool
+	}{
+		{"valid msg", NewMsgPayPacketFee(fee, validPort, validChannel, addr1), true},
+		{"valid msg with recv fee only", NewMsgPayPacketFee(NewFee(coins, nil, nil), validPort, validChannel, addr1), true},
+		{"invalid source port id", NewMsgPayPacketFee(fee, invalidPort, validChannel, addr1), false},
+		{"invalid source channel id", NewMsgPayPacketFee(fee, validPort, invalidChannel, addr1), false},
+		{"missing signer address", NewMsgPayPacketFee(fee, validPort, validChannel, emptyAddr), false},
+		{"empty fee", NewMsgPayPacketFee(emptyFee, validPort, validChannel, addr1), false},
+		{"invalid fee", NewMsgPayPacketFee(NewFee(sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}}, nil, nil), validPort, validChannel, addr1), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMsgPayPacketFeeGetSigners tests GetSigners for MsgPayPacketFee
+func TestMsgPayPacketFeeGetSigners(t *testing.T) {
+	msg := NewMsgPayPacketFee(fee, validPort, validChannel, addr1)
+
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgPayPacketFee, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+}
+
+// TestMsgPayPacketFeeAsyncValidation tests ValidateBasic for
+// MsgPayPacketFeeAsync
+func TestMsgPayPacketFeeAsyncValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *MsgPayPacketFeeAsync
+		expPass bool
+	}{
+		{"valid msg", NewMsgPayPacketFeeAsync(NewPacketId(validPort, validChannel, 1), fee, addr1), true},
+		{"invalid port id", NewMsgPayPacketFeeAsync(NewPacketId(invalidPort, validChannel, 1), fee, addr1), false},
+		{"invalid channel id", NewMsgPayPacketFeeAsync(NewPacketId(validPort, invalidChannel, 1), fee, addr1), false},
+		{"zero sequence", NewMsgPayPacketFeeAsync(NewPacketId(validPort, validChannel, 0), fee, addr1), false},
+		{"missing signer address", NewMsgPayPacketFeeAsync(NewPacketId(validPort, validChannel, 1), fee, emptyAddr), false},
+		{"empty fee", NewMsgPayPacketFeeAsync(NewPacketId(validPort, validChannel, 1), emptyFee, addr1), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMsgPayPacketFeeAsyncGetSigners tests GetSigners for
+// MsgPayPacketFeeAsync
+func TestMsgPayPacketFeeAsyncGetSigners(t *testing.T) {
+	msg := NewMsgPayPacketFeeAsync(NewPacketId(validPort, validChannel, 1), fee, addr1)
+
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgPayPacketFeeAsync, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+}
@@ -0,0 +1,182 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/interchain-accounts/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	channelutils "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/client/utils"
+)
+
+const (
+	flagPacketTimeoutHeight    = "packet-timeout-height"
+	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagAbsoluteTimeouts       = "absolute-timeouts"
+)
+
+// NewRegisterInterchainAccountTxCmd returns the command to create a
+// MsgRegisterInterchainAccount transaction
+func NewRegisterInterchainAccountTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [connection-id]",
+		Short: "Register an interchain account on the host chain of a connection",
+		Long: strings.TrimSpace(`Register an interchain account on the host chain of a connection. The controller
+port of the sender is bound, and the interchain account is created once a relayer opens an ordered
+channel from it to the host port of the counterparty chain.`),
+		Example: fmt.Sprintf("%s tx interchain-accounts register [connection-id]", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterInterchainAccount(clientCtx.GetFromAddress(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSubmitTxCmd returns the command to create a MsgSubmitTx transaction
+func NewSubmitTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-tx [connection-id] [path/to/tx.json]",
+		Short: "Submit a tx to be executed by an interchain account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a tx to be executed by the interchain account of the sender on the host chain
+of a connection. The messages of the tx are given in a JSON file in their protobuf JSON encoding,
+and must be signed by the interchain account. Timeouts can be specified as absolute or relative
+using the "absolute-timeouts" flag. Relative timeouts are added to the block height and block
+timestamp queried from the latest consensus state corresponding to the active channel of the
+interchain account. Any timeout set to 0 is disabled.
+
+Example:
+$ %s tx interchain-accounts submit-tx [connection-id] [path/to/tx.json] --from=[owner]
+
+Where tx.json contains:
+
+{
+  "messages": [
+    {
+      "@type": "/cosmos.bank.v1beta1.MsgSend",
+      "from_address": "cosmos1...",
+      "to_address": "cosmos1...",
+      "amount": [{"denom": "stake", "amount": "10"}]
+    }
+  ]
+}
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+			connectionID := args[0]
+
+			contents, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var cosmosTx types.CosmosTx
+			if err := clientCtx.JSONMarshaler.UnmarshalJSON(contents, &cosmosTx); err != nil {
+				return fmt.Errorf("invalid tx messages: %w", err)
+			}
+
+			msgs, err := cosmosTx.GetMsgs()
+			if err != nil {
+				return err
+			}
+
+			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+			if err != nil {
+				return err
+			}
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+			if err != nil {
+				return err
+			}
+
+			// if the timeouts are not absolute, retrieve latest block height and block timestamp
+			// for the consensus state connected to the active channel of the interchain account
+			if !absoluteTimeouts {
+				res, err := types.NewQueryClient(clientCtx).InterchainAccount(context.Background(), &types.QueryInterchainAccountRequest{
+					Owner:        owner.String(),
+					ConnectionId: connectionID,
+				})
+				if err != nil {
+					return err
+				}
+				if res.ChannelId == "" {
+					return fmt.Errorf("no active channel for the interchain account of %s on connection %s", owner, connectionID)
+				}
+
+				consensusState, height, _, err := channelutils.QueryLatestConsensusState(clientCtx, types.ControllerPortID(owner), res.ChannelId)
+				if err != nil {
+					return err
+				}
+
+				if !timeoutHeight.IsZero() {
+					absoluteHeight := height
+					absoluteHeight.VersionNumber += timeoutHeight.VersionNumber
+					absoluteHeight.VersionHeight += timeoutHeight.VersionHeight
+					timeoutHeight = absoluteHeight
+				}
+
+				if timeoutTimestamp != 0 {
+					timeoutTimestamp = consensusState.GetTimestamp() + timeoutTimestamp
+				}
+			}
+
+			msg, err := types.NewMsgSubmitTx(owner, connectionID, msgs, timeoutHeight, timeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPacketTimeoutHeight, types.DefaultRelativePacketTimeoutHeight, "Packet timeout block height. The timeout is disabled when set to 0-0.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes. The timeout is disabled when set to 0.")
+	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/interchain-accounts/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/core/03-connection/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+)
+
+// InitInterchainAccount binds the controller port of an owner, unless it
+// is already bound, so that a relayer can open the ordered channel over which
+// the interchain account of the owner is created on the host chain of a
+// connection. It returns the controller port.
+func (k Keeper) InitInterchainAccount(ctx sdk.Context, owner sdk.AccAddress, connectionID string) (string, error) {
+	if !k.GetControllerEnabled(ctx) {
+		return "", types.ErrControllerDisabled
+	}
+
+	if _, found := k.connectionKeeper.GetConnection(ctx, connectionID); !found {
+		return "", sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, connectionID)
+	}
+
+	portID := types.ControllerPortID(owner)
+	if channelID, found := k.GetActiveChannelID(ctx, connectionID, portID); found {
+		return "", sdkerrors.Wrapf(types.ErrActiveChannelAlreadySet, "port %s has active channel %s on connection %s", portID, channelID, connectionID)
+	}
+
+	if !k.IsBound(ctx, portID) {
+		if err := k.BindPort(ctx, portID); err != nil {
+			return "", err
+		}
+		k.SetPort(ctx, portID)
+	}
+
+	return portID, nil
+}
+
+// SendTx sends a tx of msgs, to be executed by the interchain account of an
+// owner on the host chain of a connection, over the active channel of the
+// owner. It returns the sequence of the packet sent.
+func (k Keeper) SendTx(
+	ctx sdk.Context, owner sdk.AccAddress, connectionID string, msgs []*codectypes.Any,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+) (uint64, error) {
+	if !k.GetControllerEnabled(ctx) {
+		return 0, types.ErrControllerDisabled
+	}
+
+	portID := types.ControllerPortID(owner)
+	channelID, found := k.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrActiveChannelNotFound, "port %s on connection %s", portID, connectionID)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	// get the next sequence
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return 0, sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", portID, channelID,
+		)
+	}
+
+	// begin createOutgoingPacket logic
+	// See spec for this logic: https://github.com/cosmos/ics/tree/master/spec/ics-027-interchain-accounts
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	data, err := k.cdc.MarshalBinaryBare(&types.CosmosTx{Messages: msgs})
+	if err != nil {
+		return 0, err
+	}
+
+	packetData := types.NewInterchainAccountPacketData(types.EXECUTE_TX, data)
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		sequence,
+		portID,
+		channelID,
+		channel.Counterparty.PortId,
+		channel.Counterparty.ChannelId,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.channelKeeper.SendPacket(ctx, channelCap, packet); err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+// OnChanOpenInit validates a channel opened by a controller port to the host
+// port of the counterparty chain, and claims its capability.
+func (k Keeper) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	if !k.GetControllerEnabled(ctx) {
+		return types.ErrControllerDisabled
+	}
+
+	if order != channeltypes.ORDERED {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s ", channeltypes.ORDERED, order)
+	}
+
+	if !types.IsControllerPort(portID) {
+		return sdkerrors.Wrapf(types.ErrInvalidControllerPort, "expected %s{owner}, got %s", types.ControllerPortPrefix, portID)
+	}
+
+	if counterparty.PortId != types.HostPortID {
+		return sdkerrors.Wrapf(types.ErrInvalidHostPort, "expected %s, got %s", types.HostPortID, counterparty.PortId)
+	}
+
+	if version != types.Version {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	}
+
+	if activeChannelID, found := k.GetActiveChannelID(ctx, connectionHops[0], portID); found {
+		return sdkerrors.Wrapf(types.ErrActiveChannelAlreadySet, "port %s has active channel %s on connection %s", portID, activeChannelID, connectionHops[0])
+	}
+
+	// Claim channel capability passed back by IBC module
+	return k.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID))
+}
+
+// OnChanOpenAck stores the address of the interchain account the host chain
+// created, which it sent in its version, and makes the channel the active
+// channel of the controller port on its connection.
+func (k Keeper) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyVersion string,
+) error {
+	address, err := types.ParseHostVersion(counterpartyVersion)
+	if err != nil {
+		return err
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	connectionID := channel.ConnectionHops[0]
+	if activeChannelID, found := k.GetActiveChannelID(ctx, connectionID, portID); found {
+		return sdkerrors.Wrapf(types.ErrActiveChannelAlreadySet, "port %s has active channel %s on connection %s", portID, activeChannelID, connectionID)
+	}
+
+	k.SetActiveChannelID(ctx, connectionID, portID, channelID)
+	k.SetInterchainAccountAddress(ctx, connectionID, portID, address)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterInterchainAccount,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyAccountAddress, address),
+		),
+	)
+
+	return nil
+}
+
+// OnChanClosed removes a closed channel of a controller port from the active
+// channels, so that the owner can open a new one.
+func (k Keeper) OnChanClosed(ctx sdk.Context, portID, channelID string) {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	connectionID := channel.ConnectionHops[0]
+	if activeChannelID, found := k.GetActiveChannelID(ctx, connectionID, portID); found && activeChannelID == channelID {
+		k.DeleteActiveChannelID(ctx, connectionID, portID)
+	}
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+// InitGenesis initializes the ibc interchain accounts state and binds to the
+// host port and to the controller ports.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	// Only try to bind to a port if it is not already bound, since we may
+	// already own port capability from capability InitGenesis
+	for _, portID := range append([]string{types.HostPortID}, state.Ports...) {
+		if !k.IsBound(ctx, portID) {
+			err := k.BindPort(ctx, portID)
+			if err != nil {
+				panic(fmt.Sprintf("could not claim port capability: %v", err))
+			}
+		}
+	}
+
+	for _, portID := range state.Ports {
+		k.SetPort(ctx, portID)
+	}
+
+	for _, channel := range state.ActiveChannels {
+		k.SetActiveChannelID(ctx, channel.ConnectionId, channel.PortId, channel.ChannelId)
+	}
+
+	for _, account := range state.InterchainAccounts {
+		k.SetInterchainAccountAddress(ctx, account.ConnectionId, account.PortId, account.AccountAddress)
+	}
+
+	for _, account := range state.HostAccounts {
+		k.SetHostAccountAddress(ctx, account.ConnectionId, account.PortId, account.AccountAddress)
+	}
+
+	k.SetParams(ctx, state.Params)
+}
+
+// ExportGenesis exports ibc interchain accounts module's ports, active
+// channels and interchain accounts into its genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(
+		k.GetAllPorts(ctx),
+		k.GetAllActiveChannels(ctx),
+		k.GetAllInterchainAccounts(ctx),
+		k.GetAllHostAccounts(ctx),
+		k.GetParams(ctx),
+	)
+}
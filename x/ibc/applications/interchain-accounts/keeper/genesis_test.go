@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/interchain-accounts/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	channelA, _ := suite.registerInterchainAccount(connA, connB)
+	address := types.GenerateAddress(connB.ID, channelA.PortID).String()
+
+	genesis := suite.chainA.App.ICAKeeper.ExportGenesis(suite.chainA.GetContext())
+
+	suite.Require().Equal([]string{channelA.PortID}, genesis.Ports)
+	suite.Require().Equal([]types.ActiveChannel{{ConnectionId: connA.ID, PortId: channelA.PortID, ChannelId: channelA.ID}}, genesis.ActiveChannels)
+	suite.Require().Equal([]types.RegisteredInterchainAccount{{ConnectionId: connA.ID, PortId: channelA.PortID, AccountAddress: address}}, genesis.InterchainAccounts)
+	suite.Require().Empty(genesis.HostAccounts)
+	suite.Require().NoError(genesis.Validate())
+
+	suite.Require().NotPanics(func() {
+		suite.chainA.App.ICAKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
+	})
+
+	genesis = suite.chainB.App.ICAKeeper.ExportGenesis(suite.chainB.GetContext())
+
+	suite.Require().Empty(genesis.Ports)
+	suite.Require().Empty(genesis.ActiveChannels)
+	suite.Require().Empty(genesis.InterchainAccounts)
+	suite.Require().Equal([]types.RegisteredInterchainAccount{{ConnectionId: connB.ID, PortId: channelA.PortID, AccountAddress: address}}, genesis.HostAccounts)
+	suite.Require().NoError(genesis.Validate())
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/interchain-accounts/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+)
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
+	var (
+		req         *types.QueryInterchainAccountRequest
+		expResponse *types.QueryInterchainAccountResponse
+	)
+
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	channelA, _ := suite.registerInterchainAccount(connA, connB)
+	owner := suite.chainA.SenderAccount.GetAddress()
+	address := types.GenerateAddress(connB.ID, channelA.PortID).String()
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid owner",
+			func() {
+				req = &types.QueryInterchainAccountRequest{Owner: "owner", ConnectionId: connA.ID}
+			},
+			false,
+		},
+		{
+			"invalid connection ID",
+			func() {
+				req = &types.QueryInterchainAccountRequest{Owner: owner.String(), ConnectionId: ""}
+			},
+			false,
+		},
+		{
+			"not found",
+			func() {
+				req = &types.QueryInterchainAccountRequest{Owner: sdk.AccAddress([]byte("owner")).String(), ConnectionId: connA.ID}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				req = &types.QueryInterchainAccountRequest{Owner: owner.String(), ConnectionId: connA.ID}
+				expResponse = &types.QueryInterchainAccountResponse{Address: address, ChannelId: channelA.ID}
+			},
+			true,
+		},
+		{
+			"success without active channel",
+			func() {
+				suite.chainA.App.ICAKeeper.DeleteActiveChannelID(suite.chainA.GetContext(), connA.ID, channelA.PortID)
+				req = &types.QueryInterchainAccountRequest{Owner: owner.String(), ConnectionId: connA.ID}
+				expResponse = &types.QueryInterchainAccountResponse{Address: address}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.App.ICAKeeper.InterchainAccount(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResponse, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	expParams := types.DefaultParams()
+	res, _ := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().Equal(&expParams, res.Params)
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/interchain-accounts/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+)
+
+// OnChanOpenTry validates a channel opened by a controller port of the
+// counterparty chain to the host port, claims its capability and creates the
+// interchain account of the controller port, unless it already exists. The
+// version of the channel must carry the address of the interchain account.
+func (k Keeper) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version,
+	counterpartyVersion string,
+) error {
+	if !k.GetHostEnabled(ctx) {
+		return types.ErrHostDisabled
+	}
+
+	if order != channeltypes.ORDERED {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s ", channeltypes.ORDERED, order)
+	}
+
+	if portID != types.HostPortID {
+		return sdkerrors.Wrapf(types.ErrInvalidHostPort, "expected %s, got %s", types.HostPortID, portID)
+	}
+
+	if !types.IsControllerPort(counterparty.PortId) {
+		return sdkerrors.Wrapf(types.ErrInvalidControllerPort, "expected %s{owner}, got %s", types.ControllerPortPrefix, counterparty.PortId)
+	}
+
+	if counterpartyVersion != types.Version {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s", counterpartyVersion, types.Version)
+	}
+
+	connectionID := connectionHops[0]
+	address := types.GenerateAddress(connectionID, counterparty.PortId)
+	if expVersion := types.NewHostVersion(address.String()); version != expVersion {
+		return sdkerrors.Wrapf(types.ErrInvalidVersion, "got: %s, expected %s", version, expVersion)
+	}
+
+	// Claim channel capability passed back by IBC module
+	if err := k.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return err
+	}
+
+	// the interchain account is kept when its channel is closed, so that a
+	// new channel opened by the same controller port reuses it
+	if _, found := k.GetHostAccountAddress(ctx, connectionID, counterparty.PortId); found {
+		return nil
+	}
+
+	if k.authKeeper.GetAccount(ctx, address) == nil {
+		k.authKeeper.SetAccount(ctx, k.authKeeper.NewAccountWithAddress(ctx, address))
+	}
+	k.SetHostAccountAddress(ctx, connectionID, counterparty.PortId, address.String())
+
+	return nil
+}
+
+// OnRecvPacket executes the tx of a packet sent by a controller port of the
+// counterparty chain with the interchain account of the controller port. It
+// returns the responses of the msgs of the tx, encoded as sdk.TxMsgData. The
+// msgs must be allowed by the AllowMessages param, and be signed by the
+// interchain account only. The tx is executed atomically: none of its msgs is
+// applied if one of them fails.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+	if !k.GetHostEnabled(ctx) {
+		return nil, types.ErrHostDisabled
+	}
+
+	var data types.InterchainAccountPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPacketData, "cannot unmarshal ICS-27 interchain accounts packet data: %s", err.Error())
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	switch data.Type {
+	case types.EXECUTE_TX:
+		var tx types.CosmosTx
+		if err := k.cdc.UnmarshalBinaryBare(data.Data, &tx); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidPacketData, "cannot unmarshal interchain accounts tx: %s", err.Error())
+		}
+
+		msgs, err := tx.GetMsgs()
+		if err != nil {
+			return nil, err
+		}
+
+		channel, found := k.channelKeeper.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+		if !found {
+			return nil, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", packet.GetDestPort(), packet.GetDestChannel())
+		}
+
+		address, found := k.GetHostAccountAddress(ctx, channel.ConnectionHops[0], packet.GetSourcePort())
+		if !found {
+			return nil, sdkerrors.Wrapf(types.ErrInterchainAccountNotFound, "port %s on connection %s", packet.GetSourcePort(), channel.ConnectionHops[0])
+		}
+
+		return k.executeTx(ctx, address, msgs)
+
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrUnsupportedPacketType, "%s", data.Type)
+	}
+}
+
+func (k Keeper) executeTx(ctx sdk.Context, address string, msgs []sdk.Msg) ([]byte, error) {
+	params := k.GetParams(ctx)
+	for i, msg := range msgs {
+		if !params.IsMessageAllowed(sdk.MsgTypeURL(msg)) {
+			return nil, sdkerrors.Wrapf(types.ErrMessageNotAllowed, "%s; message index: %d", sdk.MsgTypeURL(msg), i)
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, sdkerrors.Wrapf(err, "message index: %d", i)
+		}
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 || signers[0].String() != address {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message %d must be signed by the interchain account %s only", i, address)
+		}
+	}
+
+	// the msgs are executed in a cache context so that their state changes
+	// and events are discarded if one of them fails
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	txMsgData := &sdk.TxMsgData{
+		Data: make([]*sdk.MsgData, len(msgs)),
+	}
+	for i, msg := range msgs {
+		handler := k.msgRouter.HandlerByTypeURL(sdk.MsgTypeURL(msg))
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message type: %s; message index: %d", sdk.MsgTypeURL(msg), i)
+		}
+
+		res, err := handler(cacheCtx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		txMsgData.Data[i] = &sdk.MsgData{MsgType: sdk.MsgTypeURL(msg), Data: res.Data}
+
+		// emit the events of the executed message
+		events := make(sdk.Events, len(res.Events))
+		for j, e := range res.Events {
+			events[j] = sdk.Event(e)
+		}
+		cacheCtx.EventManager().EmitEvents(events)
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return k.cdc.MarshalBinaryBare(txMsgData)
+}
@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/interchain-accounts/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper defines the IBC interchain accounts keeper. It implements both the
+// controller side, which registers interchain accounts on host chains and
+// sends them txs, and the host side, which creates the interchain accounts of
+// controller chains and executes their txs.
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.Marshaler
+	paramSpace paramtypes.Subspace
+
+	channelKeeper    types.ChannelKeeper
+	connectionKeeper types.ConnectionKeeper
+	portKeeper       types.PortKeeper
+	authKeeper       types.AccountKeeper
+	scopedKeeper     capabilitykeeper.ScopedKeeper
+	msgRouter        *baseapp.MsgServiceRouter
+}
+
+// NewKeeper creates a new IBC interchain accounts Keeper instance. The msgs
+// of the interchain accounts hosted on this chain are routed through the
+// given MsgServiceRouter.
+func NewKeeper(
+	cdc codec.Marshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	channelKeeper types.ChannelKeeper, connectionKeeper types.ConnectionKeeper, portKeeper types.PortKeeper,
+	authKeeper types.AccountKeeper, scopedKeeper capabilitykeeper.ScopedKeeper, msgRouter *baseapp.MsgServiceRouter,
+) Keeper {
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		paramSpace:       paramSpace,
+		channelKeeper:    channelKeeper,
+		connectionKeeper: connectionKeeper,
+		portKeeper:       portKeeper,
+		authKeeper:       authKeeper,
+		scopedKeeper:     scopedKeeper,
+		msgRouter:        msgRouter,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, types.ModuleName))
+}
+
+// IsBound checks if the interchain accounts module is already bound to the
+// desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort defines a wrapper function for the port Keeper's function in
+// order to expose it to module's InitGenesis function
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	cap := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, cap, host.PortPath(portID))
+}
+
+// HasPort returns whether a controller port is stored.
+func (k Keeper) HasPort(ctx sdk.Context, portID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.PortKey(portID))
+}
+
+// SetPort stores a controller port, so that it is bound again on genesis
+// import.
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PortKey(portID), []byte{0x01})
+}
+
+// GetAllPorts returns the stored controller ports.
+func (k Keeper) GetAllPorts(ctx sdk.Context) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PortKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ports := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		ports = append(ports, string(iterator.Key()))
+	}
+
+	return ports
+}
+
+// GetActiveChannelID returns the active channel of a controller port on a
+// connection.
+func (k Keeper) GetActiveChannelID(ctx sdk.Context, connectionID, portID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ActiveChannelKey(connectionID, portID))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// SetActiveChannelID sets the active channel of a controller port on a
+// connection.
+func (k Keeper) SetActiveChannelID(ctx sdk.Context, connectionID, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ActiveChannelKey(connectionID, portID), []byte(channelID))
+}
+
+// DeleteActiveChannelID deletes the active channel of a controller port on a
+// connection.
+func (k Keeper) DeleteActiveChannelID(ctx sdk.Context, connectionID, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ActiveChannelKey(connectionID, portID))
+}
+
+// GetAllActiveChannels returns the active channels of the controller ports.
+func (k Keeper) GetAllActiveChannels(ctx sdk.Context) []types.ActiveChannel {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ActiveChannelKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	activeChannels := []types.ActiveChannel{}
+	for ; iterator.Valid(); iterator.Next() {
+		connectionID, portID := types.SplitConnectionPortKey(iterator.Key())
+		activeChannels = append(activeChannels, types.ActiveChannel{
+			ConnectionId: connectionID,
+			PortId:       portID,
+			ChannelId:    string(iterator.Value()),
+		})
+	}
+
+	return activeChannels
+}
+
+// GetInterchainAccountAddress returns the address of the interchain account
+// of a controller port on the host chain of a connection.
+func (k Keeper) GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.InterchainAccountKey(connectionID, portID))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// SetInterchainAccountAddress sets the address of the interchain account of a
+// controller port on the host chain of a connection.
+func (k Keeper) SetInterchainAccountAddress(ctx sdk.Context, connectionID, portID, address string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.InterchainAccountKey(connectionID, portID), []byte(address))
+}
+
+// GetAllInterchainAccounts returns the interchain accounts of the controller
+// ports.
+func (k Keeper) GetAllInterchainAccounts(ctx sdk.Context) []types.RegisteredInterchainAccount {
+	return k.getAllAccounts(ctx, types.InterchainAccountKeyPrefix)
+}
+
+// GetHostAccountAddress returns the address of the interchain account hosted
+// on this chain for a controller port of the counterparty chain of a
+// connection.
+func (k Keeper) GetHostAccountAddress(ctx sdk.Context, connectionID, controllerPortID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.HostAccountKey(connectionID, controllerPortID))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// SetHostAccountAddress sets the address of the interchain account hosted on
+// this chain for a controller port of the counterparty chain of a connection.
+func (k Keeper) SetHostAccountAddress(ctx sdk.Context, connectionID, controllerPortID, address string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.HostAccountKey(connectionID, controllerPortID), []byte(address))
+}
+
+// GetAllHostAccounts returns the interchain accounts hosted on this chain.
+func (k Keeper) GetAllHostAccounts(ctx sdk.Context) []types.RegisteredInterchainAccount {
+	return k.getAllAccounts(ctx, types.HostAccountKeyPrefix)
+}
+
+func (k Keeper) getAllAccounts(ctx sdk.Context, keyPrefix []byte) []types.RegisteredInterchainAccount {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	accounts := []types.RegisteredInterchainAccount{}
+	for ; iterator.Valid(); iterator.Next() {
+		connectionID, portID := types.SplitConnectionPortKey(iterator.Key())
+		accounts = append(accounts, types.RegisteredInterchainAccount{
+			ConnectionId:   connectionID,
+			PortId:         portID,
+			AccountAddress: string(iterator.Value()),
+		})
+	}
+
+	return accounts
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
+}
+
+// ClaimCapability allows the interchain accounts module to claim a capability
+// that IBC module passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
@@ -0,0 +1,295 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/interchain-accounts/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.App.ICAKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+// registerInterchainAccount registers the interchain account of the sender of
+// chainA on the host chainB of a connection, and opens the channel of its
+// controller port to the host port.
+func (suite *KeeperTestSuite) registerInterchainAccount(connA, connB *ibctesting.TestConnection) (ibctesting.TestChannel, ibctesting.TestChannel) {
+	owner := suite.chainA.SenderAccount.GetAddress()
+	_, err := suite.chainA.SendMsgs(types.NewMsgRegisterInterchainAccount(owner, connA.ID))
+	suite.Require().NoError(err)
+
+	portID := types.ControllerPortID(owner)
+	connA.NextChannelVersion = types.Version
+	connB.NextChannelVersion = types.NewHostVersion(types.GenerateAddress(connB.ID, portID).String())
+
+	channelA, channelB, err := suite.coordinator.ChanOpenInit(suite.chainA, suite.chainB, connA, connB, portID, types.HostPortID, channeltypes.ORDERED)
+	suite.Require().NoError(err)
+
+	err = suite.coordinator.ChanOpenTry(suite.chainB, suite.chainA, channelB, channelA, connB, channeltypes.ORDERED)
+	suite.Require().NoError(err)
+
+	err = suite.coordinator.ChanOpenAck(suite.chainA, suite.chainB, channelA, channelB)
+	suite.Require().NoError(err)
+
+	err = suite.coordinator.ChanOpenConfirm(suite.chainB, suite.chainA, channelB, channelA)
+	suite.Require().NoError(err)
+
+	return channelA, channelB
+}
+
+func (suite *KeeperTestSuite) TestRegisterInterchainAccount() {
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	channelA, channelB := suite.registerInterchainAccount(connA, connB)
+
+	portID := types.ControllerPortID(suite.chainA.SenderAccount.GetAddress())
+	expAddress := types.GenerateAddress(connB.ID, portID)
+
+	// the interchain account is created on the host chain
+	suite.Require().NotNil(suite.chainB.App.AccountKeeper.GetAccount(suite.chainB.GetContext(), expAddress))
+	address, found := suite.chainB.App.ICAKeeper.GetHostAccountAddress(suite.chainB.GetContext(), connB.ID, portID)
+	suite.Require().True(found)
+	suite.Require().Equal(expAddress.String(), address)
+
+	// and its address is known to the controller chain
+	address, found = suite.chainA.App.ICAKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), connA.ID, portID)
+	suite.Require().True(found)
+	suite.Require().Equal(expAddress.String(), address)
+
+	channelID, found := suite.chainA.App.ICAKeeper.GetActiveChannelID(suite.chainA.GetContext(), connA.ID, portID)
+	suite.Require().True(found)
+	suite.Require().Equal(channelA.ID, channelID)
+	suite.Require().Equal(channeltypes.OPEN, suite.chainB.GetChannel(channelB).State)
+
+	// the owner can't register again while the channel is active
+	_, err := suite.chainA.App.ICAKeeper.InitInterchainAccount(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), connA.ID)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestOnChanOpenInit() {
+	var (
+		channel     *channeltypes.Channel
+		testChannel ibctesting.TestChannel
+		connA       *ibctesting.TestConnection
+		chanCap     *capabilitytypes.Capability
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"invalid order - UNORDERED", func() {
+				channel.Ordering = channeltypes.UNORDERED
+			}, false,
+		},
+		{
+			"invalid port ID", func() {
+				testChannel = connA.NextTestChannel(ibctesting.MockPort)
+			}, false,
+		},
+		{
+			"invalid counterparty port ID", func() {
+				channel.Counterparty.PortId = ibctesting.TransferPort
+			}, false,
+		},
+		{
+			"invalid version", func() {
+				channel.Version = "version"
+			}, false,
+		},
+		{
+			"controller disabled", func() {
+				suite.chainA.App.ICAKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, true, nil))
+			}, false,
+		},
+		{
+			"active channel already set", func() {
+				suite.chainA.App.ICAKeeper.SetActiveChannelID(suite.chainA.GetContext(), connA.ID, testChannel.PortID, "channel-7")
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			_, _, connA, _ = suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+			testChannel = connA.NextTestChannel(types.ControllerPortID(suite.chainA.SenderAccount.GetAddress()))
+			channel = &channeltypes.Channel{
+				State:          channeltypes.INIT,
+				Ordering:       channeltypes.ORDERED,
+				Counterparty:   channeltypes.NewCounterparty(types.HostPortID, ""),
+				ConnectionHops: []string{connA.ID},
+				Version:        types.Version,
+			}
+
+			var err error
+			chanCap, err = suite.chainA.App.ScopedIBCKeeper.NewCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(testChannel.PortID, testChannel.ID))
+			suite.Require().NoError(err)
+
+			tc.malleate() // explicitly change fields in channel and testChannel
+
+			err = suite.chainA.App.ICAKeeper.OnChanOpenInit(suite.chainA.GetContext(), channel.Ordering, channel.GetConnectionHops(),
+				testChannel.PortID, testChannel.ID, chanCap, channel.Counterparty, channel.GetVersion(),
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnChanOpenTry() {
+	var (
+		channel             *channeltypes.Channel
+		testChannel         ibctesting.TestChannel
+		connB               *ibctesting.TestConnection
+		chanCap             *capabilitytypes.Capability
+		counterpartyVersion string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"invalid order - UNORDERED", func() {
+				channel.Ordering = channeltypes.UNORDERED
+			}, false,
+		},
+		{
+			"invalid port ID", func() {
+				testChannel = connB.NextTestChannel(ibctesting.MockPort)
+			}, false,
+		},
+		{
+			"invalid counterparty port ID", func() {
+				channel.Counterparty.PortId = ibctesting.TransferPort
+			}, false,
+		},
+		{
+			"invalid counterparty version", func() {
+				counterpartyVersion = "version"
+			}, false,
+		},
+		{
+			"version without interchain account address", func() {
+				channel.Version = types.Version
+			}, false,
+		},
+		{
+			"version with another interchain account address", func() {
+				channel.Version = types.NewHostVersion(types.GenerateAddress(connB.ID, types.ControllerPortPrefix+"other").String())
+			}, false,
+		},
+		{
+			"host disabled", func() {
+				suite.chainB.App.ICAKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false, nil))
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			_, _, _, connB = suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+			controllerPortID := types.ControllerPortID(suite.chainA.SenderAccount.GetAddress())
+			address := types.GenerateAddress(connB.ID, controllerPortID)
+			testChannel = connB.NextTestChannel(types.HostPortID)
+			channel = &channeltypes.Channel{
+				State:          channeltypes.TRYOPEN,
+				Ordering:       channeltypes.ORDERED,
+				Counterparty:   channeltypes.NewCounterparty(controllerPortID, "channel-0"),
+				ConnectionHops: []string{connB.ID},
+				Version:        types.NewHostVersion(address.String()),
+			}
+			counterpartyVersion = types.Version
+
+			var err error
+			chanCap, err = suite.chainB.App.ScopedIBCKeeper.NewCapability(suite.chainB.GetContext(), host.ChannelCapabilityPath(testChannel.PortID, testChannel.ID))
+			suite.Require().NoError(err)
+
+			tc.malleate() // explicitly change fields in channel and testChannel
+
+			err = suite.chainB.App.ICAKeeper.OnChanOpenTry(suite.chainB.GetContext(), channel.Ordering, channel.GetConnectionHops(),
+				testChannel.PortID, testChannel.ID, chanCap, channel.Counterparty, channel.GetVersion(), counterpartyVersion,
+			)
+
+			hostAddress, found := suite.chainB.App.ICAKeeper.GetHostAccountAddress(suite.chainB.GetContext(), connB.ID, controllerPortID)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(address.String(), hostAddress)
+				suite.Require().NotNil(suite.chainB.App.AccountKeeper.GetAccount(suite.chainB.GetContext(), address))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetAllActiveChannels() {
+	ctx := suite.chainA.GetContext()
+	owner := sdk.AccAddress([]byte("owner"))
+	portID := types.ControllerPortID(owner)
+
+	suite.chainA.App.ICAKeeper.SetActiveChannelID(ctx, "connection-1", portID, "channel-1")
+	suite.chainA.App.ICAKeeper.SetActiveChannelID(ctx, "connection-0", portID, "channel-0")
+
+	expChannels := []types.ActiveChannel{
+		{ConnectionId: "connection-0", PortId: portID, ChannelId: "channel-0"},
+		{ConnectionId: "connection-1", PortId: portID, ChannelId: "channel-1"},
+	}
+	suite.Require().Equal(expChannels, suite.chainA.App.ICAKeeper.GetAllActiveChannels(ctx))
+
+	suite.chainA.App.ICAKeeper.DeleteActiveChannelID(ctx, "connection-0", portID)
+	suite.Require().Equal(expChannels[1:], suite.chainA.App.ICAKeeper.GetAllActiveChannels(ctx))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
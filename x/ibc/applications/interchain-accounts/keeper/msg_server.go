@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// RegisterInterchainAccount defines a rpc handler method for
+// MsgRegisterInterchainAccount.
+func (k Keeper) RegisterInterchainAccount(goCtx context.Context, msg *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	portID, err := k.InitInterchainAccount(ctx, owner, msg.ConnectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("IBC interchain account port bound", "owner", msg.Owner, "connection-id", msg.ConnectionId, "port-id", portID)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, msg.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+		),
+	})
+
+	return &types.MsgRegisterInterchainAccountResponse{PortId: portID}, nil
+}
+
+// SubmitTx defines a rpc handler method for MsgSubmitTx.
+func (k Keeper) SubmitTx(goCtx context.Context, msg *types.MsgSubmitTx) (*types.MsgSubmitTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, err := k.SendTx(ctx, owner, msg.ConnectionId, msg.Msgs, msg.TimeoutHeight, msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSubmitTx,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, msg.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", sequence)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+
+	return &types.MsgSubmitTxResponse{Sequence: sequence}, nil
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+// GetControllerEnabled retrieves the controller enabled boolean from the
+// paramstore
+func (k Keeper) GetControllerEnabled(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.Get(ctx, types.KeyControllerEnabled, &res)
+	return res
+}
+
+// GetHostEnabled retrieves the host enabled boolean from the paramstore
+func (k Keeper) GetHostEnabled(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.Get(ctx, types.KeyHostEnabled, &res)
+	return res
+}
+
+// GetAllowMessages retrieves the allowed msg type URLs from the paramstore
+func (k Keeper) GetAllowMessages(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.Get(ctx, types.KeyAllowMessages, &res)
+	return res
+}
+
+// GetParams returns the total set of ibc interchain accounts parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(k.GetControllerEnabled(ctx), k.GetHostEnabled(ctx), k.GetAllowMessages(ctx))
+}
+
+// SetParams sets the total set of ibc interchain accounts parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/interchain-accounts/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+var (
+	bankSendTypeURL = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	coins           = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+)
+
+// getPacket returns the packet sent by the controller port of the sender of
+// chainA to execute msgs with its interchain account.
+func (suite *KeeperTestSuite) getPacket(
+	channelA, channelB ibctesting.TestChannel, sequence uint64, msgs []*codectypes.Any, timeoutHeight clienttypes.Height,
+) channeltypes.Packet {
+	data, err := suite.chainA.App.AppCodec().MarshalBinaryBare(&types.CosmosTx{Messages: msgs})
+	suite.Require().NoError(err)
+
+	packetData := types.NewInterchainAccountPacketData(types.EXECUTE_TX, data)
+	return channeltypes.NewPacket(packetData.GetBytes(), sequence, channelA.PortID, channelA.ID, channelB.PortID, channelB.ID, timeoutHeight, 0)
+}
+
+// test executing a bank send with an interchain account, from submitting
+// it on the controller chain to acknowledging its execution on the host chain
+func (suite *KeeperTestSuite) TestSubmitTx() {
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	channelA, channelB := suite.registerInterchainAccount(connA, connB)
+
+	owner := suite.chainA.SenderAccount.GetAddress()
+	icaAddr := types.GenerateAddress(connB.ID, types.ControllerPortID(owner))
+	receiver := suite.chainB.SenderAccount.GetAddress()
+
+	suite.chainB.App.ICAKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, []string{bankSendTypeURL}))
+	suite.Require().NoError(simapp.FundAccount(suite.chainB.App, suite.chainB.GetContext(), icaAddr, coins))
+	receiverBalance := suite.chainB.App.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, sdk.DefaultBondDenom)
+
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	msg, err := types.NewMsgSubmitTx(owner, connA.ID, []sdk.Msg{banktypes.NewMsgSend(icaAddr, receiver, coins)}, timeoutHeight, 0)
+	suite.Require().NoError(err)
+
+	err = suite.coordinator.SendMsg(suite.chainA, suite.chainB, channelB.ClientID, msg)
+	suite.Require().NoError(err) // message committed
+
+	packet := suite.getPacket(channelA, channelB, 1, msg.Msgs, timeoutHeight)
+
+	// the acknowledgement carries the response of the bank send
+	msgResponse, err := (&banktypes.MsgSendResponse{}).Marshal()
+	suite.Require().NoError(err)
+	result, err := suite.chainB.App.AppCodec().MarshalBinaryBare(&sdk.TxMsgData{
+		Data: []*sdk.MsgData{{MsgType: bankSendTypeURL, Data: msgResponse}},
+	})
+	suite.Require().NoError(err)
+	ack := channeltypes.NewResultAcknowledgement(result)
+
+	err = suite.coordinator.RelayPacket(suite.chainA, suite.chainB, channelA.ClientID, channelB.ClientID, packet, ack.GetBytes())
+	suite.Require().NoError(err) // relay committed
+
+	suite.Require().True(suite.chainB.App.BankKeeper.GetAllBalances(suite.chainB.GetContext(), icaAddr).IsZero())
+	expBalance := receiverBalance.Add(coins[0])
+	suite.Require().Equal(expBalance, suite.chainB.App.BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, sdk.DefaultBondDenom))
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	var (
+		channelA, channelB ibctesting.TestChannel
+		icaAddr            sdk.AccAddress
+		msgs               []sdk.Msg
+		packet             channeltypes.Packet
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"success with several messages", func() {
+			msgs = append(msgs, banktypes.NewMsgSend(icaAddr, suite.chainB.SenderAccount.GetAddress(), coins))
+		}, true},
+		{"message not allowed", func() {
+			suite.chainB.App.ICAKeeper.SetParams(suite.chainB.GetContext(), types.DefaultParams())
+		}, false},
+		{"host disabled", func() {
+			suite.chainB.App.ICAKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false, []string{bankSendTypeURL}))
+		}, false},
+		{"message not signed by the interchain account", func() {
+			msgs = []sdk.Msg{banktypes.NewMsgSend(suite.chainB.SenderAccount.GetAddress(), icaAddr, coins)}
+		}, false},
+		{"invalid message", func() {
+			msgs = []sdk.Msg{banktypes.NewMsgSend(icaAddr, suite.chainB.SenderAccount.GetAddress(), sdk.Coins{})}
+		}, false},
+		{"failed message reverts the tx", func() {
+			msgs = append(msgs, banktypes.NewMsgSend(icaAddr, suite.chainB.SenderAccount.GetAddress(), coins.Add(coins...)))
+		}, false},
+		{"unknown source port", func() {
+			channelA.PortID = types.ControllerPortPrefix + "unknown"
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+			channelA, channelB = suite.registerInterchainAccount(connA, connB)
+
+			icaAddr = types.GenerateAddress(connB.ID, channelA.PortID)
+			suite.chainB.App.ICAKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, []string{bankSendTypeURL}))
+			suite.Require().NoError(simapp.FundAccount(suite.chainB.App, suite.chainB.GetContext(), icaAddr, coins.Add(coins...)))
+
+			msgs = []sdk.Msg{banktypes.NewMsgSend(icaAddr, suite.chainB.SenderAccount.GetAddress(), coins)}
+
+			tc.malleate()
+
+			msg, err := types.NewMsgSubmitTx(suite.chainA.SenderAccount.GetAddress(), connA.ID, msgs, clienttypes.NewHeight(0, 110), 0)
+			suite.Require().NoError(err)
+			packet = suite.getPacket(channelA, channelB, 1, msg.Msgs, clienttypes.NewHeight(0, 110))
+
+			balance := suite.chainB.App.BankKeeper.GetBalance(suite.chainB.GetContext(), icaAddr, sdk.DefaultBondDenom)
+			result, err := suite.chainB.App.ICAKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			newBalance := suite.chainB.App.BankKeeper.GetBalance(suite.chainB.GetContext(), icaAddr, sdk.DefaultBondDenom)
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				var txMsgData sdk.TxMsgData
+				suite.Require().NoError(suite.chainB.App.AppCodec().UnmarshalBinaryBare(result, &txMsgData))
+				suite.Require().Len(txMsgData.Data, len(msgs))
+				suite.Require().True(balance.Amount.Sub(coins[0].Amount.MulRaw(int64(len(msgs)))).Equal(newBalance.Amount))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(balance, newBalance)
+			}
+		})
+	}
+}
+
+// test that a timed out packet closes the ordered channel of an interchain
+// account, and that the owner can then open a new channel to the same account
+func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	channelA, channelB := suite.registerInterchainAccount(connA, connB)
+
+	owner := suite.chainA.SenderAccount.GetAddress()
+	icaAddr := types.GenerateAddress(connB.ID, channelA.PortID)
+
+	timeoutHeight := clienttypes.NewHeight(0, uint64(suite.chainB.GetContext().BlockHeight())+1)
+	msg, err := types.NewMsgSubmitTx(owner, connA.ID, []sdk.Msg{banktypes.NewMsgSend(icaAddr, suite.chainB.SenderAccount.GetAddress(), coins)}, timeoutHeight, 0)
+	suite.Require().NoError(err)
+
+	err = suite.coordinator.SendMsg(suite.chainA, suite.chainB, channelB.ClientID, msg)
+	suite.Require().NoError(err) // message committed
+
+	packet := suite.getPacket(channelA, channelB, 1, msg.Msgs, timeoutHeight)
+
+	// the packet times out on the host chain
+	suite.coordinator.CommitNBlocks(suite.chainB, 3)
+	err = suite.coordinator.UpdateClient(suite.chainA, suite.chainB, channelA.ClientID, exported.Tendermint)
+	suite.Require().NoError(err)
+
+	proof, proofHeight := suite.chainB.QueryProof(host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel()))
+	timeoutMsg := channeltypes.NewMsgTimeout(packet, 1, proof, proofHeight, suite.chainA.SenderAccount.GetAddress())
+	err = suite.coordinator.SendMsg(suite.chainA, suite.chainB, channelB.ClientID, timeoutMsg)
+	suite.Require().NoError(err) // message committed
+
+	suite.Require().Equal(channeltypes.CLOSED, suite.chainA.GetChannel(channelA).State)
+	_, found := suite.chainA.App.ICAKeeper.GetActiveChannelID(suite.chainA.GetContext(), connA.ID, channelA.PortID)
+	suite.Require().False(found)
+
+	// a new channel is opened to the same interchain account
+	newChannelA, _ := suite.registerInterchainAccount(connA, connB)
+	suite.Require().NotEqual(channelA.ID, newChannelA.ID)
+
+	channelID, found := suite.chainA.App.ICAKeeper.GetActiveChannelID(suite.chainA.GetContext(), connA.ID, channelA.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(newChannelA.ID, channelID)
+
+	address, found := suite.chainA.App.ICAKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), connA.ID, channelA.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(icaAddr.String(), address)
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBC interchain accounts sentinel errors
+var (
+	ErrInvalidVersion              = sdkerrors.Register(ModuleName, 2, "invalid ICS27 version")
+	ErrInvalidControllerPort       = sdkerrors.Register(ModuleName, 3, "invalid interchain accounts controller port")
+	ErrInvalidHostPort             = sdkerrors.Register(ModuleName, 4, "invalid interchain accounts host port")
+	ErrActiveChannelAlreadySet     = sdkerrors.Register(ModuleName, 5, "active channel already set for this owner")
+	ErrActiveChannelNotFound       = sdkerrors.Register(ModuleName, 6, "no active channel for this owner")
+	ErrInterchainAccountNotFound   = sdkerrors.Register(ModuleName, 7, "interchain account not found")
+	ErrControllerDisabled          = sdkerrors.Register(ModuleName, 8, "interchain accounts controller is disabled on this chain")
+	ErrHostDisabled                = sdkerrors.Register(ModuleName, 9, "interchain accounts host is disabled on this chain")
+	ErrInvalidPacketData           = sdkerrors.Register(ModuleName, 10, "invalid interchain accounts packet data")
+	ErrUnsupportedPacketType       = sdkerrors.Register(ModuleName, 11, "unsupported interchain accounts packet type")
+	ErrMessageNotAllowed           = sdkerrors.Register(ModuleName, 12, "message not allowed for interchain accounts")
+	ErrInvalidInterchainAccountMsg = sdkerrors.Register(ModuleName, 13, "invalid interchain account message")
+)
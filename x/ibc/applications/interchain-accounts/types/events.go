@@ -0,0 +1,17 @@
+package types
+
+// IBC interchain accounts events
+const (
+	EventTypeRegisterInterchainAccount = "register_interchain_account"
+	EventTypeSubmitTx                  = "submit_interchain_account_tx"
+	EventTypePacket                    = "interchain_account_packet"
+	EventTypeTimeout                   = "timeout"
+
+	AttributeKeyOwner          = "owner"
+	AttributeKeyConnectionID   = "connection_id"
+	AttributeKeyPortID         = "port_id"
+	AttributeKeyAccountAddress = "account_address"
+	AttributeKeySequence       = "sequence"
+	AttributeKeyAckSuccess     = "success"
+	AttributeKeyAckError       = "error"
+)
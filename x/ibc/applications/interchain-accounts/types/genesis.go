@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+)
+
+// NewGenesisState creates a new ibc interchain accounts GenesisState instance.
+func NewGenesisState(
+	ports []string, activeChannels []ActiveChannel, interchainAccounts, hostAccounts []RegisteredInterchainAccount,
+	params Params,
+) *GenesisState {
+	return &GenesisState{
+		Ports:              ports,
+		ActiveChannels:     activeChannels,
+		InterchainAccounts: interchainAccounts,
+		HostAccounts:       hostAccounts,
+		Params:             params,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState without interchain accounts.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Ports:              []string{},
+		ActiveChannels:     []ActiveChannel{},
+		InterchainAccounts: []RegisteredInterchainAccount{},
+		HostAccounts:       []RegisteredInterchainAccount{},
+		Params:             DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for _, portID := range gs.Ports {
+		if err := validateControllerPort(portID); err != nil {
+			return err
+		}
+	}
+
+	for _, channel := range gs.ActiveChannels {
+		if err := validateConnectionAndPort(channel.ConnectionId, channel.PortId); err != nil {
+			return err
+		}
+		if err := host.ChannelIdentifierValidator(channel.ChannelId); err != nil {
+			return err
+		}
+	}
+
+	for _, account := range gs.InterchainAccounts {
+		if err := validateConnectionAndPort(account.ConnectionId, account.PortId); err != nil {
+			return err
+		}
+		if account.AccountAddress == "" {
+			return fmt.Errorf("empty address of the interchain account of port %s on connection %s", account.PortId, account.ConnectionId)
+		}
+	}
+
+	for _, account := range gs.HostAccounts {
+		if err := validateConnectionAndPort(account.ConnectionId, account.PortId); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(account.AccountAddress); err != nil {
+			return err
+		}
+	}
+
+	return gs.Params.Validate()
+}
+
+func validateConnectionAndPort(connectionID, portID string) error {
+	if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+		return err
+	}
+
+	return validateControllerPort(portID)
+}
+
+func validateControllerPort(portID string) error {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return err
+	}
+	if !IsControllerPort(portID) {
+		return fmt.Errorf("port %s is not an interchain accounts controller port", portID)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	portID := types.ControllerPortID(sdk.AccAddress("owner"))
+	address := types.GenerateAddress("connection-0", portID).String()
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			name:     "default",
+			genState: types.DefaultGenesisState(),
+			expPass:  true,
+		},
+		{
+			"valid genesis",
+			types.NewGenesisState(
+				[]string{portID},
+				[]types.ActiveChannel{{ConnectionId: "connection-0", PortId: portID, ChannelId: "channelidone"}},
+				[]types.RegisteredInterchainAccount{{ConnectionId: "connection-0", PortId: portID, AccountAddress: "cosmos1..."}},
+				[]types.RegisteredInterchainAccount{{ConnectionId: "connection-0", PortId: portID, AccountAddress: address}},
+				types.DefaultParams(),
+			),
+			true,
+		},
+		{
+			"invalid controller port",
+			&types.GenesisState{
+				Ports:  []string{types.HostPortID},
+				Params: types.DefaultParams(),
+			},
+			false,
+		},
+		{
+			"invalid active channel",
+			&types.GenesisState{
+				ActiveChannels: []types.ActiveChannel{{ConnectionId: "connection-0", PortId: portID, ChannelId: "(invalidchannel)"}},
+				Params:         types.DefaultParams(),
+			},
+			false,
+		},
+		{
+			"empty interchain account address",
+			&types.GenesisState{
+				InterchainAccounts: []types.RegisteredInterchainAccount{{ConnectionId: "connection-0", PortId: portID}},
+				Params:             types.DefaultParams(),
+			},
+			false,
+		},
+		{
+			"invalid host account address",
+			&types.GenesisState{
+				HostAccounts: []types.RegisteredInterchainAccount{{ConnectionId: "connection-0", PortId: portID, AccountAddress: "address"}},
+				Params:       types.DefaultParams(),
+			},
+			false,
+		},
+		{
+			"invalid params",
+			&types.GenesisState{
+				Params: types.NewParams(true, true, []string{" "}),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
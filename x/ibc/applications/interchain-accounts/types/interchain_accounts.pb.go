@@ -0,0 +1,861 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/applications/interchain_accounts/v1/interchain_accounts.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Type defines the type of an interchain accounts packet.
+type Type int32
+
+const (
+	// zero-value for packet type
+	UNSPECIFIED Type = 0
+	// executes a tx with the interchain account on the host chain
+	EXECUTE_TX Type = 1
+)
+
+var Type_name = map[int32]string{
+	0: "TYPE_UNSPECIFIED",
+	1: "TYPE_EXECUTE_TX",
+}
+
+var Type_value = map[string]int32{
+	"TYPE_UNSPECIFIED": 0,
+	"TYPE_EXECUTE_TX":  1,
+}
+
+func (x Type) String() string {
+	return proto.EnumName(Type_name, int32(x))
+}
+
+func (Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02aef6139978fdfa, []int{0}
+}
+
+// Params defines the set of IBC interchain accounts parameters.
+type Params struct {
+	// controller_enabled enables or disables the registration of interchain
+	// accounts on host chains and the submission of txs to them.
+	ControllerEnabled bool `protobuf:"varint,1,opt,name=controller_enabled,json=controllerEnabled,proto3" json:"controller_enabled,omitempty" yaml:"controller_enabled"`
+	// host_enabled enables or disables the interchain accounts hosted on this
+	// chain for controller chains.
+	HostEnabled bool `protobuf:"varint,2,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty" yaml:"host_enabled"`
+	// allow_messages defines the type URLs of the sdk.Msgs the interchain
+	// accounts hosted on this chain are allowed to execute.
+	AllowMessages []string `protobuf:"bytes,3,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02aef6139978fdfa, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetControllerEnabled() bool {
+	if m != nil {
+		return m.ControllerEnabled
+	}
+	return false
+}
+
+func (m *Params) GetHostEnabled() bool {
+	if m != nil {
+		return m.HostEnabled
+	}
+	return false
+}
+
+func (m *Params) GetAllowMessages() []string {
+	if m != nil {
+		return m.AllowMessages
+	}
+	return nil
+}
+
+// InterchainAccountPacketData defines the packet data sent by a controller
+// chain to the host chain of an interchain account.
+type InterchainAccountPacketData struct {
+	Type Type `protobuf:"varint,1,opt,name=type,proto3,enum=ibc.applications.interchain_accounts.v1.Type" json:"type,omitempty"`
+	// data is the packet payload, a CosmosTx for EXECUTE_TX packets.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *InterchainAccountPacketData) Reset()         { *m = InterchainAccountPacketData{} }
+func (m *InterchainAccountPacketData) String() string { return proto.CompactTextString(m) }
+func (*InterchainAccountPacketData) ProtoMessage()    {}
+func (*InterchainAccountPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02aef6139978fdfa, []int{1}
+}
+func (m *InterchainAccountPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainAccountPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainAccountPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainAccountPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainAccountPacketData.Merge(m, src)
+}
+func (m *InterchainAccountPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainAccountPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainAccountPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainAccountPacketData proto.InternalMessageInfo
+
+func (m *InterchainAccountPacketData) GetType() Type {
+	if m != nil {
+		return m.Type
+	}
+	return UNSPECIFIED
+}
+
+func (m *InterchainAccountPacketData) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// CosmosTx defines the msgs an interchain account executes on its host chain.
+type CosmosTx struct {
+	Messages []*types.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *CosmosTx) Reset()         { *m = CosmosTx{} }
+func (m *CosmosTx) String() string { return proto.CompactTextString(m) }
+func (*CosmosTx) ProtoMessage()    {}
+func (*CosmosTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02aef6139978fdfa, []int{2}
+}
+func (m *CosmosTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosmosTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosmosTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosmosTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosmosTx.Merge(m, src)
+}
+func (m *CosmosTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosmosTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosmosTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosmosTx proto.InternalMessageInfo
+
+func (m *CosmosTx) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ibc.applications.interchain_accounts.v1.Type", Type_name, Type_value)
+	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.v1.Params")
+	proto.RegisterType((*InterchainAccountPacketData)(nil), "ibc.applications.interchain_accounts.v1.InterchainAccountPacketData")
+	proto.RegisterType((*CosmosTx)(nil), "ibc.applications.interchain_accounts.v1.CosmosTx")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/interchain_accounts/v1/interchain_accounts.proto", fileDescriptor_02aef6139978fdfa)
+}
+
+var fileDescriptor_02aef6139978fdfa = []byte{
+	// 474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0x6e, 0x68, 0x35, 0x15, 0x77, 0x74, 0xc5, 0x0c, 0xb1, 0x06, 0x91, 0x46, 0x41, 0x88, 0x0a,
+	0xa9, 0x31, 0x1b, 0xb7, 0x89, 0x03, 0x6d, 0x17, 0xa4, 0x4a, 0x80, 0xaa, 0x90, 0x49, 0x85, 0x4b,
+	0xe4, 0xb8, 0x26, 0x8d, 0x96, 0xc4, 0x51, 0xec, 0x8e, 0xe5, 0x0d, 0xd0, 0x4e, 0xbc, 0xc0, 0x4e,
+	0xbc, 0x0c, 0xc7, 0x1d, 0x38, 0x70, 0xaa, 0x50, 0xfb, 0x06, 0x7d, 0x02, 0x54, 0x87, 0x66, 0x45,
+	0x4c, 0x68, 0x27, 0xfb, 0xff, 0xfe, 0xef, 0xfb, 0xe4, 0xff, 0xf3, 0x0f, 0xba, 0x81, 0x47, 0x10,
+	0x4e, 0x92, 0x30, 0x20, 0x58, 0x04, 0x2c, 0xe6, 0x28, 0x88, 0x05, 0x4d, 0xc9, 0x04, 0x07, 0xb1,
+	0x8b, 0x09, 0x61, 0xd3, 0x58, 0x70, 0x74, 0xba, 0x7f, 0x1d, 0x6c, 0x26, 0x29, 0x13, 0x0c, 0x3e,
+	0x0d, 0x3c, 0x62, 0x6e, 0x5a, 0x98, 0xd7, 0x71, 0x4f, 0xf7, 0xd5, 0x5d, 0x9f, 0xf9, 0x4c, 0x6a,
+	0xd0, 0xea, 0x96, 0xcb, 0xd5, 0xa6, 0xcf, 0x98, 0x1f, 0x52, 0x24, 0x2b, 0x6f, 0xfa, 0x09, 0xe1,
+	0x38, 0xcb, 0x5b, 0xc6, 0x0f, 0x05, 0x6c, 0x0d, 0x71, 0x8a, 0x23, 0x0e, 0xdf, 0x00, 0x48, 0x58,
+	0x2c, 0x52, 0x16, 0x86, 0x34, 0x75, 0x69, 0x8c, 0xbd, 0x90, 0x8e, 0xf7, 0x14, 0x5d, 0x69, 0x57,
+	0x7b, 0x8f, 0x96, 0xb3, 0x56, 0x33, 0xc3, 0x51, 0x78, 0x68, 0xfc, 0xcb, 0x31, 0xec, 0xbb, 0x57,
+	0xa0, 0x95, 0x63, 0xf0, 0x10, 0x6c, 0x4f, 0x18, 0x17, 0x85, 0xcf, 0x2d, 0xe9, 0xf3, 0x60, 0x39,
+	0x6b, 0xdd, 0xcb, 0x7d, 0x36, 0xbb, 0x86, 0x5d, 0x5b, 0x95, 0x6b, 0xed, 0x2b, 0x50, 0xc7, 0x61,
+	0xc8, 0x3e, 0xbb, 0x11, 0xe5, 0x1c, 0xfb, 0x94, 0xef, 0x95, 0xf5, 0x72, 0xfb, 0x76, 0xaf, 0xb9,
+	0x9c, 0xb5, 0xee, 0xe7, 0xea, 0xbf, 0xfb, 0x86, 0x7d, 0x47, 0x02, 0x6f, 0xd7, 0xb5, 0x00, 0x0f,
+	0x07, 0x45, 0x42, 0xdd, 0x3c, 0xa0, 0x21, 0x26, 0x27, 0x54, 0x1c, 0x61, 0x81, 0x61, 0x17, 0x54,
+	0x44, 0x96, 0x50, 0x39, 0x5c, 0xfd, 0xa0, 0x63, 0xde, 0x30, 0x5e, 0xd3, 0xc9, 0x12, 0x6a, 0x4b,
+	0x29, 0x84, 0xa0, 0x32, 0xc6, 0x02, 0xcb, 0xb9, 0xb6, 0x6d, 0x79, 0x37, 0x5e, 0x82, 0x6a, 0x9f,
+	0xf1, 0x88, 0x71, 0xe7, 0x0c, 0x3e, 0x07, 0xd5, 0xe2, 0xf5, 0x8a, 0x5e, 0x6e, 0xd7, 0x0e, 0x76,
+	0xcd, 0xfc, 0x1b, 0xcc, 0xf5, 0x37, 0x98, 0xdd, 0x38, 0xb3, 0x0b, 0xd6, 0xb3, 0x11, 0xa8, 0xac,
+	0xfc, 0xe1, 0x13, 0xd0, 0x70, 0x3e, 0x0c, 0x2d, 0xf7, 0xf8, 0xdd, 0xfb, 0xa1, 0xd5, 0x1f, 0xbc,
+	0x1e, 0x58, 0x47, 0x8d, 0x92, 0xba, 0x73, 0x7e, 0xa1, 0xd7, 0x36, 0x20, 0xf8, 0x18, 0xec, 0x48,
+	0x9a, 0x35, 0xb2, 0xfa, 0xc7, 0x8e, 0xe5, 0x3a, 0xa3, 0x86, 0xa2, 0xd6, 0xcf, 0x2f, 0x74, 0x70,
+	0x85, 0xa8, 0x95, 0x2f, 0xdf, 0xb4, 0x52, 0x8f, 0x7c, 0x9f, 0x6b, 0xca, 0xe5, 0x5c, 0x53, 0x7e,
+	0xcd, 0x35, 0xe5, 0xeb, 0x42, 0x2b, 0x5d, 0x2e, 0xb4, 0xd2, 0xcf, 0x85, 0x56, 0xfa, 0x38, 0xf0,
+	0x03, 0x31, 0x99, 0x7a, 0x26, 0x61, 0x11, 0x22, 0xf2, 0xe9, 0x7f, 0x8e, 0x0e, 0x1f, 0x9f, 0xa0,
+	0x33, 0xf4, 0x9f, 0xd5, 0xed, 0x14, 0xab, 0xbb, 0xca, 0x83, 0x7b, 0x5b, 0x72, 0xac, 0x17, 0xbf,
+	0x07, 0x00, 0x54, 0xcd, 0x1d, 0x5b, 0xef, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintInterchainAccounts(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.HostEnabled {
+		i--
+		if m.HostEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ControllerEnabled {
+		i--
+		if m.ControllerEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterchainAccountPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainAccountPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainAccountPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintInterchainAccounts(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintInterchainAccounts(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CosmosTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosmosTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosmosTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInterchainAccounts(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInterchainAccounts(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInterchainAccounts(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ControllerEnabled {
+		n += 2
+	}
+	if m.HostEnabled {
+		n += 2
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovInterchainAccounts(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InterchainAccountPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovInterchainAccounts(uint64(m.Type))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovInterchainAccounts(uint64(l))
+	}
+	return n
+}
+
+func (m *CosmosTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovInterchainAccounts(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovInterchainAccounts(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInterchainAccounts(x uint64) (n int) {
+	return sovInterchainAccounts(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainAccounts
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainAccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ControllerEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainAccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HostEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainAccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainAccounts
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainAccounts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainAccounts(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInterchainAccounts
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthInterchainAccounts
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterchainAccountPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainAccounts
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccountPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccountPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainAccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainAccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInterchainAccounts
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainAccounts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainAccounts(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInterchainAccounts
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthInterchainAccounts
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosmosTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainAccounts
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmosTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmosTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainAccounts
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInterchainAccounts
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainAccounts
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainAccounts(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthInterchainAccounts
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthInterchainAccounts
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInterchainAccounts(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInterchainAccounts
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInterchainAccounts
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInterchainAccounts
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInterchainAccounts
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInterchainAccounts
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInterchainAccounts
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInterchainAccounts        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInterchainAccounts          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInterchainAccounts = fmt.Errorf("proto: unexpected end of group")
+)
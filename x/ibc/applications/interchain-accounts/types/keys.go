@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ModuleName defines the IBC interchain accounts name
+	ModuleName = "interchainaccounts"
+
+	// Version defines the current version the IBC interchain accounts
+	// module supports
+	Version = "ics27-1"
+
+	// HostPortID is the port id the host side of the interchain accounts
+	// module binds to
+	HostPortID = "icahost"
+
+	// ControllerPortPrefix is the prefix of the ports the controller side of
+	// the interchain accounts module binds to, one per owner
+	ControllerPortPrefix = "icacontroller-"
+
+	// StoreKey is the store key string for IBC interchain accounts
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for IBC interchain accounts
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for IBC interchain accounts
+	QuerierRoute = ModuleName
+)
+
+var (
+	// PortKeyPrefix defines the key prefix to store the controller ports in store
+	PortKeyPrefix = []byte{0x01}
+	// ActiveChannelKeyPrefix defines the key prefix to store the active
+	// channels of the controller ports in store
+	ActiveChannelKeyPrefix = []byte{0x02}
+	// InterchainAccountKeyPrefix defines the key prefix to store the addresses
+	// of the interchain accounts of the controller ports in store
+	InterchainAccountKeyPrefix = []byte{0x03}
+	// HostAccountKeyPrefix defines the key prefix to store the addresses of
+	// the interchain accounts hosted on this chain in store
+	HostAccountKeyPrefix = []byte{0x04}
+)
+
+// ControllerPortID returns the controller port of the owner of interchain
+// accounts.
+func ControllerPortID(owner sdk.AccAddress) string {
+	return ControllerPortPrefix + owner.String()
+}
+
+// IsControllerPort returns whether a port is a controller port.
+func IsControllerPort(portID string) bool {
+	return strings.HasPrefix(portID, ControllerPortPrefix) && len(portID) > len(ControllerPortPrefix)
+}
+
+// PortKey returns the key of a controller port.
+func PortKey(portID string) []byte {
+	return append(PortKeyPrefix, []byte(portID)...)
+}
+
+// ActiveChannelKey returns the key of the active channel of a controller port
+// on a connection.
+func ActiveChannelKey(connectionID, portID string) []byte {
+	return append(ActiveChannelKeyPrefix, connectionPortKey(connectionID, portID)...)
+}
+
+// InterchainAccountKey returns the key of the interchain account address of a
+// controller port on a connection.
+func InterchainAccountKey(connectionID, portID string) []byte {
+	return append(InterchainAccountKeyPrefix, connectionPortKey(connectionID, portID)...)
+}
+
+// HostAccountKey returns the key of the address of the interchain account
+// hosted on this chain for a controller port of the counterparty chain of a
+// connection.
+func HostAccountKey(connectionID, controllerPortID string) []byte {
+	return append(HostAccountKeyPrefix, connectionPortKey(connectionID, controllerPortID)...)
+}
+
+// SplitConnectionPortKey returns the connection and port ids of a key without
+// its prefix.
+func SplitConnectionPortKey(key []byte) (connectionID, portID string) {
+	keySplit := strings.SplitN(string(key), "/", 2)
+	if len(keySplit) != 2 {
+		panic(fmt.Sprintf("invalid connection and port key %s", key))
+	}
+
+	return keySplit[0], keySplit[1]
+}
+
+func connectionPortKey(connectionID, portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", connectionID, portID))
+}
+
+// GenerateAddress returns the address of the interchain account hosted on
+// this chain for a controller port of the counterparty chain of a connection.
+func GenerateAddress(connectionID, controllerPortID string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/%s/%s", ModuleName, connectionID, controllerPortID))))
+}
+
+// NewHostVersion returns the version the host chain opens a channel with,
+// which carries the address of the interchain account to the controller chain.
+func NewHostVersion(address string) string {
+	return fmt.Sprintf("%s.%s", Version, address)
+}
+
+// ParseHostVersion returns the interchain account address carried by the
+// version a host chain opened a channel with.
+func ParseHostVersion(version string) (string, error) {
+	address := strings.TrimPrefix(version, Version+".")
+	if address == version || strings.TrimSpace(address) == "" {
+		return "", sdkerrors.Wrapf(ErrInvalidVersion, "expected %s, got %s", NewHostVersion("{address}"), version)
+	}
+
+	return address, nil
+}
@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/interchain-accounts/types"
+)
+
+func TestControllerPortID(t *testing.T) {
+	owner := sdk.AccAddress("owner")
+	portID := types.ControllerPortID(owner)
+
+	require.Equal(t, types.ControllerPortPrefix+owner.String(), portID)
+	require.True(t, types.IsControllerPort(portID))
+	require.False(t, types.IsControllerPort(types.ControllerPortPrefix))
+	require.False(t, types.IsControllerPort(types.HostPortID))
+}
+
+func TestSplitConnectionPortKey(t *testing.T) {
+	portID := types.ControllerPortID(sdk.AccAddress("owner"))
+	key := types.ActiveChannelKey("connection-0", portID)
+
+	connectionID, splitPortID := types.SplitConnectionPortKey(key[len(types.ActiveChannelKeyPrefix):])
+	require.Equal(t, "connection-0", connectionID)
+	require.Equal(t, portID, splitPortID)
+
+	require.Panics(t, func() {
+		types.SplitConnectionPortKey([]byte("connection-0"))
+	})
+}
+
+func TestGenerateAddress(t *testing.T) {
+	portID := types.ControllerPortID(sdk.AccAddress("owner"))
+	address := types.GenerateAddress("connection-0", portID)
+
+	require.Len(t, address, 20)
+	require.Equal(t, address, types.GenerateAddress("connection-0", portID))
+	require.NotEqual(t, address, types.GenerateAddress("connection-1", portID))
+	require.NotEqual(t, address, types.GenerateAddress("connection-0", types.ControllerPortID(sdk.AccAddress("other"))))
+}
+
+func TestParseHostVersion(t *testing.T) {
+	address := types.GenerateAddress("connection-0", types.ControllerPortID(sdk.AccAddress("owner"))).String()
+
+	testCases := []struct {
+		name    string
+		version string
+		expPass bool
+	}{
+		{"valid host version", types.NewHostVersion(address), true},
+		{"controller version", types.Version, false},
+		{"empty address", types.Version + ".", false},
+		{"other version", "ics20-1." + address, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		parsed, err := types.ParseHostVersion(tc.version)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, address, parsed, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
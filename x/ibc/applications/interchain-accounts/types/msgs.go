@@ -0,0 +1,157 @@
+package types
+
+import (
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+)
+
+// msg types
+const (
+	TypeMsgRegisterInterchainAccount = "register_interchain_account"
+	TypeMsgSubmitTx                  = "submit_tx"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterInterchainAccount{}
+	_ sdk.Msg = &MsgSubmitTx{}
+)
+
+// NewMsgRegisterInterchainAccount creates a new MsgRegisterInterchainAccount
+// instance
+//nolint:interfacer
+func NewMsgRegisterInterchainAccount(owner sdk.AccAddress, connectionID string) *MsgRegisterInterchainAccount {
+	return &MsgRegisterInterchainAccount{
+		Owner:        owner.String(),
+		ConnectionId: connectionID,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgRegisterInterchainAccount) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgRegisterInterchainAccount) Type() string {
+	return TypeMsgRegisterInterchainAccount
+}
+
+// ValidateBasic performs a basic check of the MsgRegisterInterchainAccount
+// fields.
+func (msg MsgRegisterInterchainAccount) ValidateBasic() error {
+	return validateOwnerAndConnection(msg.Owner, msg.ConnectionId)
+}
+
+// GetSignBytes implements sdk.Msg. The function will panic since it is used
+// for amino transaction verification which IBC does not support.
+func (msg MsgRegisterInterchainAccount) GetSignBytes() []byte {
+	panic("IBC messages do not support amino")
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRegisterInterchainAccount) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgSubmitTx creates a new MsgSubmitTx instance. The msgs are executed by
+// the interchain account of the owner, so they must be msgs of the host
+// chain.
+//nolint:interfacer
+func NewMsgSubmitTx(
+	owner sdk.AccAddress, connectionID string, msgs []sdk.Msg,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+) (*MsgSubmitTx, error) {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSubmitTx{
+		Owner:            owner.String(),
+		ConnectionId:     connectionID,
+		Msgs:             anys,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}, nil
+}
+
+// Route implements sdk.Msg
+func (MsgSubmitTx) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgSubmitTx) Type() string {
+	return TypeMsgSubmitTx
+}
+
+// ValidateBasic performs a basic check of the MsgSubmitTx fields.
+// NOTE: timeout height or timestamp values can be 0 to disable the timeout.
+// NOTE: the msgs are not decoded as they are msgs of the host chain, which may
+// not be known to this chain.
+func (msg MsgSubmitTx) ValidateBasic() error {
+	if err := validateOwnerAndConnection(msg.Owner, msg.ConnectionId); err != nil {
+		return err
+	}
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidInterchainAccountMsg, "msgs cannot be empty")
+	}
+	for i, any := range msg.Msgs {
+		if any == nil || strings.TrimSpace(any.TypeUrl) == "" {
+			return sdkerrors.Wrapf(ErrInvalidInterchainAccountMsg, "msg %d has no type URL", i)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg. The function will panic since it is used
+// for amino transaction verification which IBC does not support.
+func (msg MsgSubmitTx) GetSignBytes() []byte {
+	panic("IBC messages do not support amino")
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSubmitTx) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func validateOwnerAndConnection(owner, connectionID string) error {
+	// NOTE: owner format must be validated as it is required by the GetSigners function.
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+	if err := host.PortIdentifierValidator(ControllerPortID(ownerAddr)); err != nil {
+		return sdkerrors.Wrap(err, "invalid owner controller port ID")
+	}
+	return nil
+}
+
+func packMsgs(msgs []sdk.Msg) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return anys, nil
+}
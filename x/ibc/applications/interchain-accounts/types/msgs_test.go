@@ -0,0 +1,106 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+)
+
+// define constants used for testing
+const (
+	validConnection   = "connection-0"
+	invalidConnection = "(invalidconnection1)"
+)
+
+var (
+	addr1     = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	addr2     = sdk.AccAddress("testaddr2")
+	emptyAddr sdk.AccAddress
+
+	msgSend = banktypes.NewMsgSend(addr2, addr1, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(100))))
+
+	timeoutHeight = clienttypes.NewHeight(0, 10)
+)
+
+// TestMsgRegisterInterchainAccountValidation tests ValidateBasic for
+// MsgRegisterInterchainAccount
+func TestMsgRegisterInterchainAccountValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *MsgRegisterInterchainAccount
+		expPass bool
+	}{
+		{"valid msg", NewMsgRegisterInterchainAccount(addr1, validConnection), true},
+		{"missing owner address", NewMsgRegisterInterchainAccount(emptyAddr, validConnection), false},
+		{"invalid connection id", NewMsgRegisterInterchainAccount(addr1, invalidConnection), false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMsgRegisterInterchainAccountGetSigners tests GetSigners for
+// MsgRegisterInterchainAccount
+func TestMsgRegisterInterchainAccountGetSigners(t *testing.T) {
+	msg := NewMsgRegisterInterchainAccount(addr1, validConnection)
+
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgRegisterInterchainAccount, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+}
+
+// TestMsgSubmitTxValidation tests ValidateBasic for MsgSubmitTx
+func TestMsgSubmitTxValidation(t *testing.T) {
+	newMsg := func(owner sdk.AccAddress, connectionID string, msgs ...sdk.Msg) *MsgSubmitTx {
+		msg, err := NewMsgSubmitTx(owner, connectionID, msgs, timeoutHeight, 0)
+		require.NoError(t, err)
+		return msg
+	}
+
+	msgWithoutTypeURL := newMsg(addr1, validConnection, msgSend)
+	msgWithoutTypeURL.Msgs = append(msgWithoutTypeURL.Msgs, &codectypes.Any{})
+
+	testCases := []struct {
+		name    string
+		msg     *MsgSubmitTx
+		expPass bool
+	}{
+		{"valid msg", newMsg(addr1, validConnection, msgSend), true},
+		{"valid msg with several msgs", newMsg(addr1, validConnection, msgSend, msgSend), true},
+		{"missing owner address", newMsg(emptyAddr, validConnection, msgSend), false},
+		{"invalid connection id", newMsg(addr1, invalidConnection, msgSend), false},
+		{"no msgs", newMsg(addr1, validConnection), false},
+		{"msg without type URL", msgWithoutTypeURL, false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMsgSubmitTxGetSigners tests GetSigners for MsgSubmitTx
+func TestMsgSubmitTxGetSigners(t *testing.T) {
+	msg, err := NewMsgSubmitTx(addr1, validConnection, []sdk.Msg{msgSend}, timeoutHeight, 0)
+	require.NoError(t, err)
+
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgSubmitTx, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	// DefaultRelativePacketTimeoutHeight is the default packet timeout height (in blocks) relative
+	// to the current block height of the host chain provided by the client state. The timeout is
+	// disabled when set to 0.
+	DefaultRelativePacketTimeoutHeight = "0-1000"
+
+	// DefaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
+	// relative to the current block timestamp of the host chain provided by the client state. The
+	// timeout is disabled when set to 0. The default is currently set to a 10 minute timeout.
+	DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
+
+	_ codectypes.UnpackInterfacesMessage = CosmosTx{}
+)
+
+// NewInterchainAccountPacketData contructs a new InterchainAccountPacketData
+// instance
+func NewInterchainAccountPacketData(packetType Type, data []byte) InterchainAccountPacketData {
+	return InterchainAccountPacketData{
+		Type: packetType,
+		Data: data,
+	}
+}
+
+// ValidateBasic is used for validating the interchain accounts packet data.
+func (iapd InterchainAccountPacketData) ValidateBasic() error {
+	if iapd.Type == UNSPECIFIED {
+		return sdkerrors.Wrap(ErrInvalidPacketData, "packet type cannot be unspecified")
+	}
+	if len(iapd.Data) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacketData, "packet data cannot be empty")
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising
+func (iapd InterchainAccountPacketData) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&iapd))
+}
+
+// GetMsgs returns the msgs of the CosmosTx.
+func (tx CosmosTx) GetMsgs() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(tx.Messages))
+	for i, any := range tx.Messages {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalidInterchainAccountMsg, "message %d contains %T which is not a sdk.Msg", i, any.GetCachedValue())
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (tx CosmosTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range tx.Messages {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
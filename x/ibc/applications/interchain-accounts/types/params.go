@@ -0,0 +1,103 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	// DefaultControllerEnabled enabled
+	DefaultControllerEnabled = true
+	// DefaultHostEnabled enabled
+	DefaultHostEnabled = true
+)
+
+var (
+	// KeyControllerEnabled is store's key for ControllerEnabled Params
+	KeyControllerEnabled = []byte("ControllerEnabled")
+	// KeyHostEnabled is store's key for HostEnabled Params
+	KeyHostEnabled = []byte("HostEnabled")
+	// KeyAllowMessages is store's key for AllowMessages Params
+	KeyAllowMessages = []byte("AllowMessages")
+)
+
+// ParamKeyTable type declaration for parameters
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter configuration for the ibc interchain
+// accounts module
+func NewParams(enableController, enableHost bool, allowMessages []string) Params {
+	return Params{
+		ControllerEnabled: enableController,
+		HostEnabled:       enableHost,
+		AllowMessages:     allowMessages,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the ibc interchain
+// accounts module. The hosted interchain accounts are not allowed to execute
+// any message by default.
+func DefaultParams() Params {
+	return NewParams(DefaultControllerEnabled, DefaultHostEnabled, nil)
+}
+
+// Validate all ibc interchain accounts module parameters
+func (p Params) Validate() error {
+	if err := validateEnabled(p.ControllerEnabled); err != nil {
+		return err
+	}
+	if err := validateEnabled(p.HostEnabled); err != nil {
+		return err
+	}
+
+	return validateAllowMessages(p.AllowMessages)
+}
+
+// IsMessageAllowed returns whether the hosted interchain accounts are allowed
+// to execute the messages of a type URL.
+func (p Params) IsMessageAllowed(typeURL string) bool {
+	for _, allowed := range p.AllowMessages {
+		if allowed == typeURL {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyControllerEnabled, p.ControllerEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyHostEnabled, p.HostEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyAllowMessages, p.AllowMessages, validateAllowMessages),
+	}
+}
+
+func validateEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAllowMessages(i interface{}) error {
+	allowMessages, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, typeURL := range allowMessages {
+		if strings.TrimSpace(typeURL) == "" {
+			return fmt.Errorf("allowed message type URL cannot be blank")
+		}
+	}
+
+	return nil
+}
@@ -19,6 +19,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDenomTrace(),
 		GetCmdQueryDenomTraces(),
 		GetCmdParams(),
+		GetCmdQueryRateLimits(),
+		GetCmdQueryRateLimit(),
 	)
 
 	return queryCmd
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -11,6 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
 	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
 	channelutils "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/client/utils"
@@ -20,6 +23,7 @@ const (
 	flagPacketTimeoutHeight    = "packet-timeout-height"
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
+	flagPacketMemo             = "packet-memo"
 )
 
 // NewTransferTxCmd returns the command to create a NewMsgTransfer transaction
@@ -31,7 +35,8 @@ func NewTransferTxCmd() *cobra.Command {
 as absolute or relative using the "absolute-timeouts" flag. Timeout height can be set by passing in the height string
 in the form {version}-{height} using the "packet-timeout-height" flag. Relative timeouts are added to
 the block height and block timestamp queried from the latest consensus state corresponding
-to the counterparty channel. Any timeout set to 0 is disabled.`),
+to the counterparty channel. Any timeout set to 0 is disabled. The "packet-memo" flag sets the memo
+of the packet, which is read by the receive hooks of the counterparty chain.`),
 		Example: fmt.Sprintf("%s tx ibc-transfer transfer [src-port] [src-channel] [receiver] [amount]", version.AppName),
 		Args:    cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -95,8 +100,13 @@ to the counterparty channel. Any timeout set to 0 is disabled.`),
 				}
 			}
 
+			memo, err := cmd.Flags().GetString(flagPacketMemo)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgTransfer(
-				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp,
+				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -109,7 +119,145 @@ to the counterparty channel. Any timeout set to 0 is disabled.`),
 	cmd.Flags().String(flagPacketTimeoutHeight, types.DefaultRelativePacketTimeoutHeight, "Packet timeout block height. The timeout is disabled when set to 0-0.")
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().String(flagPacketMemo, "", "Memo of the transfer packet, e.g. a forward to another chain.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// NewCmdSubmitSetRateLimitProposal implements a command handler for submitting a set rate limit proposal transaction.
+func NewCmdSubmitSetRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rate-limit [channel-id] [denom] [max-percent-send] [max-percent-recv] [duration]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Submit a proposal to set the rate limit of a denom on a transfer channel",
+		Long: strings.TrimSpace(`Submit a proposal to set the rate limit of a denom on a transfer channel along with an
+initial deposit. The net amount of the denom sent or received through the channel in a rolling window of
+the given duration is limited to a percentage of the supply of the denom. The window moves forward by a
+tenth of its duration at a time. The denom must have a supply, and the flow of an existing rate limit is
+reset.`),
+		Example: fmt.Sprintf(
+			"%s tx gov submit-proposal set-rate-limit channel-0 stake 10 10 24h --title=\"Rate limit\" --description=\"Limit stake transfers\" --deposit=1000stake --from=<key_or_address>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			maxPercentSend, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			maxPercentRecv, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[4])
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetRateLimitProposal(title, description, args[0], args[1], maxPercentSend, maxPercentRecv, duration)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewCmdSubmitRemoveRateLimitProposal implements a command handler for submitting a remove rate limit proposal transaction.
+func NewCmdSubmitRemoveRateLimitProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-rate-limit [channel-id] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to remove the rate limit of a denom on a transfer channel",
+		Long:  "Submit a proposal to remove the rate limit of a denom on a transfer channel along with an initial deposit.",
+		Example: fmt.Sprintf(
+			"%s tx gov submit-proposal remove-rate-limit channel-0 stake --title=\"Remove rate limit\" --description=\"Lift the limit\" --deposit=1000stake --from=<key_or_address>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRemoveRateLimitProposal(title, description, args[0], args[1])
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// addProposalFlags adds the title, description and deposit flags of a proposal.
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+}
+
+// parseProposalFlags returns the title, description and deposit of a proposal.
+func parseProposalFlags(cmd *cobra.Command) (string, string, sdk.Coins, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	return title, description, deposit, nil
+}
@@ -0,0 +1,18 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/client/rest"
+)
+
+var (
+	// SetRateLimitProposalHandler is the transfer rate limit set proposal handler.
+	SetRateLimitProposalHandler = govclient.NewProposalHandler(
+		cli.NewCmdSubmitSetRateLimitProposal, rest.SetRateLimitProposalRESTHandler,
+	)
+	// RemoveRateLimitProposalHandler is the transfer rate limit remove proposal handler.
+	RemoveRateLimitProposalHandler = govclient.NewProposalHandler(
+		cli.NewCmdSubmitRemoveRateLimitProposal, rest.RemoveRateLimitProposalRESTHandler,
+	)
+)
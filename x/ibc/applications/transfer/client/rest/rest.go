@@ -0,0 +1,113 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+)
+
+type (
+	// SetRateLimitProposalReq defines a set rate limit proposal request body.
+	SetRateLimitProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title          string         `json:"title" yaml:"title"`
+		Description    string         `json:"description" yaml:"description"`
+		ChannelID      string         `json:"channel_id" yaml:"channel_id"`
+		Denom          string         `json:"denom" yaml:"denom"`
+		MaxPercentSend sdk.Dec        `json:"max_percent_send" yaml:"max_percent_send"`
+		MaxPercentRecv sdk.Dec        `json:"max_percent_recv" yaml:"max_percent_recv"`
+		Duration       time.Duration  `json:"duration" yaml:"duration"`
+		Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit        sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// RemoveRateLimitProposalReq defines a remove rate limit proposal request body.
+	RemoveRateLimitProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		ChannelID   string         `json:"channel_id" yaml:"channel_id"`
+		Denom       string         `json:"denom" yaml:"denom"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// SetRateLimitProposalRESTHandler returns a ProposalRESTHandler that exposes the set rate limit REST handler with a given sub-route.
+func SetRateLimitProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set_rate_limit",
+		Handler:  postSetRateLimitProposalHandlerFn(clientCtx),
+	}
+}
+
+// RemoveRateLimitProposalRESTHandler returns a ProposalRESTHandler that exposes the remove rate limit REST handler with a given sub-route.
+func RemoveRateLimitProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove_rate_limit",
+		Handler:  postRemoveRateLimitProposalHandlerFn(clientCtx),
+	}
+}
+
+func postSetRateLimitProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetRateLimitProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewSetRateLimitProposal(
+			req.Title, req.Description, req.ChannelID, req.Denom, req.MaxPercentSend, req.MaxPercentRecv, req.Duration,
+		)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func postRemoveRateLimitProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RemoveRateLimitProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewRemoveRateLimitProposal(req.Title, req.Description, req.ChannelID, req.Denom)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
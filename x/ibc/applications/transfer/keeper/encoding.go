@@ -33,3 +33,27 @@ func (k Keeper) MarshalDenomTrace(denomTrace types.DenomTrace) ([]byte, error) {
 func (k Keeper) MustMarshalDenomTrace(denomTrace types.DenomTrace) []byte {
 	return k.cdc.MustMarshalBinaryBare(&denomTrace)
 }
+
+// MustUnmarshalForwardedPacket attempts to decode and return a ForwardedPacket
+// object from raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalForwardedPacket(bz []byte) types.ForwardedPacket {
+	var forwardedPacket types.ForwardedPacket
+	k.cdc.MustUnmarshalBinaryBare(bz, &forwardedPacket)
+	return forwardedPacket
+}
+
+// MustUnmarshalRateLimit attempts to decode and return a RateLimit object from
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalRateLimit(bz []byte) types.RateLimit {
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshalBinaryBare(bz, &rateLimit)
+	return rateLimit
+}
+
+// MustUnmarshalPendingSendPacket attempts to decode and return a
+// PendingSendPacket object from raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalPendingSendPacket(bz []byte) types.PendingSendPacket {
+	var pendingSendPacket types.PendingSendPacket
+	k.cdc.MustUnmarshalBinaryBare(bz, &pendingSendPacket)
+	return pendingSendPacket
+}
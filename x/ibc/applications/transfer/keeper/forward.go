@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+)
+
+var _ types.ReceiveHook = ForwardHook{}
+
+// ForwardHook is the receive hook which forwards the received tokens from the
+// receiver to another chain, as described by the ForwardMetadata of the
+// memo. It is registered under the ForwardMemoKey.
+//
+// The forwarded packet is stored until it is acknowledged. If it fails, the
+// refunded tokens are transferred back to the sender of the received packet,
+// and the forward hooks of the previous chains route them back to the
+// original sender.
+type ForwardHook struct {
+	k Keeper
+}
+
+// NewForwardHook creates a new ForwardHook instance sending the forwarded
+// packets with the given transfer keeper.
+func NewForwardHook(k Keeper) ForwardHook {
+	return ForwardHook{k: k}
+}
+
+// OnRecvPacket implements the ReceiveHook interface
+func (h ForwardHook) OnRecvPacket(
+	ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, token sdk.Coin, hookData json.RawMessage,
+) error {
+	var metadata types.ForwardMetadata
+	if err := json.Unmarshal(hookData, &metadata); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidMemo, "cannot unmarshal forward metadata: %s", err)
+	}
+	if err := metadata.ValidateBasic(); err != nil {
+		return err
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	memo := metadata.GetNextMemo()
+	if !metadata.Refund {
+		// if the next chain fails to forward the tokens further, they are
+		// refunded back to the sender through this chain
+		refundMemo := types.ForwardMetadata{
+			Receiver: data.Sender,
+			Port:     packet.GetDestPort(),
+			Channel:  packet.GetDestChannel(),
+			Next:     metadata.RefundMemo,
+			Refund:   true,
+		}.Memo()
+
+		memo, err = types.SetForwardRefundMemo(memo, refundMemo)
+		if err != nil {
+			return err
+		}
+	}
+
+	sequence, found := h.k.channelKeeper.GetNextSequenceSend(ctx, metadata.Port, metadata.Channel)
+	if !found {
+		return sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", metadata.Port, metadata.Channel,
+		)
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + metadata.GetTimeout()
+	if err := h.k.SendTransfer(
+		ctx, metadata.Port, metadata.Channel, token, receiver, metadata.Receiver,
+		clienttypes.ZeroHeight(), timeoutTimestamp, memo,
+	); err != nil {
+		return err
+	}
+
+	// the tokens of a failed refund are left with the receiver
+	if !metadata.Refund {
+		h.k.SetForwardedPacket(ctx, types.NewForwardedPacket(
+			metadata.Port, metadata.Channel, sequence,
+			packet.GetDestPort(), packet.GetDestChannel(), data.Sender, metadata.GetRefundMemo(),
+		))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForward,
+			sdk.NewAttribute(types.AttributeKeyReceiver, metadata.Receiver),
+			sdk.NewAttribute(types.AttributeKeyDenom, token.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, token.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyChannel, metadata.Channel),
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", sequence)),
+		),
+	)
+
+	return nil
+}
+
+// refundForwardedPacket transfers the tokens of a failed packet sent by the
+// forward receive hook, which have been refunded to its sender, back to the
+// sender of the packet it forwarded. If the refund can't be sent, the tokens
+// are left with the sender of the failed packet.
+func (k Keeper) refundForwardedPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+	forwardedPacket, found := k.GetForwardedPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	k.DeleteForwardedPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	trace := types.ParseDenomTrace(data.Denom)
+	token := sdk.NewCoin(trace.IBCDenom(), sdk.NewIntFromUint64(data.Amount))
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + types.DefaultRelativePacketTimeoutTimestamp
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyRefundReceiver, forwardedPacket.RefundReceiver),
+		sdk.NewAttribute(types.AttributeKeyRefundDenom, token.Denom),
+		sdk.NewAttribute(types.AttributeKeyRefundAmount, token.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyChannel, forwardedPacket.RefundChannelId),
+	}
+
+	// the refund is sent in a cached context so that a failure doesn't prevent
+	// the acknowledgement or timeout of the forwarded packet
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.SendTransfer(
+		cacheCtx, forwardedPacket.RefundPortId, forwardedPacket.RefundChannelId, token, sender, forwardedPacket.RefundReceiver,
+		clienttypes.ZeroHeight(), timeoutTimestamp, forwardedPacket.RefundMemo,
+	); err != nil {
+		k.Logger(ctx).Error("failed to refund forwarded packet", "sender", data.Sender, "receiver", forwardedPacket.RefundReceiver, "error", err)
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyRefundError, err.Error()))
+	} else {
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeRefund, attributes...))
+	return nil
+}
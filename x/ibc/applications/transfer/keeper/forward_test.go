@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+// forwardTransfer sends a transfer from chainA to chainB which is forwarded to
+// chainC, and relays it to chainB. It returns the channels from chainA to
+// chainB and from chainB to chainC, along with the forwarded packet.
+func (suite *KeeperTestSuite) forwardTransfer(coin sdk.Coin) (
+	channelA, channelB, channelBToC, channelC ibctesting.TestChannel, forwardedPacket channeltypes.Packet,
+) {
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	channelA, channelB = suite.coordinator.CreateTransferChannels(suite.chainA, suite.chainB, connA, connB, channeltypes.UNORDERED)
+	_, _, connBToC, connC := suite.coordinator.SetupClientConnections(suite.chainB, suite.chainC, exported.Tendermint)
+	channelBToC, channelC = suite.coordinator.CreateTransferChannels(suite.chainB, suite.chainC, connBToC, connC, channeltypes.UNORDERED)
+
+	sender := suite.chainA.SenderAccount.GetAddress().String()
+	intermediary := suite.chainB.SenderAccount.GetAddress().String()
+	receiver := suite.chainC.SenderAccount.GetAddress().String()
+	memo := types.NewForwardMemo(receiver, channelBToC.PortID, channelBToC.ID, 0, "")
+
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	msg := types.NewMsgTransfer(channelA.PortID, channelA.ID, coin, suite.chainA.SenderAccount.GetAddress(), intermediary, timeoutHeight, 0, memo)
+	err := suite.coordinator.SendMsg(suite.chainA, suite.chainB, channelB.ClientID, msg)
+	suite.Require().NoError(err) // message committed
+
+	// the forwarded packet is sent when the transfer is received on chainB
+	timeoutTimestamp := uint64(suite.chainB.CurrentHeader.Time.UnixNano()) + types.DefaultRelativePacketTimeoutTimestamp
+
+	data := types.NewFungibleTokenPacketData(coin.Denom, coin.Amount.Uint64(), sender, intermediary, memo)
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, channelA.PortID, channelA.ID, channelB.PortID, channelB.ID, timeoutHeight, 0)
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	err = suite.coordinator.RelayPacket(suite.chainA, suite.chainB, channelA.ClientID, channelB.ClientID, packet, ack.GetBytes())
+	suite.Require().NoError(err) // relay committed
+
+	// update the client of chainB on chainC with the forwarded packet
+	err = suite.coordinator.UpdateClient(suite.chainC, suite.chainB, channelC.ClientID, exported.Tendermint)
+	suite.Require().NoError(err)
+
+	fullDenomPath := types.GetPrefixedDenom(channelB.PortID, channelB.ID, coin.Denom)
+	forwardedData := types.NewFungibleTokenPacketData(fullDenomPath, coin.Amount.Uint64(), intermediary, receiver, "")
+	forwardedPacket = channeltypes.NewPacket(
+		forwardedData.GetBytes(), 1, channelBToC.PortID, channelBToC.ID, channelC.PortID, channelC.ID,
+		clienttypes.ZeroHeight(), timeoutTimestamp,
+	)
+
+	return channelA, channelB, channelBToC, channelC, forwardedPacket
+}
+
+// test forwarding a transfer from chainA through chainB to chainC
+func (suite *KeeperTestSuite) TestForwardTransfer() {
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	_, channelB, channelBToC, channelC, forwardedPacket := suite.forwardTransfer(coin)
+
+	// the intermediary doesn't keep the vouchers
+	voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(channelB.PortID, channelB.ID, coin.Denom)).IBCDenom()
+	intermediary := suite.chainB.SenderAccount.GetAddress()
+	suite.Require().True(suite.chainB.App.BankKeeper.GetBalance(suite.chainB.GetContext(), intermediary, voucherDenom).IsZero())
+
+	_, found := suite.chainB.App.TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), channelBToC.PortID, channelBToC.ID, 1)
+	suite.Require().True(found)
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	err := suite.coordinator.RelayPacket(suite.chainB, suite.chainC, channelBToC.ClientID, channelC.ClientID, forwardedPacket, ack.GetBytes())
+	suite.Require().NoError(err) // relay committed
+
+	// the receiver on chainC gets the vouchers of the vouchers of chainB
+	fullDenomPath := types.GetPrefixedDenom(channelC.PortID, channelC.ID, types.GetPrefixedDenom(channelB.PortID, channelB.ID, coin.Denom))
+	receiver := suite.chainC.SenderAccount.GetAddress()
+	balance := suite.chainC.App.BankKeeper.GetBalance(suite.chainC.GetContext(), receiver, types.ParseDenomTrace(fullDenomPath).IBCDenom())
+	suite.Require().Equal(coin.Amount, balance.Amount)
+
+	_, found = suite.chainB.App.TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), channelBToC.PortID, channelBToC.ID, 1)
+	suite.Require().False(found)
+}
+
+// test that a transfer which can't be forwarded is not received
+func (suite *KeeperTestSuite) TestForwardTransferFailure() {
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	channelA, channelB := suite.coordinator.CreateTransferChannels(suite.chainA, suite.chainB, connA, connB, channeltypes.UNORDERED)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	intermediary := suite.chainB.SenderAccount.GetAddress()
+
+	// the channel to forward to doesn't exist
+	memo := types.NewForwardMemo(suite.chainC.SenderAccount.GetAddress().String(), types.PortID, "channelidone", 0, "")
+	data := types.NewFungibleTokenPacketData(coin.Denom, coin.Amount.Uint64(), suite.chainA.SenderAccount.GetAddress().String(), intermediary.String(), memo)
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, channelA.PortID, channelA.ID, channelB.PortID, channelB.ID, clienttypes.NewHeight(0, 110), 0)
+
+	err := suite.chainB.App.TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+	suite.Require().Error(err)
+
+	voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(channelB.PortID, channelB.ID, coin.Denom)).IBCDenom()
+	suite.Require().True(suite.chainB.App.BankKeeper.GetSupply(suite.chainB.GetContext(), voucherDenom).IsZero())
+}
+
+// test that the tokens of a forwarded transfer failing on chainC are refunded
+// to the sender on chainA
+func (suite *KeeperTestSuite) TestForwardTransferRefund() {
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	sender := suite.chainA.SenderAccount.GetAddress()
+	balance := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), sender, coin.Denom)
+
+	channelA, channelB, channelBToC, channelC, forwardedPacket := suite.forwardTransfer(coin)
+	suite.Require().Equal(balance.Sub(coin), suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), sender, coin.Denom))
+
+	// chainC fails to receive the forwarded packet
+	suite.chainC.App.TransferKeeper.SetParams(suite.chainC.GetContext(), types.NewParams(true, false))
+	err := suite.coordinator.RecvPacket(suite.chainB, suite.chainC, channelBToC.ClientID, forwardedPacket)
+	suite.Require().NoError(err) // recv committed
+
+	// the refund is sent when the error acknowledgement is received on chainB
+	timeoutTimestamp := uint64(suite.chainB.CurrentHeader.Time.UnixNano()) + types.DefaultRelativePacketTimeoutTimestamp
+
+	ack := channeltypes.NewErrorAcknowledgement(types.ErrReceiveDisabled.Error())
+	err = suite.coordinator.AcknowledgePacket(suite.chainB, suite.chainC, channelC.ClientID, forwardedPacket, ack.GetBytes())
+	suite.Require().NoError(err) // ack committed
+
+	// update the client of chainB on chainA with the refund packet
+	err = suite.coordinator.UpdateClient(suite.chainA, suite.chainB, channelA.ClientID, exported.Tendermint)
+	suite.Require().NoError(err)
+
+	_, found := suite.chainB.App.TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), channelBToC.PortID, channelBToC.ID, 1)
+	suite.Require().False(found)
+
+	fullDenomPath := types.GetPrefixedDenom(channelB.PortID, channelB.ID, coin.Denom)
+	intermediary := suite.chainB.SenderAccount.GetAddress()
+	suite.Require().True(suite.chainB.App.BankKeeper.GetBalance(suite.chainB.GetContext(), intermediary, types.ParseDenomTrace(fullDenomPath).IBCDenom()).IsZero())
+
+	data := types.NewFungibleTokenPacketData(fullDenomPath, coin.Amount.Uint64(), intermediary.String(), sender.String(), "")
+	refundPacket := channeltypes.NewPacket(
+		data.GetBytes(), 1, channelB.PortID, channelB.ID, channelA.PortID, channelA.ID,
+		clienttypes.ZeroHeight(), timeoutTimestamp,
+	)
+	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	err = suite.coordinator.RelayPacket(suite.chainB, suite.chainA, channelB.ClientID, channelA.ClientID, refundPacket, successAck.GetBytes())
+	suite.Require().NoError(err) // relay committed
+
+	suite.Require().Equal(balance, suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), sender, coin.Denom))
+}
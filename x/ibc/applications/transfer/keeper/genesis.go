@@ -15,6 +15,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 		k.SetDenomTrace(ctx, trace)
 	}
 
+	for _, forwardedPacket := range state.ForwardedPackets {
+		k.SetForwardedPacket(ctx, forwardedPacket)
+	}
+
+	for _, rateLimit := range state.RateLimits {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	for _, pendingSendPacket := range state.PendingSendPackets {
+		k.SetPendingSendPacket(ctx, pendingSendPacket)
+	}
+
 	// Only try to bind to port if it is not already bound, since we may already own
 	// port capability from capability InitGenesis
 	if !k.IsBound(ctx, state.PortId) {
@@ -38,8 +50,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 // ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:      k.GetPort(ctx),
-		DenomTraces: k.GetAllDenomTraces(ctx),
-		Params:      k.GetParams(ctx),
+		PortId:             k.GetPort(ctx),
+		DenomTraces:        k.GetAllDenomTraces(ctx),
+		Params:             k.GetParams(ctx),
+		ForwardedPackets:   k.GetAllForwardedPackets(ctx),
+		RateLimits:         k.GetAllRateLimits(ctx),
+		PendingSendPackets: k.GetAllPendingSendPackets(ctx),
 	}
 }
@@ -2,7 +2,9 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
 )
 
@@ -28,10 +30,29 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		suite.chainA.App.TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
 	}
 
+	forwardedPacket := types.NewForwardedPacket(
+		types.PortID, "channelToChain1", 1, types.PortID, "channelToChain0", "cosmos1sender", "",
+	)
+	suite.chainA.App.TransferKeeper.SetForwardedPacket(suite.chainA.GetContext(), forwardedPacket)
+
+	bucketStart := time.Unix(0, 0).UTC()
+	rateLimit := types.NewRateLimit(
+		"channelToChain0", "uatom", sdk.NewDec(10), sdk.NewDec(20), time.Hour,
+		types.NewRateLimitFlow(sdk.NewInt(1000)),
+	)
+	rateLimit.StartBucket(bucketStart, sdk.NewInt(1000))
+	suite.Require().NoError(rateLimit.AddOutflow(sdk.NewInt(100)))
+	suite.chainA.App.TransferKeeper.SetRateLimit(suite.chainA.GetContext(), rateLimit)
+	pendingSendPacket := types.NewPendingSendPacket("channelToChain0", 1, bucketStart)
+	suite.chainA.App.TransferKeeper.SetPendingSendPacket(suite.chainA.GetContext(), pendingSendPacket)
+
 	genesis := suite.chainA.App.TransferKeeper.ExportGenesis(suite.chainA.GetContext())
 
 	suite.Require().Equal(types.PortID, genesis.PortId)
 	suite.Require().Equal(traces.Sort(), genesis.DenomTraces)
+	suite.Require().Equal([]types.ForwardedPacket{forwardedPacket}, genesis.ForwardedPackets)
+	suite.Require().Equal([]types.RateLimit{rateLimit}, genesis.RateLimits)
+	suite.Require().Equal([]types.PendingSendPacket{pendingSendPacket}, genesis.PendingSendPackets)
 
 	suite.Require().NotPanics(func() {
 		suite.chainA.App.TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -140,3 +141,48 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryRateLimit() {
+	ctx := suite.chainA.GetContext()
+	supply := suite.chainA.App.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount
+
+	rateLimit := types.NewRateLimit(
+		"channelidone", sdk.DefaultBondDenom, sdk.NewDec(10), sdk.NewDec(10), time.Hour,
+		types.NewRateLimitFlow(sdk.NewInt(1000)),
+	)
+	rateLimit.StartBucket(ctx.BlockTime(), sdk.NewInt(1000))
+	suite.Require().NoError(rateLimit.AddOutflow(sdk.NewInt(50)))
+	suite.chainA.App.TransferKeeper.SetRateLimit(ctx, rateLimit)
+
+	// the flow of a bucket which left the window is removed
+	expiredRateLimit := types.NewRateLimit(
+		"channelidtwo", sdk.DefaultBondDenom, sdk.NewDec(10), sdk.NewDec(10), time.Hour,
+		types.NewRateLimitFlow(sdk.NewInt(1000)),
+	)
+	expiredRateLimit.StartBucket(ctx.BlockTime().Add(-time.Hour), sdk.NewInt(1000))
+	suite.Require().NoError(expiredRateLimit.AddOutflow(sdk.NewInt(50)))
+	suite.chainA.App.TransferKeeper.SetRateLimit(ctx, expiredRateLimit)
+	expiredRateLimit.Flow = types.NewRateLimitFlow(supply)
+	expiredRateLimit.StartBucket(ctx.BlockTime(), supply)
+
+	res, err := suite.queryClient.RateLimit(sdk.WrapSDKContext(ctx), &types.QueryRateLimitRequest{
+		ChannelId: "channelidone", Denom: sdk.DefaultBondDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(rateLimit, res.RateLimit)
+
+	res, err = suite.queryClient.RateLimit(sdk.WrapSDKContext(ctx), &types.QueryRateLimitRequest{
+		ChannelId: "channelidtwo", Denom: sdk.DefaultBondDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expiredRateLimit, res.RateLimit)
+
+	_, err = suite.queryClient.RateLimit(sdk.WrapSDKContext(ctx), &types.QueryRateLimitRequest{
+		ChannelId: "channelidone", Denom: "atom",
+	})
+	suite.Require().Error(err)
+
+	resAll, err := suite.queryClient.RateLimits(sdk.WrapSDKContext(ctx), &types.QueryRateLimitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.RateLimit{rateLimit, expiredRateLimit}, resAll.RateLimits)
+}
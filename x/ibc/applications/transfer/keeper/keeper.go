@@ -30,6 +30,8 @@ type Keeper struct {
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	scopedKeeper  capabilitykeeper.ScopedKeeper
+
+	receiveHooks *types.ReceiveHooks
 }
 
 // NewKeeper creates a new IBC transfer Keeper instance
@@ -61,6 +63,17 @@ func NewKeeper(
 	}
 }
 
+// SetReceiveHooks sets the receive hooks run for the memos of the received
+// transfer packets. It panics if the hooks have already been set.
+func (k *Keeper) SetReceiveHooks(hooks *types.ReceiveHooks) *Keeper {
+	if k.receiveHooks != nil {
+		panic("cannot set transfer receive hooks twice")
+	}
+
+	k.receiveHooks = hooks
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, types.ModuleName))
@@ -159,6 +172,47 @@ func (k Keeper) IterateDenomTraces(ctx sdk.Context, cb func(denomTrace types.Den
 	}
 }
 
+// GetForwardedPacket returns a packet sent by the forward receive hook which is
+// still in flight.
+func (k Keeper) GetForwardedPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.ForwardedPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetForwardedPacketKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.ForwardedPacket{}, false
+	}
+
+	return k.MustUnmarshalForwardedPacket(bz), true
+}
+
+// SetForwardedPacket stores a packet sent by the forward receive hook until it
+// is acknowledged or timed out.
+func (k Keeper) SetForwardedPacket(ctx sdk.Context, forwardedPacket types.ForwardedPacket) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&forwardedPacket)
+	store.Set(types.GetForwardedPacketKey(forwardedPacket.PortId, forwardedPacket.ChannelId, forwardedPacket.Sequence), bz)
+}
+
+// DeleteForwardedPacket deletes a packet sent by the forward receive hook.
+func (k Keeper) DeleteForwardedPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetForwardedPacketKey(portID, channelID, sequence))
+}
+
+// GetAllForwardedPackets returns the packets sent by the forward receive hook
+// which are still in flight.
+func (k Keeper) GetAllForwardedPackets(ctx sdk.Context) []types.ForwardedPacket {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ForwardedPacketKey)
+	defer iterator.Close()
+
+	forwardedPackets := []types.ForwardedPacket{}
+	for ; iterator.Valid(); iterator.Next() {
+		forwardedPackets = append(forwardedPackets, k.MustUnmarshalForwardedPacket(iterator.Value()))
+	}
+
+	return forwardedPackets
+}
+
 // AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
 func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
 	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
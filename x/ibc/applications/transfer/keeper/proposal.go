@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+)
+
+// SetRateLimitProposal sets the rate limit of a denom on a transfer channel
+// if and only if the proposal passes. The current supply of the denom is the
+// channel value, and the flow of an existing rate limit is discarded. The
+// proposal fails if the denom has no supply, since no amount could then be
+// transferred.
+func (k Keeper) SetRateLimitProposal(ctx sdk.Context, p *types.SetRateLimitProposal) error {
+	portID := k.GetPort(ctx)
+	if _, found := k.channelKeeper.GetChannel(ctx, portID, p.ChannelId); !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, p.ChannelId)
+	}
+
+	channelValue := k.bankKeeper.GetSupply(ctx, p.Denom).Amount
+	if !channelValue.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrInvalidRateLimit, "denom %s has no supply", p.Denom)
+	}
+
+	rateLimit := types.NewRateLimit(
+		p.ChannelId, p.Denom, p.MaxPercentSend, p.MaxPercentRecv, p.Duration,
+		types.NewRateLimitFlow(channelValue),
+	)
+	k.SetRateLimit(ctx, rateLimit)
+
+	k.Logger(ctx).Info("rate limit set after governance proposal passed", "channel-id", p.ChannelId, "denom", p.Denom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetRateLimit,
+			sdk.NewAttribute(types.AttributeKeyChannel, p.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDenom, p.Denom),
+			sdk.NewAttribute(types.AttributeKeyMaxPercentSend, p.MaxPercentSend.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxPercentRecv, p.MaxPercentRecv.String()),
+			sdk.NewAttribute(types.AttributeKeyDuration, p.Duration.String()),
+		),
+	)
+
+	return nil
+}
+
+// RemoveRateLimitProposal removes the rate limit of a denom on a transfer
+// channel if and only if the proposal passes.
+func (k Keeper) RemoveRateLimitProposal(ctx sdk.Context, p *types.RemoveRateLimitProposal) error {
+	if _, found := k.GetRateLimit(ctx, p.ChannelId, p.Denom); !found {
+		return sdkerrors.Wrapf(types.ErrRateLimitNotFound, "channel ID %s, denom %s", p.ChannelId, p.Denom)
+	}
+	k.DeleteRateLimit(ctx, p.ChannelId, p.Denom)
+
+	k.Logger(ctx).Info("rate limit removed after governance proposal passed", "channel-id", p.ChannelId, "denom", p.Denom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveRateLimit,
+			sdk.NewAttribute(types.AttributeKeyChannel, p.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDenom, p.Denom),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+)
+
+// GetRateLimit returns the rate limit of a denom on a channel.
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRateLimitKey(channelID, denom))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	return k.MustUnmarshalRateLimit(bz), true
+}
+
+// SetRateLimit sets the rate limit of a denom on a channel.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&rateLimit)
+	store.Set(types.GetRateLimitKey(rateLimit.ChannelId, rateLimit.Denom), bz)
+}
+
+// DeleteRateLimit deletes the rate limit of a denom on a channel.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, channelID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRateLimitKey(channelID, denom))
+}
+
+// GetAllRateLimits returns the rate limits of all the denoms on all the
+// channels.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RateLimitKey)
+	defer iterator.Close()
+
+	rateLimits := []types.RateLimit{}
+	for ; iterator.Valid(); iterator.Next() {
+		rateLimits = append(rateLimits, k.MustUnmarshalRateLimit(iterator.Value()))
+	}
+
+	return rateLimits
+}
+
+// GetPendingSendPacket returns a packet counted in the outflow of a rate limit
+// which is still in flight.
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) (types.PendingSendPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingSendPacketKey(channelID, sequence))
+	if bz == nil {
+		return types.PendingSendPacket{}, false
+	}
+
+	return k.MustUnmarshalPendingSendPacket(bz), true
+}
+
+// SetPendingSendPacket stores a packet counted in the outflow of a rate limit
+// until it is acknowledged or timed out.
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, pendingSendPacket types.PendingSendPacket) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&pendingSendPacket)
+	store.Set(types.GetPendingSendPacketKey(pendingSendPacket.ChannelId, pendingSendPacket.Sequence), bz)
+}
+
+// DeletePendingSendPacket deletes a packet counted in the outflow of a rate
+// limit.
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingSendPacketKey(channelID, sequence))
+}
+
+// GetAllPendingSendPackets returns the packets counted in the outflow of a
+// rate limit which are still in flight.
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingSendPacketKey)
+	defer iterator.Close()
+
+	pendingSendPackets := []types.PendingSendPacket{}
+	for ; iterator.Valid(); iterator.Next() {
+		pendingSendPackets = append(pendingSendPackets, k.MustUnmarshalPendingSendPacket(iterator.Value()))
+	}
+
+	return pendingSendPackets
+}
+
+// GetCurrentRateLimit returns the rate limit with the flow of the rolling
+// window of the current block. If the latest bucket is over, the window moves
+// forward to a new bucket with the current supply of the denom as the channel
+// value.
+func (k Keeper) GetCurrentRateLimit(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimit {
+	if rateLimit.IsBucketExpired(ctx.BlockTime()) {
+		supply := k.bankKeeper.GetSupply(ctx, rateLimit.Denom).Amount
+		rateLimit.StartBucket(ctx.BlockTime(), supply)
+	}
+
+	return rateLimit
+}
+
+// checkSendRateLimit adds the tokens sent in a packet to the outflow of their
+// rate limit on the channel, if any. It returns an error if the send quota is
+// exceeded. The packet is stored so that its amount is restored to the quota
+// if it fails.
+func (k Keeper) checkSendRateLimit(ctx sdk.Context, channelID string, sequence uint64, token sdk.Coin) error {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, token.Denom)
+	if !found {
+		return nil
+	}
+
+	rateLimit = k.GetCurrentRateLimit(ctx, rateLimit)
+	if err := rateLimit.AddOutflow(token.Amount); err != nil {
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	k.SetPendingSendPacket(ctx, types.NewPendingSendPacket(channelID, sequence, rateLimit.BucketStart(ctx.BlockTime())))
+	return nil
+}
+
+// checkRecvRateLimit adds the tokens received in a packet to the inflow of
+// their rate limit on the channel, if any. It returns an error if the receive
+// quota is exceeded.
+func (k Keeper) checkRecvRateLimit(ctx sdk.Context, channelID string, token sdk.Coin) error {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, token.Denom)
+	if !found {
+		return nil
+	}
+
+	rateLimit = k.GetCurrentRateLimit(ctx, rateLimit)
+	if err := rateLimit.AddInflow(token.Amount); err != nil {
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// restoreRateLimitQuota removes the tokens of a failed packet from the outflow
+// of their rate limit, if the bucket the packet was sent in is still in the
+// window.
+func (k Keeper) restoreRateLimitQuota(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) {
+	pendingSendPacket, found := k.GetPendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return
+	}
+	k.DeletePendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+
+	denom := types.ParseDenomTrace(data.Denom).IBCDenom()
+	rateLimit, found := k.GetRateLimit(ctx, packet.GetSourceChannel(), denom)
+	if !found || !rateLimit.RemoveOutflow(pendingSendPacket.BucketStart, sdk.NewIntFromUint64(data.Amount)) {
+		return
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+}
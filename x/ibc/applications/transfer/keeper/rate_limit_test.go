@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+// setupRateLimit creates a transfer channel between chainA and chainB, mints
+// 1000atom to the sender on chainA and sets a rate limit of 10% of the atom
+// supply on the channel of chainA.
+func (suite *KeeperTestSuite) setupRateLimit() (channelA, channelB ibctesting.TestChannel) {
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	channelA, channelB = suite.coordinator.CreateTransferChannels(suite.chainA, suite.chainB, connA, connB, channeltypes.UNORDERED)
+
+	ctx := suite.chainA.GetContext()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	suite.Require().NoError(suite.chainA.App.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	suite.Require().NoError(suite.chainA.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, suite.chainA.SenderAccount.GetAddress(), coins))
+
+	proposal := types.NewSetRateLimitProposal("title", "description", channelA.ID, "atom", sdk.NewDec(10), sdk.NewDec(10), time.Hour)
+	suite.Require().NoError(suite.chainA.App.TransferKeeper.SetRateLimitProposal(ctx, proposal))
+
+	return channelA, channelB
+}
+
+// sendTransfer sends atom from chainA to chainB.
+func (suite *KeeperTestSuite) sendTransfer(ctx sdk.Context, channelA ibctesting.TestChannel, amount int64) error {
+	return suite.chainA.App.TransferKeeper.SendTransfer(
+		ctx, channelA.PortID, channelA.ID, sdk.NewInt64Coin("atom", amount), suite.chainA.SenderAccount.GetAddress(),
+		suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "",
+	)
+}
+
+func (suite *KeeperTestSuite) TestSendRateLimit() {
+	channelA, channelB := suite.setupRateLimit()
+	ctx := suite.chainA.GetContext()
+
+	suite.Require().NoError(suite.sendTransfer(ctx, channelA, 100))
+	err := suite.sendTransfer(ctx, channelA, 1)
+	suite.Require().True(types.ErrRateLimitExceeded.Is(err))
+
+	pendingSendPacket, found := suite.chainA.App.TransferKeeper.GetPendingSendPacket(ctx, channelA.ID, 1)
+	suite.Require().True(found)
+	rateLimit, found := suite.chainA.App.TransferKeeper.GetRateLimit(ctx, channelA.ID, "atom")
+	suite.Require().True(found)
+	suite.Require().Equal(rateLimit.BucketStart(ctx.BlockTime()), pendingSendPacket.BucketStart)
+
+	// the timed out packet is refunded and its amount restored to the quota
+	data := types.NewFungibleTokenPacketData("atom", 100, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, channelA.PortID, channelA.ID, channelB.PortID, channelB.ID, clienttypes.NewHeight(0, 110), 0)
+	suite.Require().NoError(suite.chainA.App.TransferKeeper.OnTimeoutPacket(ctx, packet, data))
+
+	_, found = suite.chainA.App.TransferKeeper.GetPendingSendPacket(ctx, channelA.ID, 1)
+	suite.Require().False(found)
+	rateLimit, _ = suite.chainA.App.TransferKeeper.GetRateLimit(ctx, channelA.ID, "atom")
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+
+	suite.Require().NoError(suite.sendTransfer(ctx, channelA, 100))
+
+	// the quota is available again once the bucket leaves the window
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(suite.sendTransfer(ctx, channelA, 100))
+
+	// packets sent in a bucket which left the window don't restore the quota
+	data = types.NewFungibleTokenPacketData("atom", 100, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+	packet = channeltypes.NewPacket(data.GetBytes(), 2, channelA.PortID, channelA.ID, channelB.PortID, channelB.ID, clienttypes.NewHeight(0, 110), 0)
+	ack := channeltypes.NewErrorAcknowledgement("failed")
+	suite.Require().NoError(suite.chainA.App.TransferKeeper.OnAcknowledgementPacket(ctx, packet, data, ack))
+
+	rateLimit, _ = suite.chainA.App.TransferKeeper.GetRateLimit(ctx, channelA.ID, "atom")
+	suite.Require().Equal(sdk.NewInt(100), rateLimit.Flow.Outflow)
+	suite.Require().Len(rateLimit.Flow.Buckets, 1)
+	suite.Require().Equal(rateLimit.BucketStart(ctx.BlockTime()), rateLimit.Flow.Buckets[0].Start)
+}
+
+func (suite *KeeperTestSuite) TestSendRateLimitRollingWindow() {
+	channelA, _ := suite.setupRateLimit()
+	ctx := suite.chainA.GetContext()
+	start := ctx.BlockTime()
+
+	// the quota is used at the end of the hour since the rate limit was set
+	ctx = ctx.WithBlockTime(start.Add(59 * time.Minute))
+	suite.Require().NoError(suite.sendTransfer(ctx, channelA, 100))
+
+	// the quota is not available again after the hour
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	err := suite.sendTransfer(ctx, channelA, 1)
+	suite.Require().True(types.ErrRateLimitExceeded.Is(err))
+
+	rateLimit, _ := suite.chainA.App.TransferKeeper.GetRateLimit(ctx, channelA.ID, "atom")
+	bucketStart := rateLimit.BucketStart(start.Add(59 * time.Minute))
+	ctx = ctx.WithBlockTime(bucketStart.Add(time.Hour - 1))
+	err = suite.sendTransfer(ctx, channelA, 1)
+	suite.Require().True(types.ErrRateLimitExceeded.Is(err))
+
+	ctx = ctx.WithBlockTime(bucketStart.Add(time.Hour))
+	suite.Require().NoError(suite.sendTransfer(ctx, channelA, 100))
+}
+
+func (suite *KeeperTestSuite) TestRecvRateLimit() {
+	channelA, channelB := suite.setupRateLimit()
+	ctx := suite.chainA.GetContext()
+
+	escrow := types.GetEscrowAddress(channelA.PortID, channelA.ID)
+	err := suite.chainA.App.BankKeeper.SendCoins(ctx, suite.chainA.SenderAccount.GetAddress(), escrow, sdk.NewCoins(sdk.NewInt64Coin("atom", 300)))
+	suite.Require().NoError(err)
+
+	recvPacket := func(amount uint64) error {
+		denom := types.GetPrefixedDenom(channelB.PortID, channelB.ID, "atom")
+		data := types.NewFungibleTokenPacketData(denom, amount, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), "")
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, channelB.PortID, channelB.ID, channelA.PortID, channelA.ID, clienttypes.NewHeight(0, 110), 0)
+		return suite.chainA.App.TransferKeeper.OnRecvPacket(ctx, packet, data)
+	}
+
+	err = recvPacket(101)
+	suite.Require().True(types.ErrRateLimitExceeded.Is(err))
+	suite.Require().NoError(recvPacket(100))
+	suite.Require().Error(recvPacket(1))
+
+	// the quota applies to the net inflow
+	suite.Require().NoError(suite.sendTransfer(ctx, channelA, 50))
+	suite.Require().NoError(recvPacket(50))
+
+	rateLimit, found := suite.chainA.App.TransferKeeper.GetRateLimit(ctx, channelA.ID, "atom")
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(150), rateLimit.Flow.Inflow)
+	suite.Require().Equal(sdk.NewInt(50), rateLimit.Flow.Outflow)
+}
+
+func (suite *KeeperTestSuite) TestRemoveRateLimitProposal() {
+	channelA, _ := suite.setupRateLimit()
+	ctx := suite.chainA.GetContext()
+
+	proposal := types.NewRemoveRateLimitProposal("title", "description", channelA.ID, "atom")
+	suite.Require().NoError(suite.chainA.App.TransferKeeper.RemoveRateLimitProposal(ctx, proposal))
+	suite.Require().NoError(suite.sendTransfer(ctx, channelA, 1000))
+
+	err := suite.chainA.App.TransferKeeper.RemoveRateLimitProposal(ctx, proposal)
+	suite.Require().True(types.ErrRateLimitNotFound.Is(err))
+
+	// rate limits cannot be set on denoms without supply
+	setProposal := types.NewSetRateLimitProposal("title", "description", channelA.ID, "nosupply", sdk.NewDec(10), sdk.NewDec(10), time.Hour)
+	err = suite.chainA.App.TransferKeeper.SetRateLimitProposal(ctx, setProposal)
+	suite.Require().True(types.ErrInvalidRateLimit.Is(err))
+
+	// rate limits can only be set on existing channels
+	setProposal = types.NewSetRateLimitProposal("title", "description", "channelidone", "atom", sdk.NewDec(10), sdk.NewDec(10), time.Hour)
+	suite.Require().Error(suite.chainA.App.TransferKeeper.SetRateLimitProposal(ctx, setProposal))
+}
@@ -47,6 +47,12 @@ import (
 // 4. A -> C : sender chain is sink zone. Denom upon receiving: 'C/B/denom'
 // 5. C -> B : sender chain is sink zone. Denom upon receiving: 'B/denom'
 // 6. B -> A : sender chain is sink zone. Denom upon receiving: 'denom'
+//
+// The memo is carried by the packet data to the receive hooks of the
+// receiving chain.
+//
+// If the token has a rate limit on the source channel, the transfer fails
+// when the net amount sent in the rolling window exceeds the send quota.
 func (k Keeper) SendTransfer(
 	ctx sdk.Context,
 	sourcePort,
@@ -56,6 +62,7 @@ func (k Keeper) SendTransfer(
 	receiver string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
+	memo string,
 ) error {
 
 	if !k.GetSendEnabled(ctx) {
@@ -100,6 +107,10 @@ func (k Keeper) SendTransfer(
 		}
 	}
 
+	if err := k.checkSendRateLimit(ctx, sourceChannel, sequence, token); err != nil {
+		return err
+	}
+
 	labels := []metrics.Label{
 		telemetry.NewLabel("destination-port", destinationPort),
 		telemetry.NewLabel("destination-channel", destinationChannel),
@@ -143,7 +154,7 @@ func (k Keeper) SendTransfer(
 	}
 
 	packetData := types.NewFungibleTokenPacketData(
-		fullDenomPath, token.Amount.Uint64(), sender.String(), receiver,
+		fullDenomPath, token.Amount.Uint64(), sender.String(), receiver, memo,
 	)
 
 	packet := channeltypes.NewPacket(
@@ -183,20 +194,46 @@ func (k Keeper) SendTransfer(
 // and sent to the receiving address. Otherwise if the sender chain is sending
 // back tokens this chain originally transferred to it, the tokens are
 // unescrowed and sent to the receiving address.
+//
+// The receive hooks are then run for the memo of the packet. The tokens are
+// only received if all the hooks succeed and the net amount received in the
+// rolling window of their rate limit on the channel, if any, doesn't exceed
+// the receive quota.
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	token, err := k.receiveTokens(cacheCtx, packet, data)
+	if err != nil {
+		return err
+	}
+
+	if k.receiveHooks != nil {
+		if err := k.receiveHooks.OnRecvPacket(cacheCtx, packet, data, token); err != nil {
+			return err
+		}
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+// receiveTokens mints or unescrows the tokens of a transfer packet to the
+// receiver, and returns the received coin.
+func (k Keeper) receiveTokens(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) (sdk.Coin, error) {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
-		return err
+		return sdk.Coin{}, err
 	}
 
 	if !k.GetReceiveEnabled(ctx) {
-		return types.ErrReceiveDisabled
+		return sdk.Coin{}, types.ErrReceiveDisabled
 	}
 
 	// decode the receiver address
 	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
 	if err != nil {
-		return err
+		return sdk.Coin{}, err
 	}
 
 	labels := []metrics.Label{
@@ -220,6 +257,10 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		unprefixedDenom := data.Denom[len(voucherPrefix):]
 		token := sdk.NewCoin(unprefixedDenom, sdk.NewIntFromUint64(data.Amount))
 
+		if err := k.checkRecvRateLimit(ctx, packet.GetDestChannel(), token); err != nil {
+			return sdk.Coin{}, err
+		}
+
 		// unescrow tokens
 		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
 		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, sdk.NewCoins(token)); err != nil {
@@ -227,7 +268,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			// counterparty module. The bug may occur in bank or any part of the code that allows
 			// the escrow address to be drained. A malicious counterparty module could drain the
 			// escrow address by allowing more tokens to be sent back then were escrowed.
-			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
+			return sdk.Coin{}, sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
 		}
 
 		defer func() {
@@ -246,7 +287,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			)
 		}()
 
-		return nil
+		return token, nil
 	}
 
 	// sender chain is the source, mint vouchers
@@ -275,11 +316,15 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 
 	voucher := sdk.NewCoin(voucherDenom, sdk.NewIntFromUint64(data.Amount))
 
+	if err := k.checkRecvRateLimit(ctx, packet.GetDestChannel(), voucher); err != nil {
+		return sdk.Coin{}, err
+	}
+
 	// mint new tokens if the source of the transfer is the same chain
 	if err := k.bankKeeper.MintCoins(
 		ctx, types.ModuleName, sdk.NewCoins(voucher),
 	); err != nil {
-		return err
+		return sdk.Coin{}, err
 	}
 
 	// send to receiver
@@ -305,28 +350,47 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		)
 	}()
 
-	return nil
+	return voucher, nil
 }
 
 // OnAcknowledgementPacket responds to the the success or failure of a packet
 // acknowledgement written on the receiving chain. If the acknowledgement
 // was a success then nothing occurs. If the acknowledgement failed, then
 // the sender is refunded their tokens using the refundPacketToken function.
+//
+// The refunded amount is restored to the send quota of the rate limit of the
+// tokens if the packet was sent in a bucket which is still in its window. If
+// the packet was sent by the forward receive hook, the refunded tokens are then transferred back
+// to the sender of the packet it forwarded.
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		return k.refundPacketToken(ctx, packet, data)
+		if err := k.refundPacketToken(ctx, packet, data); err != nil {
+			return err
+		}
+		k.restoreRateLimitQuota(ctx, packet, data)
+		return k.refundForwardedPacket(ctx, packet, data)
 	default:
 		// the acknowledgement succeeded on the receiving chain so nothing
 		// needs to be executed and no error needs to be returned
+		k.DeleteForwardedPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		k.DeletePendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
 		return nil
 	}
 }
 
 // OnTimeoutPacket refunds the sender since the original packet sent was
-// never received and has been timed out.
+// never received and has been timed out. The refunded amount is restored to
+// the send quota of the rate limit of the tokens if the packet was sent in a
+// bucket which is still in its window. If the packet was sent by the forward
+// receive hook, the refunded tokens are then transferred back to the sender of
+// the packet it forwarded.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
-	return k.refundPacketToken(ctx, packet, data)
+	if err := k.refundPacketToken(ctx, packet, data); err != nil {
+		return err
+	}
+	k.restoreRateLimitQuota(ctx, packet, data)
+	return k.refundForwardedPacket(ctx, packet, data)
 }
 
 // refundPacketToken will unescrow and send back the tokens back to sender
@@ -0,0 +1,25 @@
+package transfer
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+)
+
+// NewRateLimitProposalHandler defines the transfer rate limit proposal handler
+func NewRateLimitProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetRateLimitProposal:
+			return k.SetRateLimitProposal(ctx, c)
+
+		case *types.RemoveRateLimitProposal:
+			return k.RemoveRateLimitProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ICS-20 transfer proposal content type: %T", c)
+		}
+	}
+}
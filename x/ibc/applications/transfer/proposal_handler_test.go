@@ -0,0 +1,41 @@
+package transfer_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func (suite *TransferTestSuite) TestRateLimitProposalHandler() {
+	_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	channelA, _ := suite.coordinator.CreateTransferChannels(suite.chainA, suite.chainB, connA, connB, channeltypes.UNORDERED)
+
+	ctx := suite.chainA.GetContext()
+	handler := transfer.NewRateLimitProposalHandler(suite.chainA.App.TransferKeeper)
+
+	setProposal := types.NewSetRateLimitProposal(
+		ibctesting.Title, ibctesting.Description, channelA.ID, sdk.DefaultBondDenom, sdk.NewDec(10), sdk.NewDec(20), time.Hour,
+	)
+	suite.Require().NoError(handler(ctx, setProposal))
+
+	rateLimit, found := suite.chainA.App.TransferKeeper.GetRateLimit(ctx, channelA.ID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(10), rateLimit.MaxPercentSend)
+	suite.Require().Equal(sdk.NewDec(20), rateLimit.MaxPercentRecv)
+	suite.Require().Equal(suite.chainA.App.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount, rateLimit.Flow.ChannelValue)
+
+	removeProposal := types.NewRemoveRateLimitProposal(ibctesting.Title, ibctesting.Description, channelA.ID, sdk.DefaultBondDenom)
+	suite.Require().NoError(handler(ctx, removeProposal))
+
+	_, found = suite.chainA.App.TransferKeeper.GetRateLimit(ctx, channelA.ID, sdk.DefaultBondDenom)
+	suite.Require().False(found)
+
+	// other proposals are rejected
+	suite.Require().Error(handler(ctx, distributiontypes.NewCommunityPoolSpendProposal(ibctesting.Title, ibctesting.Description, suite.chainA.SenderAccount.GetAddress(), nil)))
+}
@@ -11,10 +11,14 @@ import (
 // TransferUnmarshaler defines the expected encoding store functions.
 type TransferUnmarshaler interface {
 	MustUnmarshalDenomTrace([]byte) types.DenomTrace
+	MustUnmarshalForwardedPacket([]byte) types.ForwardedPacket
+	MustUnmarshalRateLimit([]byte) types.RateLimit
+	MustUnmarshalPendingSendPacket([]byte) types.PendingSendPacket
 }
 
 // NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
-// Value to the corresponding DenomTrace type.
+// Value to the corresponding DenomTrace, ForwardedPacket, RateLimit or
+// PendingSendPacket type.
 func NewDecodeStore(cdc TransferUnmarshaler) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
@@ -26,6 +30,21 @@ func NewDecodeStore(cdc TransferUnmarshaler) func(kvA, kvB kv.Pair) string {
 			denomTraceB := cdc.MustUnmarshalDenomTrace(kvB.Value)
 			return fmt.Sprintf("DenomTrace A: %s\nDenomTrace B: %s", denomTraceA.IBCDenom(), denomTraceB.IBCDenom())
 
+		case bytes.Equal(kvA.Key[:1], types.ForwardedPacketKey):
+			forwardedPacketA := cdc.MustUnmarshalForwardedPacket(kvA.Value)
+			forwardedPacketB := cdc.MustUnmarshalForwardedPacket(kvB.Value)
+			return fmt.Sprintf("%v\n%v", forwardedPacketA, forwardedPacketB)
+
+		case bytes.Equal(kvA.Key[:1], types.RateLimitKey):
+			rateLimitA := cdc.MustUnmarshalRateLimit(kvA.Value)
+			rateLimitB := cdc.MustUnmarshalRateLimit(kvB.Value)
+			return fmt.Sprintf("%v\n%v", rateLimitA, rateLimitB)
+
+		case bytes.Equal(kvA.Key[:1], types.PendingSendPacketKey):
+			pendingSendPacketA := cdc.MustUnmarshalPendingSendPacket(kvA.Value)
+			pendingSendPacketB := cdc.MustUnmarshalPendingSendPacket(kvB.Value)
+			return fmt.Sprintf("%v\n%v", pendingSendPacketA, pendingSendPacketB)
+
 		default:
 			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 		}
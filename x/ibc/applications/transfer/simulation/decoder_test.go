@@ -3,10 +3,12 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/simulation"
 	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
@@ -20,6 +22,12 @@ func TestDecodeStore(t *testing.T) {
 		BaseDenom: "uatom",
 		Path:      "transfer/channelToA",
 	}
+	forwardedPacket := types.NewForwardedPacket(types.PortID, "channelToB", 1, types.PortID, "channelToA", "cosmos1sender", "")
+	rateLimit := types.NewRateLimit(
+		"channelToA", "uatom", sdk.NewDec(10), sdk.NewDec(10), time.Hour,
+		types.NewRateLimitFlow(sdk.NewInt(1000)),
+	)
+	pendingSendPacket := types.NewPendingSendPacket("channelToA", 1, time.Unix(0, 0).UTC())
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -31,6 +39,18 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.DenomTraceKey,
 				Value: app.TransferKeeper.MustMarshalDenomTrace(trace),
 			},
+			{
+				Key:   types.GetForwardedPacketKey(types.PortID, "channelToB", 1),
+				Value: app.AppCodec().MustMarshalBinaryBare(&forwardedPacket),
+			},
+			{
+				Key:   types.GetRateLimitKey("channelToA", "uatom"),
+				Value: app.AppCodec().MustMarshalBinaryBare(&rateLimit),
+			},
+			{
+				Key:   types.GetPendingSendPacketKey("channelToA", 1),
+				Value: app.AppCodec().MustMarshalBinaryBare(&pendingSendPacket),
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -43,6 +63,9 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"PortID", fmt.Sprintf("Port A: %s\nPort B: %s", types.PortID, types.PortID)},
 		{"DenomTrace", fmt.Sprintf("DenomTrace A: %s\nDenomTrace B: %s", trace.IBCDenom(), trace.IBCDenom())},
+		{"ForwardedPacket", fmt.Sprintf("%v\n%v", forwardedPacket, forwardedPacket)},
+		{"RateLimit", fmt.Sprintf("%v\n%v", rateLimit, rateLimit)},
+		{"PendingSendPacket", fmt.Sprintf("%v\n%v", pendingSendPacket, pendingSendPacket)},
 		{"other", ""},
 	}
 
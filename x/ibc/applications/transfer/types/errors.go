@@ -13,4 +13,9 @@ var (
 	ErrTraceNotFound           = sdkerrors.Register(ModuleName, 6, "denomination trace not found")
 	ErrSendDisabled            = sdkerrors.Register(ModuleName, 7, "fungible token transfers from this chain are disabled")
 	ErrReceiveDisabled         = sdkerrors.Register(ModuleName, 8, "fungible token transfers to this chain are disabled")
+	ErrInvalidMemo             = sdkerrors.Register(ModuleName, 9, "invalid transfer memo")
+	ErrReceiveHookFailed       = sdkerrors.Register(ModuleName, 10, "transfer receive hook failed")
+	ErrRateLimitExceeded       = sdkerrors.Register(ModuleName, 11, "transfer rate limit exceeded")
+	ErrRateLimitNotFound       = sdkerrors.Register(ModuleName, 12, "transfer rate limit not found")
+	ErrInvalidRateLimit        = sdkerrors.Register(ModuleName, 13, "invalid transfer rate limit")
 )
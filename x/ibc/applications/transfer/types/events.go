@@ -2,11 +2,15 @@ package types
 
 // IBC transfer events
 const (
-	EventTypeTimeout      = "timeout"
-	EventTypePacket       = "fungible_token_packet"
-	EventTypeTransfer     = "ibc_transfer"
-	EventTypeChannelClose = "channel_closed"
-	EventTypeDenomTrace   = "denomination_trace"
+	EventTypeTimeout         = "timeout"
+	EventTypePacket          = "fungible_token_packet"
+	EventTypeTransfer        = "ibc_transfer"
+	EventTypeChannelClose    = "channel_closed"
+	EventTypeDenomTrace      = "denomination_trace"
+	EventTypeForward         = "forward"
+	EventTypeRefund          = "forward_refund"
+	EventTypeSetRateLimit    = "set_rate_limit"
+	EventTypeRemoveRateLimit = "remove_rate_limit"
 
 	AttributeKeyReceiver       = "receiver"
 	AttributeKeyDenom          = "denom"
@@ -18,4 +22,11 @@ const (
 	AttributeKeyAck            = "acknowledgement"
 	AttributeKeyAckError       = "error"
 	AttributeKeyTraceHash      = "trace_hash"
+	AttributeKeyMemo           = "memo"
+	AttributeKeyChannel        = "channel"
+	AttributeKeySequence       = "sequence"
+	AttributeKeyRefundError    = "refund_error"
+	AttributeKeyMaxPercentSend = "max_percent_send"
+	AttributeKeyMaxPercentRecv = "max_percent_recv"
+	AttributeKeyDuration       = "duration"
 )
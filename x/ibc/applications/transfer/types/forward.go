@@ -0,0 +1,189 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+)
+
+// ForwardMemoKey is the memo key of the forward receive hook.
+const ForwardMemoKey = "forward"
+
+// ForwardMetadata defines the memo data of the forward receive hook, which
+// sends the received tokens from the receiver to another chain, e.g.:
+//
+//   {"forward":{"receiver":"cosmos1...","port":"transfer","channel":"channel-1"}}
+//
+// Next is the memo of the forwarded packet, either a JSON string or a JSON
+// object such as another forward to route through several chains.
+//
+// RefundMemo is the memo of the transfer refunding the tokens back to the
+// sender of the received packet if the forwarded packet fails. It is set by
+// the forward hook of the previous chain so that refunds flow back along the
+// path. Refund marks such refund transfers, whose tokens are left with the
+// receiver if they fail in turn.
+type ForwardMetadata struct {
+	Receiver   string          `json:"receiver"`
+	Port       string          `json:"port"`
+	Channel    string          `json:"channel"`
+	Timeout    uint64          `json:"timeout,omitempty"`
+	Next       json.RawMessage `json:"next,omitempty"`
+	RefundMemo json.RawMessage `json:"refund_memo,omitempty"`
+	Refund     bool            `json:"refund,omitempty"`
+}
+
+// NewForwardMemo returns the memo of a transfer forwarded to the receiver on
+// the chain at the other end of a channel. The timeout (in nanoseconds) is
+// relative to the block time of the forwarding chain, and the default timeout
+// is used when set to 0.
+func NewForwardMemo(receiver, portID, channelID string, timeout uint64, next string) string {
+	return ForwardMetadata{
+		Receiver: receiver,
+		Port:     portID,
+		Channel:  channelID,
+		Timeout:  timeout,
+		Next:     memoToJSON(next),
+	}.Memo()
+}
+
+// ValidateBasic performs a basic check of the forward metadata fields.
+// NOTE: The receiver address format is not validated as the format defined by
+// the next chain is not known to IBC.
+func (fm ForwardMetadata) ValidateBasic() error {
+	if strings.TrimSpace(fm.Receiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "forward receiver address cannot be blank")
+	}
+	if err := host.PortIdentifierValidator(fm.Port); err != nil {
+		return sdkerrors.Wrap(err, "invalid forward port ID")
+	}
+	if err := host.ChannelIdentifierValidator(fm.Channel); err != nil {
+		return sdkerrors.Wrap(err, "invalid forward channel ID")
+	}
+	return nil
+}
+
+// Memo returns the memo of a transfer forwarded with the metadata.
+func (fm ForwardMetadata) Memo() string {
+	bz, err := json.Marshal(map[string]ForwardMetadata{ForwardMemoKey: fm})
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
+// GetTimeout returns the relative timeout of the forwarded packet.
+func (fm ForwardMetadata) GetTimeout() uint64 {
+	if fm.Timeout == 0 {
+		return DefaultRelativePacketTimeoutTimestamp
+	}
+	return fm.Timeout
+}
+
+// GetNextMemo returns the memo of the forwarded packet.
+func (fm ForwardMetadata) GetNextMemo() string {
+	return memoFromJSON(fm.Next)
+}
+
+// GetRefundMemo returns the memo of the refund transfer.
+func (fm ForwardMetadata) GetRefundMemo() string {
+	return memoFromJSON(fm.RefundMemo)
+}
+
+// SetForwardRefundMemo sets the refund memo of the forward in a memo, if any.
+// Other memos are returned as is.
+func SetForwardRefundMemo(memo, refundMemo string) (string, error) {
+	fields, ok := ParseMemo(memo)
+	if !ok {
+		return memo, nil
+	}
+	hookData, ok := fields[ForwardMemoKey]
+	if !ok {
+		return memo, nil
+	}
+
+	var metadata ForwardMetadata
+	if err := json.Unmarshal(hookData, &metadata); err != nil {
+		return "", sdkerrors.Wrapf(ErrInvalidMemo, "cannot unmarshal forward metadata: %s", err)
+	}
+	metadata.RefundMemo = memoToJSON(refundMemo)
+
+	bz, err := json.Marshal(metadata)
+	if err != nil {
+		return "", err
+	}
+	fields[ForwardMemoKey] = bz
+
+	bz, err = json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// memoToJSON embeds a memo in JSON: memos which are JSON objects are embedded
+// as is, and other memos as JSON strings.
+func memoToJSON(memo string) json.RawMessage {
+	if memo == "" {
+		return nil
+	}
+	if _, ok := ParseMemo(memo); ok {
+		return json.RawMessage(memo)
+	}
+
+	bz, err := json.Marshal(memo)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// memoFromJSON returns the memo embedded in JSON by memoToJSON.
+func memoFromJSON(bz json.RawMessage) string {
+	var memo string
+	if err := json.Unmarshal(bz, &memo); err == nil {
+		return memo
+	}
+	return string(bz)
+}
+
+// NewForwardedPacket creates a new ForwardedPacket instance.
+func NewForwardedPacket(
+	portID, channelID string, sequence uint64,
+	refundPortID, refundChannelID, refundReceiver, refundMemo string,
+) ForwardedPacket {
+	return ForwardedPacket{
+		PortId:          portID,
+		ChannelId:       channelID,
+		Sequence:        sequence,
+		RefundPortId:    refundPortID,
+		RefundChannelId: refundChannelID,
+		RefundReceiver:  refundReceiver,
+		RefundMemo:      refundMemo,
+	}
+}
+
+// Validate performs a basic validation of the forwarded packet fields.
+func (fp ForwardedPacket) Validate() error {
+	if err := host.PortIdentifierValidator(fp.PortId); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(fp.ChannelId); err != nil {
+		return err
+	}
+	if fp.Sequence == 0 {
+		return sdkerrors.Wrap(channeltypes.ErrInvalidPacket, "packet sequence cannot be 0")
+	}
+	if err := host.PortIdentifierValidator(fp.RefundPortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid refund port ID")
+	}
+	if err := host.ChannelIdentifierValidator(fp.RefundChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid refund channel ID")
+	}
+	if strings.TrimSpace(fp.RefundReceiver) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "refund receiver address cannot be blank")
+	}
+	return nil
+}
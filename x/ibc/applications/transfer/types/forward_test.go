@@ -0,0 +1,93 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+)
+
+func TestForwardMetadataValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata types.ForwardMetadata
+		expPass  bool
+	}{
+		{"valid metadata", types.ForwardMetadata{Receiver: "cosmos1receiver", Port: "transfer", Channel: "channelidone"}, true},
+		{"missing receiver", types.ForwardMetadata{Port: "transfer", Channel: "channelidone"}, false},
+		{"invalid port", types.ForwardMetadata{Receiver: "cosmos1receiver", Port: "(invalidport)", Channel: "channelidone"}, false},
+		{"invalid channel", types.ForwardMetadata{Receiver: "cosmos1receiver", Port: "transfer", Channel: "channel"}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.metadata.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestNewForwardMemo(t *testing.T) {
+	testCases := []struct {
+		name    string
+		next    string
+		expMemo string
+	}{
+		{"no next memo", "", `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channelidone"}}`},
+		{"text next memo", "deposit", `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channelidone","next":"deposit"}}`},
+		{
+			"forward next memo",
+			`{"forward":{"receiver":"cosmos1next","port":"transfer","channel":"channelidtwo"}}`,
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channelidone","next":{"forward":{"receiver":"cosmos1next","port":"transfer","channel":"channelidtwo"}}}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		memo := types.NewForwardMemo("cosmos1receiver", "transfer", "channelidone", 0, tc.next)
+		require.Equal(t, tc.expMemo, memo, tc.name)
+
+		fields, ok := types.ParseMemo(memo)
+		require.True(t, ok, tc.name)
+
+		var metadata types.ForwardMetadata
+		require.NoError(t, json.Unmarshal(fields[types.ForwardMemoKey], &metadata), tc.name)
+		require.Equal(t, tc.next, metadata.GetNextMemo(), tc.name)
+		require.Equal(t, types.DefaultRelativePacketTimeoutTimestamp, metadata.GetTimeout(), tc.name)
+	}
+}
+
+func TestSetForwardRefundMemo(t *testing.T) {
+	refundMemo := types.ForwardMetadata{Receiver: "cosmos1sender", Port: "transfer", Channel: "channelidone", Refund: true}.Memo()
+
+	testCases := []struct {
+		name    string
+		memo    string
+		expMemo string
+		expPass bool
+	}{
+		{"empty memo", "", "", true},
+		{"text memo", "deposit", "deposit", true},
+		{"memo without forward", `{"other":{}}`, `{"other":{}}`, true},
+		{
+			"forward memo",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channelidtwo"},"other":{}}`,
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channelidtwo","refund_memo":` + refundMemo + `},"other":{}}`,
+			true,
+		},
+		{"invalid forward metadata", `{"forward":"receiver"}`, "", false},
+	}
+
+	for _, tc := range testCases {
+		memo, err := types.SetForwardRefundMemo(tc.memo, refundMemo)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expMemo, memo, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -1,24 +1,35 @@
 package types
 
 import (
+	"fmt"
+
 	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
 )
 
 // NewGenesisState creates a new ibc-transfer GenesisState instance.
-func NewGenesisState(portID string, denomTraces Traces, params Params) *GenesisState {
+func NewGenesisState(
+	portID string, denomTraces Traces, params Params, forwardedPackets []ForwardedPacket,
+	rateLimits []RateLimit, pendingSendPackets []PendingSendPacket,
+) *GenesisState {
 	return &GenesisState{
-		PortId:      portID,
-		DenomTraces: denomTraces,
-		Params:      params,
+		PortId:             portID,
+		DenomTraces:        denomTraces,
+		Params:             params,
+		ForwardedPackets:   forwardedPackets,
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
 	}
 }
 
 // DefaultGenesisState returns a GenesisState with "transfer" as the default PortID.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		PortId:      PortID,
-		DenomTraces: Traces{},
-		Params:      DefaultParams(),
+		PortId:             PortID,
+		DenomTraces:        Traces{},
+		Params:             DefaultParams(),
+		ForwardedPackets:   []ForwardedPacket{},
+		RateLimits:         []RateLimit{},
+		PendingSendPackets: []PendingSendPacket{},
 	}
 }
 
@@ -31,5 +42,20 @@ func (gs GenesisState) Validate() error {
 	if err := gs.DenomTraces.Validate(); err != nil {
 		return err
 	}
+	for i, forwardedPacket := range gs.ForwardedPackets {
+		if err := forwardedPacket.Validate(); err != nil {
+			return fmt.Errorf("invalid forwarded packet %d: %w", i, err)
+		}
+	}
+	for i, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return fmt.Errorf("invalid rate limit %d: %w", i, err)
+		}
+	}
+	for i, pendingSendPacket := range gs.PendingSendPackets {
+		if err := pendingSendPacket.Validate(); err != nil {
+			return fmt.Errorf("invalid pending send packet %d: %w", i, err)
+		}
+	}
 	return gs.Params.Validate()
 }
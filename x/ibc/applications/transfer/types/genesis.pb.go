@@ -28,6 +28,13 @@ type GenesisState struct {
 	PortId      string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
 	DenomTraces Traces `protobuf:"bytes,2,rep,name=denom_traces,json=denomTraces,proto3,castrepeated=Traces" json:"denom_traces" yaml:"denom_traces"`
 	Params      Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// the packets sent by the forward receive hook which are still in flight
+	ForwardedPackets []ForwardedPacket `protobuf:"bytes,4,rep,name=forwarded_packets,json=forwardedPackets,proto3" json:"forwarded_packets" yaml:"forwarded_packets"`
+	// the rate limits of the denoms transferred through the channels
+	RateLimits []RateLimit `protobuf:"bytes,5,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits" yaml:"rate_limits"`
+	// the packets counted in the outflow of a rate limit which are still in
+	// flight
+	PendingSendPackets []PendingSendPacket `protobuf:"bytes,6,rep,name=pending_send_packets,json=pendingSendPackets,proto3" json:"pending_send_packets" yaml:"pending_send_packets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +91,27 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetForwardedPackets() []ForwardedPacket {
+	if m != nil {
+		return m.ForwardedPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingSendPackets() []PendingSendPacket {
+	if m != nil {
+		return m.PendingSendPackets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.transfer.v1.GenesisState")
 }
@@ -93,27 +121,35 @@ func init() {
 }
 
 var fileDescriptor_a4f788affd5bea89 = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xca, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0x2f, 0x29, 0x4a,
-	0xcc, 0x2b, 0x4e, 0x4b, 0x2d, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xc9, 0x4c, 0x4a, 0xd6, 0x43, 0x56, 0xab, 0x07,
-	0x53, 0xab, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41,
-	0xf4, 0x48, 0x69, 0xe3, 0x35, 0x1f, 0xae, 0x1f, 0xac, 0x58, 0xe9, 0x33, 0x23, 0x17, 0x8f, 0x3b,
-	0xc4, 0xca, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x6d, 0x2e, 0xf6, 0x82, 0xfc, 0xa2, 0x92, 0xf8,
-	0xcc, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x4e, 0x27, 0xa1, 0x4f, 0xf7, 0xe4, 0xf9, 0x2a, 0x13,
-	0x73, 0x73, 0xac, 0x94, 0xa0, 0x12, 0x4a, 0x41, 0x6c, 0x20, 0x96, 0x67, 0x8a, 0x50, 0x11, 0x17,
-	0x4f, 0x4a, 0x6a, 0x5e, 0x7e, 0x6e, 0x7c, 0x49, 0x51, 0x62, 0x72, 0x6a, 0xb1, 0x04, 0x93, 0x02,
-	0xb3, 0x06, 0xb7, 0x91, 0x86, 0x1e, 0x3e, 0x57, 0xeb, 0xb9, 0x80, 0x74, 0x84, 0x80, 0x34, 0x38,
-	0xa9, 0x9e, 0xb8, 0x27, 0xcf, 0xf0, 0xe9, 0x9e, 0xbc, 0x30, 0xc4, 0x7c, 0x64, 0xb3, 0x94, 0x56,
-	0xdd, 0x97, 0x67, 0x03, 0xab, 0x2a, 0x0e, 0xe2, 0x4e, 0x81, 0x6b, 0x29, 0x16, 0x72, 0xe2, 0x62,
-	0x2b, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x96, 0x60, 0x56, 0x60, 0xd4, 0xe0, 0x36, 0x52, 0xc1, 0x6f,
-	0x5b, 0x00, 0x58, 0xad, 0x13, 0x0b, 0xc8, 0xa6, 0x20, 0xa8, 0x4e, 0xa7, 0x88, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xb2, 0x4b, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
-	0xce, 0xcf, 0xd5, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0x86, 0x52, 0xba, 0xc5, 0x29, 0xd9, 0xfa,
-	0x15, 0xfa, 0xb8, 0xc3, 0xb6, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0xac, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xda, 0xbb, 0x81, 0x1e, 0xe5, 0x01, 0x00, 0x00,
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x56, 0x82, 0x70, 0x27, 0x04, 0x66, 0x87, 0xa8, 0xa0, 0xb4, 0x0a, 0x20, 0x2a,
+	0xa6, 0xc5, 0xda, 0xb8, 0x71, 0xe0, 0x10, 0x21, 0x10, 0x12, 0x87, 0x29, 0xe3, 0x80, 0xb8, 0x44,
+	0x6e, 0xec, 0x06, 0x6b, 0x8d, 0x6d, 0xf9, 0x35, 0x83, 0x49, 0x9c, 0x39, 0xf3, 0x39, 0xf8, 0x24,
+	0x3b, 0xee, 0xc8, 0xa9, 0x4c, 0xed, 0x37, 0xd8, 0x27, 0x40, 0x71, 0x92, 0x52, 0xfe, 0x85, 0x53,
+	0x2c, 0xe5, 0xf9, 0x3d, 0x3f, 0xbf, 0xd6, 0x8b, 0x1e, 0x89, 0x69, 0x4e, 0xa8, 0xd6, 0x73, 0x91,
+	0x53, 0x2b, 0x94, 0x04, 0x62, 0x0d, 0x95, 0x30, 0xe3, 0x86, 0x9c, 0xec, 0x93, 0x82, 0x4b, 0x0e,
+	0x02, 0x62, 0x6d, 0x94, 0x55, 0xf8, 0xae, 0x98, 0xe6, 0xf1, 0x66, 0x36, 0x6e, 0xb3, 0xf1, 0xc9,
+	0xfe, 0x70, 0xa7, 0x50, 0x85, 0x72, 0x41, 0x52, 0x9d, 0x6a, 0x66, 0xb8, 0xdb, 0xd9, 0xbf, 0xe6,
+	0x5d, 0x38, 0xba, 0xe8, 0xa3, 0xed, 0x17, 0xb5, 0xf2, 0xc8, 0x52, 0xcb, 0xf1, 0x2e, 0xba, 0xa6,
+	0x95, 0xb1, 0x99, 0x60, 0x81, 0x37, 0xf6, 0x26, 0xd7, 0x13, 0x7c, 0xb9, 0x18, 0xdd, 0x38, 0xa5,
+	0xe5, 0xfc, 0x49, 0xd4, 0xfc, 0x88, 0x52, 0xbf, 0x3a, 0xbd, 0x64, 0xd8, 0xa0, 0x6d, 0xc6, 0xa5,
+	0x2a, 0x33, 0x6b, 0x68, 0xce, 0x21, 0xb8, 0x32, 0xde, 0x9a, 0x0c, 0x0e, 0x26, 0x71, 0xd7, 0xad,
+	0xe3, 0x67, 0x15, 0xf1, 0xba, 0x02, 0x92, 0x07, 0x67, 0x8b, 0x51, 0xef, 0x72, 0x31, 0xba, 0x5d,
+	0xf7, 0x6f, 0x76, 0x45, 0x5f, 0xbf, 0x8f, 0x7c, 0x97, 0x82, 0x74, 0xc0, 0xd6, 0x08, 0xe0, 0x04,
+	0xf9, 0x9a, 0x1a, 0x5a, 0x42, 0xb0, 0x35, 0xf6, 0x26, 0x83, 0x83, 0xfb, 0xdd, 0xb6, 0x43, 0x97,
+	0x4d, 0xfa, 0x95, 0x29, 0x6d, 0x48, 0xfc, 0x09, 0xdd, 0x9a, 0x29, 0xf3, 0x81, 0x1a, 0xc6, 0x59,
+	0xa6, 0x69, 0x7e, 0xcc, 0x2d, 0x04, 0x7d, 0x77, 0xf9, 0xbd, 0xee, 0xba, 0xe7, 0x2d, 0x76, 0xe8,
+	0xa8, 0x64, 0xdc, 0x4c, 0x10, 0xd4, 0x13, 0xfc, 0xd1, 0x1a, 0xa5, 0x37, 0x67, 0xbf, 0x22, 0x80,
+	0x19, 0x1a, 0x18, 0x6a, 0x79, 0x36, 0x17, 0xa5, 0xb0, 0x10, 0x5c, 0x75, 0xde, 0x87, 0xdd, 0xde,
+	0x94, 0x5a, 0xfe, 0xaa, 0xca, 0x27, 0xc3, 0xc6, 0x88, 0x6b, 0xe3, 0x46, 0x53, 0x94, 0x22, 0xd3,
+	0xc6, 0x00, 0x7f, 0xf6, 0xd0, 0x8e, 0xe6, 0x92, 0x09, 0x59, 0x64, 0xc0, 0xe5, 0xcf, 0x39, 0x7d,
+	0xe7, 0x23, 0xff, 0x79, 0xb6, 0x9a, 0x3c, 0xe2, 0xb2, 0x9d, 0xf4, 0x5e, 0xe3, 0xbd, 0xd3, 0xec,
+	0xc2, 0x5f, 0xaa, 0xa3, 0x14, 0xeb, 0xdf, 0x39, 0x48, 0xde, 0x9c, 0x2d, 0x43, 0xef, 0x7c, 0x19,
+	0x7a, 0x17, 0xcb, 0xd0, 0xfb, 0xb2, 0x0a, 0x7b, 0xe7, 0xab, 0xb0, 0xf7, 0x6d, 0x15, 0xf6, 0xde,
+	0x3e, 0x2d, 0x84, 0x7d, 0xf7, 0x7e, 0x1a, 0xe7, 0xaa, 0x24, 0xb9, 0x82, 0x52, 0x41, 0xf3, 0xd9,
+	0x03, 0x76, 0x4c, 0x3e, 0x92, 0x7f, 0x2f, 0xb2, 0x3d, 0xd5, 0x1c, 0xa6, 0xbe, 0xdb, 0xe1, 0xc7,
+	0x3f, 0x06, 0x00, 0x9b, 0x96, 0x10, 0x0c, 0x52, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -136,6 +172,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingSendPackets) > 0 {
+		for iNdEx := len(m.PendingSendPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSendPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ForwardedPackets) > 0 {
+		for iNdEx := len(m.ForwardedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -199,6 +277,24 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ForwardedPackets) > 0 {
+		for _, e := range m.ForwardedPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingSendPackets) > 0 {
+		for _, e := range m.PendingSendPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -336,6 +432,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedPackets = append(m.ForwardedPackets, ForwardedPacket{})
+			if err := m.ForwardedPackets[len(m.ForwardedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSendPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSendPackets = append(m.PendingSendPackets, PendingSendPacket{})
+			if err := m.PendingSendPackets[len(m.PendingSendPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
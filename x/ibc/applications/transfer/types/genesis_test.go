@@ -2,9 +2,11 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
 )
 
@@ -33,6 +35,93 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid forwarded packet",
+			&types.GenesisState{
+				PortId: "portidone",
+				ForwardedPackets: []types.ForwardedPacket{
+					types.NewForwardedPacket("portidone", "channelidone", 1, "portidone", "channelidtwo", "cosmos1sender", ""),
+				},
+			},
+			true,
+		},
+		{
+			"invalid forwarded packet sequence",
+			&types.GenesisState{
+				PortId: "portidone",
+				ForwardedPackets: []types.ForwardedPacket{
+					types.NewForwardedPacket("portidone", "channelidone", 0, "portidone", "channelidtwo", "cosmos1sender", ""),
+				},
+			},
+			false,
+		},
+		{
+			"invalid forwarded packet refund receiver",
+			&types.GenesisState{
+				PortId: "portidone",
+				ForwardedPackets: []types.ForwardedPacket{
+					types.NewForwardedPacket("portidone", "channelidone", 1, "portidone", "channelidtwo", " ", ""),
+				},
+			},
+			false,
+		},
+		{
+			"valid rate limit",
+			&types.GenesisState{
+				PortId: "portidone",
+				RateLimits: []types.RateLimit{
+					types.NewRateLimit(
+						"channelidone", "uatom", sdk.NewDec(10), sdk.NewDec(10), time.Hour,
+						types.NewRateLimitFlow(sdk.NewInt(1000)),
+					),
+				},
+				PendingSendPackets: []types.PendingSendPacket{
+					types.NewPendingSendPacket("channelidone", 1, time.Unix(0, 0)),
+				},
+			},
+			true,
+		},
+		{
+			"invalid rate limit flow",
+			&types.GenesisState{
+				PortId: "portidone",
+				RateLimits: []types.RateLimit{
+					types.NewRateLimit(
+						"channelidone", "uatom", sdk.NewDec(10), sdk.NewDec(10), time.Hour,
+						types.NewRateLimitFlow(sdk.NewInt(-1)),
+					),
+				},
+			},
+			false,
+		},
+		{
+			"rate limit flow does not match its buckets",
+			&types.GenesisState{
+				PortId: "portidone",
+				RateLimits: []types.RateLimit{
+					types.NewRateLimit(
+						"channelidone", "uatom", sdk.NewDec(10), sdk.NewDec(10), time.Hour,
+						types.RateLimitFlow{
+							Inflow:       sdk.ZeroInt(),
+							Outflow:      sdk.NewInt(100),
+							ChannelValue: sdk.NewInt(1000),
+							Buckets:      []types.RateLimitBucket{types.NewRateLimitBucket(time.Unix(0, 0))},
+						},
+					),
+				},
+			},
+			false,
+		},
+		{
+			"invalid pending send packet sequence",
+			&types.GenesisState{
+				PortId: "portidone",
+				PendingSendPackets: []types.PendingSendPacket{
+					types.NewPendingSendPacket("channelidone", 0, time.Unix(0, 0)),
+				},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
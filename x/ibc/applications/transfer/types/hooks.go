@@ -0,0 +1,102 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+)
+
+// ReceiveHook defines a hook run after the tokens of a transfer packet have
+// been received. A hook is registered under a memo key, and it is only run for
+// the packets whose memo is a JSON object containing that key. The value of
+// the key is passed to the hook as hookData, and token is the coin credited to
+// the receiver on this chain.
+//
+// If the hook returns an error, the receive is reverted and an error
+// acknowledgement is written, so that the sender is refunded.
+type ReceiveHook interface {
+	OnRecvPacket(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		data FungibleTokenPacketData,
+		token sdk.Coin,
+		hookData json.RawMessage,
+	) error
+}
+
+// ReceiveHooks is a registry of the receive hooks, keyed by memo key.
+type ReceiveHooks struct {
+	keys  []string
+	hooks map[string]ReceiveHook
+}
+
+// NewReceiveHooks returns an empty ReceiveHooks registry.
+func NewReceiveHooks() *ReceiveHooks {
+	return &ReceiveHooks{
+		hooks: make(map[string]ReceiveHook),
+	}
+}
+
+// AddHook registers a receive hook under a memo key. It panics if the key is
+// blank or already registered.
+func (rh *ReceiveHooks) AddHook(key string, hook ReceiveHook) *ReceiveHooks {
+	if strings.TrimSpace(key) == "" {
+		panic("receive hook key cannot be blank")
+	}
+	if rh.HasHook(key) {
+		panic(fmt.Sprintf("receive hook with key %s has already been registered", key))
+	}
+
+	rh.keys = append(rh.keys, key)
+	rh.hooks[key] = hook
+	return rh
+}
+
+// HasHook returns whether a receive hook is registered under a memo key.
+func (rh *ReceiveHooks) HasHook(key string) bool {
+	_, ok := rh.hooks[key]
+	return ok
+}
+
+// OnRecvPacket runs the receive hooks whose keys are found in the memo of the
+// packet, in the order they were registered. Memos which are not JSON objects
+// are not meant for the hooks and are ignored.
+func (rh *ReceiveHooks) OnRecvPacket(
+	ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, token sdk.Coin,
+) error {
+	memo, ok := ParseMemo(data.Memo)
+	if !ok {
+		return nil
+	}
+
+	for _, key := range rh.keys {
+		hookData, ok := memo[key]
+		if !ok {
+			continue
+		}
+
+		if err := rh.hooks[key].OnRecvPacket(ctx, packet, data, token, hookData); err != nil {
+			return sdkerrors.Wrapf(ErrReceiveHookFailed, "%s: %s", key, err)
+		}
+	}
+
+	return nil
+}
+
+// ParseMemo returns the fields of a memo which is a JSON object.
+func ParseMemo(memo string) (map[string]json.RawMessage, bool) {
+	if !strings.HasPrefix(strings.TrimSpace(memo), "{") {
+		return nil, false
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil, false
+	}
+
+	return fields, true
+}
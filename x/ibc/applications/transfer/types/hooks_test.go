@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+)
+
+// mockReceiveHook records the hook data it is run with
+type mockReceiveHook struct {
+	hookData []json.RawMessage
+	err      error
+}
+
+func (h *mockReceiveHook) OnRecvPacket(
+	_ sdk.Context, _ channeltypes.Packet, _ types.FungibleTokenPacketData, _ sdk.Coin, hookData json.RawMessage,
+) error {
+	h.hookData = append(h.hookData, hookData)
+	return h.err
+}
+
+func TestReceiveHooks(t *testing.T) {
+	testCases := []struct {
+		name        string
+		memo        string
+		hookErr     error
+		expHookData []json.RawMessage
+		expPass     bool
+	}{
+		{"empty memo", "", nil, nil, true},
+		{"text memo", "deposit", nil, nil, true},
+		{"memo without hook key", `{"other":{"a":1}}`, nil, nil, true},
+		{"memo with hook key", `{"mock":{"a":1},"other":{}}`, nil, []json.RawMessage{json.RawMessage(`{"a":1}`)}, true},
+		{"hook fails", `{"mock":{"a":1}}`, errors.New("failed"), []json.RawMessage{json.RawMessage(`{"a":1}`)}, false},
+	}
+
+	for _, tc := range testCases {
+		hook := &mockReceiveHook{err: tc.hookErr}
+		hooks := types.NewReceiveHooks().AddHook("mock", hook)
+
+		data := types.NewFungibleTokenPacketData("atom", 100, "sender", "receiver", tc.memo)
+		err := hooks.OnRecvPacket(sdk.Context{}, channeltypes.Packet{}, data, sdk.NewInt64Coin("atom", 100))
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+		require.Equal(t, tc.expHookData, hook.hookData, tc.name)
+	}
+}
+
+func TestAddReceiveHook(t *testing.T) {
+	hooks := types.NewReceiveHooks().AddHook("mock", &mockReceiveHook{})
+	require.True(t, hooks.HasHook("mock"))
+	require.False(t, hooks.HasHook("other"))
+
+	require.Panics(t, func() { hooks.AddHook("mock", &mockReceiveHook{}) })
+	require.Panics(t, func() { hooks.AddHook(" ", &mockReceiveHook{}) })
+}
@@ -37,6 +37,15 @@ var (
 	PortKey = []byte{0x01}
 	// DenomTraceKey defines the key to store the denomination trace info in store
 	DenomTraceKey = []byte{0x02}
+	// ForwardedPacketKey defines the key prefix to store the packets sent by
+	// the forward receive hook which are still in flight
+	ForwardedPacketKey = []byte{0x03}
+	// RateLimitKey defines the key prefix to store the rate limits of the
+	// denoms transferred through the channels
+	RateLimitKey = []byte{0x04}
+	// PendingSendPacketKey defines the key prefix to store the packets counted
+	// in the outflow of a rate limit which are still in flight
+	PendingSendPacketKey = []byte{0x05}
 )
 
 // GetEscrowAddress returns the escrow address for the specified channel
@@ -47,3 +56,21 @@ var (
 func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 	return sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("%s/%s", portID, channelID))))
 }
+
+// GetForwardedPacketKey returns the store key of a packet sent by the forward
+// receive hook.
+func GetForwardedPacketKey(portID, channelID string, sequence uint64) []byte {
+	return append(ForwardedPacketKey, []byte(fmt.Sprintf("%s/%s/%d", portID, channelID, sequence))...)
+}
+
+// GetRateLimitKey returns the store key of the rate limit of a denom on a
+// channel.
+func GetRateLimitKey(channelID, denom string) []byte {
+	return append(RateLimitKey, []byte(fmt.Sprintf("%s/%s", channelID, denom))...)
+}
+
+// GetPendingSendPacketKey returns the store key of a packet counted in the
+// outflow of a rate limit.
+func GetPendingSendPacketKey(channelID string, sequence uint64) []byte {
+	return append(PendingSendPacketKey, []byte(fmt.Sprintf("%s/%d", channelID, sequence))...)
+}
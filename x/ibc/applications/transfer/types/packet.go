@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"strings"
 	"time"
 
@@ -21,16 +22,21 @@ var (
 	DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
 )
 
+// MaximumMemoLength is the maximum length in bytes of the memo of a transfer. The memo is parsed by
+// the receive hooks of the counterparty chain and emitted in its events, so it is kept bounded.
+const MaximumMemoLength = 32768
+
 // NewFungibleTokenPacketData contructs a new FungibleTokenPacketData instance
 func NewFungibleTokenPacketData(
 	denom string, amount uint64,
-	sender, receiver string,
+	sender, receiver, memo string,
 ) FungibleTokenPacketData {
 	return FungibleTokenPacketData{
 		Denom:    denom,
 		Amount:   amount,
 		Sender:   sender,
 		Receiver: receiver,
+		Memo:     memo,
 	}
 }
 
@@ -47,10 +53,38 @@ func (ftpd FungibleTokenPacketData) ValidateBasic() error {
 	if strings.TrimSpace(ftpd.Receiver) == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver address cannot be blank")
 	}
+	if err := ValidateMemo(ftpd.Memo); err != nil {
+		return err
+	}
 	return ValidatePrefixedDenom(ftpd.Denom)
 }
 
-// GetBytes is a helper for serialising
+// ValidateMemo returns an error if the memo of a transfer is longer than MaximumMemoLength.
+func ValidateMemo(memo string) error {
+	if len(memo) > MaximumMemoLength {
+		return sdkerrors.Wrapf(ErrInvalidMemo, "memo length %d exceeds the maximum of %d bytes", len(memo), MaximumMemoLength)
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising. An empty memo is omitted so that the
+// packets without a memo can still be decoded by chains which don't know the
+// memo field.
 func (ftpd FungibleTokenPacketData) GetBytes() []byte {
-	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ftpd))
+	bz := ModuleCdc.MustMarshalJSON(&ftpd)
+	if ftpd.Memo != "" {
+		return sdk.MustSortJSON(bz)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		panic(err)
+	}
+	delete(fields, "memo")
+
+	bz, err := json.Marshal(fields)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
 }
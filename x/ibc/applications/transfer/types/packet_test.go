@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -18,11 +19,13 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		packetData FungibleTokenPacketData
 		expPass    bool
 	}{
-		{"valid packet", NewFungibleTokenPacketData(denom, amount, addr1.String(), addr2), true},
-		{"invalid denom", NewFungibleTokenPacketData("", amount, addr1.String(), addr2), false},
-		{"invalid amount", NewFungibleTokenPacketData(denom, 0, addr1.String(), addr2), false},
-		{"missing sender address", NewFungibleTokenPacketData(denom, amount, emptyAddr.String(), addr2), false},
-		{"missing recipient address", NewFungibleTokenPacketData(denom, amount, addr1.String(), emptyAddr.String()), false},
+		{"valid packet", NewFungibleTokenPacketData(denom, amount, addr1.String(), addr2, ""), true},
+		{"invalid denom", NewFungibleTokenPacketData("", amount, addr1.String(), addr2, ""), false},
+		{"invalid amount", NewFungibleTokenPacketData(denom, 0, addr1.String(), addr2, ""), false},
+		{"missing sender address", NewFungibleTokenPacketData(denom, amount, emptyAddr.String(), addr2, ""), false},
+		{"missing recipient address", NewFungibleTokenPacketData(denom, amount, addr1.String(), emptyAddr.String(), ""), false},
+		{"memo of the maximum length", NewFungibleTokenPacketData(denom, amount, addr1.String(), addr2, maxMemo), true},
+		{"too long memo", NewFungibleTokenPacketData(denom, amount, addr1.String(), addr2, tooLongMemo), false},
 	}
 
 	for i, tc := range testCases {
@@ -34,3 +37,18 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+// TestFungibleTokenPacketDataGetBytes tests that an empty memo is omitted from the packet bytes
+func TestFungibleTokenPacketDataGetBytes(t *testing.T) {
+	packetData := NewFungibleTokenPacketData(denom, amount, addr1.String(), addr2, "")
+	expBytes := fmt.Sprintf(`{"amount":"100","denom":"%s","receiver":"%s","sender":"%s"}`, denom, addr2, addr1)
+	require.Equal(t, expBytes, string(packetData.GetBytes()))
+
+	packetData.Memo = "memo"
+	expBytes = fmt.Sprintf(`{"amount":"100","denom":"%s","memo":"memo","receiver":"%s","sender":"%s"}`, denom, addr2, addr1)
+	require.Equal(t, expBytes, string(packetData.GetBytes()))
+
+	var decoded FungibleTokenPacketData
+	require.NoError(t, ModuleCdc.UnmarshalJSON(packetData.GetBytes(), &decoded))
+	require.Equal(t, packetData, decoded)
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+)
+
+const (
+	// ProposalTypeSetRateLimit defines the type for a SetRateLimitProposal
+	ProposalTypeSetRateLimit = "SetRateLimit"
+	// ProposalTypeRemoveRateLimit defines the type for a RemoveRateLimitProposal
+	ProposalTypeRemoveRateLimit = "RemoveRateLimit"
+)
+
+var (
+	_ govtypes.Content = &SetRateLimitProposal{}
+	_ govtypes.Content = &RemoveRateLimitProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetRateLimit)
+	govtypes.RegisterProposalTypeCodec(&SetRateLimitProposal{}, "cosmos-sdk/SetRateLimitProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveRateLimit)
+	govtypes.RegisterProposalTypeCodec(&RemoveRateLimitProposal{}, "cosmos-sdk/RemoveRateLimitProposal")
+}
+
+// NewSetRateLimitProposal creates a new set rate limit proposal.
+func NewSetRateLimitProposal(
+	title, description, channelID, denom string, maxPercentSend, maxPercentRecv sdk.Dec, duration time.Duration,
+) *SetRateLimitProposal {
+	return &SetRateLimitProposal{
+		Title:          title,
+		Description:    description,
+		ChannelId:      channelID,
+		Denom:          denom,
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		Duration:       duration,
+	}
+}
+
+// GetTitle returns the title of a set rate limit proposal.
+func (srp *SetRateLimitProposal) GetTitle() string { return srp.Title }
+
+// GetDescription returns the description of a set rate limit proposal.
+func (srp *SetRateLimitProposal) GetDescription() string { return srp.Description }
+
+// ProposalRoute returns the routing key of a set rate limit proposal.
+func (srp *SetRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a set rate limit proposal.
+func (srp *SetRateLimitProposal) ProposalType() string { return ProposalTypeSetRateLimit }
+
+// ValidateBasic runs basic stateless validity checks
+func (srp *SetRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(srp); err != nil {
+		return err
+	}
+
+	return ValidateRateLimit(srp.ChannelId, srp.Denom, srp.MaxPercentSend, srp.MaxPercentRecv, srp.Duration)
+}
+
+// NewRemoveRateLimitProposal creates a new remove rate limit proposal.
+func NewRemoveRateLimitProposal(title, description, channelID, denom string) *RemoveRateLimitProposal {
+	return &RemoveRateLimitProposal{
+		Title:       title,
+		Description: description,
+		ChannelId:   channelID,
+		Denom:       denom,
+	}
+}
+
+// GetTitle returns the title of a remove rate limit proposal.
+func (rrp *RemoveRateLimitProposal) GetTitle() string { return rrp.Title }
+
+// GetDescription returns the description of a remove rate limit proposal.
+func (rrp *RemoveRateLimitProposal) GetDescription() string { return rrp.Description }
+
+// ProposalRoute returns the routing key of a remove rate limit proposal.
+func (rrp *RemoveRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a remove rate limit proposal.
+func (rrp *RemoveRateLimitProposal) ProposalType() string { return ProposalTypeRemoveRateLimit }
+
+// ValidateBasic runs basic stateless validity checks
+func (rrp *RemoveRateLimitProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(rrp); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(rrp.ChannelId); err != nil {
+		return err
+	}
+
+	return ValidateIBCDenom(rrp.Denom)
+}
@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+)
+
+func TestRateLimitProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal govtypes.Content
+		expPass  bool
+	}{
+		{
+			"valid set rate limit proposal",
+			types.NewSetRateLimitProposal("title", "description", "channelidone", "uatom", sdk.NewDec(10), sdk.NewDec(10), time.Hour),
+			true,
+		},
+		{
+			"set rate limit proposal without title",
+			types.NewSetRateLimitProposal("", "description", "channelidone", "uatom", sdk.NewDec(10), sdk.NewDec(10), time.Hour),
+			false,
+		},
+		{
+			"set rate limit proposal with invalid quota",
+			types.NewSetRateLimitProposal("title", "description", "channelidone", "uatom", sdk.NewDec(10), sdk.NewDec(-1), time.Hour),
+			false,
+		},
+		{
+			"valid remove rate limit proposal",
+			types.NewRemoveRateLimitProposal("title", "description", "channelidone", "uatom"),
+			true,
+		},
+		{
+			"remove rate limit proposal with invalid channel",
+			types.NewRemoveRateLimitProposal("title", "description", "channel", "uatom"),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
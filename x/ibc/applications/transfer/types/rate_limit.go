@@ -0,0 +1,247 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+)
+
+// RateLimitBuckets is the number of buckets the rolling window of a rate limit
+// is split into. The window moves forward a bucket at a time, so the flow over
+// any period of the window duration exceeds the quota by the flow of a single
+// bucket at most.
+const RateLimitBuckets = 10
+
+// maxRateLimitPercent is the maximum percentage of the channel value
+// transferred in a rate limit window.
+var maxRateLimitPercent = sdk.NewDec(100)
+
+// NewRateLimit creates a new RateLimit instance.
+func NewRateLimit(
+	channelID, denom string, maxPercentSend, maxPercentRecv sdk.Dec, duration time.Duration, flow RateLimitFlow,
+) RateLimit {
+	return RateLimit{
+		ChannelId:      channelID,
+		Denom:          denom,
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		Duration:       duration,
+		Flow:           flow,
+	}
+}
+
+// ValidateRateLimit performs a basic validation of the rate limit quota of a
+// denom on a channel.
+func ValidateRateLimit(channelID, denom string, maxPercentSend, maxPercentRecv sdk.Dec, duration time.Duration) error {
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return err
+	}
+	if err := ValidateIBCDenom(denom); err != nil {
+		return err
+	}
+	if maxPercentSend.IsNil() || !maxPercentSend.IsPositive() || maxPercentSend.GT(maxRateLimitPercent) {
+		return sdkerrors.Wrapf(ErrInvalidRateLimit, "max percent send must be within (0, 100], got %s", maxPercentSend)
+	}
+	if maxPercentRecv.IsNil() || !maxPercentRecv.IsPositive() || maxPercentRecv.GT(maxRateLimitPercent) {
+		return sdkerrors.Wrapf(ErrInvalidRateLimit, "max percent receive must be within (0, 100], got %s", maxPercentRecv)
+	}
+	if duration < RateLimitBuckets {
+		return sdkerrors.Wrapf(ErrInvalidRateLimit, "duration must be at least %s, got %s", time.Duration(RateLimitBuckets), duration)
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the rate limit fields.
+func (rl RateLimit) Validate() error {
+	if err := ValidateRateLimit(rl.ChannelId, rl.Denom, rl.MaxPercentSend, rl.MaxPercentRecv, rl.Duration); err != nil {
+		return err
+	}
+	return rl.Flow.Validate()
+}
+
+// BucketStart returns the start time of the bucket of the rolling window at
+// the given time. Buckets are aligned on multiples of the bucket duration.
+func (rl RateLimit) BucketStart(blockTime time.Time) time.Time {
+	return blockTime.Truncate(rl.Duration / RateLimitBuckets)
+}
+
+// IsBucketExpired returns true if the latest bucket of the rolling window is
+// over at the given time.
+func (rl RateLimit) IsBucketExpired(blockTime time.Time) bool {
+	buckets := rl.Flow.Buckets
+	return len(buckets) == 0 || buckets[len(buckets)-1].Start.Before(rl.BucketStart(blockTime))
+}
+
+// StartBucket moves the rolling window forward to the given time. The buckets
+// which started before the window are removed from the flow, and a new bucket
+// starts with the given supply of the denom as the channel value. The channel
+// value is kept if the supply is zero, so that the denom can be transferred
+// again once it is minted or received.
+func (rl *RateLimit) StartBucket(blockTime time.Time, supply sdk.Int) {
+	bucketStart := rl.BucketStart(blockTime)
+	windowStart := bucketStart.Add(-rl.Duration)
+
+	buckets := []RateLimitBucket{}
+	for _, bucket := range rl.Flow.Buckets {
+		if !bucket.Start.After(windowStart) {
+			rl.Flow.Inflow = rl.Flow.Inflow.Sub(bucket.Inflow)
+			rl.Flow.Outflow = rl.Flow.Outflow.Sub(bucket.Outflow)
+			continue
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	rl.Flow.Buckets = append(buckets, NewRateLimitBucket(bucketStart))
+	if supply.IsPositive() {
+		rl.Flow.ChannelValue = supply
+	}
+}
+
+// SendQuota returns the maximum net amount sent in the rolling window.
+func (rl RateLimit) SendQuota() sdk.Int {
+	return rl.Flow.ChannelValue.ToDec().Mul(rl.MaxPercentSend).QuoInt64(100).TruncateInt()
+}
+
+// RecvQuota returns the maximum net amount received in the rolling window.
+func (rl RateLimit) RecvQuota() sdk.Int {
+	return rl.Flow.ChannelValue.ToDec().Mul(rl.MaxPercentRecv).QuoInt64(100).TruncateInt()
+}
+
+// AddOutflow adds an amount sent through the channel to the flow of the latest
+// bucket. It returns an error if the net amount sent exceeds the send quota.
+func (rl *RateLimit) AddOutflow(amount sdk.Int) error {
+	outflow := rl.Flow.Outflow.Add(amount)
+	if netOutflow := outflow.Sub(rl.Flow.Inflow); netOutflow.GT(rl.SendQuota()) {
+		return sdkerrors.Wrapf(
+			ErrRateLimitExceeded, "net outflow %s of %s on %s exceeds the quota %s",
+			netOutflow, rl.Denom, rl.ChannelId, rl.SendQuota(),
+		)
+	}
+
+	rl.Flow.Outflow = outflow
+	bucket := &rl.Flow.Buckets[len(rl.Flow.Buckets)-1]
+	bucket.Outflow = bucket.Outflow.Add(amount)
+	return nil
+}
+
+// AddInflow adds an amount received through the channel to the flow of the
+// latest bucket. It returns an error if the net amount received exceeds the
+// receive quota.
+func (rl *RateLimit) AddInflow(amount sdk.Int) error {
+	inflow := rl.Flow.Inflow.Add(amount)
+	if netInflow := inflow.Sub(rl.Flow.Outflow); netInflow.GT(rl.RecvQuota()) {
+		return sdkerrors.Wrapf(
+			ErrRateLimitExceeded, "net inflow %s of %s on %s exceeds the quota %s",
+			netInflow, rl.Denom, rl.ChannelId, rl.RecvQuota(),
+		)
+	}
+
+	rl.Flow.Inflow = inflow
+	bucket := &rl.Flow.Buckets[len(rl.Flow.Buckets)-1]
+	bucket.Inflow = bucket.Inflow.Add(amount)
+	return nil
+}
+
+// RemoveOutflow removes the amount of a failed packet from the outflow of the
+// bucket it was sent in, restoring its quota. It returns false if the bucket
+// is not in the window anymore.
+func (rl *RateLimit) RemoveOutflow(bucketStart time.Time, amount sdk.Int) bool {
+	for i := range rl.Flow.Buckets {
+		bucket := &rl.Flow.Buckets[i]
+		if !bucket.Start.Equal(bucketStart) {
+			continue
+		}
+
+		amount = sdk.MinInt(amount, bucket.Outflow)
+		bucket.Outflow = bucket.Outflow.Sub(amount)
+		rl.Flow.Outflow = rl.Flow.Outflow.Sub(amount)
+		return true
+	}
+
+	return false
+}
+
+// NewRateLimitFlow creates the flow of a rate limit with the given channel
+// value and no buckets.
+func NewRateLimitFlow(channelValue sdk.Int) RateLimitFlow {
+	return RateLimitFlow{
+		Inflow:       sdk.ZeroInt(),
+		Outflow:      sdk.ZeroInt(),
+		ChannelValue: channelValue,
+	}
+}
+
+// Validate performs a basic validation of the rate limit flow fields.
+func (f RateLimitFlow) Validate() error {
+	if f.Inflow.IsNil() || f.Inflow.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "inflow cannot be negative")
+	}
+	if f.Outflow.IsNil() || f.Outflow.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "outflow cannot be negative")
+	}
+	if f.ChannelValue.IsNil() || !f.ChannelValue.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "channel value must be positive")
+	}
+
+	inflow, outflow := sdk.ZeroInt(), sdk.ZeroInt()
+	for i, bucket := range f.Buckets {
+		if err := bucket.Validate(); err != nil {
+			return err
+		}
+		if i > 0 && !bucket.Start.After(f.Buckets[i-1].Start) {
+			return sdkerrors.Wrapf(ErrInvalidRateLimit, "bucket %d does not start after the previous bucket", i)
+		}
+		inflow, outflow = inflow.Add(bucket.Inflow), outflow.Add(bucket.Outflow)
+	}
+	if !inflow.Equal(f.Inflow) || !outflow.Equal(f.Outflow) {
+		return sdkerrors.Wrapf(
+			ErrInvalidRateLimit, "inflow %s and outflow %s must be the totals of the buckets (%s, %s)",
+			f.Inflow, f.Outflow, inflow, outflow,
+		)
+	}
+	return nil
+}
+
+// NewRateLimitBucket creates a bucket of a rate limit flow starting at the
+// given time.
+func NewRateLimitBucket(start time.Time) RateLimitBucket {
+	return RateLimitBucket{
+		Start:   start,
+		Inflow:  sdk.ZeroInt(),
+		Outflow: sdk.ZeroInt(),
+	}
+}
+
+// Validate performs a basic validation of the rate limit bucket fields.
+func (b RateLimitBucket) Validate() error {
+	if b.Inflow.IsNil() || b.Inflow.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "bucket inflow cannot be negative")
+	}
+	if b.Outflow.IsNil() || b.Outflow.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "bucket outflow cannot be negative")
+	}
+	return nil
+}
+
+// NewPendingSendPacket creates a new PendingSendPacket instance.
+func NewPendingSendPacket(channelID string, sequence uint64, bucketStart time.Time) PendingSendPacket {
+	return PendingSendPacket{
+		ChannelId:   channelID,
+		Sequence:    sequence,
+		BucketStart: bucketStart,
+	}
+}
+
+// Validate performs a basic validation of the pending send packet fields.
+func (p PendingSendPacket) Validate() error {
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return err
+	}
+	if p.Sequence == 0 {
+		return sdkerrors.Wrap(channeltypes.ErrInvalidPacket, "packet sequence cannot be 0")
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+)
+
+func TestValidateRateLimit(t *testing.T) {
+	testCases := []struct {
+		name           string
+		channelID      string
+		denom          string
+		maxPercentSend sdk.Dec
+		maxPercentRecv sdk.Dec
+		duration       time.Duration
+		expPass        bool
+	}{
+		{"valid rate limit", "channelidone", "uatom", sdk.NewDec(10), sdk.NewDec(100), time.Hour, true},
+		{"valid ibc denom", "channelidone", "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", sdk.NewDec(10), sdk.NewDec(10), time.Hour, true},
+		{"invalid channel", "channel", "uatom", sdk.NewDec(10), sdk.NewDec(10), time.Hour, false},
+		{"invalid denom", "channelidone", "ibc/atom", sdk.NewDec(10), sdk.NewDec(10), time.Hour, false},
+		{"zero max percent send", "channelidone", "uatom", sdk.ZeroDec(), sdk.NewDec(10), time.Hour, false},
+		{"max percent receive above 100", "channelidone", "uatom", sdk.NewDec(10), sdk.NewDec(101), time.Hour, false},
+		{"nil max percent receive", "channelidone", "uatom", sdk.NewDec(10), sdk.Dec{}, time.Hour, false},
+		{"zero duration", "channelidone", "uatom", sdk.NewDec(10), sdk.NewDec(10), 0, false},
+		{"duration shorter than a nanosecond per bucket", "channelidone", "uatom", sdk.NewDec(10), sdk.NewDec(10), types.RateLimitBuckets - 1, false},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateRateLimit(tc.channelID, tc.denom, tc.maxPercentSend, tc.maxPercentRecv, tc.duration)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestRateLimitFlow(t *testing.T) {
+	bucketStart := time.Unix(0, 0).UTC()
+	rateLimit := types.NewRateLimit(
+		"channelidone", "uatom", sdk.NewDec(10), sdk.NewDecWithPrec(55, 1), time.Hour,
+		types.NewRateLimitFlow(sdk.NewInt(1000)),
+	)
+	rateLimit.StartBucket(bucketStart, sdk.NewInt(1000))
+	require.Equal(t, sdk.NewInt(100), rateLimit.SendQuota())
+	require.Equal(t, sdk.NewInt(55), rateLimit.RecvQuota())
+
+	// the quotas apply to the net flows
+	require.NoError(t, rateLimit.AddOutflow(sdk.NewInt(100)))
+	require.Error(t, rateLimit.AddOutflow(sdk.OneInt()))
+	require.NoError(t, rateLimit.AddInflow(sdk.NewInt(155)))
+	require.Error(t, rateLimit.AddInflow(sdk.OneInt()))
+	require.NoError(t, rateLimit.AddOutflow(sdk.NewInt(155)))
+	require.Equal(t, sdk.NewInt(255), rateLimit.Flow.Outflow)
+	require.Equal(t, sdk.NewInt(155), rateLimit.Flow.Inflow)
+
+	require.Equal(t, rateLimit.Flow.Outflow, rateLimit.Flow.Buckets[0].Outflow)
+	require.NoError(t, rateLimit.Validate())
+
+	require.True(t, rateLimit.RemoveOutflow(bucketStart, sdk.NewInt(55)))
+	require.Equal(t, sdk.NewInt(200), rateLimit.Flow.Outflow)
+	require.False(t, rateLimit.RemoveOutflow(bucketStart.Add(time.Minute), sdk.NewInt(55)))
+	require.Equal(t, sdk.NewInt(200), rateLimit.Flow.Outflow)
+	require.True(t, rateLimit.RemoveOutflow(bucketStart, sdk.NewInt(1000)))
+	require.True(t, rateLimit.Flow.Outflow.IsZero())
+	require.NoError(t, rateLimit.Validate())
+}
+
+func TestRateLimitRollingWindow(t *testing.T) {
+	start := time.Unix(0, 0).UTC()
+	rateLimit := types.NewRateLimit(
+		"channelidone", "uatom", sdk.NewDec(10), sdk.NewDec(10), time.Hour,
+		types.NewRateLimitFlow(sdk.NewInt(1000)),
+	)
+	require.True(t, rateLimit.IsBucketExpired(start))
+
+	// the window is split into buckets of 6 minutes
+	rateLimit.StartBucket(start.Add(time.Minute), sdk.NewInt(1000))
+	require.Equal(t, start, rateLimit.Flow.Buckets[0].Start)
+	require.NoError(t, rateLimit.AddOutflow(sdk.NewInt(60)))
+	require.False(t, rateLimit.IsBucketExpired(start.Add(6*time.Minute-1)))
+	require.True(t, rateLimit.IsBucketExpired(start.Add(6*time.Minute)))
+
+	// the channel value is the supply at the start of the latest bucket
+	rateLimit.StartBucket(start.Add(30*time.Minute), sdk.NewInt(2000))
+	require.Equal(t, sdk.NewInt(2000), rateLimit.Flow.ChannelValue)
+	require.NoError(t, rateLimit.AddOutflow(sdk.NewInt(140)))
+	require.Error(t, rateLimit.AddOutflow(sdk.OneInt()))
+
+	// the first bucket leaves the window, and the channel value is kept while
+	// the denom has no supply
+	rateLimit.StartBucket(start.Add(time.Hour), sdk.ZeroInt())
+	require.Equal(t, sdk.NewInt(2000), rateLimit.Flow.ChannelValue)
+	require.Equal(t, sdk.NewInt(140), rateLimit.Flow.Outflow)
+	require.Len(t, rateLimit.Flow.Buckets, 2)
+	require.Error(t, rateLimit.AddOutflow(sdk.NewInt(61)))
+	require.NoError(t, rateLimit.AddOutflow(sdk.NewInt(60)))
+	require.NoError(t, rateLimit.Validate())
+
+	rateLimit.StartBucket(start.Add(2*time.Hour), sdk.NewInt(2000))
+	require.True(t, rateLimit.Flow.Outflow.IsZero())
+	require.Len(t, rateLimit.Flow.Buckets, 1)
+	require.NoError(t, rateLimit.Validate())
+}
@@ -473,3 +473,40 @@ func GetCmdQueryNextSequenceReceive() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryUpgrade defines the command to query the upgrade of a channel end
+// in an upgrade handshake
+func GetCmdQueryUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade [port-id] [channel-id]",
+		Short: "Query a channel upgrade",
+		Long:  "Query the upgrade in progress of an IBC channel end, including the channel end restored if the upgrade fails",
+		Example: fmt.Sprintf(
+			"%s query %s %s upgrade [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			portID := args[0]
+			channelID := args[1]
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			upgradeRes, err := utils.QueryUpgrade(clientCtx, portID, channelID, prove)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(upgradeRes)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -9,6 +10,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
 	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
 	connectionutils "github.com/cosmos/cosmos-sdk/x/ibc/core/03-connection/client/utils"
@@ -19,6 +24,9 @@ import (
 const (
 	FlagOrdered    = "ordered"
 	FlagIBCVersion = "ibc-version"
+
+	flagUpgradeTimeoutHeight    = "upgrade-timeout-height"
+	flagUpgradeTimeoutTimestamp = "upgrade-timeout-timestamp"
 )
 
 // NewChannelOpenInitCmd returns the command to create a MsgChannelOpenInit transaction
@@ -275,6 +283,92 @@ func NewChannelCloseConfirmCmd() *cobra.Command {
 	return cmd
 }
 
+// NewCmdSubmitChannelUpgradeProposal implements a command handler for submitting a channel upgrade proposal transaction.
+func NewCmdSubmitChannelUpgradeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-channel [port-id] [channel-id] [connection-hop] [version]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submit a proposal to upgrade an IBC channel",
+		Long: strings.TrimSpace(`Submit a proposal to upgrade the ordering, connection hop or version of an OPEN IBC channel
+along with an initial deposit. Once the proposal passes, the upgrade is proposed to the counterparty chain.
+The upgrade is restored if the counterparty chain does not accept it before the upgrade timeout height
+{version}-{height} or timeout timestamp (in nanoseconds) of the counterparty chain.`),
+		Example: fmt.Sprintf(
+			"%s tx gov submit-proposal upgrade-channel transfer channel-0 connection-1 ics20-1 --ordered=false --upgrade-timeout-height=1-1000 --title=\"Channel upgrade\" --description=\"Move channel-0 to connection-1\" --deposit=1000stake --from=<key_or_address>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			timeoutHeightStr, err := cmd.Flags().GetString(flagUpgradeTimeoutHeight)
+			if err != nil {
+				return err
+			}
+
+			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagUpgradeTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewChannelUpgradeProposal(
+				title, description, args[0], args[1], channelOrder(cmd.Flags()), []string{args[2]}, args[3],
+				timeoutHeight, timeoutTimestamp,
+			)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagOrdered, true, "Pass flag for upgrading to an ordered channel")
+	cmd.Flags().String(flagUpgradeTimeoutHeight, "0-0", "Upgrade timeout height on the counterparty chain in the format {version}-{height}")
+	cmd.Flags().Uint64(flagUpgradeTimeoutTimestamp, 0, "Upgrade timeout timestamp in nanoseconds on the counterparty chain")
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
+
 func channelOrder(fs *pflag.FlagSet) types.Order {
 	if ordered, _ := fs.GetBool(FlagOrdered); ordered {
 		return types.ORDERED
@@ -0,0 +1,12 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/client/rest"
+)
+
+// ChannelUpgradeProposalHandler is the channel upgrade proposal handler.
+var ChannelUpgradeProposalHandler = govclient.NewProposalHandler(
+	cli.NewCmdSubmitChannelUpgradeProposal, rest.ChannelUpgradeProposalRESTHandler,
+)
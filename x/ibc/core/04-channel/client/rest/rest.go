@@ -0,0 +1,68 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+)
+
+// ChannelUpgradeProposalReq defines a channel upgrade proposal request body.
+type ChannelUpgradeProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title            string             `json:"title" yaml:"title"`
+	Description      string             `json:"description" yaml:"description"`
+	PortID           string             `json:"port_id" yaml:"port_id"`
+	ChannelID        string             `json:"channel_id" yaml:"channel_id"`
+	Ordering         types.Order        `json:"ordering" yaml:"ordering"`
+	ConnectionHops   []string           `json:"connection_hops" yaml:"connection_hops"`
+	Version          string             `json:"version" yaml:"version"`
+	TimeoutHeight    clienttypes.Height `json:"timeout_height" yaml:"timeout_height"`
+	TimeoutTimestamp uint64             `json:"timeout_timestamp" yaml:"timeout_timestamp"`
+	Proposer         sdk.AccAddress     `json:"proposer" yaml:"proposer"`
+	Deposit          sdk.Coins          `json:"deposit" yaml:"deposit"`
+}
+
+// ChannelUpgradeProposalRESTHandler returns a ProposalRESTHandler that exposes the channel upgrade REST handler with a given sub-route.
+func ChannelUpgradeProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "upgrade_channel",
+		Handler:  postChannelUpgradeProposalHandlerFn(clientCtx),
+	}
+}
+
+func postChannelUpgradeProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ChannelUpgradeProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewChannelUpgradeProposal(
+			req.Title, req.Description, req.PortID, req.ChannelID, req.Ordering, req.ConnectionHops, req.Version,
+			req.TimeoutHeight, req.TimeoutTimestamp,
+		)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -11,8 +11,12 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, channel := range gs.Channels {
 		ch := types.NewChannel(channel.State, channel.Ordering, channel.Counterparty, channel.ConnectionHops, channel.Version)
+		ch.UpgradeSequence = channel.UpgradeSequence
 		k.SetChannel(ctx, channel.PortId, channel.ChannelId, ch)
 	}
+	for _, upgrade := range gs.Upgrades {
+		k.SetUpgrade(ctx, upgrade)
+	}
 	for _, ack := range gs.Acknowledgements {
 		k.SetPacketAcknowledgement(ctx, ack.PortId, ack.ChannelId, ack.Sequence, ack.Data)
 	}
@@ -43,5 +47,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		SendSequences:    k.GetAllPacketSendSeqs(ctx),
 		RecvSequences:    k.GetAllPacketRecvSeqs(ctx),
 		AckSequences:     k.GetAllPacketAckSeqs(ctx),
+		Upgrades:         k.GetAllUpgrades(ctx),
 	}
 }
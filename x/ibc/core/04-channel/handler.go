@@ -1,6 +1,8 @@
 package channel
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
@@ -184,3 +186,85 @@ func HandleMsgChannelCloseConfirm(ctx sdk.Context, k keeper.Keeper, channelCap *
 		Events: ctx.EventManager().Events().ToABCIEvents(),
 	}, nil
 }
+
+// HandleMsgChannelUpgradeTry defines the sdk.Handler for MsgChannelUpgradeTry
+func HandleMsgChannelUpgradeTry(ctx sdk.Context, k keeper.Keeper, channelCap *capabilitytypes.Capability, msg *types.MsgChannelUpgradeTry) (*sdk.Result, error) {
+	err := k.ChanUpgradeTry(
+		ctx, msg.PortId, msg.ChannelId, channelCap, msg.Ordering, msg.ConnectionHops, msg.Version,
+		msg.CounterpartyVersion, msg.CounterpartyUpgradeSequence, msg.UpgradeTimeoutHeight, msg.UpgradeTimeoutTimestamp,
+		msg.ProofInit, msg.ProofHeight,
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade try failed")
+	}
+
+	return emitChannelUpgradeEvent(ctx, k, types.EventTypeChannelUpgradeTry, msg.PortId, msg.ChannelId), nil
+}
+
+// HandleMsgChannelUpgradeAck defines the sdk.Handler for MsgChannelUpgradeAck
+func HandleMsgChannelUpgradeAck(ctx sdk.Context, k keeper.Keeper, channelCap *capabilitytypes.Capability, msg *types.MsgChannelUpgradeAck) (*sdk.Result, error) {
+	err := k.ChanUpgradeAck(ctx, msg.PortId, msg.ChannelId, channelCap, msg.CounterpartyVersion, msg.ProofTry, msg.ProofHeight)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade ack failed")
+	}
+
+	return emitChannelUpgradeEvent(ctx, k, types.EventTypeChannelUpgradeAck, msg.PortId, msg.ChannelId), nil
+}
+
+// HandleMsgChannelUpgradeConfirm defines the sdk.Handler for MsgChannelUpgradeConfirm
+func HandleMsgChannelUpgradeConfirm(ctx sdk.Context, k keeper.Keeper, channelCap *capabilitytypes.Capability, msg *types.MsgChannelUpgradeConfirm) (*sdk.Result, error) {
+	err := k.ChanUpgradeConfirm(ctx, msg.PortId, msg.ChannelId, channelCap, msg.ProofAck, msg.ProofHeight)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade confirm failed")
+	}
+
+	return emitChannelUpgradeEvent(ctx, k, types.EventTypeChannelUpgradeConfirm, msg.PortId, msg.ChannelId), nil
+}
+
+// HandleMsgChannelUpgradeTimeout defines the sdk.Handler for MsgChannelUpgradeTimeout
+func HandleMsgChannelUpgradeTimeout(ctx sdk.Context, k keeper.Keeper, channelCap *capabilitytypes.Capability, msg *types.MsgChannelUpgradeTimeout) (*sdk.Result, error) {
+	err := k.ChanUpgradeTimeout(ctx, msg.PortId, msg.ChannelId, channelCap, msg.CounterpartyChannel, msg.ProofChannel, msg.ProofHeight)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade timeout failed")
+	}
+
+	return emitChannelUpgradeEvent(ctx, k, types.EventTypeChannelUpgradeTimeout, msg.PortId, msg.ChannelId), nil
+}
+
+// HandleMsgChannelUpgradeCancel defines the sdk.Handler for MsgChannelUpgradeCancel
+func HandleMsgChannelUpgradeCancel(ctx sdk.Context, k keeper.Keeper, channelCap *capabilitytypes.Capability, msg *types.MsgChannelUpgradeCancel) (*sdk.Result, error) {
+	err := k.ChanUpgradeCancel(ctx, msg.PortId, msg.ChannelId, channelCap, msg.CounterpartyChannel, msg.ProofChannel, msg.ProofHeight)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade cancel failed")
+	}
+
+	return emitChannelUpgradeEvent(ctx, k, types.EventTypeChannelUpgradeCancel, msg.PortId, msg.ChannelId), nil
+}
+
+// emitChannelUpgradeEvent emits an event of the upgrade handshake with the
+// fields of the channel end after the handshake step.
+func emitChannelUpgradeEvent(ctx sdk.Context, k keeper.Keeper, eventType, portID, channelID string) *sdk.Result {
+	channel, _ := k.GetChannel(ctx, portID, channelID)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeCounterpartyPortID, channel.Counterparty.PortId),
+			sdk.NewAttribute(types.AttributeCounterpartyChannelID, channel.Counterparty.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, channel.ConnectionHops[0]),
+			sdk.NewAttribute(types.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", channel.UpgradeSequence)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeOrdering, channel.Ordering.String()),
+			sdk.NewAttribute(types.AttributeKeyUpgradeVersion, channel.Version),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}
+}
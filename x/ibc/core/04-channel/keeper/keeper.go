@@ -325,6 +325,47 @@ func (k Keeper) GetAllPacketAcks(ctx sdk.Context) (acks []types.PacketState) {
 	return acks
 }
 
+// GetUpgrade returns the upgrade handshake in progress for a channel
+func (k Keeper) GetUpgrade(ctx sdk.Context, portID, channelID string) (types.Upgrade, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ChannelUpgradeKey(portID, channelID))
+	if bz == nil {
+		return types.Upgrade{}, false
+	}
+
+	var upgrade types.Upgrade
+	k.cdc.MustUnmarshalBinaryBare(bz, &upgrade)
+	return upgrade, true
+}
+
+// SetUpgrade sets the upgrade handshake in progress for a channel to the store
+func (k Keeper) SetUpgrade(ctx sdk.Context, upgrade types.Upgrade) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&upgrade)
+	store.Set(host.ChannelUpgradeKey(upgrade.PortId, upgrade.ChannelId), bz)
+}
+
+// deleteUpgrade deletes the upgrade handshake of a channel from the store
+func (k Keeper) deleteUpgrade(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ChannelUpgradeKey(portID, channelID))
+}
+
+// GetAllUpgrades returns the upgrade handshakes in progress for all channels
+func (k Keeper) GetAllUpgrades(ctx sdk.Context) []types.Upgrade {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyChannelUpgradePrefix))
+	defer iterator.Close()
+
+	upgrades := []types.Upgrade{}
+	for ; iterator.Valid(); iterator.Next() {
+		var upgrade types.Upgrade
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &upgrade)
+		upgrades = append(upgrades, upgrade)
+	}
+	return upgrades
+}
+
 // IterateChannels provides an iterator over all Channel objects. For each
 // Channel, cb will be called. If the cb returns true, the iterator will close
 // and stop.
@@ -39,6 +39,14 @@ func (k Keeper) SendPacket(
 		)
 	}
 
+	// packets cannot be sent while the channel fields are being upgraded
+	if channel.IsUpgrading() {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel is upgrading (got %s)", channel.State.String(),
+		)
+	}
+
 	if !k.scopedKeeper.AuthenticateCapability(ctx, channelCap, host.ChannelCapabilityPath(packet.GetSourcePort(), packet.GetSourceChannel())) {
 		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", packet.GetSourcePort(), packet.GetSourceChannel())
 	}
@@ -0,0 +1,595 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/core/03-connection/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+)
+
+// Upgrade Handshake
+//
+// This section defines the set of functions required to upgrade the ordering,
+// connection hops or version of an OPEN channel. The channel identifiers,
+// packet sequences and application state (e.g. escrowed tokens) are kept.
+//
+// The upgrading channel ends hold the proposed fields in the INITUPGRADE and
+// TRYUPGRADE states, and store their previous fields so that they can be
+// restored if the upgrade times out or is cancelled. Every upgrade attempt is
+// identified by the upgrade sequence of the initiating channel end, which is
+// kept by both channel ends once the handshake ends. A channel end restored
+// after a timeout or a cancellation takes the upgrade sequence of the
+// counterparty channel end, so that either end can start the next attempt.
+//
+// ChanUpgradeInit is called by a module to propose an upgrade of a channel to
+// the counterparty module. The upgrade times out if it is not accepted by the
+// counterparty channel end before the given counterparty height or timestamp.
+// The timeout is held by the INITUPGRADE channel end, so that the counterparty
+// chain verifies it along with the proposed upgrade.
+func (k Keeper) ChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	ordering types.Order,
+	connectionHops []string,
+	version string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.OPEN {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not OPEN (got %s)", channel.State.String(),
+		)
+	}
+
+	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if timeoutHeight.IsZero() && timeoutTimestamp == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidUpgrade, "upgrade timeout height and timeout timestamp cannot both be 0")
+	}
+
+	proposedChannel, err := k.validateUpgrade(ctx, portID, channelID, channel, ordering, connectionHops, version)
+	if err != nil {
+		return err
+	}
+
+	proposedChannel.State = types.INITUPGRADE
+	proposedChannel.UpgradeSequence = channel.UpgradeSequence + 1
+	proposedChannel.UpgradeTimeoutHeight = timeoutHeight
+	proposedChannel.UpgradeTimeoutTimestamp = timeoutTimestamp
+
+	k.SetUpgrade(ctx, types.NewUpgrade(portID, channelID, channel))
+	k.SetChannel(ctx, portID, channelID, proposedChannel)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", "OPEN", "new-state", "INITUPGRADE")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-init")
+	}()
+
+	return nil
+}
+
+// ChanUpgradeTry is called by a module to accept the upgrade proposed by the
+// counterparty module. The ordering and connection hops must match the ones
+// proposed by the counterparty channel end, and the upgrade must not have
+// timed out on this chain, since the counterparty channel end may then be
+// restored.
+func (k Keeper) ChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	ordering types.Order,
+	connectionHops []string,
+	version,
+	counterpartyVersion string,
+	counterpartyUpgradeSequence uint64,
+	upgradeTimeoutHeight clienttypes.Height,
+	upgradeTimeoutTimestamp uint64,
+	proofInit []byte,
+	proofHeight exported.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.OPEN {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not OPEN (got %s)", channel.State.String(),
+		)
+	}
+
+	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if counterpartyUpgradeSequence <= channel.UpgradeSequence {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidUpgrade,
+			"counterparty upgrade sequence (%d) must be greater than the channel upgrade sequence (%d)",
+			counterpartyUpgradeSequence, channel.UpgradeSequence,
+		)
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	if !upgradeTimeoutHeight.IsZero() && selfHeight.GTE(upgradeTimeoutHeight) {
+		return sdkerrors.Wrapf(
+			types.ErrUpgradeTimeout,
+			"block height >= upgrade timeout height (%s >= %s)", selfHeight, upgradeTimeoutHeight,
+		)
+	}
+
+	if upgradeTimeoutTimestamp != 0 && uint64(ctx.BlockTime().UnixNano()) >= upgradeTimeoutTimestamp {
+		return sdkerrors.Wrapf(
+			types.ErrUpgradeTimeout,
+			"block timestamp >= upgrade timeout timestamp (%s >= %s)", ctx.BlockTime(), time.Unix(0, int64(upgradeTimeoutTimestamp)),
+		)
+	}
+
+	proposedChannel, err := k.validateUpgrade(ctx, portID, channelID, channel, ordering, connectionHops, version)
+	if err != nil {
+		return err
+	}
+
+	proposedChannel.State = types.TRYUPGRADE
+	proposedChannel.UpgradeSequence = counterpartyUpgradeSequence
+
+	connectionEnd, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	counterpartyHops, found := k.CounterpartyHops(ctx, proposedChannel)
+	if !found {
+		// should not reach here, the proposed connection was retrieved when validating the upgrade
+		panic("cannot find connection")
+	}
+
+	// counterparty of the counterparty channel end (i.e self)
+	expectedCounterparty := types.NewCounterparty(portID, channelID)
+	expectedChannel := types.NewChannel(
+		types.INITUPGRADE, ordering, expectedCounterparty,
+		counterpartyHops, counterpartyVersion,
+	)
+	expectedChannel.UpgradeSequence = counterpartyUpgradeSequence
+	expectedChannel.UpgradeTimeoutHeight = upgradeTimeoutHeight
+	expectedChannel.UpgradeTimeoutTimestamp = upgradeTimeoutTimestamp
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connectionEnd, proofHeight, proofInit,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		expectedChannel,
+	); err != nil {
+		return err
+	}
+
+	k.SetUpgrade(ctx, types.NewUpgrade(portID, channelID, channel))
+	k.SetChannel(ctx, portID, channelID, proposedChannel)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", "OPEN", "new-state", "TRYUPGRADE")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-try")
+	}()
+
+	return nil
+}
+
+// ChanUpgradeAck is called by the upgrade-originating module to acknowledge
+// the acceptance of the upgrade by the counterparty module. The channel end is
+// upgraded and OPEN again.
+func (k Keeper) ChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterpartyVersion string,
+	proofTry []byte,
+	proofHeight exported.Height,
+) error {
+	channel, upgrade, err := k.getUpgradingChannel(ctx, portID, channelID, chanCap)
+	if err != nil {
+		return err
+	}
+
+	if channel.State != types.INITUPGRADE {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not INITUPGRADE (got %s)", channel.State.String(),
+		)
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, upgrade.RestoreChannel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	counterpartyHops, found := k.CounterpartyHops(ctx, channel)
+	if !found {
+		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	// counterparty of the counterparty channel end (i.e self)
+	expectedCounterparty := types.NewCounterparty(portID, channelID)
+	expectedChannel := types.NewChannel(
+		types.TRYUPGRADE, channel.Ordering, expectedCounterparty,
+		counterpartyHops, counterpartyVersion,
+	)
+	expectedChannel.UpgradeSequence = channel.UpgradeSequence
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connectionEnd, proofHeight, proofTry,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		expectedChannel,
+	); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", "INITUPGRADE", "new-state", "OPEN")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-ack")
+	}()
+
+	channel.State = types.OPEN
+	channel.Version = counterpartyVersion
+	channel.UpgradeTimeoutHeight = clienttypes.ZeroHeight()
+	channel.UpgradeTimeoutTimestamp = 0
+	k.SetChannel(ctx, portID, channelID, channel)
+	k.deleteUpgrade(ctx, portID, channelID)
+
+	return nil
+}
+
+// ChanUpgradeConfirm is called by the counterparty module to complete the
+// upgrade of their channel end, since the other end has been upgraded.
+func (k Keeper) ChanUpgradeConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	proofAck []byte,
+	proofHeight exported.Height,
+) error {
+	channel, upgrade, err := k.getUpgradingChannel(ctx, portID, channelID, chanCap)
+	if err != nil {
+		return err
+	}
+
+	if channel.State != types.TRYUPGRADE {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not TRYUPGRADE (got %s)", channel.State.String(),
+		)
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, upgrade.RestoreChannel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	counterpartyHops, found := k.CounterpartyHops(ctx, channel)
+	if !found {
+		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	counterparty := types.NewCounterparty(portID, channelID)
+	expectedChannel := types.NewChannel(
+		types.OPEN, channel.Ordering, counterparty,
+		counterpartyHops, channel.Version,
+	)
+	expectedChannel.UpgradeSequence = channel.UpgradeSequence
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connectionEnd, proofHeight, proofAck,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		expectedChannel,
+	); err != nil {
+		return err
+	}
+
+	channel.State = types.OPEN
+	k.SetChannel(ctx, portID, channelID, channel)
+	k.deleteUpgrade(ctx, portID, channelID)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", "TRYUPGRADE", "new-state", "OPEN")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-confirm")
+	}()
+
+	return nil
+}
+
+// ChanUpgradeTimeout is called by the upgrade-originating module to restore
+// its channel end once the upgrade timeout is reached on the counterparty
+// chain. The given counterparty channel end must be proven at a height past
+// the timeout and must not have accepted the upgrade.
+func (k Keeper) ChanUpgradeTimeout(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterpartyChannel types.Channel,
+	proofChannel []byte,
+	proofHeight exported.Height,
+) error {
+	channel, upgrade, err := k.getUpgradingChannel(ctx, portID, channelID, chanCap)
+	if err != nil {
+		return err
+	}
+
+	if channel.State != types.INITUPGRADE {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not INITUPGRADE (got %s)", channel.State.String(),
+		)
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, upgrade.RestoreChannel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	timedOut := !channel.UpgradeTimeoutHeight.IsZero() && !proofHeight.LT(channel.UpgradeTimeoutHeight)
+	if !timedOut && channel.UpgradeTimeoutTimestamp != 0 {
+		proofTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, proofHeight)
+		if err != nil {
+			return err
+		}
+
+		timedOut = proofTimestamp >= channel.UpgradeTimeoutTimestamp
+	}
+
+	if !timedOut {
+		return sdkerrors.Wrapf(
+			types.ErrUpgradeTimeoutNotReached,
+			"proof height (%s) is before the upgrade timeout height (%s) and timeout timestamp (%d)",
+			proofHeight, channel.UpgradeTimeoutHeight, channel.UpgradeTimeoutTimestamp,
+		)
+	}
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connectionEnd, proofHeight, proofChannel,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		counterpartyChannel,
+	); err != nil {
+		return err
+	}
+
+	// the counterparty channel end has accepted the upgrade if it moved to
+	// TRYUPGRADE, or past it, with the upgrade sequence of this attempt. A
+	// counterparty channel end in INITUPGRADE is proposing its own upgrade.
+	if counterpartyChannel.UpgradeSequence >= channel.UpgradeSequence && counterpartyChannel.State != types.INITUPGRADE {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidUpgrade,
+			"counterparty channel has accepted the upgrade (state %s, upgrade sequence %d)",
+			counterpartyChannel.State, counterpartyChannel.UpgradeSequence,
+		)
+	}
+
+	// the counterparty channel end cannot accept the upgrade anymore, since it
+	// rejects it past the timeout, so its sequence is released. A counterparty
+	// channel end in INITUPGRADE is proposing the upgrade sequence after its own.
+	upgradeSequence := counterpartyChannel.UpgradeSequence
+	if counterpartyChannel.State == types.INITUPGRADE {
+		upgradeSequence--
+	}
+
+	k.restoreChannel(ctx, portID, channelID, channel, upgrade, upgradeSequence)
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-timeout")
+	}()
+
+	return nil
+}
+
+// ChanUpgradeCancel is called by either module to restore its channel end
+// once the counterparty channel end is proven to be OPEN without the upgrade,
+// i.e. the counterparty channel end timed out or cancelled the upgrade.
+func (k Keeper) ChanUpgradeCancel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterpartyChannel types.Channel,
+	proofChannel []byte,
+	proofHeight exported.Height,
+) error {
+	channel, upgrade, err := k.getUpgradingChannel(ctx, portID, channelID, chanCap)
+	if err != nil {
+		return err
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, upgrade.RestoreChannel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connectionEnd, proofHeight, proofChannel,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		counterpartyChannel,
+	); err != nil {
+		return err
+	}
+
+	if counterpartyChannel.State != types.OPEN || counterpartyChannel.UpgradeSequence < channel.UpgradeSequence {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidUpgrade,
+			"counterparty channel must be OPEN with an upgrade sequence of at least %d (got %s with upgrade sequence %d)",
+			channel.UpgradeSequence, counterpartyChannel.State, counterpartyChannel.UpgradeSequence,
+		)
+	}
+
+	counterpartyHops, found := k.CounterpartyHops(ctx, channel)
+	if !found {
+		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	if counterpartyChannel.Ordering == channel.Ordering &&
+		counterpartyChannel.ConnectionHops[0] == counterpartyHops[0] &&
+		counterpartyChannel.Version == channel.Version {
+		return sdkerrors.Wrap(types.ErrInvalidUpgrade, "counterparty channel has completed the upgrade")
+	}
+
+	k.restoreChannel(ctx, portID, channelID, channel, upgrade, counterpartyChannel.UpgradeSequence)
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-cancel")
+	}()
+
+	return nil
+}
+
+// validateUpgrade checks the fields proposed in an upgrade of the channel and
+// returns the proposed channel end.
+func (k Keeper) validateUpgrade(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	channel types.Channel,
+	ordering types.Order,
+	connectionHops []string,
+	version string,
+) (types.Channel, error) {
+	if err := types.ValidateUpgradeFields(ordering, connectionHops, version); err != nil {
+		return types.Channel{}, err
+	}
+
+	if ordering == channel.Ordering && connectionHops[0] == channel.ConnectionHops[0] && version == channel.Version {
+		return types.Channel{}, sdkerrors.Wrap(types.ErrInvalidUpgrade, "upgrade must change the ordering, connection hops or version of the channel")
+	}
+
+	// packets received on an UNORDERED channel are tracked by receipts, which
+	// an ORDERED channel does not verify
+	if channel.Ordering == types.UNORDERED && ordering == types.ORDERED {
+		return types.Channel{}, sdkerrors.Wrapf(
+			types.ErrInvalidChannelOrdering,
+			"cannot upgrade channel ordering from %s to %s", channel.Ordering, ordering,
+		)
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, connectionHops[0])
+	if err != nil {
+		return types.Channel{}, err
+	}
+
+	if len(connectionEnd.GetVersions()) != 1 {
+		return types.Channel{}, sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidVersion,
+			"single version must be negotiated on connection before upgrading channel, got: %v",
+			connectionEnd.GetVersions(),
+		)
+	}
+
+	if !connectiontypes.VerifySupportedFeature(connectionEnd.GetVersions()[0], ordering.String()) {
+		return types.Channel{}, sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidVersion,
+			"connection version %s does not support channel ordering: %s",
+			connectionEnd.GetVersions()[0], ordering.String(),
+		)
+	}
+
+	// packets sent before the upgrade must be acknowledged or timed out with
+	// the fields they were sent with
+	if k.hasPacketsInFlight(ctx, portID, channelID) {
+		return types.Channel{}, sdkerrors.Wrapf(
+			types.ErrInvalidUpgrade,
+			"channel has packets in flight, port ID (%s) channel ID (%s)", portID, channelID,
+		)
+	}
+
+	return types.NewChannel(channel.State, ordering, channel.Counterparty, connectionHops, version), nil
+}
+
+// getUpgradingChannel returns a channel end in an upgrade handshake along with
+// its upgrade, after authenticating the channel capability.
+func (k Keeper) getUpgradingChannel(
+	ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability,
+) (types.Channel, types.Upgrade, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return types.Channel{}, types.Upgrade{}, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if !channel.IsUpgrading() {
+		return types.Channel{}, types.Upgrade{}, sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not INITUPGRADE or TRYUPGRADE (got %s)", channel.State.String(),
+		)
+	}
+
+	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		return types.Channel{}, types.Upgrade{}, sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return types.Channel{}, types.Upgrade{}, sdkerrors.Wrapf(types.ErrUpgradeNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return channel, upgrade, nil
+}
+
+// getOpenConnection returns a connection end which must be OPEN.
+func (k Keeper) getOpenConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, error) {
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, connectionID)
+	}
+
+	if connectionEnd.GetState() != int32(connectiontypes.OPEN) {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidConnectionState,
+			"connection state is not OPEN (got %s)", connectiontypes.State(connectionEnd.GetState()).String(),
+		)
+	}
+
+	return connectionEnd, nil
+}
+
+// hasPacketsInFlight returns true if a packet sent on the channel has not
+// been acknowledged or timed out yet.
+func (k Keeper) hasPacketsInFlight(ctx sdk.Context, portID, channelID string) bool {
+	inFlight := false
+	k.IteratePacketCommitmentAtChannel(ctx, portID, channelID, func(_, _ string, _ uint64, _ []byte) bool {
+		inFlight = true
+		return true
+	})
+	return inFlight
+}
+
+// restoreChannel restores the channel end to its fields before the upgrade,
+// with the given upgrade sequence of the counterparty channel end.
+func (k Keeper) restoreChannel(
+	ctx sdk.Context, portID, channelID string, channel types.Channel, upgrade types.Upgrade, upgradeSequence uint64,
+) {
+	restoreChannel := upgrade.RestoreChannel
+	restoreChannel.UpgradeSequence = upgradeSequence
+
+	k.SetChannel(ctx, portID, channelID, restoreChannel)
+	k.deleteUpgrade(ctx, portID, channelID)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", channel.State.String(), "new-state", "OPEN")
+}
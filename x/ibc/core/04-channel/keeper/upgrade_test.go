@@ -0,0 +1,661 @@
+package keeper_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/core/03-connection/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+	ibcmock "github.com/cosmos/cosmos-sdk/x/ibc/testing/mock"
+)
+
+const upgradeVersion = "mock-version-2"
+
+var upgradeTimeoutHeight = clienttypes.NewHeight(0, 1000)
+
+// setupUpgrade creates an ORDERED channel between chainA and chainB along with
+// a second connection which the channel can be upgraded to.
+func (suite *KeeperTestSuite) setupUpgrade() (ibctesting.TestChannel, ibctesting.TestChannel, *ibctesting.TestConnection, *ibctesting.TestConnection) {
+	clientA, clientB, _, _, channelA, channelB := suite.coordinator.Setup(suite.chainA, suite.chainB, types.ORDERED)
+	connA, connB := suite.coordinator.CreateConnection(suite.chainA, suite.chainB, clientA, clientB)
+
+	return channelA, channelB, connA, connB
+}
+
+// TestChanUpgradeInit tests the upgrade proposal of a channel on chainA.
+func (suite *KeeperTestSuite) TestChanUpgradeInit() {
+	var (
+		channelA       ibctesting.TestChannel
+		ordering       types.Order
+		connectionHops []string
+		version        string
+		timeoutHeight  clienttypes.Height
+		channelCap     *capabilitytypes.Capability
+	)
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"success: only the version changes", func() {
+			connectionHops = []string{suite.chainA.GetChannel(channelA).ConnectionHops[0]}
+			ordering = types.ORDERED
+		}, true},
+		{"channel not found", func() {
+			channelA.ID = ibctesting.InvalidID
+		}, false},
+		{"channel not OPEN", func() {
+			err := suite.coordinator.SetChannelClosed(suite.chainA, suite.chainB, channelA)
+			suite.Require().NoError(err)
+		}, false},
+		{"capability is incorrect", func() {
+			channelCap = capabilitytypes.NewCapability(42)
+		}, false},
+		{"no upgrade timeout", func() {
+			timeoutHeight = clienttypes.ZeroHeight()
+		}, false},
+		{"upgrade does not change the channel", func() {
+			channel := suite.chainA.GetChannel(channelA)
+			ordering, connectionHops, version = channel.Ordering, channel.ConnectionHops, channel.Version
+		}, false},
+		{"UNORDERED channel cannot be upgraded to ORDERED", func() {
+			channel := suite.chainA.GetChannel(channelA)
+			channel.Ordering = types.UNORDERED
+			suite.chainA.App.IBCKeeper.ChannelKeeper.SetChannel(suite.chainA.GetContext(), channelA.PortID, channelA.ID, channel)
+
+			ordering = types.ORDERED
+		}, false},
+		{"connection not found", func() {
+			connectionHops = []string{"connectionnotfound"}
+		}, false},
+		{"connection does not support UNORDERED channels", func() {
+			conn, _ := suite.chainA.App.IBCKeeper.ConnectionKeeper.GetConnection(suite.chainA.GetContext(), connectionHops[0])
+			conn.Versions = []*connectiontypes.Version{connectiontypes.NewVersion("1", []string{"ORDER_ORDERED"})}
+			suite.chainA.App.IBCKeeper.ConnectionKeeper.SetConnection(suite.chainA.GetContext(), connectionHops[0], conn)
+		}, false},
+		{"channel has packets in flight", func() {
+			packet := types.NewPacket(validPacketData, 1, channelA.PortID, channelA.ID, "portid", "channelid", timeoutHeight, disabledTimeoutTimestamp)
+			suite.chainA.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), channelA.PortID, channelA.ID, 1, types.CommitPacket(suite.chainA.App.AppCodec(), packet))
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			var connA *ibctesting.TestConnection
+			channelA, _, connA, _ = suite.setupUpgrade()
+			ordering = types.UNORDERED
+			connectionHops = []string{connA.ID}
+			version = upgradeVersion
+			timeoutHeight = upgradeTimeoutHeight
+			channelCap = suite.chainA.GetChannelCapability(channelA.PortID, channelA.ID)
+
+			tc.malleate()
+
+			previousChannel, _ := suite.chainA.App.IBCKeeper.ChannelKeeper.GetChannel(suite.chainA.GetContext(), channelA.PortID, channelA.ID)
+
+			err := suite.chainA.App.IBCKeeper.ChannelKeeper.ChanUpgradeInit(
+				suite.chainA.GetContext(), channelA.PortID, channelA.ID, channelCap,
+				ordering, connectionHops, version, timeoutHeight, 0,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				channel := suite.chainA.GetChannel(channelA)
+				suite.Require().Equal(types.INITUPGRADE, channel.State)
+				suite.Require().Equal(ordering, channel.Ordering)
+				suite.Require().Equal(connectionHops, channel.ConnectionHops)
+				suite.Require().Equal(version, channel.Version)
+				suite.Require().Equal(uint64(1), channel.UpgradeSequence)
+
+				upgrade, found := suite.chainA.App.IBCKeeper.ChannelKeeper.GetUpgrade(suite.chainA.GetContext(), channelA.PortID, channelA.ID)
+				suite.Require().True(found)
+				suite.Require().Equal(previousChannel, upgrade.RestoreChannel)
+				suite.Require().Equal(timeoutHeight, channel.UpgradeTimeoutHeight)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestChanUpgradeTry tests the acceptance on chainB of the upgrade proposed
+// on chainA.
+func (suite *KeeperTestSuite) TestChanUpgradeTry() {
+	var (
+		channelA, channelB           ibctesting.TestChannel
+		connB                        *ibctesting.TestConnection
+		ordering                     types.Order
+		counterpartyVersion          string
+		counterpartyUpgradeSequence  uint64
+		counterpartyTimeoutHeight    clienttypes.Height
+		counterpartyTimeoutTimestamp uint64
+		channelCap                   *capabilitytypes.Capability
+	)
+
+	// setUpgradeTimeout sets the upgrade timeout of the channel on chainA
+	setUpgradeTimeout := func(height clienttypes.Height, timestamp uint64) {
+		channel := suite.chainA.GetChannel(channelA)
+		channel.UpgradeTimeoutHeight = height
+		channel.UpgradeTimeoutTimestamp = timestamp
+		suite.chainA.App.IBCKeeper.ChannelKeeper.SetChannel(suite.chainA.GetContext(), channelA.PortID, channelA.ID, channel)
+
+		suite.coordinator.CommitBlock(suite.chainA)
+		err := suite.coordinator.UpdateClient(suite.chainB, suite.chainA, channelB.ClientID, exported.Tendermint)
+		suite.Require().NoError(err)
+
+		counterpartyTimeoutHeight, counterpartyTimeoutTimestamp = height, timestamp
+	}
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"success: upgrade timeout timestamp", func() {
+			setUpgradeTimeout(clienttypes.ZeroHeight(), uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano()))
+		}, true},
+		{"channel not OPEN", func() {
+			err := suite.coordinator.SetChannelClosed(suite.chainB, suite.chainA, channelB)
+			suite.Require().NoError(err)
+		}, false},
+		{"capability is incorrect", func() {
+			channelCap = capabilitytypes.NewCapability(42)
+		}, false},
+		{"counterparty upgrade sequence is not greater than the upgrade sequence", func() {
+			channel := suite.chainB.GetChannel(channelB)
+			channel.UpgradeSequence = 1
+			suite.chainB.App.IBCKeeper.ChannelKeeper.SetChannel(suite.chainB.GetContext(), channelB.PortID, channelB.ID, channel)
+		}, false},
+		{"ordering does not match the proposed ordering", func() {
+			ordering = types.ORDERED
+		}, false},
+		{"counterparty version does not match the proposed version", func() {
+			counterpartyVersion = ibctesting.DefaultChannelVersion
+		}, false},
+		{"counterparty upgrade sequence does not match", func() {
+			counterpartyUpgradeSequence = 2
+		}, false},
+		{"upgrade timeout height reached", func() {
+			setUpgradeTimeout(clienttypes.GetSelfHeight(suite.chainB.GetContext()), 0)
+		}, false},
+		{"upgrade timeout timestamp reached", func() {
+			setUpgradeTimeout(clienttypes.ZeroHeight(), uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
+		}, false},
+		{"upgrade timeout does not match the proposed timeout", func() {
+			counterpartyTimeoutHeight = counterpartyTimeoutHeight.Increment()
+		}, false},
+		{"channel has packets in flight", func() {
+			packet := types.NewPacket(validPacketData, 1, channelB.PortID, channelB.ID, channelA.PortID, channelA.ID, timeoutHeight, disabledTimeoutTimestamp)
+			suite.chainB.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.chainB.GetContext(), channelB.PortID, channelB.ID, 1, types.CommitPacket(suite.chainB.App.AppCodec(), packet))
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			var connA *ibctesting.TestConnection
+			channelA, channelB, connA, connB = suite.setupUpgrade()
+			err := suite.coordinator.ChanUpgradeInit(suite.chainA, suite.chainB, channelA, types.UNORDERED, connA.ID, upgradeVersion, upgradeTimeoutHeight, 0)
+			suite.Require().NoError(err)
+
+			ordering = types.UNORDERED
+			counterpartyVersion = upgradeVersion
+			counterpartyUpgradeSequence = 1
+			counterpartyTimeoutHeight, counterpartyTimeoutTimestamp = upgradeTimeoutHeight, 0
+			channelCap = suite.chainB.GetChannelCapability(channelB.PortID, channelB.ID)
+
+			tc.malleate()
+
+			proof, proofHeight := suite.chainA.QueryProof(host.ChannelKey(channelA.PortID, channelA.ID))
+
+			err = suite.chainB.App.IBCKeeper.ChannelKeeper.ChanUpgradeTry(
+				suite.chainB.GetContext(), channelB.PortID, channelB.ID, channelCap,
+				ordering, []string{connB.ID}, upgradeVersion, counterpartyVersion, counterpartyUpgradeSequence,
+				counterpartyTimeoutHeight, counterpartyTimeoutTimestamp, proof, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				channel := suite.chainB.GetChannel(channelB)
+				suite.Require().Equal(types.TRYUPGRADE, channel.State)
+				suite.Require().Equal([]string{connB.ID}, channel.ConnectionHops)
+				suite.Require().Equal(uint64(1), channel.UpgradeSequence)
+
+				_, found := suite.chainB.App.IBCKeeper.ChannelKeeper.GetUpgrade(suite.chainB.GetContext(), channelB.PortID, channelB.ID)
+				suite.Require().True(found)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestChanUpgradeAck tests the completion of the upgrade on chainA once it is
+// accepted on chainB.
+func (suite *KeeperTestSuite) TestChanUpgradeAck() {
+	var (
+		channelA, channelB ibctesting.TestChannel
+		connA, connB       *ibctesting.TestConnection
+		channelCap         *capabilitytypes.Capability
+	)
+
+	testCases := []testCase{
+		{"success", func() {
+			err := suite.coordinator.ChanUpgradeTry(suite.chainB, suite.chainA, channelB, channelA, types.UNORDERED, connB.ID, upgradeVersion)
+			suite.Require().NoError(err)
+		}, true},
+		{"channel not INITUPGRADE", func() {
+			err := suite.coordinator.ChanUpgradeTry(suite.chainB, suite.chainA, channelB, channelA, types.UNORDERED, connB.ID, upgradeVersion)
+			suite.Require().NoError(err)
+
+			channel := suite.chainA.GetChannel(channelA)
+			channel.State = types.TRYUPGRADE
+			suite.chainA.App.IBCKeeper.ChannelKeeper.SetChannel(suite.chainA.GetContext(), channelA.PortID, channelA.ID, channel)
+		}, false},
+		{"capability is incorrect", func() {
+			err := suite.coordinator.ChanUpgradeTry(suite.chainB, suite.chainA, channelB, channelA, types.UNORDERED, connB.ID, upgradeVersion)
+			suite.Require().NoError(err)
+
+			channelCap = capabilitytypes.NewCapability(42)
+		}, false},
+		{"channel verification failed", func() {
+			// chainB has not accepted the upgrade
+			suite.coordinator.CommitBlock(suite.chainB)
+			err := suite.coordinator.UpdateClient(suite.chainA, suite.chainB, channelA.ClientID, exported.Tendermint)
+			suite.Require().NoError(err)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			channelA, channelB, connA, connB = suite.setupUpgrade()
+			err := suite.coordinator.ChanUpgradeInit(suite.chainA, suite.chainB, channelA, types.UNORDERED, connA.ID, upgradeVersion, upgradeTimeoutHeight, 0)
+			suite.Require().NoError(err)
+
+			channelCap = suite.chainA.GetChannelCapability(channelA.PortID, channelA.ID)
+
+			tc.malleate()
+
+			proof, proofHeight := suite.chainB.QueryProof(host.ChannelKey(channelB.PortID, channelB.ID))
+
+			err = suite.chainA.App.IBCKeeper.ChannelKeeper.ChanUpgradeAck(
+				suite.chainA.GetContext(), channelA.PortID, channelA.ID, channelCap,
+				upgradeVersion, proof, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				channel := suite.chainA.GetChannel(channelA)
+				suite.Require().Equal(types.OPEN, channel.State)
+				suite.Require().Equal(types.UNORDERED, channel.Ordering)
+				suite.Require().Equal([]string{connA.ID}, channel.ConnectionHops)
+				suite.Require().Equal(upgradeVersion, channel.Version)
+
+				_, found := suite.chainA.App.IBCKeeper.ChannelKeeper.GetUpgrade(suite.chainA.GetContext(), channelA.PortID, channelA.ID)
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestChanUpgradeConfirm tests the completion of the upgrade on chainB once
+// it is completed on chainA.
+func (suite *KeeperTestSuite) TestChanUpgradeConfirm() {
+	var (
+		channelA, channelB ibctesting.TestChannel
+		connB              *ibctesting.TestConnection
+		channelCap         *capabilitytypes.Capability
+	)
+
+	testCases := []testCase{
+		{"success", func() {
+			err := suite.coordinator.ChanUpgradeAck(suite.chainA, suite.chainB, channelA, channelB)
+			suite.Require().NoError(err)
+		}, true},
+		{"capability is incorrect", func() {
+			err := suite.coordinator.ChanUpgradeAck(suite.chainA, suite.chainB, channelA, channelB)
+			suite.Require().NoError(err)
+
+			channelCap = capabilitytypes.NewCapability(42)
+		}, false},
+		{"channel verification failed", func() {
+			// chainA has not completed the upgrade
+			suite.coordinator.CommitBlock(suite.chainA)
+			err := suite.coordinator.UpdateClient(suite.chainB, suite.chainA, channelB.ClientID, exported.Tendermint)
+			suite.Require().NoError(err)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			var connA *ibctesting.TestConnection
+			channelA, channelB, connA, connB = suite.setupUpgrade()
+			err := suite.coordinator.ChanUpgradeInit(suite.chainA, suite.chainB, channelA, types.UNORDERED, connA.ID, upgradeVersion, upgradeTimeoutHeight, 0)
+			suite.Require().NoError(err)
+
+			err = suite.coordinator.ChanUpgradeTry(suite.chainB, suite.chainA, channelB, channelA, types.UNORDERED, connB.ID, upgradeVersion)
+			suite.Require().NoError(err)
+
+			channelCap = suite.chainB.GetChannelCapability(channelB.PortID, channelB.ID)
+
+			tc.malleate()
+
+			proof, proofHeight := suite.chainA.QueryProof(host.ChannelKey(channelA.PortID, channelA.ID))
+
+			err = suite.chainB.App.IBCKeeper.ChannelKeeper.ChanUpgradeConfirm(
+				suite.chainB.GetContext(), channelB.PortID, channelB.ID, channelCap,
+				proof, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				channel := suite.chainB.GetChannel(channelB)
+				suite.Require().Equal(types.OPEN, channel.State)
+				suite.Require().Equal([]string{connB.ID}, channel.ConnectionHops)
+				suite.Require().Equal(upgradeVersion, channel.Version)
+
+				_, found := suite.chainB.App.IBCKeeper.ChannelKeeper.GetUpgrade(suite.chainB.GetContext(), channelB.PortID, channelB.ID)
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestChanUpgradeTimeout tests the restoration of the channel on chainA once
+// the upgrade timed out on chainB.
+func (suite *KeeperTestSuite) TestChanUpgradeTimeout() {
+	var (
+		channelA, channelB ibctesting.TestChannel
+		connB              *ibctesting.TestConnection
+		channel            types.Channel
+		channelCap         *capabilitytypes.Capability
+	)
+
+	testCases := []testCase{
+		{"success: timeout height", func() {}, true},
+		{"success: timeout timestamp", func() {
+			channel.UpgradeTimeoutTimestamp = uint64(suite.chainB.GetContext().BlockTime().UnixNano())
+			channel.UpgradeTimeoutHeight = clienttypes.ZeroHeight()
+		}, true},
+		{"success: counterparty channel proposes another upgrade", func() {
+			counterpartyChannel := suite.chainB.GetChannel(channelB)
+			counterpartyChannel.State = types.INITUPGRADE
+			counterpartyChannel.UpgradeSequence = 1
+			suite.chainB.App.IBCKeeper.ChannelKeeper.SetChannel(suite.chainB.GetContext(), channelB.PortID, channelB.ID, counterpartyChannel)
+		}, true},
+		{"timeout height not reached", func() {
+			channel.UpgradeTimeoutHeight = upgradeTimeoutHeight
+		}, false},
+		{"timeout timestamp not reached", func() {
+			channel.UpgradeTimeoutTimestamp = uint64(suite.chainB.GetContext().BlockTime().Add(time.Hour).UnixNano())
+			channel.UpgradeTimeoutHeight = clienttypes.ZeroHeight()
+		}, false},
+		{"capability is incorrect", func() {
+			channelCap = capabilitytypes.NewCapability(42)
+		}, false},
+		{"counterparty channel accepted the upgrade", func() {
+			// chainB accepts the upgrade before it times out
+			channel.UpgradeTimeoutHeight = upgradeTimeoutHeight
+			suite.chainA.App.IBCKeeper.ChannelKeeper.SetChannel(suite.chainA.GetContext(), channelA.PortID, channelA.ID, channel)
+
+			suite.coordinator.CommitBlock(suite.chainA)
+			err := suite.coordinator.UpdateClient(suite.chainB, suite.chainA, channelB.ClientID, exported.Tendermint)
+			suite.Require().NoError(err)
+
+			err = suite.coordinator.ChanUpgradeTry(suite.chainB, suite.chainA, channelB, channelA, types.UNORDERED, connB.ID, upgradeVersion)
+			suite.Require().NoError(err)
+
+			channel = suite.chainA.GetChannel(channelA)
+			channel.UpgradeTimeoutHeight = clienttypes.GetSelfHeight(suite.chainB.GetContext())
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			var connA *ibctesting.TestConnection
+			channelA, channelB, connA, connB = suite.setupUpgrade()
+
+			// the timeout height is reached once chainB commits the next block
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			err := suite.coordinator.ChanUpgradeInit(suite.chainA, suite.chainB, channelA, types.UNORDERED, connA.ID, upgradeVersion, timeoutHeight, 0)
+			suite.Require().NoError(err)
+
+			channel = suite.chainA.GetChannel(channelA)
+			channelCap = suite.chainA.GetChannelCapability(channelA.PortID, channelA.ID)
+
+			tc.malleate()
+
+			suite.chainA.App.IBCKeeper.ChannelKeeper.SetChannel(suite.chainA.GetContext(), channelA.PortID, channelA.ID, channel)
+
+			// update chainB client on chainA
+			suite.coordinator.CommitBlock(suite.chainB)
+			err = suite.coordinator.UpdateClient(suite.chainA, suite.chainB, channelA.ClientID, exported.Tendermint)
+			suite.Require().NoError(err)
+
+			counterpartyChannel := suite.chainB.GetChannel(channelB)
+			proof, proofHeight := suite.chainB.QueryProof(host.ChannelKey(channelB.PortID, channelB.ID))
+
+			err = suite.chainA.App.IBCKeeper.ChannelKeeper.ChanUpgradeTimeout(
+				suite.chainA.GetContext(), channelA.PortID, channelA.ID, channelCap,
+				counterpartyChannel, proof, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				// the upgrade sequence of chainB is taken since it never accepted the upgrade
+				channel := suite.chainA.GetChannel(channelA)
+				suite.Require().Equal(types.OPEN, channel.State)
+				suite.Require().Equal(types.ORDERED, channel.Ordering)
+				suite.Require().Equal(ibctesting.DefaultChannelVersion, channel.Version)
+				suite.Require().Equal(uint64(0), channel.UpgradeSequence)
+
+				_, found := suite.chainA.App.IBCKeeper.ChannelKeeper.GetUpgrade(suite.chainA.GetContext(), channelA.PortID, channelA.ID)
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(types.INITUPGRADE, suite.chainA.GetChannel(channelA).State)
+			}
+		})
+	}
+}
+
+// TestChanUpgradeCancel tests the restoration of the channel on chainB once
+// the upgrade is restored on chainA.
+func (suite *KeeperTestSuite) TestChanUpgradeCancel() {
+	var (
+		channelA, channelB ibctesting.TestChannel
+		channelCap         *capabilitytypes.Capability
+	)
+
+	// restoreChannelA restores the channel on chainA as if the upgrade timed out
+	restoreChannelA := func() {
+		upgrade, found := suite.chainA.App.IBCKeeper.ChannelKeeper.GetUpgrade(suite.chainA.GetContext(), channelA.PortID, channelA.ID)
+		suite.Require().True(found)
+
+		channel := upgrade.RestoreChannel
+		channel.UpgradeSequence = 1
+		suite.chainA.App.IBCKeeper.ChannelKeeper.SetChannel(suite.chainA.GetContext(), channelA.PortID, channelA.ID, channel)
+
+		suite.coordinator.CommitBlock(suite.chainA)
+		err := suite.coordinator.UpdateClient(suite.chainB, suite.chainA, channelB.ClientID, exported.Tendermint)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []testCase{
+		{"success", func() {
+			restoreChannelA()
+		}, true},
+		{"capability is incorrect", func() {
+			restoreChannelA()
+			channelCap = capabilitytypes.NewCapability(42)
+		}, false},
+		{"counterparty channel is upgrading", func() {
+			// chainA has not restored the channel
+			suite.coordinator.CommitBlock(suite.chainA)
+			err := suite.coordinator.UpdateClient(suite.chainB, suite.chainA, channelB.ClientID, exported.Tendermint)
+			suite.Require().NoError(err)
+		}, false},
+		{"counterparty channel completed the upgrade", func() {
+			err := suite.coordinator.ChanUpgradeAck(suite.chainA, suite.chainB, channelA, channelB)
+			suite.Require().NoError(err)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			var connA, connB *ibctesting.TestConnection
+			channelA, channelB, connA, connB = suite.setupUpgrade()
+
+			err := suite.coordinator.ChanUpgradeInit(suite.chainA, suite.chainB, channelA, types.UNORDERED, connA.ID, upgradeVersion, upgradeTimeoutHeight, 0)
+			suite.Require().NoError(err)
+
+			err = suite.coordinator.ChanUpgradeTry(suite.chainB, suite.chainA, channelB, channelA, types.UNORDERED, connB.ID, upgradeVersion)
+			suite.Require().NoError(err)
+
+			channelCap = suite.chainB.GetChannelCapability(channelB.PortID, channelB.ID)
+
+			tc.malleate()
+
+			counterpartyChannel := suite.chainA.GetChannel(channelA)
+			proof, proofHeight := suite.chainA.QueryProof(host.ChannelKey(channelA.PortID, channelA.ID))
+
+			err = suite.chainB.App.IBCKeeper.ChannelKeeper.ChanUpgradeCancel(
+				suite.chainB.GetContext(), channelB.PortID, channelB.ID, channelCap,
+				counterpartyChannel, proof, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				channel := suite.chainB.GetChannel(channelB)
+				suite.Require().Equal(types.OPEN, channel.State)
+				suite.Require().Equal(types.ORDERED, channel.Ordering)
+				suite.Require().Equal(ibctesting.DefaultChannelVersion, channel.Version)
+				suite.Require().Equal(uint64(1), channel.UpgradeSequence)
+
+				_, found := suite.chainB.App.IBCKeeper.ChannelKeeper.GetUpgrade(suite.chainB.GetContext(), channelB.PortID, channelB.ID)
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(types.TRYUPGRADE, suite.chainB.GetChannel(channelB).State)
+			}
+		})
+	}
+}
+
+// TestChannelUpgrade runs the upgrade handshake through the messages and the
+// application callbacks, and sends a packet on the upgraded channel.
+func (suite *KeeperTestSuite) TestChannelUpgrade() {
+	channelA, channelB, connA, connB := suite.setupUpgrade()
+
+	// the mock application rejects the upgrade
+	err := suite.coordinator.ChanUpgradeInit(suite.chainA, suite.chainB, channelA, types.UNORDERED, connA.ID, ibcmock.MockRejectedVersion, upgradeTimeoutHeight, 0)
+	suite.Require().Error(err)
+
+	err = suite.coordinator.ChanUpgradeInit(suite.chainA, suite.chainB, channelA, types.UNORDERED, connA.ID, upgradeVersion, upgradeTimeoutHeight, 0)
+	suite.Require().NoError(err)
+
+	// packets cannot be sent while the channel is upgrading
+	packet := types.NewPacket(validPacketData, 1, channelA.PortID, channelA.ID, channelB.PortID, channelB.ID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().Error(suite.chainA.SendPacket(packet))
+
+	err = suite.coordinator.ChanUpgradeTry(suite.chainB, suite.chainA, channelB, channelA, types.UNORDERED, connB.ID, upgradeVersion)
+	suite.Require().NoError(err)
+
+	err = suite.coordinator.ChanUpgradeAck(suite.chainA, suite.chainB, channelA, channelB)
+	suite.Require().NoError(err)
+
+	err = suite.coordinator.ChanUpgradeConfirm(suite.chainB, suite.chainA, channelB, channelA)
+	suite.Require().NoError(err)
+
+	expChannelA := types.NewChannel(types.OPEN, types.UNORDERED, types.NewCounterparty(channelB.PortID, channelB.ID), []string{connA.ID}, upgradeVersion)
+	expChannelA.UpgradeSequence = 1
+	suite.Require().Equal(expChannelA, suite.chainA.GetChannel(channelA))
+
+	expChannelB := types.NewChannel(types.OPEN, types.UNORDERED, types.NewCounterparty(channelA.PortID, channelA.ID), []string{connB.ID}, upgradeVersion)
+	expChannelB.UpgradeSequence = 1
+	suite.Require().Equal(expChannelB, suite.chainB.GetChannel(channelB))
+
+	// the packet sequences are kept
+	suite.Require().NoError(suite.chainA.SendPacket(packet))
+}
+
+// TestChannelUpgradeAfterTimeout tests that an upgrade cannot be accepted once
+// it timed out, and that the counterparty can then upgrade the channel.
+func (suite *KeeperTestSuite) TestChannelUpgradeAfterTimeout() {
+	channelA, channelB, connA, connB := suite.setupUpgrade()
+
+	// the timeout height is reached once chainB commits the next block
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	err := suite.coordinator.ChanUpgradeInit(suite.chainA, suite.chainB, channelA, types.UNORDERED, connA.ID, upgradeVersion, timeoutHeight, 0)
+	suite.Require().NoError(err)
+
+	// chainB cannot accept the upgrade once it timed out, even though chainA
+	// has not restored the channel yet
+	counterpartyChannel := suite.chainA.GetChannel(channelA)
+	proof, proofHeight := suite.chainA.QueryProof(host.ChannelKey(channelA.PortID, channelA.ID))
+	err = suite.chainB.App.IBCKeeper.ChannelKeeper.ChanUpgradeTry(
+		suite.chainB.GetContext(), channelB.PortID, channelB.ID, suite.chainB.GetChannelCapability(channelB.PortID, channelB.ID),
+		types.UNORDERED, []string{connB.ID}, upgradeVersion, counterpartyChannel.Version, counterpartyChannel.UpgradeSequence,
+		counterpartyChannel.UpgradeTimeoutHeight, counterpartyChannel.UpgradeTimeoutTimestamp, proof, proofHeight,
+	)
+	suite.Require().True(errors.Is(err, types.ErrUpgradeTimeout))
+	suite.Require().Equal(types.OPEN, suite.chainB.GetChannel(channelB).State)
+
+	err = suite.coordinator.UpdateClient(suite.chainA, suite.chainB, channelA.ClientID, exported.Tendermint)
+	suite.Require().NoError(err)
+
+	// chainA takes the upgrade sequence of chainB back
+	err = suite.coordinator.ChanUpgradeTimeout(suite.chainA, suite.chainB, channelA, channelB)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.OPEN, suite.chainA.GetChannel(channelA).State)
+	suite.Require().Equal(uint64(0), suite.chainA.GetChannel(channelA).UpgradeSequence)
+
+	// chainB proposes the upgrade in the reverse direction
+	err = suite.coordinator.ChanUpgradeInit(suite.chainB, suite.chainA, channelB, types.UNORDERED, connB.ID, upgradeVersion, upgradeTimeoutHeight, 0)
+	suite.Require().NoError(err)
+
+	err = suite.coordinator.ChanUpgradeTry(suite.chainA, suite.chainB, channelA, channelB, types.UNORDERED, connA.ID, upgradeVersion)
+	suite.Require().NoError(err)
+
+	err = suite.coordinator.ChanUpgradeAck(suite.chainB, suite.chainA, channelB, channelA)
+	suite.Require().NoError(err)
+
+	err = suite.coordinator.ChanUpgradeConfirm(suite.chainA, suite.chainB, channelA, channelB)
+	suite.Require().NoError(err)
+
+	expChannelA := types.NewChannel(types.OPEN, types.UNORDERED, types.NewCounterparty(channelB.PortID, channelB.ID), []string{connA.ID}, upgradeVersion)
+	expChannelA.UpgradeSequence = 1
+	suite.Require().Equal(expChannelA, suite.chainA.GetChannel(channelA))
+
+	expChannelB := types.NewChannel(types.OPEN, types.UNORDERED, types.NewCounterparty(channelA.PortID, channelA.ID), []string{connB.ID}, upgradeVersion)
+	expChannelB.UpgradeSequence = 1
+	suite.Require().Equal(expChannelB, suite.chainB.GetChannel(channelB))
+}
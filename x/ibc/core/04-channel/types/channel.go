@@ -73,6 +73,11 @@ func (ch Channel) ValidateBasic() error {
 	return ch.Counterparty.ValidateBasic()
 }
 
+// IsUpgrading returns true if the channel end is in an upgrade handshake.
+func (ch Channel) IsUpgrading() bool {
+	return ch.State == INITUPGRADE || ch.State == TRYUPGRADE
+}
+
 // NewCounterparty returns a new Counterparty instance
 func NewCounterparty(portID, channelID string) Counterparty {
 	return Counterparty{
@@ -107,13 +112,16 @@ func (c Counterparty) ValidateBasic() error {
 // NewIdentifiedChannel creates a new IdentifiedChannel instance
 func NewIdentifiedChannel(portID, channelID string, ch Channel) IdentifiedChannel {
 	return IdentifiedChannel{
-		State:          ch.State,
-		Ordering:       ch.Ordering,
-		Counterparty:   ch.Counterparty,
-		ConnectionHops: ch.ConnectionHops,
-		Version:        ch.Version,
-		PortId:         portID,
-		ChannelId:      channelID,
+		State:                   ch.State,
+		Ordering:                ch.Ordering,
+		Counterparty:            ch.Counterparty,
+		ConnectionHops:          ch.ConnectionHops,
+		Version:                 ch.Version,
+		PortId:                  portID,
+		ChannelId:               channelID,
+		UpgradeSequence:         ch.UpgradeSequence,
+		UpgradeTimeoutHeight:    ch.UpgradeTimeoutHeight,
+		UpgradeTimeoutTimestamp: ch.UpgradeTimeoutTimestamp,
 	}
 }
 
@@ -126,6 +134,7 @@ func (ic IdentifiedChannel) ValidateBasic() error {
 		return sdkerrors.Wrap(err, "invalid port ID")
 	}
 	channel := NewChannel(ic.State, ic.Ordering, ic.Counterparty, ic.ConnectionHops, ic.Version)
+	channel.UpgradeSequence = ic.UpgradeSequence
 	return channel.ValidateBasic()
 }
 
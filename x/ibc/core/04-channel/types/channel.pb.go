@@ -25,7 +25,7 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // State defines if a channel is in one of the following states:
-// CLOSED, INIT, TRYOPEN, OPEN or UNINITIALIZED.
+// CLOSED, INIT, TRYOPEN, OPEN, INITUPGRADE, TRYUPGRADE or UNINITIALIZED.
 type State int32
 
 const (
@@ -41,6 +41,13 @@ const (
 	// A channel has been closed and can no longer be used to send or receive
 	// packets.
 	CLOSED State = 4
+	// An open channel has proposed an upgrade of its ordering, connection hops
+	// or version. Packets cannot be sent or received until the upgrade
+	// handshake completes or the channel is restored.
+	INITUPGRADE State = 5
+	// An open channel has accepted the upgrade proposed by the counterparty
+	// channel end.
+	TRYUPGRADE State = 6
 )
 
 var State_name = map[int32]string{
@@ -49,6 +56,8 @@ var State_name = map[int32]string{
 	2: "STATE_TRYOPEN",
 	3: "STATE_OPEN",
 	4: "STATE_CLOSED",
+	5: "STATE_INITUPGRADE",
+	6: "STATE_TRYUPGRADE",
 }
 
 var State_value = map[string]int32{
@@ -57,6 +66,8 @@ var State_value = map[string]int32{
 	"STATE_TRYOPEN":                   2,
 	"STATE_OPEN":                      3,
 	"STATE_CLOSED":                    4,
+	"STATE_INITUPGRADE":               5,
+	"STATE_TRYUPGRADE":                6,
 }
 
 func (x State) String() string {
@@ -115,6 +126,15 @@ type Channel struct {
 	ConnectionHops []string `protobuf:"bytes,4,rep,name=connection_hops,json=connectionHops,proto3" json:"connection_hops,omitempty" yaml:"connection_hops"`
 	// opaque channel version, which is agreed upon during the handshake
 	Version string `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	// sequence of the last upgrade handshake started on the channel
+	UpgradeSequence uint64 `protobuf:"varint,6,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty" yaml:"upgrade_sequence"`
+	// counterparty block height after which the upgrade proposed by the channel
+	// end times out. It is only set in the INITUPGRADE state.
+	UpgradeTimeoutHeight types.Height `protobuf:"bytes,7,opt,name=upgrade_timeout_height,json=upgradeTimeoutHeight,proto3" json:"upgrade_timeout_height" yaml:"upgrade_timeout_height"`
+	// counterparty block timestamp (in nanoseconds) after which the upgrade
+	// proposed by the channel end times out. It is only set in the INITUPGRADE
+	// state.
+	UpgradeTimeoutTimestamp uint64 `protobuf:"varint,8,opt,name=upgrade_timeout_timestamp,json=upgradeTimeoutTimestamp,proto3" json:"upgrade_timeout_timestamp,omitempty" yaml:"upgrade_timeout_timestamp"`
 }
 
 func (m *Channel) Reset()         { *m = Channel{} }
@@ -168,6 +188,15 @@ type IdentifiedChannel struct {
 	PortId string `protobuf:"bytes,6,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
 	// channel identifier
 	ChannelId string `protobuf:"bytes,7,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence of the last upgrade handshake started on the channel
+	UpgradeSequence uint64 `protobuf:"varint,8,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty" yaml:"upgrade_sequence"`
+	// counterparty block height after which the upgrade proposed by the channel
+	// end times out. It is only set in the INITUPGRADE state.
+	UpgradeTimeoutHeight types.Height `protobuf:"bytes,9,opt,name=upgrade_timeout_height,json=upgradeTimeoutHeight,proto3" json:"upgrade_timeout_height" yaml:"upgrade_timeout_height"`
+	// counterparty block timestamp (in nanoseconds) after which the upgrade
+	// proposed by the channel end times out. It is only set in the INITUPGRADE
+	// state.
+	UpgradeTimeoutTimestamp uint64 `protobuf:"varint,10,opt,name=upgrade_timeout_timestamp,json=upgradeTimeoutTimestamp,proto3" json:"upgrade_timeout_timestamp,omitempty" yaml:"upgrade_timeout_timestamp"`
 }
 
 func (m *IdentifiedChannel) Reset()         { *m = IdentifiedChannel{} }
@@ -347,6 +376,110 @@ func (m *PacketState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PacketState proto.InternalMessageInfo
 
+// Upgrade defines the state stored for a channel end during an upgrade
+// handshake. The channel end is restored to its previous fields if the upgrade
+// times out or is cancelled.
+type Upgrade struct {
+	// channel port identifier.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// channel unique identifier.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// channel end before the upgrade handshake started.
+	RestoreChannel Channel `protobuf:"bytes,3,opt,name=restore_channel,json=restoreChannel,proto3" json:"restore_channel" yaml:"restore_channel"`
+}
+
+func (m *Upgrade) Reset()         { *m = Upgrade{} }
+func (m *Upgrade) String() string { return proto.CompactTextString(m) }
+func (*Upgrade) ProtoMessage()    {}
+func (*Upgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{5}
+}
+func (m *Upgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Upgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Upgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Upgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Upgrade.Merge(m, src)
+}
+func (m *Upgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Upgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Upgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Upgrade proto.InternalMessageInfo
+
+// ChannelUpgradeProposal is a governance proposal. If it passes, an upgrade
+// handshake of the channel to the proposed ordering, connection hops and
+// version is started. The upgrade is only completed if the application on both
+// channel ends accepts it before the timeout.
+type ChannelUpgradeProposal struct {
+	// the title of the upgrade proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the port identifier of the channel to be upgraded
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// the identifier of the channel to be upgraded
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// the proposed ordering of the channel
+	Ordering Order `protobuf:"varint,5,opt,name=ordering,proto3,enum=ibc.core.channel.v1.Order" json:"ordering,omitempty"`
+	// the proposed connection hops of the channel
+	ConnectionHops []string `protobuf:"bytes,6,rep,name=connection_hops,json=connectionHops,proto3" json:"connection_hops,omitempty" yaml:"connection_hops"`
+	// the proposed version of the channel
+	Version string `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	// counterparty block height after which the upgrade times out
+	TimeoutHeight types.Height `protobuf:"bytes,8,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height" yaml:"timeout_height"`
+	// counterparty block timestamp (in nanoseconds) after which the upgrade
+	// times out
+	TimeoutTimestamp uint64 `protobuf:"varint,9,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
+}
+
+func (m *ChannelUpgradeProposal) Reset()         { *m = ChannelUpgradeProposal{} }
+func (m *ChannelUpgradeProposal) String() string { return proto.CompactTextString(m) }
+func (*ChannelUpgradeProposal) ProtoMessage()    {}
+func (*ChannelUpgradeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{6}
+}
+func (m *ChannelUpgradeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelUpgradeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelUpgradeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelUpgradeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelUpgradeProposal.Merge(m, src)
+}
+func (m *ChannelUpgradeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelUpgradeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelUpgradeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelUpgradeProposal proto.InternalMessageInfo
+
 // Acknowledgement is the recommended acknowledgement format to be used by
 // app-specific protocols.
 // NOTE: The field numbers 21 and 22 were explicitly chosen to avoid accidental
@@ -367,7 +500,7 @@ func (m *Acknowledgement) Reset()         { *m = Acknowledgement{} }
 func (m *Acknowledgement) String() string { return proto.CompactTextString(m) }
 func (*Acknowledgement) ProtoMessage()    {}
 func (*Acknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3a07336710636a0, []int{5}
+	return fileDescriptor_c3a07336710636a0, []int{7}
 }
 func (m *Acknowledgement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,70 +582,87 @@ func init() {
 	proto.RegisterType((*Counterparty)(nil), "ibc.core.channel.v1.Counterparty")
 	proto.RegisterType((*Packet)(nil), "ibc.core.channel.v1.Packet")
 	proto.RegisterType((*PacketState)(nil), "ibc.core.channel.v1.PacketState")
+	proto.RegisterType((*Upgrade)(nil), "ibc.core.channel.v1.Upgrade")
+	proto.RegisterType((*ChannelUpgradeProposal)(nil), "ibc.core.channel.v1.ChannelUpgradeProposal")
 	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v1.Acknowledgement")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
 
 var fileDescriptor_c3a07336710636a0 = []byte{
-	// 904 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xcb, 0x6e, 0xdb, 0x46,
-	0x14, 0x15, 0x25, 0xea, 0x75, 0x65, 0xc9, 0xf2, 0xa4, 0x56, 0x58, 0x36, 0x11, 0x15, 0xa2, 0x0b,
-	0x23, 0x45, 0xa4, 0x38, 0x0d, 0xda, 0x22, 0xab, 0x5a, 0x8f, 0xc0, 0x44, 0x03, 0xc9, 0xa0, 0xe4,
-	0x45, 0xb3, 0x51, 0x65, 0x72, 0x2a, 0x11, 0x96, 0x38, 0x2a, 0x39, 0xb2, 0xeb, 0x3f, 0x08, 0xb4,
-	0xea, 0x0f, 0x08, 0x28, 0x50, 0xb4, 0xbf, 0xd0, 0x5f, 0xc8, 0x32, 0xcb, 0xae, 0x88, 0xc2, 0x5e,
-	0x74, 0xaf, 0x1f, 0x68, 0xc1, 0x99, 0xa1, 0x1e, 0x4e, 0xe0, 0x65, 0x57, 0x59, 0x71, 0xee, 0x39,
-	0xe7, 0x3e, 0x74, 0xef, 0xd5, 0x0c, 0x3c, 0x72, 0xce, 0xac, 0x9a, 0x45, 0x3c, 0x5c, 0xb3, 0x46,
-	0x03, 0xd7, 0xc5, 0xe3, 0xda, 0xc5, 0x61, 0x74, 0xac, 0x4e, 0x3d, 0x42, 0x09, 0xba, 0xe7, 0x9c,
-	0x59, 0xd5, 0x50, 0x52, 0x8d, 0xf0, 0x8b, 0x43, 0xf5, 0x93, 0x21, 0x19, 0x12, 0xc6, 0xd7, 0xc2,
-	0x13, 0x97, 0xaa, 0xda, 0x3a, 0xda, 0xd8, 0xc1, 0x2e, 0x65, 0xc1, 0xd8, 0x89, 0x0b, 0xf4, 0xdf,
-	0xe3, 0x90, 0x6e, 0xf0, 0x28, 0xe8, 0x29, 0x24, 0x7d, 0x3a, 0xa0, 0x58, 0x91, 0x2a, 0xd2, 0x41,
-	0xe1, 0x99, 0x5a, 0xfd, 0x40, 0x9e, 0x6a, 0x37, 0x54, 0x98, 0x5c, 0x88, 0xbe, 0x82, 0x0c, 0xf1,
-	0x6c, 0xec, 0x39, 0xee, 0x50, 0x89, 0xdf, 0xe1, 0xd4, 0x09, 0x45, 0xe6, 0x4a, 0x8b, 0xbe, 0x83,
-	0x1d, 0x8b, 0xcc, 0x5c, 0x8a, 0xbd, 0xe9, 0xc0, 0xa3, 0x57, 0x4a, 0xa2, 0x22, 0x1d, 0xe4, 0x9e,
-	0x3d, 0xfa, 0xa0, 0x6f, 0x63, 0x43, 0x58, 0x97, 0xdf, 0x06, 0x5a, 0xcc, 0xdc, 0x72, 0x46, 0x0d,
-	0xd8, 0xb5, 0x88, 0xeb, 0x62, 0x8b, 0x3a, 0xc4, 0xed, 0x8f, 0xc8, 0xd4, 0x57, 0xe4, 0x4a, 0xe2,
-	0x20, 0x5b, 0x57, 0x97, 0x81, 0x56, 0xba, 0x1a, 0x4c, 0xc6, 0x2f, 0xf4, 0x5b, 0x02, 0xdd, 0x2c,
-	0xac, 0x91, 0x63, 0x32, 0xf5, 0x91, 0x02, 0xe9, 0x0b, 0xec, 0xf9, 0x0e, 0x71, 0x95, 0x64, 0x45,
-	0x3a, 0xc8, 0x9a, 0x91, 0xf9, 0x42, 0x7e, 0xf3, 0xab, 0x16, 0xd3, 0xff, 0x89, 0xc3, 0x9e, 0x61,
-	0x63, 0x97, 0x3a, 0x3f, 0x3a, 0xd8, 0xfe, 0xd8, 0xb1, 0x3b, 0x3a, 0x86, 0xee, 0x43, 0x7a, 0x4a,
-	0x3c, 0xda, 0x77, 0x6c, 0x25, 0xc5, 0x98, 0x54, 0x68, 0x1a, 0x36, 0x7a, 0x08, 0x20, 0xca, 0x0c,
-	0xb9, 0x34, 0xe3, 0xb2, 0x02, 0x31, 0x6c, 0xd1, 0xe9, 0x4b, 0xd8, 0xd9, 0xfc, 0x01, 0xe8, 0x8b,
-	0x75, 0xb4, 0xb0, 0xcb, 0xd9, 0x3a, 0x5a, 0x06, 0x5a, 0x81, 0x17, 0x29, 0x08, 0x7d, 0x95, 0xe1,
-	0xf9, 0x56, 0x86, 0x38, 0xd3, 0xef, 0x2f, 0x03, 0x6d, 0x4f, 0xfc, 0xa8, 0x15, 0xa7, 0xbf, 0x9f,
-	0xf8, 0xdf, 0x04, 0xa4, 0x4e, 0x06, 0xd6, 0x39, 0xa6, 0x48, 0x85, 0x8c, 0x8f, 0x7f, 0x9a, 0x61,
-	0xd7, 0xe2, 0xa3, 0x95, 0xcd, 0x95, 0x8d, 0xbe, 0x86, 0x9c, 0x4f, 0x66, 0x9e, 0x85, 0xfb, 0x61,
-	0x4e, 0x91, 0xa3, 0xb4, 0x0c, 0x34, 0xc4, 0x73, 0x6c, 0x90, 0xba, 0x09, 0xdc, 0x3a, 0x21, 0x1e,
-	0x45, 0xdf, 0x42, 0x41, 0x70, 0x22, 0x33, 0x1b, 0x62, 0xb6, 0xfe, 0xe9, 0x32, 0xd0, 0xf6, 0xb7,
-	0x7c, 0x05, 0xaf, 0x9b, 0x79, 0x0e, 0x44, 0xeb, 0xf6, 0x12, 0x8a, 0x36, 0xf6, 0xa9, 0xe3, 0x0e,
-	0xd8, 0x5c, 0x58, 0x7e, 0x99, 0xc5, 0xf8, 0x6c, 0x19, 0x68, 0xf7, 0x79, 0x8c, 0xdb, 0x0a, 0xdd,
-	0xdc, 0xdd, 0x80, 0x58, 0x25, 0x1d, 0xb8, 0xb7, 0xa9, 0x8a, 0xca, 0x61, 0x63, 0xac, 0x97, 0x97,
-	0x81, 0xa6, 0xbe, 0x1f, 0x6a, 0x55, 0x13, 0xda, 0x40, 0xa3, 0xc2, 0x10, 0xc8, 0xf6, 0x80, 0x0e,
-	0xd8, 0xb8, 0x77, 0x4c, 0x76, 0x46, 0x3f, 0x40, 0x81, 0x3a, 0x13, 0x4c, 0x66, 0xb4, 0x3f, 0xc2,
-	0xce, 0x70, 0x44, 0xd9, 0xc0, 0x73, 0x5b, 0xfb, 0xce, 0x6f, 0xa2, 0x8b, 0xc3, 0xea, 0x31, 0x53,
-	0xd4, 0x1f, 0x86, 0xcb, 0xba, 0x6e, 0xc7, 0xb6, 0xbf, 0x6e, 0xe6, 0x05, 0xc0, 0xd5, 0xc8, 0x80,
-	0xbd, 0x48, 0x11, 0x7e, 0x7d, 0x3a, 0x98, 0x4c, 0x95, 0x4c, 0x38, 0xae, 0xfa, 0x83, 0x65, 0xa0,
-	0x29, 0xdb, 0x41, 0x56, 0x12, 0xdd, 0x2c, 0x0a, 0xac, 0x17, 0x41, 0x62, 0x03, 0xfe, 0x90, 0x20,
-	0xc7, 0x37, 0x80, 0xfd, 0x67, 0xff, 0x87, 0xd5, 0xdb, 0xda, 0xb4, 0xc4, 0xad, 0x4d, 0x8b, 0xba,
-	0x2a, 0xaf, 0xbb, 0x2a, 0x0a, 0xed, 0xc0, 0xee, 0x91, 0x75, 0xee, 0x92, 0xcb, 0x31, 0xb6, 0x87,
-	0x78, 0x82, 0x5d, 0x8a, 0x14, 0x48, 0x79, 0xd8, 0x9f, 0x8d, 0xa9, 0xb2, 0x1f, 0xca, 0x8f, 0x63,
-	0xa6, 0xb0, 0x51, 0x09, 0x92, 0xd8, 0xf3, 0x88, 0xa7, 0x94, 0xc2, 0x9a, 0x8e, 0x63, 0x26, 0x37,
-	0xeb, 0x00, 0x19, 0x0f, 0xfb, 0x53, 0xe2, 0xfa, 0xf8, 0xf1, 0x9f, 0x12, 0x24, 0xbb, 0xe2, 0x82,
-	0xd2, 0xba, 0xbd, 0xa3, 0x5e, 0xab, 0x7f, 0xda, 0x36, 0xda, 0x46, 0xcf, 0x38, 0x7a, 0x65, 0xbc,
-	0x6e, 0x35, 0xfb, 0xa7, 0xed, 0xee, 0x49, 0xab, 0x61, 0xbc, 0x34, 0x5a, 0xcd, 0x62, 0x4c, 0xdd,
-	0x9b, 0x2f, 0x2a, 0xf9, 0x2d, 0x01, 0x52, 0x00, 0xb8, 0x5f, 0x08, 0x16, 0x25, 0x35, 0x33, 0x5f,
-	0x54, 0xe4, 0xf0, 0x8c, 0xca, 0x90, 0xe7, 0x4c, 0xcf, 0xfc, 0xbe, 0x73, 0xd2, 0x6a, 0x17, 0xe3,
-	0x6a, 0x6e, 0xbe, 0xa8, 0xa4, 0x85, 0xb9, 0xf6, 0x64, 0x64, 0x82, 0x7b, 0x32, 0xe6, 0x01, 0xec,
-	0x70, 0xa6, 0xf1, 0xaa, 0xd3, 0x6d, 0x35, 0x8b, 0xb2, 0x0a, 0xf3, 0x45, 0x25, 0xc5, 0x2d, 0x55,
-	0x7e, 0xf3, 0x5b, 0x39, 0xf6, 0xf8, 0x12, 0x92, 0xec, 0xae, 0x44, 0x9f, 0x43, 0xa9, 0x63, 0x36,
-	0x5b, 0x66, 0xbf, 0xdd, 0x69, 0xb7, 0x6e, 0xd5, 0xcb, 0x42, 0x86, 0x38, 0xd2, 0x61, 0x97, 0xab,
-	0x4e, 0xdb, 0xec, 0xdb, 0x6a, 0x16, 0x25, 0x35, 0x3f, 0x5f, 0x54, 0xb2, 0x2b, 0x20, 0x2c, 0x98,
-	0x6b, 0x22, 0x85, 0x28, 0x58, 0x98, 0x3c, 0x71, 0xdd, 0x7c, 0x7b, 0x5d, 0x96, 0xde, 0x5d, 0x97,
-	0xa5, 0xbf, 0xaf, 0xcb, 0xd2, 0x2f, 0x37, 0xe5, 0xd8, 0xbb, 0x9b, 0x72, 0xec, 0xaf, 0x9b, 0x72,
-	0xec, 0xf5, 0x37, 0x43, 0x87, 0x8e, 0x66, 0x67, 0x55, 0x8b, 0x4c, 0x6a, 0x16, 0xf1, 0x27, 0xc4,
-	0x17, 0x9f, 0x27, 0xbe, 0x7d, 0x5e, 0xfb, 0xb9, 0xb6, 0x7a, 0x93, 0x9f, 0x3e, 0x7f, 0x12, 0x3d,
-	0xf2, 0xf4, 0x6a, 0x8a, 0xfd, 0xb3, 0x14, 0x7b, 0x94, 0xbf, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff,
-	0xd7, 0x33, 0x69, 0x35, 0x05, 0x08, 0x00, 0x00,
+	// 1150 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4f, 0x6f, 0x13, 0x47,
+	0x14, 0xb7, 0xe3, 0xf5, 0xbf, 0xe7, 0xc4, 0x76, 0x06, 0x30, 0xcb, 0x16, 0xbc, 0xcb, 0x8a, 0x56,
+	0x11, 0x15, 0x36, 0x50, 0xd4, 0x56, 0x9c, 0x1a, 0x27, 0xa6, 0xb1, 0x8a, 0xec, 0x68, 0x92, 0x1c,
+	0xca, 0xc5, 0x38, 0xbb, 0x53, 0x67, 0x85, 0xb3, 0xe3, 0xee, 0x8e, 0x93, 0xf2, 0x01, 0x2a, 0x21,
+	0x9f, 0xfa, 0x05, 0x2c, 0x55, 0xaa, 0xd4, 0xcf, 0xc2, 0x91, 0x63, 0x7b, 0xb1, 0x2a, 0xf8, 0x06,
+	0xbe, 0x57, 0xad, 0x76, 0x66, 0xd6, 0xde, 0x35, 0x2e, 0x2a, 0x02, 0xd1, 0x4b, 0x4f, 0x9e, 0xf7,
+	0x7b, 0xbf, 0xf7, 0xde, 0xbc, 0x99, 0xdf, 0xcc, 0x78, 0xe1, 0xba, 0x73, 0x6c, 0xd5, 0x2d, 0xea,
+	0x91, 0xba, 0x75, 0xd2, 0x73, 0x5d, 0x32, 0xa8, 0x9f, 0xdd, 0x09, 0x87, 0xb5, 0xa1, 0x47, 0x19,
+	0x45, 0x17, 0x9c, 0x63, 0xab, 0x16, 0x50, 0x6a, 0x21, 0x7e, 0x76, 0x47, 0xbb, 0xd8, 0xa7, 0x7d,
+	0xca, 0xfd, 0xf5, 0x60, 0x24, 0xa8, 0x9a, 0xbe, 0xc8, 0x36, 0x70, 0x88, 0xcb, 0x78, 0x32, 0x3e,
+	0x12, 0x04, 0xf3, 0xb9, 0x02, 0xd9, 0x1d, 0x91, 0x05, 0xdd, 0x86, 0xb4, 0xcf, 0x7a, 0x8c, 0xa8,
+	0x49, 0x23, 0xb9, 0x55, 0xbc, 0xab, 0xd5, 0x56, 0xd4, 0xa9, 0x1d, 0x04, 0x0c, 0x2c, 0x88, 0xe8,
+	0x73, 0xc8, 0x51, 0xcf, 0x26, 0x9e, 0xe3, 0xf6, 0xd5, 0xb5, 0x37, 0x04, 0x75, 0x02, 0x12, 0x9e,
+	0x73, 0xd1, 0x37, 0xb0, 0x6e, 0xd1, 0x91, 0xcb, 0x88, 0x37, 0xec, 0x79, 0xec, 0xa9, 0x9a, 0x32,
+	0x92, 0x5b, 0x85, 0xbb, 0xd7, 0x57, 0xc6, 0xee, 0x44, 0x88, 0x0d, 0xe5, 0xf9, 0x54, 0x4f, 0xe0,
+	0x58, 0x30, 0xda, 0x81, 0x92, 0x45, 0x5d, 0x97, 0x58, 0xcc, 0xa1, 0x6e, 0xf7, 0x84, 0x0e, 0x7d,
+	0x55, 0x31, 0x52, 0x5b, 0xf9, 0x86, 0x36, 0x9b, 0xea, 0x95, 0xa7, 0xbd, 0xd3, 0xc1, 0x7d, 0x73,
+	0x89, 0x60, 0xe2, 0xe2, 0x02, 0xd9, 0xa3, 0x43, 0x1f, 0xa9, 0x90, 0x3d, 0x23, 0x9e, 0xef, 0x50,
+	0x57, 0x4d, 0x1b, 0xc9, 0xad, 0x3c, 0x0e, 0x4d, 0xf4, 0x00, 0xca, 0xa3, 0x61, 0xdf, 0xeb, 0xd9,
+	0xa4, 0xeb, 0x93, 0xef, 0x47, 0xc4, 0xb5, 0x88, 0x9a, 0x31, 0x92, 0x5b, 0x4a, 0xe3, 0xa3, 0xd9,
+	0x54, 0xbf, 0x2c, 0xf2, 0x2f, 0x33, 0x4c, 0x5c, 0x92, 0xd0, 0x81, 0x44, 0xd0, 0x39, 0x54, 0x42,
+	0x16, 0x73, 0x4e, 0x09, 0x1d, 0xb1, 0xee, 0x09, 0x71, 0xfa, 0x27, 0x4c, 0xcd, 0xf2, 0xee, 0xa3,
+	0x2b, 0x27, 0x76, 0xe8, 0xec, 0x4e, 0x6d, 0x8f, 0x33, 0x1a, 0x1f, 0x07, 0x6d, 0xcf, 0xa6, 0xfa,
+	0xb5, 0x78, 0xb5, 0x78, 0x1e, 0x13, 0x5f, 0x94, 0x8e, 0x43, 0x81, 0x8b, 0x60, 0xf4, 0x18, 0xae,
+	0x2c, 0x07, 0x04, 0xbf, 0x3e, 0xeb, 0x9d, 0x0e, 0xd5, 0x1c, 0xef, 0xe4, 0xc6, 0x6c, 0xaa, 0x1b,
+	0xab, 0x73, 0xcf, 0xa9, 0x26, 0xbe, 0x1c, 0x4f, 0x7f, 0x18, 0x7a, 0xee, 0x2b, 0xcf, 0x7e, 0xd6,
+	0x13, 0xe6, 0x38, 0x0d, 0x9b, 0x2d, 0x9b, 0xb8, 0xcc, 0xf9, 0xce, 0x21, 0xf6, 0xff, 0xa2, 0x7a,
+	0x93, 0xa8, 0x2e, 0x43, 0x76, 0x48, 0x3d, 0xd6, 0x75, 0x6c, 0xae, 0xa5, 0x3c, 0xce, 0x04, 0x66,
+	0xcb, 0x46, 0xd7, 0x00, 0xe4, 0x34, 0x03, 0x5f, 0x96, 0xfb, 0xf2, 0x12, 0x69, 0xd9, 0x2b, 0xc5,
+	0x98, 0x7b, 0xaf, 0x62, 0xcc, 0xff, 0x87, 0x62, 0x84, 0xf7, 0x27, 0xc6, 0x73, 0x58, 0x8f, 0xee,
+	0x31, 0xfa, 0x74, 0xb1, 0xe0, 0x81, 0x10, 0xf3, 0x0d, 0x34, 0x9b, 0xea, 0x45, 0x51, 0x45, 0x3a,
+	0xcc, 0xf9, 0x26, 0xdc, 0x8b, 0x6d, 0xc2, 0x1a, 0xe7, 0x5f, 0x9a, 0x4d, 0xf5, 0x4d, 0xb9, 0xef,
+	0x73, 0x9f, 0x19, 0xd9, 0x1b, 0x59, 0xf8, 0xaf, 0x14, 0x64, 0xf6, 0x7b, 0xd6, 0x13, 0xc2, 0x90,
+	0x06, 0xb9, 0xf9, 0x26, 0x05, 0x45, 0x15, 0x3c, 0xb7, 0xd1, 0x17, 0x50, 0xf0, 0xe9, 0xc8, 0xb3,
+	0x48, 0x37, 0xa8, 0x29, 0x6b, 0x54, 0x66, 0x53, 0x1d, 0x89, 0x1a, 0x11, 0xa7, 0x89, 0x41, 0x58,
+	0xfb, 0xd4, 0x63, 0xe8, 0x2b, 0x28, 0x4a, 0x9f, 0xac, 0xcc, 0x75, 0x9e, 0x6f, 0x5c, 0x99, 0x4d,
+	0xf5, 0x4b, 0xb1, 0x58, 0xe9, 0x37, 0xf1, 0x86, 0x00, 0xc2, 0x13, 0xf9, 0x00, 0xca, 0x36, 0xf1,
+	0x99, 0xe3, 0xf6, 0xb8, 0x74, 0x79, 0x7d, 0x85, 0xe7, 0x88, 0x68, 0x68, 0x99, 0x61, 0xe2, 0x52,
+	0x04, 0xe2, 0x33, 0xe9, 0xc0, 0x85, 0x28, 0x2b, 0x9c, 0x0e, 0x57, 0x7a, 0xa3, 0x3a, 0x9b, 0xea,
+	0xda, 0xeb, 0xa9, 0xe6, 0x73, 0x42, 0x11, 0x34, 0x9c, 0x18, 0x02, 0xc5, 0xee, 0xb1, 0x1e, 0x3f,
+	0x11, 0xeb, 0x98, 0x8f, 0xd1, 0x63, 0x28, 0xbe, 0xf5, 0x6d, 0x79, 0x4d, 0x0a, 0x54, 0x2e, 0xc7,
+	0xb2, 0x30, 0x37, 0x58, 0x4c, 0x91, 0x2d, 0xd8, 0xfc, 0xa7, 0x6b, 0xf1, 0xea, 0x6c, 0xaa, 0xab,
+	0xf1, 0x24, 0x11, 0x05, 0x96, 0xd9, 0x6a, 0xe9, 0xfd, 0x9a, 0x84, 0x82, 0x50, 0x00, 0xbf, 0xd6,
+	0x3e, 0x80, 0xf4, 0x62, 0x4a, 0x4b, 0x2d, 0x29, 0x2d, 0x5c, 0x55, 0x65, 0xb1, 0xaa, 0x72, 0xa2,
+	0xbf, 0x27, 0x21, 0x7b, 0x24, 0x4e, 0xd1, 0x87, 0x98, 0x24, 0x81, 0x92, 0x47, 0x7c, 0x46, 0xbd,
+	0xb8, 0x74, 0x0b, 0x77, 0xaf, 0xae, 0xbe, 0xa2, 0xc5, 0xb0, 0x51, 0x95, 0xbb, 0x29, 0x2f, 0xdd,
+	0xa5, 0x14, 0x26, 0x2e, 0x4a, 0x44, 0xf2, 0x65, 0x6f, 0x7f, 0xa6, 0xa0, 0x22, 0x11, 0xd9, 0xe2,
+	0xbe, 0x47, 0x87, 0xd4, 0xef, 0x0d, 0xd0, 0x45, 0x48, 0x33, 0x87, 0x0d, 0xc4, 0x99, 0xcc, 0x63,
+	0x61, 0x20, 0x03, 0x0a, 0x36, 0xf1, 0x2d, 0xcf, 0x19, 0x06, 0x92, 0x14, 0x4d, 0xe1, 0x28, 0x14,
+	0x5d, 0xa2, 0xd4, 0x5b, 0x2e, 0x91, 0xf2, 0x2f, 0x97, 0x28, 0xfa, 0xf4, 0xa5, 0xdf, 0xe2, 0xe9,
+	0x5b, 0xf1, 0x5a, 0x65, 0xde, 0xe5, 0xb5, 0xca, 0xc6, 0x5f, 0xab, 0xd7, 0x0f, 0x61, 0xee, 0x43,
+	0x1c, 0xc2, 0xfc, 0x3b, 0x1c, 0xc2, 0x0e, 0x94, 0xb6, 0xad, 0x27, 0x2e, 0x3d, 0x1f, 0x10, 0xbb,
+	0x4f, 0x4e, 0x89, 0xcb, 0x90, 0x0a, 0x19, 0x8f, 0xf8, 0xa3, 0x01, 0x53, 0x2f, 0x05, 0x47, 0x61,
+	0x2f, 0x81, 0xa5, 0x8d, 0x2a, 0x90, 0x26, 0x9e, 0x47, 0x3d, 0xb5, 0x12, 0xf4, 0xbd, 0x97, 0xc0,
+	0xc2, 0x6c, 0x00, 0xe4, 0x3c, 0xe2, 0x0f, 0xa9, 0xeb, 0x93, 0x9b, 0x3f, 0xae, 0x41, 0xfa, 0x40,
+	0xfe, 0x3f, 0xd1, 0x0f, 0x0e, 0xb7, 0x0f, 0x9b, 0xdd, 0xa3, 0x76, 0xab, 0xdd, 0x3a, 0x6c, 0x6d,
+	0x3f, 0x6c, 0x3d, 0x6a, 0xee, 0x76, 0x8f, 0xda, 0x07, 0xfb, 0xcd, 0x9d, 0xd6, 0x83, 0x56, 0x73,
+	0xb7, 0x9c, 0xd0, 0x36, 0xc7, 0x13, 0x63, 0x23, 0x46, 0x40, 0x2a, 0x80, 0x88, 0x0b, 0xc0, 0x72,
+	0x52, 0xcb, 0x8d, 0x27, 0x86, 0x12, 0x8c, 0x51, 0x15, 0x36, 0x84, 0xe7, 0x10, 0x7f, 0xdb, 0xd9,
+	0x6f, 0xb6, 0xcb, 0x6b, 0x5a, 0x61, 0x3c, 0x31, 0xb2, 0xd2, 0x5c, 0x44, 0x72, 0x67, 0x4a, 0x44,
+	0x72, 0xcf, 0x55, 0x58, 0x17, 0x9e, 0x9d, 0x87, 0x9d, 0x83, 0xe6, 0x6e, 0x59, 0xd1, 0x60, 0x3c,
+	0x31, 0x32, 0xc2, 0x42, 0x9f, 0xc0, 0xe6, 0xa2, 0xe2, 0xd1, 0xfe, 0xd7, 0x78, 0x7b, 0xb7, 0x59,
+	0x4e, 0x6b, 0xa5, 0xf1, 0xc4, 0x28, 0x44, 0x20, 0x74, 0x03, 0xca, 0xf3, 0xfa, 0x21, 0x2d, 0xa3,
+	0x15, 0xc7, 0x13, 0x03, 0x16, 0x88, 0xa6, 0x3c, 0xfb, 0xa5, 0x9a, 0xb8, 0x79, 0x0e, 0x69, 0xae,
+	0x3e, 0x74, 0x03, 0x2a, 0x1d, 0xbc, 0xdb, 0xc4, 0xdd, 0x76, 0xa7, 0xdd, 0x5c, 0xea, 0x9e, 0x4f,
+	0x30, 0xc0, 0x91, 0x09, 0x25, 0xc1, 0x3a, 0x6a, 0xf3, 0xdf, 0xe6, 0x6e, 0x39, 0xa9, 0x6d, 0x8c,
+	0x27, 0x46, 0x7e, 0x0e, 0x04, 0xed, 0x0b, 0x4e, 0xc8, 0x90, 0xed, 0x4b, 0x53, 0x14, 0x6e, 0xe0,
+	0xe7, 0x2f, 0xab, 0xc9, 0x17, 0x2f, 0xab, 0xc9, 0x3f, 0x5e, 0x56, 0x93, 0x3f, 0xbd, 0xaa, 0x26,
+	0x5e, 0xbc, 0xaa, 0x26, 0x7e, 0x7b, 0x55, 0x4d, 0x3c, 0xfa, 0xb2, 0xef, 0xb0, 0x93, 0xd1, 0x71,
+	0xcd, 0xa2, 0xa7, 0x75, 0x8b, 0xfa, 0xa7, 0xd4, 0x97, 0x3f, 0xb7, 0x7c, 0xfb, 0x49, 0xfd, 0x87,
+	0xfa, 0xfc, 0x1b, 0xe8, 0xf6, 0xbd, 0x5b, 0xe1, 0x47, 0x15, 0x7b, 0x3a, 0x24, 0xfe, 0x71, 0x86,
+	0x7f, 0x04, 0x7d, 0xf6, 0xf7, 0x00, 0x0d, 0x21, 0xf6, 0x5b, 0x75, 0x0d, 0x00, 0x00,
 }
 
 func (m *Channel) Marshal() (dAtA []byte, err error) {
@@ -535,6 +685,26 @@ func (m *Channel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpgradeTimeoutTimestamp != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.UpgradeTimeoutTimestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.UpgradeTimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Version) > 0 {
 		i -= len(m.Version)
 		copy(dAtA[i:], m.Version)
@@ -594,6 +764,26 @@ func (m *IdentifiedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpgradeTimeoutTimestamp != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.UpgradeTimeoutTimestamp))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.UpgradeTimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.ChannelId) > 0 {
 		i -= len(m.ChannelId)
 		copy(dAtA[i:], m.ChannelId)
@@ -811,6 +1001,140 @@ func (m *PacketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Upgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Upgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Upgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RestoreChannel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelUpgradeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelUpgradeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ConnectionHops) > 0 {
+		for iNdEx := len(m.ConnectionHops) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConnectionHops[iNdEx])
+			copy(dAtA[i:], m.ConnectionHops[iNdEx])
+			i = encodeVarintChannel(dAtA, i, uint64(len(m.ConnectionHops[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Ordering != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Ordering))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Acknowledgement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -912,6 +1236,14 @@ func (m *Channel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovChannel(uint64(l))
 	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovChannel(uint64(m.UpgradeSequence))
+	}
+	l = m.UpgradeTimeoutHeight.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if m.UpgradeTimeoutTimestamp != 0 {
+		n += 1 + sovChannel(uint64(m.UpgradeTimeoutTimestamp))
+	}
 	return n
 }
 
@@ -947,6 +1279,14 @@ func (m *IdentifiedChannel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovChannel(uint64(l))
 	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovChannel(uint64(m.UpgradeSequence))
+	}
+	l = m.UpgradeTimeoutHeight.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if m.UpgradeTimeoutTimestamp != 0 {
+		n += 1 + sovChannel(uint64(m.UpgradeTimeoutTimestamp))
+	}
 	return n
 }
 
@@ -1028,6 +1368,68 @@ func (m *PacketState) Size() (n int) {
 	return n
 }
 
+func (m *Upgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = m.RestoreChannel.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	return n
+}
+
+func (m *ChannelUpgradeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.Ordering != 0 {
+		n += 1 + sovChannel(uint64(m.Ordering))
+	}
+	if len(m.ConnectionHops) > 0 {
+		for _, s := range m.ConnectionHops {
+			l = len(s)
+			n += 1 + l + sovChannel(uint64(l))
+		}
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovChannel(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
 func (m *Acknowledgement) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1233,14 +1635,85 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipChannel(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthChannel
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeTimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpgradeTimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeTimeoutTimestamp", wireType)
+			}
+			m.UpgradeTimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeTimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthChannel
 			}
 			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthChannel
@@ -1485,6 +1958,77 @@ func (m *IdentifiedChannel) Unmarshal(dAtA []byte) error {
 			}
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeTimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpgradeTimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeTimeoutTimestamp", wireType)
+			}
+			m.UpgradeTimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeTimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
@@ -2082,6 +2626,472 @@ func (m *PacketState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Upgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Upgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Upgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoreChannel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RestoreChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelUpgradeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelUpgradeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelUpgradeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			m.Ordering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ordering |= Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionHops", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionHops = append(m.ConnectionHops, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
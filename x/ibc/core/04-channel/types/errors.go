@@ -25,4 +25,8 @@ var (
 	ErrPacketReceived            = sdkerrors.Register(SubModuleName, 18, "packet already received")
 	ErrAcknowledgementExists     = sdkerrors.Register(SubModuleName, 19, "acknowledgement for packet already exists")
 	ErrInvalidChannelIdentifier  = sdkerrors.Register(SubModuleName, 20, "invalid channel identifier")
+	ErrInvalidUpgrade            = sdkerrors.Register(SubModuleName, 21, "invalid channel upgrade")
+	ErrUpgradeNotFound           = sdkerrors.Register(SubModuleName, 22, "channel upgrade not found")
+	ErrUpgradeTimeoutNotReached  = sdkerrors.Register(SubModuleName, 23, "channel upgrade timeout not reached")
+	ErrUpgradeTimeout            = sdkerrors.Register(SubModuleName, 24, "channel upgrade timeout")
 )
@@ -13,6 +13,9 @@ const (
 	AttributeKeyChannelID          = "channel_id"
 	AttributeCounterpartyPortID    = "counterparty_port_id"
 	AttributeCounterpartyChannelID = "counterparty_channel_id"
+	AttributeKeyUpgradeSequence    = "upgrade_sequence"
+	AttributeKeyUpgradeOrdering    = "upgrade_ordering"
+	AttributeKeyUpgradeVersion     = "upgrade_version"
 
 	EventTypeSendPacket        = "send_packet"
 	EventTypeRecvPacket        = "recv_packet"
@@ -34,12 +37,18 @@ const (
 
 // IBC channel events vars
 var (
-	EventTypeChannelOpenInit     = MsgChannelOpenInit{}.Type()
-	EventTypeChannelOpenTry      = MsgChannelOpenTry{}.Type()
-	EventTypeChannelOpenAck      = MsgChannelOpenAck{}.Type()
-	EventTypeChannelOpenConfirm  = MsgChannelOpenConfirm{}.Type()
-	EventTypeChannelCloseInit    = MsgChannelCloseInit{}.Type()
-	EventTypeChannelCloseConfirm = MsgChannelCloseConfirm{}.Type()
+	EventTypeChannelOpenInit       = MsgChannelOpenInit{}.Type()
+	EventTypeChannelOpenTry        = MsgChannelOpenTry{}.Type()
+	EventTypeChannelOpenAck        = MsgChannelOpenAck{}.Type()
+	EventTypeChannelOpenConfirm    = MsgChannelOpenConfirm{}.Type()
+	EventTypeChannelCloseInit      = MsgChannelCloseInit{}.Type()
+	EventTypeChannelCloseConfirm   = MsgChannelCloseConfirm{}.Type()
+	EventTypeChannelUpgradeInit    = "channel_upgrade_init"
+	EventTypeChannelUpgradeTry     = MsgChannelUpgradeTry{}.Type()
+	EventTypeChannelUpgradeAck     = MsgChannelUpgradeAck{}.Type()
+	EventTypeChannelUpgradeConfirm = MsgChannelUpgradeConfirm{}.Type()
+	EventTypeChannelUpgradeTimeout = MsgChannelUpgradeTimeout{}.Type()
+	EventTypeChannelUpgradeCancel  = MsgChannelUpgradeCancel{}.Type()
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", host.ModuleName, SubModuleName)
 )
@@ -44,7 +44,7 @@ func (ps PacketSequence) Validate() error {
 // NewGenesisState creates a GenesisState instance.
 func NewGenesisState(
 	channels []IdentifiedChannel, acks, receipts, commitments []PacketState,
-	sendSeqs, recvSeqs, ackSeqs []PacketSequence,
+	sendSeqs, recvSeqs, ackSeqs []PacketSequence, upgrades []Upgrade,
 ) GenesisState {
 	return GenesisState{
 		Channels:         channels,
@@ -53,6 +53,7 @@ func NewGenesisState(
 		SendSequences:    sendSeqs,
 		RecvSequences:    recvSeqs,
 		AckSequences:     ackSeqs,
+		Upgrades:         upgrades,
 	}
 }
 
@@ -66,6 +67,7 @@ func DefaultGenesisState() GenesisState {
 		SendSequences:    []PacketSequence{},
 		RecvSequences:    []PacketSequence{},
 		AckSequences:     []PacketSequence{},
+		Upgrades:         []Upgrade{},
 	}
 }
 
@@ -120,6 +122,12 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, upgrade := range gs.Upgrades {
+		if err := upgrade.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid upgrade %v index %d: %w", upgrade, i, err)
+		}
+	}
+
 	return nil
 }
 
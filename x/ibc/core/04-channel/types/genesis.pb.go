@@ -32,6 +32,7 @@ type GenesisState struct {
 	SendSequences    []PacketSequence    `protobuf:"bytes,5,rep,name=send_sequences,json=sendSequences,proto3" json:"send_sequences" yaml:"send_sequences"`
 	RecvSequences    []PacketSequence    `protobuf:"bytes,6,rep,name=recv_sequences,json=recvSequences,proto3" json:"recv_sequences" yaml:"recv_sequences"`
 	AckSequences     []PacketSequence    `protobuf:"bytes,7,rep,name=ack_sequences,json=ackSequences,proto3" json:"ack_sequences" yaml:"ack_sequences"`
+	Upgrades         []Upgrade           `protobuf:"bytes,8,rep,name=upgrades,proto3" json:"upgrades"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -116,6 +117,13 @@ func (m *GenesisState) GetAckSequences() []PacketSequence {
 	return nil
 }
 
+func (m *GenesisState) GetUpgrades() []Upgrade {
+	if m != nil {
+		return m.Upgrades
+	}
+	return nil
+}
+
 // PacketSequence defines the genesis type necessary to retrieve and store
 // next send and receive sequences.
 type PacketSequence struct {
@@ -186,37 +194,38 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v1/genesis.proto", fileDescriptor_cb06ec201f452595) }
 
 var fileDescriptor_cb06ec201f452595 = []byte{
-	// 471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xe3, 0x26, 0x4d, 0xd3, 0x6b, 0x13, 0xd1, 0xa3, 0x95, 0x4c, 0x04, 0x76, 0x30, 0x12,
-	0x8a, 0x84, 0x6a, 0x53, 0xe8, 0x80, 0x18, 0xcd, 0x00, 0xd9, 0xd0, 0xb1, 0x21, 0xa1, 0xca, 0x39,
-	0xbf, 0xba, 0x27, 0xc7, 0xbe, 0xe0, 0xbb, 0x06, 0xfa, 0x21, 0x10, 0x7c, 0xac, 0x8e, 0x1d, 0x99,
-	0x2c, 0x94, 0x7c, 0x83, 0x8c, 0x4c, 0xc8, 0xe7, 0x8b, 0x9b, 0xa8, 0x11, 0x22, 0x4c, 0xf6, 0xbd,
-	0xf7, 0x7f, 0xbf, 0xdf, 0x5b, 0x1e, 0x7a, 0xcc, 0x86, 0xd4, 0xa3, 0x3c, 0x03, 0x8f, 0x5e, 0x04,
-	0x69, 0x0a, 0x23, 0x6f, 0x72, 0xe2, 0x45, 0x90, 0x82, 0x60, 0xc2, 0x1d, 0x67, 0x5c, 0x72, 0x7c,
-	0x9f, 0x0d, 0xa9, 0x5b, 0x44, 0x5c, 0x1d, 0x71, 0x27, 0x27, 0xdd, 0xc3, 0x88, 0x47, 0x5c, 0xf5,
-	0xbd, 0xe2, 0xaf, 0x8c, 0x76, 0xd7, 0xd2, 0x16, 0x53, 0x2a, 0xe2, 0x7c, 0xdb, 0x46, 0xfb, 0x6f,
-	0x4b, 0xfe, 0x07, 0x19, 0x48, 0xc0, 0x9f, 0x50, 0x4b, 0x27, 0x84, 0x69, 0xf4, 0xea, 0xfd, 0xbd,
-	0x17, 0x4f, 0xdd, 0x35, 0x46, 0x77, 0x10, 0x42, 0x2a, 0xd9, 0x39, 0x83, 0xf0, 0x4d, 0x59, 0xf4,
-	0x1f, 0x5c, 0xe7, 0x76, 0xed, 0x77, 0x6e, 0x1f, 0xdc, 0x69, 0x91, 0x0a, 0x89, 0x09, 0xba, 0x17,
-	0xd0, 0x38, 0xe5, 0x5f, 0x46, 0x10, 0x46, 0x90, 0x40, 0x2a, 0x85, 0xb9, 0xa5, 0x34, 0xbd, 0xb5,
-	0x9a, 0xf7, 0x01, 0x8d, 0x41, 0xaa, 0xd5, 0xfc, 0x46, 0x21, 0x20, 0x77, 0xe6, 0xf1, 0x3b, 0xb4,
-	0x47, 0x79, 0x92, 0x30, 0x59, 0xe2, 0xea, 0x1b, 0xe1, 0x96, 0x47, 0xb1, 0x8f, 0x5a, 0x19, 0x50,
-	0x60, 0x63, 0x29, 0xcc, 0xc6, 0x46, 0x98, 0x6a, 0x0e, 0x33, 0xd4, 0x11, 0x90, 0x86, 0x67, 0x02,
-	0x3e, 0x5f, 0x42, 0x4a, 0x41, 0x98, 0xdb, 0x8a, 0xf4, 0xe4, 0x6f, 0x24, 0x9d, 0xf5, 0x1f, 0x15,
-	0xb0, 0x79, 0x6e, 0x1f, 0x5d, 0x05, 0xc9, 0xe8, 0xb5, 0xb3, 0x0a, 0x72, 0x48, 0xbb, 0x28, 0x2c,
-	0xc2, 0x4a, 0x95, 0x01, 0x9d, 0x2c, 0xa9, 0x9a, 0xff, 0xad, 0x5a, 0x05, 0x39, 0xa4, 0x5d, 0x14,
-	0x6e, 0x55, 0xe7, 0xa8, 0x1d, 0xd0, 0x78, 0xc9, 0xb4, 0xf3, 0xef, 0xa6, 0x87, 0xda, 0x74, 0x58,
-	0x9a, 0x56, 0x38, 0x0e, 0xd9, 0x0f, 0x68, 0x5c, 0x79, 0x9c, 0xef, 0x06, 0xea, 0xac, 0x8e, 0xe3,
-	0x67, 0x68, 0x67, 0xcc, 0x33, 0x79, 0xc6, 0x42, 0xd3, 0xe8, 0x19, 0xfd, 0x5d, 0x1f, 0xcf, 0x73,
-	0xbb, 0x53, 0xb2, 0x74, 0xc3, 0x21, 0xcd, 0xe2, 0x6f, 0x10, 0xe2, 0x53, 0x84, 0xf4, 0x22, 0x45,
-	0x7e, 0x4b, 0xe5, 0x8f, 0xe6, 0xb9, 0x7d, 0x50, 0xe6, 0x6f, 0x7b, 0x0e, 0xd9, 0xd5, 0x8f, 0x41,
-	0x88, 0xbb, 0xa8, 0xb5, 0xd8, 0xc8, 0xac, 0xf7, 0x8c, 0x7e, 0x83, 0x54, 0x6f, 0x9f, 0x5c, 0x4f,
-	0x2d, 0xe3, 0x66, 0x6a, 0x19, 0xbf, 0xa6, 0x96, 0xf1, 0x63, 0x66, 0xd5, 0x6e, 0x66, 0x56, 0xed,
-	0xe7, 0xcc, 0xaa, 0x7d, 0x7c, 0x15, 0x31, 0x79, 0x71, 0x39, 0x74, 0x29, 0x4f, 0x3c, 0xca, 0x45,
-	0xc2, 0x85, 0xfe, 0x1c, 0x8b, 0x30, 0xf6, 0xbe, 0x7a, 0xd5, 0xf5, 0x3d, 0x3f, 0x3d, 0x5e, 0x1c,
-	0xa0, 0xbc, 0x1a, 0x83, 0x18, 0x36, 0xd5, 0xf1, 0xbd, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0x70,
-	0xac, 0x09, 0x09, 0xef, 0x03, 0x00, 0x00,
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xe3, 0x26, 0x4d, 0xdd, 0x6d, 0x13, 0xd1, 0xa5, 0x95, 0x4c, 0x54, 0xec, 0x60, 0x24,
+	0x14, 0x09, 0xd5, 0xa6, 0xd0, 0x03, 0xe2, 0xc0, 0xc1, 0x1c, 0x20, 0x37, 0xb4, 0x88, 0x0b, 0x12,
+	0xaa, 0x9c, 0xf5, 0xd4, 0x5d, 0x39, 0xf6, 0x1a, 0xef, 0x26, 0xd0, 0xa7, 0x80, 0xc7, 0xea, 0x05,
+	0xa9, 0x47, 0x4e, 0x16, 0x4a, 0xde, 0x20, 0x47, 0x4e, 0xc8, 0x5f, 0xf9, 0x50, 0x23, 0x44, 0x7a,
+	0xca, 0xee, 0xcc, 0x7f, 0x7e, 0xbf, 0x8d, 0xa5, 0x41, 0x8f, 0xd8, 0x80, 0xda, 0x94, 0x27, 0x60,
+	0xd3, 0x4b, 0x37, 0x8a, 0x60, 0x68, 0x8f, 0x4f, 0x6d, 0x1f, 0x22, 0x10, 0x4c, 0x58, 0x71, 0xc2,
+	0x25, 0xc7, 0xf7, 0xd9, 0x80, 0x5a, 0x59, 0xc4, 0x2a, 0x23, 0xd6, 0xf8, 0xb4, 0x73, 0xe8, 0x73,
+	0x9f, 0xe7, 0x7d, 0x3b, 0x3b, 0x15, 0xd1, 0xce, 0x5a, 0x5a, 0x35, 0x95, 0x47, 0xcc, 0x9f, 0xdb,
+	0x68, 0xff, 0x6d, 0xc1, 0xff, 0x20, 0x5d, 0x09, 0xf8, 0x33, 0x52, 0xcb, 0x84, 0xd0, 0x94, 0x6e,
+	0xbd, 0xb7, 0xf7, 0xfc, 0x89, 0xb5, 0xc6, 0x68, 0xf5, 0x3d, 0x88, 0x24, 0xbb, 0x60, 0xe0, 0xbd,
+	0x29, 0x8a, 0xce, 0x83, 0xeb, 0xd4, 0xa8, 0xfd, 0x49, 0x8d, 0x83, 0x5b, 0x2d, 0x32, 0x47, 0x62,
+	0x82, 0xee, 0xb9, 0x34, 0x88, 0xf8, 0xd7, 0x21, 0x78, 0x3e, 0x84, 0x10, 0x49, 0xa1, 0x6d, 0xe5,
+	0x9a, 0xee, 0x5a, 0xcd, 0x7b, 0x97, 0x06, 0x20, 0xf3, 0xa7, 0x39, 0x8d, 0x4c, 0x40, 0x6e, 0xcd,
+	0xe3, 0x77, 0x68, 0x8f, 0xf2, 0x30, 0x64, 0xb2, 0xc0, 0xd5, 0x37, 0xc2, 0x2d, 0x8f, 0x62, 0x07,
+	0xa9, 0x09, 0x50, 0x60, 0xb1, 0x14, 0x5a, 0x63, 0x23, 0xcc, 0x7c, 0x0e, 0x33, 0xd4, 0x16, 0x10,
+	0x79, 0xe7, 0x02, 0xbe, 0x8c, 0x20, 0xa2, 0x20, 0xb4, 0xed, 0x9c, 0xf4, 0xf8, 0x5f, 0xa4, 0x32,
+	0xeb, 0x3c, 0xcc, 0x60, 0xb3, 0xd4, 0x38, 0xba, 0x72, 0xc3, 0xe1, 0x2b, 0x73, 0x15, 0x64, 0x92,
+	0x56, 0x56, 0xa8, 0xc2, 0xb9, 0x2a, 0x01, 0x3a, 0x5e, 0x52, 0x35, 0xef, 0xac, 0x5a, 0x05, 0x99,
+	0xa4, 0x95, 0x15, 0x16, 0xaa, 0x0b, 0xd4, 0x72, 0x69, 0xb0, 0x64, 0xda, 0xf9, 0x7f, 0xd3, 0x71,
+	0x69, 0x3a, 0x2c, 0x4c, 0x2b, 0x1c, 0x93, 0xec, 0xbb, 0x34, 0x58, 0x78, 0x5e, 0x23, 0x75, 0x14,
+	0xfb, 0x89, 0xeb, 0x81, 0xd0, 0xd4, 0x5c, 0x71, 0xbc, 0x56, 0xf1, 0xb1, 0x08, 0x55, 0x5f, 0xbf,
+	0x9a, 0x31, 0xbf, 0x2b, 0xa8, 0xbd, 0xaa, 0xc7, 0x4f, 0xd1, 0x4e, 0xcc, 0x13, 0x79, 0xce, 0x3c,
+	0x4d, 0xe9, 0x2a, 0xbd, 0x5d, 0x07, 0xcf, 0x52, 0xa3, 0x5d, 0xbc, 0xa5, 0x6c, 0x98, 0xa4, 0x99,
+	0x9d, 0xfa, 0x1e, 0x3e, 0x43, 0xa8, 0xb4, 0x64, 0xf9, 0xad, 0x3c, 0x7f, 0x34, 0x4b, 0x8d, 0x83,
+	0x22, 0xbf, 0xe8, 0x99, 0x64, 0xb7, 0xbc, 0xf4, 0x3d, 0xdc, 0x41, 0x6a, 0xf5, 0x8f, 0xb4, 0x7a,
+	0x57, 0xe9, 0x35, 0xc8, 0xfc, 0xee, 0x90, 0xeb, 0x89, 0xae, 0xdc, 0x4c, 0x74, 0xe5, 0xf7, 0x44,
+	0x57, 0x7e, 0x4c, 0xf5, 0xda, 0xcd, 0x54, 0xaf, 0xfd, 0x9a, 0xea, 0xb5, 0x4f, 0x2f, 0x7d, 0x26,
+	0x2f, 0x47, 0x03, 0x8b, 0xf2, 0xd0, 0xa6, 0x5c, 0x84, 0x5c, 0x94, 0x3f, 0x27, 0xc2, 0x0b, 0xec,
+	0x6f, 0xf6, 0x7c, 0x7b, 0x9f, 0x9d, 0x9d, 0x54, 0x0b, 0x2c, 0xaf, 0x62, 0x10, 0x83, 0x66, 0xbe,
+	0xbc, 0x2f, 0xfe, 0x0e, 0x00, 0xee, 0x1d, 0x15, 0x1d, 0x2f, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -239,6 +248,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Upgrades) > 0 {
+		for iNdEx := len(m.Upgrades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Upgrades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.AckSequences) > 0 {
 		for iNdEx := len(m.AckSequences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -441,6 +464,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Upgrades) > 0 {
+		for _, e := range m.Upgrades {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -737,6 +766,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Upgrades = append(m.Upgrades, Upgrade{})
+			if err := m.Upgrades[len(m.Upgrades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
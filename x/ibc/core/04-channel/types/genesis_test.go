@@ -66,6 +66,13 @@ func TestValidateGenesis(t *testing.T) {
 				[]types.PacketSequence{
 					types.NewPacketSequence(testPort2, testChannel2, 1),
 				},
+				[]types.Upgrade{
+					types.NewUpgrade(
+						testPort1, testChannel1, types.NewChannel(
+							types.OPEN, testChannelOrder, counterparty2, []string{testConnectionIDA}, testChannelVersion,
+						),
+					),
+				},
 			),
 			expPass: true,
 		},
@@ -118,6 +125,19 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expPass: false,
 		},
+		{
+			name: "invalid upgrade",
+			genState: types.GenesisState{
+				Upgrades: []types.Upgrade{
+					types.NewUpgrade(
+						testPort1, testChannel1, types.NewChannel(
+							types.INIT, testChannelOrder, counterparty2, []string{testConnectionIDA}, testChannelVersion,
+						),
+					),
+				},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid recv seq 2",
 			genState: types.GenesisState{
@@ -136,6 +156,19 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expPass: false,
 		},
+		{
+			name: "invalid upgrade",
+			genState: types.GenesisState{
+				Upgrades: []types.Upgrade{
+					types.NewUpgrade(
+						testPort1, testChannel1, types.NewChannel(
+							types.INIT, testChannelOrder, counterparty2, []string{testConnectionIDA}, testChannelVersion,
+						),
+					),
+				},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -400,6 +400,338 @@ func (msg MsgChannelCloseConfirm) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{signer}
 }
 
+var _ sdk.Msg = &MsgChannelUpgradeTry{}
+
+// NewMsgChannelUpgradeTry creates a new MsgChannelUpgradeTry instance
+// nolint:interfacer
+func NewMsgChannelUpgradeTry(
+	portID, channelID string, ordering Order, connectionHops []string, version, counterpartyVersion string,
+	counterpartyUpgradeSequence uint64, upgradeTimeoutHeight clienttypes.Height, upgradeTimeoutTimestamp uint64,
+	proofInit []byte, proofHeight clienttypes.Height, signer sdk.AccAddress,
+) *MsgChannelUpgradeTry {
+	return &MsgChannelUpgradeTry{
+		PortId:                      portID,
+		ChannelId:                   channelID,
+		Ordering:                    ordering,
+		ConnectionHops:              connectionHops,
+		Version:                     version,
+		CounterpartyVersion:         counterpartyVersion,
+		CounterpartyUpgradeSequence: counterpartyUpgradeSequence,
+		ProofInit:                   proofInit,
+		ProofHeight:                 proofHeight,
+		Signer:                      signer.String(),
+		UpgradeTimeoutHeight:        upgradeTimeoutHeight,
+		UpgradeTimeoutTimestamp:     upgradeTimeoutTimestamp,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgChannelUpgradeTry) Route() string {
+	return host.RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgChannelUpgradeTry) Type() string {
+	return "channel_upgrade_try"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeTry) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	if msg.CounterpartyUpgradeSequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpgrade, "counterparty upgrade sequence cannot be 0")
+	}
+	if msg.UpgradeTimeoutHeight.IsZero() && msg.UpgradeTimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpgrade, "upgrade timeout height and timeout timestamp cannot both be 0")
+	}
+	if len(msg.ProofInit) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof init")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return ValidateUpgradeFields(msg.Ordering, msg.ConnectionHops, msg.Version)
+}
+
+// GetSignBytes implements sdk.Msg. The function will panic since it is used
+// for amino transaction verification which IBC does not support.
+func (msg MsgChannelUpgradeTry) GetSignBytes() []byte {
+	panic("IBC messages do not support amino")
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeTry) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeAck{}
+
+// NewMsgChannelUpgradeAck creates a new MsgChannelUpgradeAck instance
+// nolint:interfacer
+func NewMsgChannelUpgradeAck(
+	portID, channelID, counterpartyVersion string, proofTry []byte, proofHeight clienttypes.Height,
+	signer sdk.AccAddress,
+) *MsgChannelUpgradeAck {
+	return &MsgChannelUpgradeAck{
+		PortId:              portID,
+		ChannelId:           channelID,
+		CounterpartyVersion: counterpartyVersion,
+		ProofTry:            proofTry,
+		ProofHeight:         proofHeight,
+		Signer:              signer.String(),
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgChannelUpgradeAck) Route() string {
+	return host.RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgChannelUpgradeAck) Type() string {
+	return "channel_upgrade_ack"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeAck) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	if len(msg.ProofTry) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof try")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg. The function will panic since it is used
+// for amino transaction verification which IBC does not support.
+func (msg MsgChannelUpgradeAck) GetSignBytes() []byte {
+	panic("IBC messages do not support amino")
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeAck) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeConfirm{}
+
+// NewMsgChannelUpgradeConfirm creates a new MsgChannelUpgradeConfirm instance
+// nolint:interfacer
+func NewMsgChannelUpgradeConfirm(
+	portID, channelID string, proofAck []byte, proofHeight clienttypes.Height,
+	signer sdk.AccAddress,
+) *MsgChannelUpgradeConfirm {
+	return &MsgChannelUpgradeConfirm{
+		PortId:      portID,
+		ChannelId:   channelID,
+		ProofAck:    proofAck,
+		ProofHeight: proofHeight,
+		Signer:      signer.String(),
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgChannelUpgradeConfirm) Route() string {
+	return host.RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgChannelUpgradeConfirm) Type() string {
+	return "channel_upgrade_confirm"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeConfirm) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	if len(msg.ProofAck) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof ack")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg. The function will panic since it is used
+// for amino transaction verification which IBC does not support.
+func (msg MsgChannelUpgradeConfirm) GetSignBytes() []byte {
+	panic("IBC messages do not support amino")
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeConfirm) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeTimeout{}
+
+// NewMsgChannelUpgradeTimeout creates a new MsgChannelUpgradeTimeout instance
+// nolint:interfacer
+func NewMsgChannelUpgradeTimeout(
+	portID, channelID string, counterpartyChannel Channel, proofChannel []byte, proofHeight clienttypes.Height,
+	signer sdk.AccAddress,
+) *MsgChannelUpgradeTimeout {
+	return &MsgChannelUpgradeTimeout{
+		PortId:              portID,
+		ChannelId:           channelID,
+		CounterpartyChannel: counterpartyChannel,
+		ProofChannel:        proofChannel,
+		ProofHeight:         proofHeight,
+		Signer:              signer.String(),
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgChannelUpgradeTimeout) Route() string {
+	return host.RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgChannelUpgradeTimeout) Type() string {
+	return "channel_upgrade_timeout"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeTimeout) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	if len(msg.ProofChannel) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof channel")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return msg.CounterpartyChannel.ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg. The function will panic since it is used
+// for amino transaction verification which IBC does not support.
+func (msg MsgChannelUpgradeTimeout) GetSignBytes() []byte {
+	panic("IBC messages do not support amino")
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeTimeout) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgChannelUpgradeCancel{}
+
+// NewMsgChannelUpgradeCancel creates a new MsgChannelUpgradeCancel instance
+// nolint:interfacer
+func NewMsgChannelUpgradeCancel(
+	portID, channelID string, counterpartyChannel Channel, proofChannel []byte, proofHeight clienttypes.Height,
+	signer sdk.AccAddress,
+) *MsgChannelUpgradeCancel {
+	return &MsgChannelUpgradeCancel{
+		PortId:              portID,
+		ChannelId:           channelID,
+		CounterpartyChannel: counterpartyChannel,
+		ProofChannel:        proofChannel,
+		ProofHeight:         proofHeight,
+		Signer:              signer.String(),
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgChannelUpgradeCancel) Route() string {
+	return host.RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgChannelUpgradeCancel) Type() string {
+	return "channel_upgrade_cancel"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelUpgradeCancel) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	if len(msg.ProofChannel) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof channel")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return msg.CounterpartyChannel.ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg. The function will panic since it is used
+// for amino transaction verification which IBC does not support.
+func (msg MsgChannelUpgradeCancel) GetSignBytes() []byte {
+	panic("IBC messages do not support amino")
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelUpgradeCancel) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
 var _ sdk.Msg = &MsgRecvPacket{}
 
 // NewMsgRecvPacket constructs new MsgRecvPacket
@@ -321,6 +321,161 @@ func (suite *TypesTestSuite) TestMsgChannelCloseConfirmValidateBasic() {
 	}
 }
 
+func (suite *TypesTestSuite) TestMsgChannelUpgradeTryValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeTry
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeTry(portid, chanid, types.UNORDERED, connHops, version, version, 1, timeoutHeight, 0, suite.proof, height, addr), true},
+		{"too short port id", types.NewMsgChannelUpgradeTry(invalidShortPort, chanid, types.UNORDERED, connHops, version, version, 1, timeoutHeight, 0, suite.proof, height, addr), false},
+		{"channel id contains non-alpha", types.NewMsgChannelUpgradeTry(portid, invalidChannel, types.UNORDERED, connHops, version, version, 1, timeoutHeight, 0, suite.proof, height, addr), false},
+		{"invalid ordering", types.NewMsgChannelUpgradeTry(portid, chanid, types.NONE, connHops, version, version, 1, timeoutHeight, 0, suite.proof, height, addr), false},
+		{"too many connection hops", types.NewMsgChannelUpgradeTry(portid, chanid, types.UNORDERED, invalidConnHops, version, version, 1, timeoutHeight, 0, suite.proof, height, addr), false},
+		{"too short connection id", types.NewMsgChannelUpgradeTry(portid, chanid, types.UNORDERED, invalidShortConnHops, version, version, 1, timeoutHeight, 0, suite.proof, height, addr), false},
+		{"counterparty upgrade sequence is zero", types.NewMsgChannelUpgradeTry(portid, chanid, types.UNORDERED, connHops, version, version, 0, timeoutHeight, 0, suite.proof, height, addr), false},
+		{"no upgrade timeout", types.NewMsgChannelUpgradeTry(portid, chanid, types.UNORDERED, connHops, version, version, 1, clienttypes.ZeroHeight(), 0, suite.proof, height, addr), false},
+		{"empty proof", types.NewMsgChannelUpgradeTry(portid, chanid, types.UNORDERED, connHops, version, version, 1, timeoutHeight, 0, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeTry(portid, chanid, types.UNORDERED, connHops, version, version, 1, timeoutHeight, 0, suite.proof, clienttypes.ZeroHeight(), addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeAckValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeAck
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeAck(portid, chanid, version, suite.proof, height, addr), true},
+		{"too long port id", types.NewMsgChannelUpgradeAck(invalidLongPort, chanid, version, suite.proof, height, addr), false},
+		{"too short channel id", types.NewMsgChannelUpgradeAck(portid, invalidShortChannel, version, suite.proof, height, addr), false},
+		{"empty proof", types.NewMsgChannelUpgradeAck(portid, chanid, version, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeAck(portid, chanid, version, suite.proof, clienttypes.ZeroHeight(), addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeConfirmValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeConfirm
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeConfirm(portid, chanid, suite.proof, height, addr), true},
+		{"port id contains non-alpha", types.NewMsgChannelUpgradeConfirm(invalidPort, chanid, suite.proof, height, addr), false},
+		{"too long channel id", types.NewMsgChannelUpgradeConfirm(portid, invalidLongChannel, suite.proof, height, addr), false},
+		{"empty proof", types.NewMsgChannelUpgradeConfirm(portid, chanid, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeConfirm(portid, chanid, suite.proof, clienttypes.ZeroHeight(), addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeTimeoutValidateBasic() {
+	counterparty := types.NewCounterparty(cpportid, cpchanid)
+	openChannel := types.NewChannel(types.OPEN, types.ORDERED, counterparty, connHops, version)
+	invalidChannel := types.NewChannel(types.OPEN, types.ORDERED, counterparty, invalidConnHops, version)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeTimeout
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeTimeout(portid, chanid, openChannel, suite.proof, height, addr), true},
+		{"too short port id", types.NewMsgChannelUpgradeTimeout(invalidShortPort, chanid, openChannel, suite.proof, height, addr), false},
+		{"channel id contains non-alpha", types.NewMsgChannelUpgradeTimeout(portid, "(invalidchannel1)", openChannel, suite.proof, height, addr), false},
+		{"invalid counterparty channel", types.NewMsgChannelUpgradeTimeout(portid, chanid, invalidChannel, suite.proof, height, addr), false},
+		{"empty proof", types.NewMsgChannelUpgradeTimeout(portid, chanid, openChannel, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeTimeout(portid, chanid, openChannel, suite.proof, clienttypes.ZeroHeight(), addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeCancelValidateBasic() {
+	counterparty := types.NewCounterparty(cpportid, cpchanid)
+	openChannel := types.NewChannel(types.OPEN, types.ORDERED, counterparty, connHops, version)
+	invalidChannel := types.NewChannel(types.OPEN, types.ORDERED, counterparty, invalidConnHops, version)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeCancel
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeCancel(portid, chanid, openChannel, suite.proof, height, addr), true},
+		{"too long port id", types.NewMsgChannelUpgradeCancel(invalidLongPort, chanid, openChannel, suite.proof, height, addr), false},
+		{"too short channel id", types.NewMsgChannelUpgradeCancel(portid, invalidShortChannel, openChannel, suite.proof, height, addr), false},
+		{"invalid counterparty channel", types.NewMsgChannelUpgradeCancel(portid, chanid, invalidChannel, suite.proof, height, addr), false},
+		{"empty proof", types.NewMsgChannelUpgradeCancel(portid, chanid, openChannel, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeCancel(portid, chanid, openChannel, suite.proof, clienttypes.ZeroHeight(), addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestMsgRecvPacketType() {
 	msg := types.NewMsgRecvPacket(packet, suite.proof, height, addr)
 
@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+)
+
+const (
+	// ProposalTypeChannelUpgrade defines the type for a ChannelUpgradeProposal
+	ProposalTypeChannelUpgrade = "ChannelUpgrade"
+)
+
+var _ govtypes.Content = &ChannelUpgradeProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeChannelUpgrade)
+	govtypes.RegisterProposalTypeCodec(&ChannelUpgradeProposal{}, "cosmos-sdk/ChannelUpgradeProposal")
+}
+
+// NewChannelUpgradeProposal creates a new channel upgrade proposal.
+func NewChannelUpgradeProposal(
+	title, description, portID, channelID string, ordering Order, connectionHops []string, version string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+) *ChannelUpgradeProposal {
+	return &ChannelUpgradeProposal{
+		Title:            title,
+		Description:      description,
+		PortId:           portID,
+		ChannelId:        channelID,
+		Ordering:         ordering,
+		ConnectionHops:   connectionHops,
+		Version:          version,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// GetTitle returns the title of a channel upgrade proposal.
+func (cup *ChannelUpgradeProposal) GetTitle() string { return cup.Title }
+
+// GetDescription returns the description of a channel upgrade proposal.
+func (cup *ChannelUpgradeProposal) GetDescription() string { return cup.Description }
+
+// ProposalRoute returns the routing key of a channel upgrade proposal.
+func (cup *ChannelUpgradeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a channel upgrade proposal.
+func (cup *ChannelUpgradeProposal) ProposalType() string { return ProposalTypeChannelUpgrade }
+
+// ValidateBasic runs basic stateless validity checks
+func (cup *ChannelUpgradeProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(cup); err != nil {
+		return err
+	}
+	if err := host.PortIdentifierValidator(cup.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(cup.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	if cup.TimeoutHeight.IsZero() && cup.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpgrade, "upgrade timeout height and timeout timestamp cannot both be 0")
+	}
+	return ValidateUpgradeFields(cup.Ordering, cup.ConnectionHops, cup.Version)
+}
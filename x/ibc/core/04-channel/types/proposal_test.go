@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func (suite *TypesTestSuite) TestChannelUpgradeProposalValidateBasic() {
+	testCases := []struct {
+		name     string
+		proposal govtypes.Content
+		expPass  bool
+	}{
+		{
+			"success",
+			types.NewChannelUpgradeProposal(ibctesting.Title, ibctesting.Description, portid, chanid, types.UNORDERED, connHops, version, timeoutHeight, 0),
+			true,
+		},
+		{
+			"success: timeout timestamp",
+			types.NewChannelUpgradeProposal(ibctesting.Title, ibctesting.Description, portid, chanid, types.UNORDERED, connHops, version, disabledTimeout, timeoutTimestamp),
+			true,
+		},
+		{
+			"fails validate abstract - empty title",
+			types.NewChannelUpgradeProposal("", ibctesting.Description, portid, chanid, types.UNORDERED, connHops, version, timeoutHeight, 0),
+			false,
+		},
+		{
+			"invalid port id",
+			types.NewChannelUpgradeProposal(ibctesting.Title, ibctesting.Description, invalidPort, chanid, types.UNORDERED, connHops, version, timeoutHeight, 0),
+			false,
+		},
+		{
+			"invalid channel id",
+			types.NewChannelUpgradeProposal(ibctesting.Title, ibctesting.Description, portid, invalidChannel, types.UNORDERED, connHops, version, timeoutHeight, 0),
+			false,
+		},
+		{
+			"invalid ordering",
+			types.NewChannelUpgradeProposal(ibctesting.Title, ibctesting.Description, portid, chanid, types.NONE, connHops, version, timeoutHeight, 0),
+			false,
+		},
+		{
+			"too many connection hops",
+			types.NewChannelUpgradeProposal(ibctesting.Title, ibctesting.Description, portid, chanid, types.UNORDERED, invalidConnHops, version, timeoutHeight, 0),
+			false,
+		},
+		{
+			"no upgrade timeout",
+			types.NewChannelUpgradeProposal(ibctesting.Title, ibctesting.Description, portid, chanid, types.UNORDERED, connHops, version, disabledTimeout, 0),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -1667,6 +1667,125 @@ func (m *QueryNextSequenceReceiveResponse) GetProofHeight() types.Height {
 	return types.Height{}
 }
 
+// QueryUpgradeRequest is the request type for the Query/Upgrade RPC method
+type QueryUpgradeRequest struct {
+	// port unique identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel unique identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryUpgradeRequest) Reset()         { *m = QueryUpgradeRequest{} }
+func (m *QueryUpgradeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeRequest) ProtoMessage()    {}
+func (*QueryUpgradeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{26}
+}
+func (m *QueryUpgradeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeRequest.Merge(m, src)
+}
+func (m *QueryUpgradeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeRequest proto.InternalMessageInfo
+
+func (m *QueryUpgradeRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryUpgradeRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryUpgradeResponse is the response type for the Query/Upgrade RPC method
+type QueryUpgradeResponse struct {
+	// upgrade handshake in progress for the channel
+	Upgrade Upgrade `protobuf:"bytes,1,opt,name=upgrade,proto3" json:"upgrade"`
+	// merkle proof of existence
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// height at which the proof was retrieved
+	ProofHeight types.Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+}
+
+func (m *QueryUpgradeResponse) Reset()         { *m = QueryUpgradeResponse{} }
+func (m *QueryUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeResponse) ProtoMessage()    {}
+func (*QueryUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{27}
+}
+func (m *QueryUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeResponse.Merge(m, src)
+}
+func (m *QueryUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradeResponse) GetUpgrade() Upgrade {
+	if m != nil {
+		return m.Upgrade
+	}
+	return Upgrade{}
+}
+
+func (m *QueryUpgradeResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryUpgradeResponse) GetProofHeight() types.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types.Height{}
+}
+
 func init() {
 	proto.RegisterType((*QueryChannelRequest)(nil), "ibc.core.channel.v1.QueryChannelRequest")
 	proto.RegisterType((*QueryChannelResponse)(nil), "ibc.core.channel.v1.QueryChannelResponse")
@@ -1694,105 +1813,111 @@ func init() {
 	proto.RegisterType((*QueryUnreceivedAcksResponse)(nil), "ibc.core.channel.v1.QueryUnreceivedAcksResponse")
 	proto.RegisterType((*QueryNextSequenceReceiveRequest)(nil), "ibc.core.channel.v1.QueryNextSequenceReceiveRequest")
 	proto.RegisterType((*QueryNextSequenceReceiveResponse)(nil), "ibc.core.channel.v1.QueryNextSequenceReceiveResponse")
+	proto.RegisterType((*QueryUpgradeRequest)(nil), "ibc.core.channel.v1.QueryUpgradeRequest")
+	proto.RegisterType((*QueryUpgradeResponse)(nil), "ibc.core.channel.v1.QueryUpgradeResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/query.proto", fileDescriptor_1034a1e9abc4cca1) }
 
 var fileDescriptor_1034a1e9abc4cca1 = []byte{
-	// 1487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdf, 0x6b, 0x14, 0xd7,
-	0x17, 0xcf, 0xdd, 0x44, 0x4d, 0x8e, 0xbf, 0x6f, 0x12, 0x8d, 0x63, 0x5c, 0xe3, 0x7e, 0xbf, 0x6d,
-	0xa3, 0xe0, 0x5c, 0x13, 0xad, 0x15, 0x8a, 0x2d, 0x31, 0x50, 0x1b, 0x50, 0x6b, 0x47, 0x6d, 0x55,
-	0x5a, 0x97, 0xd9, 0xd9, 0xeb, 0x66, 0x48, 0x32, 0xb3, 0xee, 0xcc, 0xc6, 0x84, 0xb0, 0xd0, 0x56,
-	0x90, 0x52, 0x2a, 0x14, 0xa4, 0x14, 0xfa, 0xd2, 0x97, 0x42, 0xf1, 0xb1, 0xff, 0x43, 0x1f, 0x7c,
-	0xe8, 0x83, 0xd0, 0x16, 0x2c, 0x05, 0x2b, 0x5a, 0xa8, 0x0f, 0x7d, 0x6e, 0x5f, 0xcb, 0xdc, 0x7b,
-	0xe6, 0xd7, 0xee, 0xcc, 0x24, 0xeb, 0x66, 0x41, 0xfa, 0x94, 0x9d, 0x3b, 0xe7, 0x9c, 0xfb, 0xf9,
-	0x7c, 0xce, 0x3d, 0x67, 0xef, 0xd9, 0xc0, 0x7e, 0xb3, 0x64, 0x30, 0xc3, 0xae, 0x71, 0x66, 0xcc,
-	0xea, 0x96, 0xc5, 0xe7, 0xd9, 0xe2, 0x04, 0xbb, 0x51, 0xe7, 0xb5, 0x65, 0xb5, 0x5a, 0xb3, 0x5d,
-	0x9b, 0x0e, 0x9a, 0x25, 0x43, 0xf5, 0x0c, 0x54, 0x34, 0x50, 0x17, 0x27, 0x94, 0x88, 0xd7, 0xbc,
-	0xc9, 0x2d, 0xd7, 0x73, 0x92, 0x9f, 0xa4, 0x97, 0x72, 0xc8, 0xb0, 0x9d, 0x05, 0xdb, 0x61, 0x25,
-	0xdd, 0xe1, 0x32, 0x1c, 0x5b, 0x9c, 0x28, 0x71, 0x57, 0x9f, 0x60, 0x55, 0xbd, 0x62, 0x5a, 0xba,
-	0x6b, 0xda, 0x16, 0xda, 0x1e, 0x48, 0x82, 0xe0, 0x6f, 0x26, 0x4d, 0x46, 0x2b, 0xb6, 0x5d, 0x99,
-	0xe7, 0x4c, 0xaf, 0x9a, 0x4c, 0xb7, 0x2c, 0xdb, 0x15, 0xfe, 0x0e, 0xbe, 0xdd, 0x83, 0x6f, 0xc5,
-	0x53, 0xa9, 0x7e, 0x9d, 0xe9, 0x16, 0xa2, 0x57, 0x86, 0x2a, 0x76, 0xc5, 0x16, 0x1f, 0x99, 0xf7,
-	0x49, 0xae, 0x16, 0xce, 0xc2, 0xe0, 0xbb, 0x1e, 0xa6, 0x69, 0xb9, 0x89, 0xc6, 0x6f, 0xd4, 0xb9,
-	0xe3, 0xd2, 0xdd, 0xb0, 0xa9, 0x6a, 0xd7, 0xdc, 0xa2, 0x59, 0x1e, 0x21, 0x63, 0x64, 0x7c, 0x40,
-	0xdb, 0xe8, 0x3d, 0xce, 0x94, 0xe9, 0x3e, 0x00, 0xc4, 0xe3, 0xbd, 0xcb, 0x89, 0x77, 0x03, 0xb8,
-	0x32, 0x53, 0x2e, 0xdc, 0x23, 0x30, 0x14, 0x8f, 0xe7, 0x54, 0x6d, 0xcb, 0xe1, 0xf4, 0x38, 0x6c,
-	0x42, 0x2b, 0x11, 0x70, 0xf3, 0xe4, 0xa8, 0x9a, 0xa0, 0xa6, 0xea, 0xbb, 0xf9, 0xc6, 0x74, 0x08,
-	0x36, 0x54, 0x6b, 0xb6, 0x7d, 0x5d, 0x6c, 0xb5, 0x45, 0x93, 0x0f, 0x74, 0x1a, 0xb6, 0x88, 0x0f,
-	0xc5, 0x59, 0x6e, 0x56, 0x66, 0xdd, 0x91, 0x5e, 0x11, 0x52, 0x89, 0x84, 0x94, 0x19, 0x58, 0x9c,
-	0x50, 0xdf, 0x16, 0x16, 0xa7, 0xfa, 0xee, 0x3f, 0xda, 0xdf, 0xa3, 0x6d, 0x16, 0x5e, 0x72, 0xa9,
-	0x70, 0x2d, 0x0e, 0xd5, 0xf1, 0xb9, 0xbf, 0x05, 0x10, 0x26, 0x06, 0xd1, 0xbe, 0xac, 0xca, 0x2c,
-	0xaa, 0x5e, 0x16, 0x55, 0x79, 0x28, 0x30, 0x8b, 0xea, 0x79, 0xbd, 0xc2, 0xd1, 0x57, 0x8b, 0x78,
-	0x16, 0x1e, 0x11, 0x18, 0x6e, 0xda, 0x00, 0xc5, 0x38, 0x05, 0xfd, 0xc8, 0xcf, 0x19, 0x21, 0x63,
-	0xbd, 0x22, 0x7e, 0x92, 0x1a, 0x33, 0x65, 0x6e, 0xb9, 0xe6, 0x75, 0x93, 0x97, 0x7d, 0x5d, 0x02,
-	0x3f, 0x7a, 0x3a, 0x86, 0x32, 0x27, 0x50, 0xbe, 0xb2, 0x2a, 0x4a, 0x09, 0x20, 0x0a, 0x93, 0x9e,
-	0x80, 0x8d, 0x6d, 0xaa, 0x88, 0xf6, 0x85, 0x4f, 0x09, 0xe4, 0x25, 0x41, 0xdb, 0xb2, 0xb8, 0xe1,
-	0x45, 0x6b, 0xd6, 0x32, 0x0f, 0x60, 0x04, 0x2f, 0xf1, 0x28, 0x45, 0x56, 0x9a, 0xb4, 0xce, 0x3d,
-	0xb7, 0xd6, 0xcf, 0x08, 0xec, 0x4f, 0x85, 0xf2, 0xdf, 0x52, 0xfd, 0xb2, 0x2f, 0xba, 0xc4, 0x34,
-	0x2d, 0xac, 0x2f, 0xb8, 0xba, 0xcb, 0x3b, 0x2d, 0xde, 0xdf, 0x03, 0x11, 0x13, 0x42, 0xa3, 0x88,
-	0x3a, 0xec, 0x36, 0x03, 0x7d, 0x8a, 0x12, 0x6a, 0xd1, 0xf1, 0x4c, 0xb0, 0x52, 0x0e, 0x26, 0x11,
-	0x89, 0x48, 0x1a, 0x89, 0x39, 0x6c, 0x26, 0x2d, 0x77, 0xb3, 0xe4, 0xef, 0x11, 0x38, 0x10, 0x63,
-	0xe8, 0x71, 0xb2, 0x9c, 0xba, 0xb3, 0x1e, 0xfa, 0xd1, 0x97, 0x60, 0xdb, 0x22, 0xaf, 0x39, 0xa6,
-	0x6d, 0x15, 0xad, 0xfa, 0x42, 0x89, 0xd7, 0x04, 0xc8, 0x3e, 0x6d, 0x2b, 0xae, 0x9e, 0x13, 0x8b,
-	0x51, 0x33, 0xe4, 0xd2, 0x17, 0x33, 0x43, 0xac, 0xbf, 0x11, 0x28, 0x64, 0x61, 0xc5, 0x84, 0x9c,
-	0x84, 0xed, 0x86, 0xff, 0x26, 0x96, 0x88, 0x21, 0x55, 0x7e, 0x17, 0xa8, 0xfe, 0x77, 0x81, 0x3a,
-	0x65, 0x2d, 0x6b, 0xdb, 0x8c, 0x58, 0x18, 0xba, 0x17, 0x06, 0x30, 0x89, 0x01, 0xa3, 0x7e, 0xb9,
-	0x30, 0x53, 0x0e, 0x33, 0xd1, 0x9b, 0x95, 0x89, 0xbe, 0xe7, 0xc9, 0x44, 0x0d, 0x46, 0x05, 0xb9,
-	0xf3, 0xba, 0x31, 0xc7, 0xdd, 0x69, 0x7b, 0x61, 0xc1, 0x74, 0x17, 0xb8, 0xe5, 0x76, 0x9a, 0x03,
-	0x05, 0xfa, 0x1d, 0x2f, 0x84, 0x65, 0x70, 0x54, 0x3f, 0x78, 0x2e, 0x7c, 0x4d, 0x60, 0x5f, 0xca,
-	0xa6, 0x28, 0xa6, 0x68, 0x57, 0xfe, 0xaa, 0xd8, 0x78, 0x8b, 0x16, 0x59, 0xe9, 0xe6, 0xd1, 0xfc,
-	0x26, 0x0d, 0x9c, 0xd3, 0xa9, 0x24, 0xf1, 0x1e, 0xdb, 0xfb, 0xdc, 0x3d, 0xf6, 0x4f, 0xbf, 0xdd,
-	0x27, 0x20, 0x0c, 0x5a, 0xec, 0xe6, 0x50, 0x2d, 0xbf, 0xcb, 0x8e, 0x25, 0x76, 0x59, 0x19, 0x44,
-	0x9e, 0xe5, 0xa8, 0xd3, 0x8b, 0xd0, 0x62, 0x6d, 0xd8, 0x13, 0x21, 0xaa, 0x71, 0x83, 0x9b, 0xd5,
-	0xae, 0x9e, 0xcc, 0xbb, 0x04, 0x94, 0xa4, 0x1d, 0x51, 0x56, 0x05, 0xfa, 0x6b, 0xde, 0xd2, 0x22,
-	0x97, 0x71, 0xfb, 0xb5, 0xe0, 0xb9, 0x9b, 0x35, 0x7a, 0x13, 0x9b, 0xa5, 0x04, 0x35, 0x65, 0xcc,
-	0x59, 0xf6, 0xcd, 0x79, 0x5e, 0xae, 0xf0, 0x6e, 0x17, 0xea, 0x3d, 0xbf, 0xf5, 0xa5, 0xec, 0x8c,
-	0xb2, 0x8c, 0xc3, 0x76, 0x3d, 0xfe, 0x0a, 0x4b, 0xb6, 0x79, 0xb9, 0x9b, 0x75, 0xfb, 0x6d, 0x26,
-	0xd6, 0x17, 0xa6, 0x78, 0xff, 0x26, 0xf0, 0xbf, 0x4c, 0x98, 0xa8, 0xe9, 0x19, 0xd8, 0xd1, 0x24,
-	0xde, 0xda, 0xcb, 0xb8, 0xc5, 0xf3, 0x45, 0xa8, 0xe5, 0xaf, 0xfc, 0xbe, 0x7a, 0xc9, 0xf2, 0x6b,
-	0x46, 0x62, 0xee, 0x38, 0x35, 0x6f, 0xc0, 0xde, 0xaa, 0x88, 0x54, 0x0c, 0xdb, 0x57, 0xd1, 0x3f,
-	0xc3, 0xce, 0x48, 0xef, 0x58, 0xef, 0x78, 0x9f, 0xb6, 0xa7, 0xda, 0xd4, 0x2c, 0x2f, 0xf8, 0x06,
-	0x85, 0x25, 0x6c, 0xa7, 0x09, 0xc0, 0x30, 0x19, 0xa3, 0x30, 0x10, 0xc6, 0x23, 0x22, 0x5e, 0xb8,
-	0x10, 0xd1, 0x24, 0xd7, 0xa6, 0x26, 0xb7, 0xfd, 0x76, 0x13, 0x6e, 0x3d, 0x65, 0xcc, 0x75, 0x2c,
-	0xc8, 0x11, 0x18, 0x42, 0x41, 0x74, 0x63, 0xae, 0x45, 0x09, 0x5a, 0xf5, 0x4f, 0x5e, 0x28, 0x41,
-	0x1d, 0xf6, 0x26, 0xe2, 0xe8, 0x32, 0xff, 0x2b, 0x78, 0xcf, 0x3d, 0xc7, 0x97, 0x82, 0x7c, 0x68,
-	0x12, 0x40, 0xa7, 0x77, 0xe8, 0xef, 0x09, 0x8c, 0xa5, 0xc7, 0x46, 0x5e, 0x93, 0x30, 0x6c, 0xf1,
-	0xa5, 0xf0, 0xb0, 0x14, 0x91, 0xbd, 0xd8, 0xaa, 0x4f, 0x1b, 0xb4, 0x5a, 0x7d, 0xbb, 0xd8, 0xc2,
-	0x26, 0x7f, 0xdc, 0x05, 0x1b, 0x04, 0x66, 0xfa, 0x1d, 0x81, 0x4d, 0x78, 0xdd, 0xa4, 0xe3, 0x89,
-	0xf5, 0x9e, 0xf0, 0x63, 0x81, 0x72, 0x70, 0x0d, 0x96, 0x92, 0x79, 0xe1, 0xf4, 0x27, 0x3f, 0xfd,
-	0x71, 0x37, 0x37, 0x45, 0xdf, 0x64, 0x09, 0xbf, 0x74, 0xc8, 0x1f, 0x45, 0xfc, 0x59, 0x8b, 0xad,
-	0x84, 0x3a, 0x37, 0x98, 0xa7, 0xbe, 0xc3, 0x56, 0x30, 0x27, 0x0d, 0x7a, 0x87, 0x40, 0xbf, 0x3f,
-	0xe1, 0xd1, 0xd5, 0x01, 0xf8, 0x67, 0x5b, 0x39, 0xb4, 0x16, 0x53, 0x04, 0x7b, 0x48, 0x80, 0xfd,
-	0x3f, 0x2d, 0xac, 0x0e, 0x96, 0xfe, 0x40, 0x80, 0xb6, 0xce, 0x9e, 0xf4, 0x68, 0xc6, 0x76, 0x69,
-	0x43, 0xb3, 0x72, 0xac, 0x3d, 0x27, 0x44, 0x3b, 0x2d, 0xd0, 0x9e, 0xa4, 0xaf, 0x67, 0xa0, 0x0d,
-	0xbc, 0x3d, 0x75, 0x83, 0x87, 0x46, 0x48, 0xe3, 0x17, 0x8f, 0x46, 0xcb, 0xf4, 0x97, 0x49, 0x23,
-	0x6d, 0x0c, 0xcd, 0xa4, 0x91, 0x3a, 0x60, 0x16, 0x2e, 0x0a, 0x1a, 0xe7, 0xe8, 0x99, 0x0e, 0x4f,
-	0x08, 0x8b, 0xce, 0xa6, 0xf4, 0xcb, 0x1c, 0x0c, 0x27, 0xce, 0x51, 0xf4, 0xf8, 0xea, 0x28, 0x93,
-	0x86, 0x44, 0xe5, 0xb5, 0xb6, 0xfd, 0x90, 0xe0, 0x67, 0x44, 0x30, 0xbc, 0x45, 0xe8, 0x47, 0xa4,
-	0x63, 0x8e, 0xf1, 0xc9, 0x8f, 0xe1, 0x04, 0xc9, 0x56, 0xe2, 0x73, 0x68, 0x83, 0xc9, 0xf6, 0x10,
-	0xae, 0xcb, 0xe7, 0x06, 0x7d, 0x4c, 0x60, 0x47, 0xf3, 0x75, 0x9e, 0x4e, 0xa4, 0x53, 0x4b, 0x19,
-	0xd7, 0x94, 0xc9, 0x76, 0x5c, 0x50, 0x08, 0x2e, 0x74, 0x28, 0xd2, 0x0f, 0x3b, 0x55, 0xa1, 0xe5,
-	0x5b, 0xd8, 0x61, 0x2b, 0x7e, 0x6b, 0x6d, 0xd0, 0x87, 0x04, 0x76, 0xb6, 0x4c, 0x2c, 0xb4, 0x0d,
-	0xc0, 0x41, 0x5d, 0x1e, 0x6d, 0xcb, 0x07, 0x59, 0x5e, 0x15, 0x2c, 0x2f, 0x52, 0x6d, 0xfd, 0x59,
-	0xd2, 0x9f, 0x09, 0x6c, 0x8d, 0x4d, 0x0c, 0x54, 0x5d, 0x0d, 0x62, 0x7c, 0x98, 0x51, 0xd8, 0x9a,
-	0xed, 0x91, 0x4e, 0x49, 0xd0, 0xf9, 0x80, 0x5e, 0x5d, 0x27, 0x3a, 0x35, 0x19, 0x3f, 0x96, 0xb1,
-	0x67, 0x04, 0x86, 0x13, 0xaf, 0xa9, 0x59, 0xc5, 0x9a, 0x35, 0xa4, 0x64, 0x15, 0x6b, 0xe6, 0x88,
-	0x51, 0xb8, 0x26, 0xe8, 0x5e, 0xa6, 0xef, 0xad, 0x13, 0x5d, 0xdd, 0x98, 0x8b, 0x51, 0xfd, 0x8b,
-	0xc0, 0xae, 0xe4, 0x1b, 0x39, 0x6d, 0x17, 0x73, 0x70, 0x4c, 0x4f, 0xb4, 0xef, 0x88, 0x6c, 0x8b,
-	0x82, 0xed, 0x15, 0xfa, 0xfe, 0xfa, 0xb1, 0x8d, 0x73, 0xfa, 0x3c, 0x07, 0x3b, 0x5b, 0xae, 0xbb,
-	0x59, 0xb5, 0x98, 0x76, 0x69, 0xcf, 0xaa, 0xc5, 0xd4, 0xfb, 0x74, 0xe1, 0x8e, 0x6c, 0xbd, 0xb7,
-	0x09, 0xbd, 0x45, 0xba, 0xd1, 0x74, 0x32, 0xc6, 0x81, 0x06, 0xab, 0x07, 0xb0, 0x8a, 0x55, 0x24,
-	0xfe, 0x0f, 0x81, 0x6d, 0xf1, 0xab, 0x2f, 0x65, 0x6b, 0xe1, 0x15, 0xb9, 0xac, 0x2b, 0x47, 0xd6,
-	0xee, 0x80, 0x2a, 0x7c, 0x2c, 0x55, 0x58, 0xa1, 0xcb, 0x5d, 0xd4, 0x20, 0x36, 0x01, 0xc4, 0xc8,
-	0x7b, 0x25, 0x40, 0x7f, 0x25, 0x30, 0x98, 0x70, 0x43, 0xa6, 0x19, 0x77, 0x86, 0xf4, 0xcb, 0xba,
-	0xf2, 0x6a, 0x9b, 0x5e, 0x28, 0xc4, 0x25, 0xa1, 0xc3, 0x3b, 0xf4, 0x6c, 0xa7, 0x3a, 0xc4, 0x2e,
-	0xf3, 0xa7, 0xb4, 0xfb, 0x4f, 0xf2, 0xe4, 0xc1, 0x93, 0x3c, 0x79, 0xfc, 0x24, 0x4f, 0xbe, 0x78,
-	0x9a, 0xef, 0x79, 0xf0, 0x34, 0xdf, 0xf3, 0xf0, 0x69, 0xbe, 0xe7, 0xea, 0x89, 0x8a, 0xe9, 0xce,
-	0xd6, 0x4b, 0xaa, 0x61, 0x2f, 0x30, 0xfc, 0xaf, 0xa0, 0xfc, 0x73, 0xd8, 0x29, 0xcf, 0xb1, 0xa5,
-	0x10, 0xc6, 0x91, 0x63, 0x87, 0x7d, 0x24, 0xee, 0x72, 0x95, 0x3b, 0xa5, 0x8d, 0xe2, 0x47, 0xdc,
-	0xa3, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x48, 0x61, 0x15, 0x26, 0xa4, 0x1c, 0x00, 0x00,
+	// 1542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdd, 0x6b, 0x14, 0x57,
+	0x14, 0xcf, 0x4d, 0xa2, 0x49, 0x8e, 0xdf, 0x37, 0x49, 0x8d, 0x63, 0x5c, 0xe3, 0xf6, 0x2b, 0x0a,
+	0xce, 0x98, 0xc4, 0xaa, 0xd0, 0xda, 0x12, 0x03, 0xda, 0x80, 0x5f, 0x1d, 0xb5, 0x55, 0x69, 0x5d,
+	0x66, 0x67, 0xaf, 0x9b, 0x21, 0xc9, 0xcc, 0xb8, 0x33, 0x1b, 0x13, 0xc2, 0x42, 0x5b, 0x41, 0x4a,
+	0xa9, 0x50, 0x90, 0x52, 0xe8, 0x4b, 0x5f, 0x0a, 0xc5, 0x27, 0xe9, 0xff, 0xd0, 0x07, 0x1f, 0x85,
+	0xb6, 0x60, 0x29, 0x58, 0xd1, 0x42, 0x7d, 0xe8, 0x73, 0xfb, 0x56, 0xca, 0xdc, 0x7b, 0xe6, 0x2b,
+	0x3b, 0x3b, 0xd9, 0x71, 0xb3, 0x10, 0xfa, 0x94, 0x99, 0x3b, 0xe7, 0x9c, 0xfb, 0xfb, 0xfd, 0xce,
+	0xbd, 0x67, 0xef, 0xb9, 0x81, 0xbd, 0x46, 0x51, 0x57, 0x74, 0xab, 0xc2, 0x14, 0x7d, 0x46, 0x33,
+	0x4d, 0x36, 0xa7, 0x2c, 0x8c, 0x29, 0x37, 0xaa, 0xac, 0xb2, 0x24, 0xdb, 0x15, 0xcb, 0xb5, 0x68,
+	0xbf, 0x51, 0xd4, 0x65, 0xcf, 0x40, 0x46, 0x03, 0x79, 0x61, 0x4c, 0x8a, 0x78, 0xcd, 0x19, 0xcc,
+	0x74, 0x3d, 0x27, 0xf1, 0x24, 0xbc, 0xa4, 0x03, 0xba, 0xe5, 0xcc, 0x5b, 0x8e, 0x52, 0xd4, 0x1c,
+	0x26, 0xc2, 0x29, 0x0b, 0x63, 0x45, 0xe6, 0x6a, 0x63, 0x8a, 0xad, 0x95, 0x0d, 0x53, 0x73, 0x0d,
+	0xcb, 0x44, 0xdb, 0x7d, 0x49, 0x10, 0xfc, 0xc9, 0x84, 0xc9, 0x70, 0xd9, 0xb2, 0xca, 0x73, 0x4c,
+	0xd1, 0x6c, 0x43, 0xd1, 0x4c, 0xd3, 0x72, 0xb9, 0xbf, 0x83, 0x5f, 0x77, 0xe1, 0x57, 0xfe, 0x56,
+	0xac, 0x5e, 0x57, 0x34, 0x13, 0xd1, 0x4b, 0x03, 0x65, 0xab, 0x6c, 0xf1, 0x47, 0xc5, 0x7b, 0x12,
+	0xa3, 0xf9, 0x33, 0xd0, 0xff, 0x9e, 0x87, 0x69, 0x4a, 0x4c, 0xa2, 0xb2, 0x1b, 0x55, 0xe6, 0xb8,
+	0x74, 0x27, 0xf4, 0xd8, 0x56, 0xc5, 0x2d, 0x18, 0xa5, 0x21, 0x32, 0x42, 0x46, 0xfb, 0xd4, 0x8d,
+	0xde, 0xeb, 0x74, 0x89, 0xee, 0x01, 0x40, 0x3c, 0xde, 0xb7, 0x4e, 0xfe, 0xad, 0x0f, 0x47, 0xa6,
+	0x4b, 0xf9, 0x7b, 0x04, 0x06, 0xe2, 0xf1, 0x1c, 0xdb, 0x32, 0x1d, 0x46, 0x8f, 0x40, 0x0f, 0x5a,
+	0xf1, 0x80, 0x9b, 0xc6, 0x87, 0xe5, 0x04, 0x35, 0x65, 0xdf, 0xcd, 0x37, 0xa6, 0x03, 0xb0, 0xc1,
+	0xae, 0x58, 0xd6, 0x75, 0x3e, 0xd5, 0x66, 0x55, 0xbc, 0xd0, 0x29, 0xd8, 0xcc, 0x1f, 0x0a, 0x33,
+	0xcc, 0x28, 0xcf, 0xb8, 0x43, 0x5d, 0x3c, 0xa4, 0x14, 0x09, 0x29, 0x32, 0xb0, 0x30, 0x26, 0xbf,
+	0xcb, 0x2d, 0x4e, 0x74, 0x3f, 0x78, 0xbc, 0xb7, 0x43, 0xdd, 0xc4, 0xbd, 0xc4, 0x50, 0xfe, 0x5a,
+	0x1c, 0xaa, 0xe3, 0x73, 0x3f, 0x09, 0x10, 0x26, 0x06, 0xd1, 0xbe, 0x26, 0x8b, 0x2c, 0xca, 0x5e,
+	0x16, 0x65, 0xb1, 0x28, 0x30, 0x8b, 0xf2, 0x79, 0xad, 0xcc, 0xd0, 0x57, 0x8d, 0x78, 0xe6, 0x1f,
+	0x13, 0x18, 0x5c, 0x31, 0x01, 0x8a, 0x71, 0x02, 0x7a, 0x91, 0x9f, 0x33, 0x44, 0x46, 0xba, 0x78,
+	0xfc, 0x24, 0x35, 0xa6, 0x4b, 0xcc, 0x74, 0x8d, 0xeb, 0x06, 0x2b, 0xf9, 0xba, 0x04, 0x7e, 0xf4,
+	0x54, 0x0c, 0x65, 0x27, 0x47, 0xf9, 0xfa, 0xaa, 0x28, 0x05, 0x80, 0x28, 0x4c, 0x7a, 0x0c, 0x36,
+	0x66, 0x54, 0x11, 0xed, 0xf3, 0x9f, 0x11, 0xc8, 0x09, 0x82, 0x96, 0x69, 0x32, 0xdd, 0x8b, 0xb6,
+	0x52, 0xcb, 0x1c, 0x80, 0x1e, 0x7c, 0xc4, 0xa5, 0x14, 0x19, 0xa1, 0x27, 0x13, 0x58, 0xbc, 0x88,
+	0xd6, 0xcf, 0x09, 0xec, 0x6d, 0x08, 0xe5, 0xff, 0xa5, 0xfa, 0x65, 0x5f, 0x74, 0x81, 0x69, 0x8a,
+	0x5b, 0x5f, 0x70, 0x35, 0x97, 0xb5, 0xba, 0x79, 0x7f, 0x0f, 0x44, 0x4c, 0x08, 0x8d, 0x22, 0x6a,
+	0xb0, 0xd3, 0x08, 0xf4, 0x29, 0x08, 0xa8, 0x05, 0xc7, 0x33, 0xc1, 0x9d, 0xb2, 0x3f, 0x89, 0x48,
+	0x44, 0xd2, 0x48, 0xcc, 0x41, 0x23, 0x69, 0xb8, 0x9d, 0x5b, 0xfe, 0x1e, 0x81, 0x7d, 0x31, 0x86,
+	0x1e, 0x27, 0xd3, 0xa9, 0x3a, 0x6b, 0xa1, 0x1f, 0x7d, 0x15, 0xb6, 0x2e, 0xb0, 0x8a, 0x63, 0x58,
+	0x66, 0xc1, 0xac, 0xce, 0x17, 0x59, 0x85, 0x83, 0xec, 0x56, 0xb7, 0xe0, 0xe8, 0x59, 0x3e, 0x18,
+	0x35, 0x43, 0x2e, 0xdd, 0x31, 0x33, 0xc4, 0xfa, 0x1b, 0x81, 0x7c, 0x1a, 0x56, 0x4c, 0xc8, 0x71,
+	0xd8, 0xa6, 0xfb, 0x5f, 0x62, 0x89, 0x18, 0x90, 0xc5, 0x6f, 0x81, 0xec, 0xff, 0x16, 0xc8, 0x93,
+	0xe6, 0x92, 0xba, 0x55, 0x8f, 0x85, 0xa1, 0xbb, 0xa1, 0x0f, 0x93, 0x18, 0x30, 0xea, 0x15, 0x03,
+	0xd3, 0xa5, 0x30, 0x13, 0x5d, 0x69, 0x99, 0xe8, 0x7e, 0x91, 0x4c, 0x54, 0x60, 0x98, 0x93, 0x3b,
+	0xaf, 0xe9, 0xb3, 0xcc, 0x9d, 0xb2, 0xe6, 0xe7, 0x0d, 0x77, 0x9e, 0x99, 0x6e, 0xab, 0x39, 0x90,
+	0xa0, 0xd7, 0xf1, 0x42, 0x98, 0x3a, 0x43, 0xf5, 0x83, 0xf7, 0xfc, 0x37, 0x04, 0xf6, 0x34, 0x98,
+	0x14, 0xc5, 0xe4, 0xe5, 0xca, 0x1f, 0xe5, 0x13, 0x6f, 0x56, 0x23, 0x23, 0xed, 0x5c, 0x9a, 0xdf,
+	0x36, 0x02, 0xe7, 0xb4, 0x2a, 0x49, 0xbc, 0xc6, 0x76, 0xbd, 0x70, 0x8d, 0xfd, 0xd3, 0x2f, 0xf7,
+	0x09, 0x08, 0x83, 0x12, 0xbb, 0x29, 0x54, 0xcb, 0xaf, 0xb2, 0x23, 0x89, 0x55, 0x56, 0x04, 0x11,
+	0x6b, 0x39, 0xea, 0xb4, 0x1e, 0x4a, 0xac, 0x05, 0xbb, 0x22, 0x44, 0x55, 0xa6, 0x33, 0xc3, 0x6e,
+	0xeb, 0xca, 0xbc, 0x4b, 0x40, 0x4a, 0x9a, 0x11, 0x65, 0x95, 0xa0, 0xb7, 0xe2, 0x0d, 0x2d, 0x30,
+	0x11, 0xb7, 0x57, 0x0d, 0xde, 0xdb, 0xb9, 0x47, 0x6f, 0xc2, 0xbe, 0x08, 0xa8, 0x49, 0x7d, 0xd6,
+	0xb4, 0x6e, 0xce, 0xb1, 0x52, 0x99, 0xb5, 0x7b, 0xa3, 0xde, 0xf3, 0x4b, 0x5f, 0x83, 0x99, 0x51,
+	0x96, 0x51, 0xd8, 0xa6, 0xc5, 0x3f, 0xe1, 0x96, 0x5d, 0x39, 0xdc, 0xce, 0x7d, 0xfb, 0x5d, 0x2a,
+	0xd6, 0x75, 0xb3, 0x79, 0xff, 0x26, 0xf0, 0x72, 0x2a, 0x4c, 0xd4, 0xf4, 0x34, 0x6c, 0x5f, 0x21,
+	0x5e, 0xf3, 0xdb, 0xb8, 0xce, 0x73, 0x3d, 0xec, 0xe5, 0xaf, 0xfd, 0xba, 0x7a, 0xc9, 0xf4, 0xf7,
+	0x8c, 0xc0, 0xdc, 0x72, 0x6a, 0xde, 0x86, 0xdd, 0x36, 0x8f, 0x54, 0x08, 0xcb, 0x57, 0xc1, 0x5f,
+	0xc3, 0xce, 0x50, 0xd7, 0x48, 0xd7, 0x68, 0xb7, 0xba, 0xcb, 0x5e, 0x51, 0x2c, 0x2f, 0xf8, 0x06,
+	0xf9, 0x45, 0xc8, 0x35, 0x02, 0x86, 0xc9, 0x18, 0x86, 0xbe, 0x30, 0x1e, 0xe1, 0xf1, 0xc2, 0x81,
+	0x88, 0x26, 0x9d, 0x19, 0x35, 0xb9, 0xed, 0x97, 0x9b, 0x70, 0xea, 0x49, 0x7d, 0xb6, 0x65, 0x41,
+	0x0e, 0xc1, 0x00, 0x0a, 0xa2, 0xe9, 0xb3, 0x75, 0x4a, 0x50, 0xdb, 0x5f, 0x79, 0xa1, 0x04, 0x55,
+	0xd8, 0x9d, 0x88, 0xa3, 0xcd, 0xfc, 0xaf, 0xe0, 0x39, 0xf7, 0x2c, 0x5b, 0x0c, 0xf2, 0xa1, 0x0a,
+	0x00, 0xad, 0x9e, 0xa1, 0x7f, 0x20, 0x30, 0xd2, 0x38, 0x36, 0xf2, 0x1a, 0x87, 0x41, 0x93, 0x2d,
+	0x86, 0x8b, 0xa5, 0x80, 0xec, 0xf9, 0x54, 0xdd, 0x6a, 0xbf, 0x59, 0xef, 0xdb, 0xce, 0x12, 0xe6,
+	0xdf, 0x01, 0x5c, 0xb2, 0xcb, 0x15, 0xad, 0xd4, 0xb2, 0x04, 0xf7, 0xfd, 0x3b, 0x80, 0x20, 0x1e,
+	0xd2, 0x7e, 0x0b, 0x7a, 0xaa, 0x62, 0x28, 0xf5, 0x0e, 0x00, 0xdd, 0x10, 0xa9, 0xef, 0xd2, 0x46,
+	0x01, 0xc6, 0xff, 0xdd, 0x09, 0x1b, 0x38, 0x62, 0xfa, 0x3d, 0x81, 0x1e, 0x3c, 0x6f, 0xd3, 0xd1,
+	0x44, 0x74, 0x09, 0xb7, 0x25, 0xd2, 0xfe, 0x26, 0x2c, 0x85, 0x06, 0xf9, 0x53, 0x9f, 0xfe, 0xf4,
+	0xc7, 0xdd, 0xce, 0x49, 0xfa, 0x8e, 0x92, 0x70, 0xd5, 0x23, 0x6e, 0x85, 0xf0, 0xdd, 0x51, 0x96,
+	0x43, 0x95, 0x6b, 0x8a, 0xa7, 0xbd, 0xa3, 0x2c, 0x63, 0x46, 0x6a, 0xf4, 0x0e, 0x81, 0x5e, 0x0c,
+	0xee, 0xd0, 0xd5, 0x01, 0xf8, 0x9b, 0x5b, 0x3a, 0xd0, 0x8c, 0x29, 0x82, 0x3d, 0xc0, 0xc1, 0xbe,
+	0x42, 0xf3, 0xab, 0x83, 0xa5, 0x3f, 0x12, 0xa0, 0xf5, 0xcd, 0x37, 0x9d, 0x48, 0x99, 0xae, 0xd1,
+	0xad, 0x81, 0x74, 0x38, 0x9b, 0x13, 0xa2, 0x9d, 0xe2, 0x68, 0x8f, 0xd3, 0x37, 0x53, 0xd0, 0x06,
+	0xde, 0x9e, 0xba, 0xc1, 0x4b, 0x2d, 0xa4, 0xf1, 0x8b, 0x47, 0xa3, 0xae, 0xfd, 0x4d, 0xa5, 0xd1,
+	0xa8, 0x0f, 0x97, 0x0e, 0x67, 0x73, 0x42, 0x1a, 0x17, 0x39, 0x8d, 0xb3, 0xf4, 0x74, 0x8b, 0x2b,
+	0x44, 0x89, 0x36, 0xe7, 0xf4, 0xab, 0x4e, 0x18, 0x4c, 0x6c, 0x24, 0xe9, 0x91, 0xd5, 0x51, 0x26,
+	0x75, 0xc9, 0xd2, 0xd1, 0xcc, 0x7e, 0x48, 0xf0, 0x73, 0xc2, 0x19, 0xde, 0x22, 0xf4, 0x63, 0xd2,
+	0x32, 0xc7, 0x78, 0xeb, 0xab, 0x60, 0x0b, 0xad, 0x2c, 0xc7, 0x1b, 0xf1, 0x9a, 0x22, 0xca, 0x43,
+	0x38, 0x2e, 0xde, 0x6b, 0xf4, 0x09, 0x81, 0xed, 0x2b, 0xfb, 0x19, 0x3a, 0xd6, 0x98, 0x5a, 0x83,
+	0x7e, 0x55, 0x1a, 0xcf, 0xe2, 0x82, 0x42, 0x30, 0xae, 0x43, 0x81, 0x7e, 0xd4, 0xaa, 0x0a, 0x75,
+	0xc7, 0x10, 0x47, 0x59, 0xf6, 0x7f, 0x5b, 0x6a, 0xf4, 0x11, 0x81, 0x1d, 0x75, 0x2d, 0x1b, 0xcd,
+	0x00, 0x38, 0xd8, 0x97, 0x13, 0x99, 0x7c, 0x90, 0xe5, 0x55, 0xce, 0xf2, 0x22, 0x55, 0xd7, 0x9e,
+	0x25, 0xfd, 0x99, 0xc0, 0x96, 0x58, 0xcb, 0x44, 0xe5, 0xd5, 0x20, 0xc6, 0xbb, 0x39, 0x49, 0x69,
+	0xda, 0x1e, 0xe9, 0x14, 0x39, 0x9d, 0x0f, 0xe9, 0xd5, 0x35, 0xa2, 0x53, 0x11, 0xf1, 0x63, 0x19,
+	0x7b, 0x4e, 0x60, 0x30, 0xf1, 0x9c, 0x9e, 0xb6, 0x59, 0xd3, 0xba, 0x34, 0xe9, 0x68, 0x66, 0x3f,
+	0xa4, 0x7b, 0x8d, 0xd3, 0xbd, 0x4c, 0xdf, 0x5f, 0x23, 0xba, 0x9a, 0x3e, 0x1b, 0xa3, 0xfa, 0x17,
+	0x81, 0x97, 0x92, 0x5b, 0x12, 0x9a, 0x15, 0x73, 0xb0, 0x4c, 0x8f, 0x65, 0x77, 0x44, 0xb6, 0x05,
+	0xce, 0xf6, 0x0a, 0xfd, 0x60, 0xed, 0xd8, 0xc6, 0x39, 0x7d, 0xd1, 0x09, 0x3b, 0xea, 0xce, 0xfb,
+	0x69, 0x7b, 0xb1, 0x51, 0xd7, 0x22, 0x4d, 0x64, 0xf2, 0x41, 0x7e, 0x77, 0x44, 0xe9, 0xbd, 0x4d,
+	0xe8, 0x2d, 0xd2, 0x8e, 0xa2, 0x93, 0xd2, 0x0f, 0xd5, 0x94, 0x6a, 0x00, 0xab, 0x60, 0x23, 0xf1,
+	0x7f, 0x08, 0x6c, 0x8d, 0x9f, 0xfd, 0xa9, 0xd2, 0x0c, 0xaf, 0x48, 0xb7, 0x22, 0x1d, 0x6a, 0xde,
+	0x01, 0x55, 0xf8, 0x44, 0xa8, 0xb0, 0x4c, 0x97, 0xda, 0xa8, 0x41, 0xac, 0x05, 0x8a, 0x91, 0xf7,
+	0xb6, 0x00, 0xfd, 0x95, 0x40, 0x7f, 0x42, 0x8b, 0x40, 0x53, 0xce, 0x0c, 0x8d, 0xbb, 0x15, 0xe9,
+	0x8d, 0x8c, 0x5e, 0x28, 0xc4, 0x25, 0xae, 0xc3, 0x39, 0x7a, 0xa6, 0x55, 0x1d, 0x62, 0xdd, 0x0c,
+	0xbd, 0x4f, 0xa0, 0x07, 0x0f, 0xf1, 0x69, 0x87, 0xe8, 0x78, 0xbb, 0x21, 0xed, 0x6f, 0xc2, 0x12,
+	0x71, 0x9f, 0xe3, 0xb8, 0xa7, 0xe9, 0xa9, 0x56, 0x71, 0x63, 0x6f, 0x71, 0x42, 0x7d, 0xf0, 0x34,
+	0x47, 0x1e, 0x3e, 0xcd, 0x91, 0x27, 0x4f, 0x73, 0xe4, 0xcb, 0x67, 0xb9, 0x8e, 0x87, 0xcf, 0x72,
+	0x1d, 0x8f, 0x9e, 0xe5, 0x3a, 0xae, 0x1e, 0x2b, 0x1b, 0xee, 0x4c, 0xb5, 0x28, 0xeb, 0xd6, 0xbc,
+	0x82, 0xff, 0xc8, 0x15, 0x7f, 0x0e, 0x3a, 0xa5, 0x59, 0x65, 0x31, 0x04, 0x70, 0xe8, 0xf0, 0x41,
+	0x1f, 0x83, 0xbb, 0x64, 0x33, 0xa7, 0xb8, 0x91, 0xdf, 0xbb, 0x4f, 0xfc, 0x37, 0x00, 0x3d, 0x55,
+	0x5b, 0x6b, 0x57, 0x1e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1840,6 +1965,8 @@ type QueryClient interface {
 	UnreceivedAcks(ctx context.Context, in *QueryUnreceivedAcksRequest, opts ...grpc.CallOption) (*QueryUnreceivedAcksResponse, error)
 	// NextSequenceReceive returns the next receive sequence for a given channel.
 	NextSequenceReceive(ctx context.Context, in *QueryNextSequenceReceiveRequest, opts ...grpc.CallOption) (*QueryNextSequenceReceiveResponse, error)
+	// Upgrade returns the upgrade handshake in progress for a given channel.
+	Upgrade(ctx context.Context, in *QueryUpgradeRequest, opts ...grpc.CallOption) (*QueryUpgradeResponse, error)
 }
 
 type queryClient struct {
@@ -1967,6 +2094,15 @@ func (c *queryClient) NextSequenceReceive(ctx context.Context, in *QueryNextSequ
 	return out, nil
 }
 
+func (c *queryClient) Upgrade(ctx context.Context, in *QueryUpgradeRequest, opts ...grpc.CallOption) (*QueryUpgradeResponse, error) {
+	out := new(QueryUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Query/Upgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Channel queries an IBC Channel.
@@ -2002,6 +2138,8 @@ type QueryServer interface {
 	UnreceivedAcks(context.Context, *QueryUnreceivedAcksRequest) (*QueryUnreceivedAcksResponse, error)
 	// NextSequenceReceive returns the next receive sequence for a given channel.
 	NextSequenceReceive(context.Context, *QueryNextSequenceReceiveRequest) (*QueryNextSequenceReceiveResponse, error)
+	// Upgrade returns the upgrade handshake in progress for a given channel.
+	Upgrade(context.Context, *QueryUpgradeRequest) (*QueryUpgradeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2047,6 +2185,9 @@ func (*UnimplementedQueryServer) UnreceivedAcks(ctx context.Context, req *QueryU
 func (*UnimplementedQueryServer) NextSequenceReceive(ctx context.Context, req *QueryNextSequenceReceiveRequest) (*QueryNextSequenceReceiveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextSequenceReceive not implemented")
 }
+func (*UnimplementedQueryServer) Upgrade(ctx context.Context, req *QueryUpgradeRequest) (*QueryUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upgrade not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2286,6 +2427,24 @@ func _Query_NextSequenceReceive_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Upgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Upgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Query/Upgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Upgrade(ctx, req.(*QueryUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.channel.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2342,6 +2501,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NextSequenceReceive",
 			Handler:    _Query_NextSequenceReceive_Handler,
 		},
+		{
+			MethodName: "Upgrade",
+			Handler:    _Query_Upgrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/channel/v1/query.proto",
@@ -3606,6 +3769,93 @@ func (m *QueryNextSequenceReceiveResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryUpgradeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -4126,6 +4376,40 @@ func (m *QueryNextSequenceReceiveResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryUpgradeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Upgrade.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7983,6 +8267,276 @@ func (m *QueryNextSequenceReceiveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryUpgradeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
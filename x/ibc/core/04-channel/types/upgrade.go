@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
+)
+
+// NewUpgrade creates a new Upgrade instance.
+func NewUpgrade(portID, channelID string, restoreChannel Channel) Upgrade {
+	return Upgrade{
+		PortId:         portID,
+		ChannelId:      channelID,
+		RestoreChannel: restoreChannel,
+	}
+}
+
+// ValidateBasic performs a basic validation of the upgrade fields.
+func (u Upgrade) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(u.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(u.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	if u.RestoreChannel.State != OPEN {
+		return sdkerrors.Wrapf(
+			ErrInvalidChannelState,
+			"restore channel state must be OPEN (got %s)", u.RestoreChannel.State,
+		)
+	}
+	return u.RestoreChannel.ValidateBasic()
+}
+
+// ValidateUpgradeFields performs a basic validation of the ordering, connection
+// hops and version proposed in a channel upgrade.
+func ValidateUpgradeFields(ordering Order, connectionHops []string, version string) error {
+	if !(ordering == ORDERED || ordering == UNORDERED) {
+		return sdkerrors.Wrap(ErrInvalidChannelOrdering, ordering.String())
+	}
+	if len(connectionHops) != 1 {
+		return sdkerrors.Wrap(
+			ErrTooManyConnectionHops,
+			"current IBC version only supports one connection hop",
+		)
+	}
+	if err := host.ConnectionIdentifierValidator(connectionHops[0]); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection hop ID")
+	}
+	return nil
+}
@@ -58,21 +58,71 @@ type IBCModule interface {
 		channelID string,
 	) error
 
+	// OnChanUpgradeInit must return an error to reject the ordering,
+	// connection hops or version proposed in a channel upgrade.
+	OnChanUpgradeInit(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		version string,
+	) error
+
+	// OnChanUpgradeTry must return an error to reject the ordering,
+	// connection hops or version of an upgrade proposed by the counterparty.
+	OnChanUpgradeTry(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		version,
+		counterpartyVersion string,
+	) error
+
+	OnChanUpgradeAck(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		counterpartyVersion string,
+	) error
+
+	OnChanUpgradeConfirm(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+	) error
+
+	// OnChanUpgradeRestore is called when an upgrade times out or is
+	// cancelled and the channel end is restored to its previous fields.
+	OnChanUpgradeRestore(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+	) error
+
 	// OnRecvPacket must return the acknowledgement bytes
 	// In the case of an asynchronous acknowledgement, nil should be returned.
+	// The relayer is the signer of the MsgRecvPacket.
 	OnRecvPacket(
 		ctx sdk.Context,
 		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
 	) (*sdk.Result, []byte, error)
 
+	// The relayer is the signer of the MsgAcknowledgement.
 	OnAcknowledgementPacket(
 		ctx sdk.Context,
 		packet channeltypes.Packet,
 		acknowledgement []byte,
+		relayer sdk.AccAddress,
 	) (*sdk.Result, error)
 
+	// The relayer is the signer of the MsgTimeout or MsgTimeoutOnClose.
 	OnTimeoutPacket(
 		ctx sdk.Context,
 		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
 	) (*sdk.Result, error)
 }
@@ -35,6 +35,7 @@ const (
 	KeyPortPrefix              = "ports"
 	KeySequencePrefix          = "sequences"
 	KeyChannelCapabilityPrefix = "capabilities"
+	KeyChannelUpgradePrefix    = "channelUpgrades"
 	KeyNextSeqSendPrefix       = "nextSequenceSend"
 	KeyNextSeqRecvPrefix       = "nextSequenceRecv"
 	KeyNextSeqAckPrefix        = "nextSequenceAck"
@@ -142,6 +143,18 @@ func ChannelCapabilityPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s", KeyChannelCapabilityPrefix, channelPath(portID, channelID))
 }
 
+// ChannelUpgradePath defines the path under which the upgrade handshake in
+// progress for a channel is stored
+func ChannelUpgradePath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyChannelUpgradePrefix, channelPath(portID, channelID))
+}
+
+// ChannelUpgradeKey returns the store key for the upgrade handshake in progress
+// for a particular channel
+func ChannelUpgradeKey(portID, channelID string) []byte {
+	return []byte(ChannelUpgradePath(portID, channelID))
+}
+
 // NextSequenceSendPath defines the next send sequence counter store path
 func NextSequenceSendPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s", KeyNextSeqSendPrefix, channelPath(portID, channelID))
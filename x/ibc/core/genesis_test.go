@@ -133,6 +133,7 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 					[]channeltypes.PacketSequence{
 						channeltypes.NewPacketSequence(port2, channel2, 1),
 					},
+					[]channeltypes.Upgrade{},
 				),
 			},
 			expPass: true,
@@ -272,6 +273,7 @@ func (suite *IBCTestSuite) TestInitGenesis() {
 					[]channeltypes.PacketSequence{
 						channeltypes.NewPacketSequence(port2, channel2, 1),
 					},
+					[]channeltypes.Upgrade{},
 				),
 			},
 		},
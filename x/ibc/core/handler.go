@@ -74,6 +74,26 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := k.ChannelCloseConfirm(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *channeltypes.MsgChannelUpgradeTry:
+			res, err := k.ChannelUpgradeTry(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *channeltypes.MsgChannelUpgradeAck:
+			res, err := k.ChannelUpgradeAck(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *channeltypes.MsgChannelUpgradeConfirm:
+			res, err := k.ChannelUpgradeConfirm(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *channeltypes.MsgChannelUpgradeTimeout:
+			res, err := k.ChannelUpgradeTimeout(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *channeltypes.MsgChannelUpgradeCancel:
+			res, err := k.ChannelUpgradeCancel(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		// IBC packet msgs get routed to the appropriate module callback
 		case *channeltypes.MsgRecvPacket:
 			res, err := k.RecvPacket(sdk.WrapSDKContext(ctx), msg)
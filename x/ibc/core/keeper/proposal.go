@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	porttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/05-port/types"
+)
+
+// ChannelUpgradeProposal proposes the upgrade of a channel to the counterparty
+// chain once the proposal passes. The module bound to the channel must accept
+// the proposed ordering, connection hops and version.
+func (k Keeper) ChannelUpgradeProposal(ctx sdk.Context, p *channeltypes.ChannelUpgradeProposal) error {
+	// Lookup module by channel capability
+	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, p.PortId, p.ChannelId)
+	if err != nil {
+		return sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	if err := k.ChannelKeeper.ChanUpgradeInit(
+		ctx, p.PortId, p.ChannelId, cap, p.Ordering, p.ConnectionHops, p.Version,
+		p.TimeoutHeight, p.TimeoutTimestamp,
+	); err != nil {
+		return sdkerrors.Wrap(err, "channel upgrade init failed")
+	}
+
+	if err := cbs.OnChanUpgradeInit(ctx, p.PortId, p.ChannelId, p.Ordering, p.ConnectionHops, p.Version); err != nil {
+		return sdkerrors.Wrap(err, "channel upgrade init callback failed")
+	}
+
+	channel, _ := k.ChannelKeeper.GetChannel(ctx, p.PortId, p.ChannelId)
+
+	// emitting events in the keeper for proposal upgrades of channels
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			channeltypes.EventTypeChannelUpgradeInit,
+			sdk.NewAttribute(channeltypes.AttributeKeyPortID, p.PortId),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, p.ChannelId),
+			sdk.NewAttribute(channeltypes.AttributeCounterpartyPortID, channel.Counterparty.PortId),
+			sdk.NewAttribute(channeltypes.AttributeCounterpartyChannelID, channel.Counterparty.ChannelId),
+			sdk.NewAttribute(channeltypes.AttributeKeyConnectionID, channel.ConnectionHops[0]),
+			sdk.NewAttribute(channeltypes.AttributeKeyUpgradeSequence, fmt.Sprintf("%d", channel.UpgradeSequence)),
+			sdk.NewAttribute(channeltypes.AttributeKeyUpgradeOrdering, channel.Ordering.String()),
+			sdk.NewAttribute(channeltypes.AttributeKeyUpgradeVersion, channel.Version),
+		),
+	)
+
+	return nil
+}
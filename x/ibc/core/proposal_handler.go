@@ -0,0 +1,24 @@
+package ibc
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/keeper"
+)
+
+// NewChannelUpgradeProposalHandler defines the channel upgrade proposal handler.
+// The IBC keeper is passed by reference since its router is set after the
+// governance router is sealed.
+func NewChannelUpgradeProposalHandler(k *keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *channeltypes.ChannelUpgradeProposal:
+			return k.ChannelUpgradeProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ibc channel proposal content type: %T", c)
+		}
+	}
+}
@@ -829,6 +829,119 @@ func (chain *TestChain) ChanCloseInit(
 	return chain.sendMsgs(msg)
 }
 
+// ChanUpgradeInit simulates the passing of a ChannelUpgradeProposal which
+// proposes the upgrade of the channel to the counterparty chain.
+func (chain *TestChain) ChanUpgradeInit(
+	ch TestChannel,
+	order channeltypes.Order,
+	connectionID, version string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+) error {
+	proposal := channeltypes.NewChannelUpgradeProposal(
+		Title, Description, ch.PortID, ch.ID,
+		order, []string{connectionID}, version,
+		timeoutHeight, timeoutTimestamp,
+	)
+
+	// no need to send message, acting as the governance module which only
+	// writes the state changes of a proposal if its handler succeeds
+	cacheCtx, writeCache := chain.GetContext().CacheContext()
+	if err := chain.App.IBCKeeper.ChannelUpgradeProposal(cacheCtx, proposal); err != nil {
+		return err
+	}
+	writeCache()
+
+	// commit changes
+	chain.App.Commit()
+	chain.NextBlock()
+
+	return nil
+}
+
+// ChanUpgradeTry will construct and execute a MsgChannelUpgradeTry.
+func (chain *TestChain) ChanUpgradeTry(
+	counterparty *TestChain,
+	ch, counterpartyCh TestChannel,
+	order channeltypes.Order,
+	connectionID, version string,
+) error {
+	proof, height := counterparty.QueryProof(host.ChannelKey(counterpartyCh.PortID, counterpartyCh.ID))
+	counterpartyChannel := counterparty.GetChannel(counterpartyCh)
+
+	msg := channeltypes.NewMsgChannelUpgradeTry(
+		ch.PortID, ch.ID,
+		order, []string{connectionID}, version,
+		counterpartyChannel.Version, counterpartyChannel.UpgradeSequence,
+		counterpartyChannel.UpgradeTimeoutHeight, counterpartyChannel.UpgradeTimeoutTimestamp,
+		proof, height,
+		chain.SenderAccount.GetAddress(),
+	)
+	return chain.sendMsgs(msg)
+}
+
+// ChanUpgradeAck will construct and execute a MsgChannelUpgradeAck.
+func (chain *TestChain) ChanUpgradeAck(
+	counterparty *TestChain,
+	ch, counterpartyCh TestChannel,
+) error {
+	proof, height := counterparty.QueryProof(host.ChannelKey(counterpartyCh.PortID, counterpartyCh.ID))
+
+	msg := channeltypes.NewMsgChannelUpgradeAck(
+		ch.PortID, ch.ID,
+		counterparty.GetChannel(counterpartyCh).Version,
+		proof, height,
+		chain.SenderAccount.GetAddress(),
+	)
+	return chain.sendMsgs(msg)
+}
+
+// ChanUpgradeConfirm will construct and execute a MsgChannelUpgradeConfirm.
+func (chain *TestChain) ChanUpgradeConfirm(
+	counterparty *TestChain,
+	ch, counterpartyCh TestChannel,
+) error {
+	proof, height := counterparty.QueryProof(host.ChannelKey(counterpartyCh.PortID, counterpartyCh.ID))
+
+	msg := channeltypes.NewMsgChannelUpgradeConfirm(
+		ch.PortID, ch.ID,
+		proof, height,
+		chain.SenderAccount.GetAddress(),
+	)
+	return chain.sendMsgs(msg)
+}
+
+// ChanUpgradeTimeout will construct and execute a MsgChannelUpgradeTimeout.
+func (chain *TestChain) ChanUpgradeTimeout(
+	counterparty *TestChain,
+	ch, counterpartyCh TestChannel,
+) error {
+	proof, height := counterparty.QueryProof(host.ChannelKey(counterpartyCh.PortID, counterpartyCh.ID))
+
+	msg := channeltypes.NewMsgChannelUpgradeTimeout(
+		ch.PortID, ch.ID,
+		counterparty.GetChannel(counterpartyCh),
+		proof, height,
+		chain.SenderAccount.GetAddress(),
+	)
+	return chain.sendMsgs(msg)
+}
+
+// ChanUpgradeCancel will construct and execute a MsgChannelUpgradeCancel.
+func (chain *TestChain) ChanUpgradeCancel(
+	counterparty *TestChain,
+	ch, counterpartyCh TestChannel,
+) error {
+	proof, height := counterparty.QueryProof(host.ChannelKey(counterpartyCh.PortID, counterpartyCh.ID))
+
+	msg := channeltypes.NewMsgChannelUpgradeCancel(
+		ch.PortID, ch.ID,
+		counterparty.GetChannel(counterpartyCh),
+		proof, height,
+		chain.SenderAccount.GetAddress(),
+	)
+	return chain.sendMsgs(msg)
+}
+
 // GetPacketData returns a ibc-transfer marshalled packet to be used for
 // callback testing.
 func (chain *TestChain) GetPacketData(counterparty *TestChain) []byte {
@@ -10,6 +10,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
 	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
 	host "github.com/cosmos/cosmos-sdk/x/ibc/core/24-host"
 	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
@@ -615,6 +616,119 @@ func (coord *Coordinator) ChanCloseInit(
 	)
 }
 
+// ChanUpgradeInit proposes the upgrade of a channel on the source chain
+// resulting in the channel state being set to INITUPGRADE.
+func (coord *Coordinator) ChanUpgradeInit(
+	source, counterparty *TestChain,
+	sourceChannel TestChannel,
+	order channeltypes.Order,
+	connectionID, version string,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+) error {
+	if err := source.ChanUpgradeInit(sourceChannel, order, connectionID, version, timeoutHeight, timeoutTimestamp); err != nil {
+		return err
+	}
+	coord.IncrementTime()
+
+	// update source client on counterparty connection
+	return coord.UpdateClient(
+		counterparty, source,
+		sourceChannel.CounterpartyClientID, exported.Tendermint,
+	)
+}
+
+// ChanUpgradeTry accepts the upgrade of the counterparty channel on the source
+// chain resulting in the channel state being set to TRYUPGRADE.
+func (coord *Coordinator) ChanUpgradeTry(
+	source, counterparty *TestChain,
+	sourceChannel, counterpartyChannel TestChannel,
+	order channeltypes.Order,
+	connectionID, version string,
+) error {
+	if err := source.ChanUpgradeTry(counterparty, sourceChannel, counterpartyChannel, order, connectionID, version); err != nil {
+		return err
+	}
+	coord.IncrementTime()
+
+	// update source client on counterparty connection
+	return coord.UpdateClient(
+		counterparty, source,
+		sourceChannel.CounterpartyClientID, exported.Tendermint,
+	)
+}
+
+// ChanUpgradeAck completes the upgrade of the channel on the source chain
+// resulting in the channel state being set to OPEN.
+func (coord *Coordinator) ChanUpgradeAck(
+	source, counterparty *TestChain,
+	sourceChannel, counterpartyChannel TestChannel,
+) error {
+	if err := source.ChanUpgradeAck(counterparty, sourceChannel, counterpartyChannel); err != nil {
+		return err
+	}
+	coord.IncrementTime()
+
+	// update source client on counterparty connection
+	return coord.UpdateClient(
+		counterparty, source,
+		sourceChannel.CounterpartyClientID, exported.Tendermint,
+	)
+}
+
+// ChanUpgradeConfirm completes the upgrade of the channel on the source chain
+// resulting in the channel state being set to OPEN.
+func (coord *Coordinator) ChanUpgradeConfirm(
+	source, counterparty *TestChain,
+	sourceChannel, counterpartyChannel TestChannel,
+) error {
+	if err := source.ChanUpgradeConfirm(counterparty, sourceChannel, counterpartyChannel); err != nil {
+		return err
+	}
+	coord.IncrementTime()
+
+	// update source client on counterparty connection
+	return coord.UpdateClient(
+		counterparty, source,
+		sourceChannel.CounterpartyClientID, exported.Tendermint,
+	)
+}
+
+// ChanUpgradeTimeout restores the channel on the source chain once its
+// upgrade timed out on the counterparty chain.
+func (coord *Coordinator) ChanUpgradeTimeout(
+	source, counterparty *TestChain,
+	sourceChannel, counterpartyChannel TestChannel,
+) error {
+	if err := source.ChanUpgradeTimeout(counterparty, sourceChannel, counterpartyChannel); err != nil {
+		return err
+	}
+	coord.IncrementTime()
+
+	// update source client on counterparty connection
+	return coord.UpdateClient(
+		counterparty, source,
+		sourceChannel.CounterpartyClientID, exported.Tendermint,
+	)
+}
+
+// ChanUpgradeCancel restores the channel on the source chain once the
+// counterparty channel is OPEN without the upgrade.
+func (coord *Coordinator) ChanUpgradeCancel(
+	source, counterparty *TestChain,
+	sourceChannel, counterpartyChannel TestChannel,
+) error {
+	if err := source.ChanUpgradeCancel(counterparty, sourceChannel, counterpartyChannel); err != nil {
+		return err
+	}
+	coord.IncrementTime()
+
+	// update source client on counterparty connection
+	return coord.UpdateClient(
+		counterparty, source,
+		sourceChannel.CounterpartyClientID, exported.Tendermint,
+	)
+}
+
 // SetChannelClosed sets a channel state to CLOSED.
 func (coord *Coordinator) SetChannelClosed(
 	source, counterparty *TestChain,
@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
@@ -24,6 +25,10 @@ import (
 
 const (
 	ModuleName = "mock"
+
+	// MockRejectedVersion is a channel version the mock module rejects in
+	// channel upgrades.
+	MockRejectedVersion = "mock-rejected-version"
 )
 
 var (
@@ -105,6 +110,9 @@ func (am AppModule) LegacyQuerierHandler(*codec.LegacyAmino) sdk.Querier {
 // RegisterServices implements the AppModule interface.
 func (am AppModule) RegisterServices(module.Configurator) {}
 
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
+
 // InitGenesis implements the AppModule interface.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data json.RawMessage) []abci.ValidatorUpdate {
 	return []abci.ValidatorUpdate{}
@@ -172,17 +180,48 @@ func (am AppModule) OnChanCloseConfirm(sdk.Context, string, string) error {
 	return nil
 }
 
+// OnChanUpgradeInit implements the IBCModule interface.
+func (am AppModule) OnChanUpgradeInit(_ sdk.Context, _, _ string, _ channeltypes.Order, _ []string, version string) error {
+	if version == MockRejectedVersion {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "rejected version %s", version)
+	}
+	return nil
+}
+
+// OnChanUpgradeTry implements the IBCModule interface.
+func (am AppModule) OnChanUpgradeTry(_ sdk.Context, _, _ string, _ channeltypes.Order, _ []string, version, _ string) error {
+	if version == MockRejectedVersion {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "rejected version %s", version)
+	}
+	return nil
+}
+
+// OnChanUpgradeAck implements the IBCModule interface.
+func (am AppModule) OnChanUpgradeAck(sdk.Context, string, string, string) error {
+	return nil
+}
+
+// OnChanUpgradeConfirm implements the IBCModule interface.
+func (am AppModule) OnChanUpgradeConfirm(sdk.Context, string, string) error {
+	return nil
+}
+
+// OnChanUpgradeRestore implements the IBCModule interface.
+func (am AppModule) OnChanUpgradeRestore(sdk.Context, string, string) error {
+	return nil
+}
+
 // OnRecvPacket implements the IBCModule interface.
-func (am AppModule) OnRecvPacket(sdk.Context, channeltypes.Packet) (*sdk.Result, []byte, error) {
+func (am AppModule) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) (*sdk.Result, []byte, error) {
 	return nil, MockAcknowledgement, nil
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface.
-func (am AppModule) OnAcknowledgementPacket(sdk.Context, channeltypes.Packet, []byte) (*sdk.Result, error) {
+func (am AppModule) OnAcknowledgementPacket(sdk.Context, channeltypes.Packet, []byte, sdk.AccAddress) (*sdk.Result, error) {
 	return nil, nil
 }
 
 // OnTimeoutPacket implements the IBCModule interface.
-func (am AppModule) OnTimeoutPacket(sdk.Context, channeltypes.Packet) (*sdk.Result, error) {
+func (am AppModule) OnTimeoutPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) (*sdk.Result, error) {
 	return nil, nil
 }
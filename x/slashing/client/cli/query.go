@@ -28,6 +28,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryMissedBlocks(),
+		GetCmdQueryJailEvents(),
 	)
 
 	return slashingQueryCmd
@@ -114,6 +116,100 @@ $ <appd> query slashing signing-infos
 	return cmd
 }
 
+// GetCmdQueryMissedBlocks implements the command to query the missed block bit
+// array of a validator.
+func GetCmdQueryMissedBlocks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "missed-blocks [validator-conspub]",
+		Short: "Query the blocks a validator missed in the current signed blocks window",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the missed block bit array of the current signed blocks window for that validator:
+
+$ <appd> query slashing missed-blocks cosmosvalconspub1zcjduepqfhvwcmt7p06fvdgexxhmz0l8c7sgswl7ulv7aulk364x4g5xsw7sr0k2g5
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			consAddr := sdk.ConsAddress(pk.Address())
+			params := &types.QueryMissedBlocksRequest{ConsAddress: consAddr.String(), Pagination: pageReq}
+			res, err := queryClient.MissedBlocks(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "missed blocks")
+
+	return cmd
+}
+
+// GetCmdQueryJailEvents implements the command to query the jail history of a
+// validator.
+func GetCmdQueryJailEvents() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jail-events [validator-conspub]",
+		Short: "Query the jail history of a validator",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the times that validator was jailed, the reason and the amount slashed:
+
+$ <appd> query slashing jail-events cosmosvalconspub1zcjduepqfhvwcmt7p06fvdgexxhmz0l8c7sgswl7ulv7aulk364x4g5xsw7sr0k2g5
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			consAddr := sdk.ConsAddress(pk.Address())
+			params := &types.QueryJailEventsRequest{ConsAddress: consAddr.String(), Pagination: pageReq}
+			res, err := queryClient.JailEvents(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "jail events")
+
+	return cmd
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
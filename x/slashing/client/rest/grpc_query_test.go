@@ -98,6 +98,44 @@ func (s *IntegrationTestSuite) TestGRPCQueries() {
 			&types.QuerySigningInfoResponse{},
 			nil,
 		},
+		{
+			"get missed blocks (height specific)",
+			fmt.Sprintf("%s/cosmos/slashing/v1beta1/signing_infos/%s/missed_blocks?pagination.limit=2", baseURL, consAddr),
+			map[string]string{
+				grpctypes.GRPCBlockHeightHeader: "1",
+			},
+			false,
+			&types.QueryMissedBlocksResponse{},
+			&types.QueryMissedBlocksResponse{
+				MissedBlocks: []types.MissedBlock{
+					types.NewMissedBlock(0, false),
+					types.NewMissedBlock(1, false),
+				},
+				Pagination: &query.PageResponse{
+					NextKey: sdk.Uint64ToBigEndian(2),
+				},
+			},
+		},
+		{
+			"get missed blocks wrong address",
+			fmt.Sprintf("%s/cosmos/slashing/v1beta1/signing_infos/%s/missed_blocks", baseURL, "wrongAddress"),
+			map[string]string{},
+			true,
+			&types.QueryMissedBlocksResponse{},
+			nil,
+		},
+		{
+			"get jail events (height specific)",
+			fmt.Sprintf("%s/cosmos/slashing/v1beta1/signing_infos/%s/jail_events", baseURL, consAddr),
+			map[string]string{
+				grpctypes.GRPCBlockHeightHeader: "1",
+			},
+			false,
+			&types.QueryJailEventsResponse{},
+			&types.QueryJailEventsResponse{
+				Pagination: &query.PageResponse{},
+			},
+		},
 		{
 			"params",
 			fmt.Sprintf("%s/cosmos/slashing/v1beta1/params", baseURL),
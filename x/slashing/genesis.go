@@ -39,6 +39,16 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, stakingKeeper types.Stak
 		}
 	}
 
+	for _, history := range data.JailEvents {
+		address, err := sdk.ConsAddressFromBech32(history.Address)
+		if err != nil {
+			panic(err)
+		}
+		for _, event := range history.JailEvents {
+			keeper.AddJailEvent(ctx, address, event)
+		}
+	}
+
 	keeper.SetParams(ctx, data.Params)
 }
 
@@ -49,6 +59,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 	params := keeper.GetParams(ctx)
 	signingInfos := make([]types.SigningInfo, 0)
 	missedBlocks := make([]types.ValidatorMissedBlocks, 0)
+	jailEvents := make([]types.ValidatorJailEvents, 0)
 	keeper.IterateValidatorSigningInfos(ctx, func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool) {
 		bechAddr := address.String()
 		signingInfos = append(signingInfos, types.SigningInfo{
@@ -63,8 +74,15 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 			MissedBlocks: localMissedBlocks,
 		})
 
+		if localJailEvents := keeper.GetValidatorJailEvents(ctx, address); len(localJailEvents) > 0 {
+			jailEvents = append(jailEvents, types.ValidatorJailEvents{
+				Address:    bechAddr,
+				JailEvents: localJailEvents,
+			})
+		}
+
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	return types.NewGenesisState(params, signingInfos, missedBlocks, jailEvents)
 }
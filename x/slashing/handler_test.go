@@ -219,6 +219,13 @@ func TestHandleAbsentValidator(t *testing.T) {
 	// validator should have been slashed
 	require.True(t, amt.Sub(slashAmt).Equal(validator.GetTokens()))
 
+	// the jail should have been recorded in the jail history of the validator
+	jailEvents := app.SlashingKeeper.GetValidatorJailEvents(ctx, sdk.ConsAddress(val.Address()))
+	require.Len(t, jailEvents, 1)
+	require.Equal(t, height, jailEvents[0].Height)
+	require.Equal(t, types.AttributeValueMissingSignature, jailEvents[0].Reason)
+	require.True(t, slashAmt.Equal(jailEvents[0].SlashAmount))
+
 	// 502nd block *also* missed (since the LastCommit would have still included the just-unbonded validator)
 	height++
 	ctx = ctx.WithBlockHeight(height)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -72,3 +73,106 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+func (k Keeper) MissedBlocks(c context.Context, req *types.QueryMissedBlocksRequest) (*types.QueryMissedBlocksResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasValidatorSigningInfo(ctx, consAddr) {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	// the bit array is sparse and keyed by little endian index, so the window
+	// is paginated by index rather than through the store
+	window := uint64(k.SignedBlocksWindow(ctx))
+	start, limit, countTotal := uint64(0), uint64(query.DefaultLimit), false
+	if req.Pagination != nil {
+		if len(req.Pagination.Key) > 0 && req.Pagination.Offset > 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "either offset or key is expected, got both")
+		}
+		if len(req.Pagination.Key) > 0 {
+			if len(req.Pagination.Key) != 8 {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid pagination key")
+			}
+			start = binary.BigEndian.Uint64(req.Pagination.Key)
+		} else {
+			start = req.Pagination.Offset
+		}
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+		countTotal = req.Pagination.CountTotal
+	}
+
+	if start >= window {
+		return nil, status.Errorf(codes.InvalidArgument, "pagination start %d is out of the signed blocks window %d", start, window)
+	}
+
+	// pages never go past the end of the window
+	if limit > window-start {
+		limit = window - start
+	}
+	end := start + limit
+
+	missedBlocks := []types.MissedBlock{}
+	for index := int64(start); index < int64(end); index++ {
+		missed := k.GetValidatorMissedBlockBitArray(ctx, consAddr, index)
+		missedBlocks = append(missedBlocks, types.NewMissedBlock(index, missed))
+	}
+
+	pageRes := &query.PageResponse{}
+	if end < window {
+		pageRes.NextKey = make([]byte, 8)
+		binary.BigEndian.PutUint64(pageRes.NextKey, end)
+	}
+	if countTotal {
+		pageRes.Total = window
+	}
+
+	return &types.QueryMissedBlocksResponse{MissedBlocks: missedBlocks, Pagination: pageRes}, nil
+}
+
+func (k Keeper) JailEvents(c context.Context, req *types.QueryJailEventsRequest) (*types.QueryJailEventsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	var jailEvents []types.JailEvent
+
+	jailEventStore := prefix.NewStore(store, types.ValidatorJailEventsPrefixKey(consAddr))
+	pageRes, err := query.Paginate(jailEventStore, req.Pagination, func(key []byte, value []byte) error {
+		var event types.JailEvent
+		err := k.cdc.UnmarshalBinaryBare(value, &event)
+		if err != nil {
+			return err
+		}
+		jailEvents = append(jailEvents, event)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryJailEventsResponse{JailEvents: jailEvents, Pagination: pageRes}, nil
+}
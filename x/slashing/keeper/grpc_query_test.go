@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"encoding/binary"
+	"math"
 	"testing"
 	"time"
 
@@ -105,6 +107,98 @@ func (suite *SlashingTestSuite) TestGRPCSigningInfos() {
 	suite.Equal(uint64(2), infoResp.Pagination.Total)
 }
 
+func (suite *SlashingTestSuite) TestGRPCMissedBlocks() {
+	queryClient := suite.queryClient
+
+	_, err := queryClient.MissedBlocks(gocontext.Background(), &types.QueryMissedBlocksRequest{ConsAddress: ""})
+	suite.Error(err)
+
+	// validators without signing info have no missed blocks
+	_, err = queryClient.MissedBlocks(gocontext.Background(),
+		&types.QueryMissedBlocksRequest{ConsAddress: sdk.ConsAddress([]byte("addr")).String()})
+	suite.Error(err)
+
+	consAddr := sdk.ConsAddress(suite.addrDels[0])
+	suite.app.SlashingKeeper.SetValidatorMissedBlockBitArray(suite.ctx, consAddr, 1, true)
+	suite.app.SlashingKeeper.SetValidatorMissedBlockBitArray(suite.ctx, consAddr, 3, true)
+
+	missedResp, err := queryClient.MissedBlocks(gocontext.Background(),
+		&types.QueryMissedBlocksRequest{ConsAddress: consAddr.String(), Pagination: &query.PageRequest{Limit: 3, CountTotal: true}})
+	suite.NoError(err)
+	suite.Equal([]types.MissedBlock{
+		types.NewMissedBlock(0, false), types.NewMissedBlock(1, true), types.NewMissedBlock(2, false),
+	}, missedResp.MissedBlocks)
+	suite.NotNil(missedResp.Pagination.NextKey)
+	suite.Equal(uint64(suite.app.SlashingKeeper.SignedBlocksWindow(suite.ctx)), missedResp.Pagination.Total)
+
+	missedResp, err = queryClient.MissedBlocks(gocontext.Background(),
+		&types.QueryMissedBlocksRequest{ConsAddress: consAddr.String(), Pagination: &query.PageRequest{Key: missedResp.Pagination.NextKey, Limit: 2}})
+	suite.NoError(err)
+	suite.Equal([]types.MissedBlock{types.NewMissedBlock(3, true), types.NewMissedBlock(4, false)}, missedResp.MissedBlocks)
+
+	// the last page of the window has no next key
+	missedResp, err = queryClient.MissedBlocks(gocontext.Background(),
+		&types.QueryMissedBlocksRequest{ConsAddress: consAddr.String(), Pagination: &query.PageRequest{Offset: 998, Limit: 10}})
+	suite.NoError(err)
+	suite.Len(missedResp.MissedBlocks, 2)
+	suite.Nil(missedResp.Pagination.NextKey)
+
+	// a huge limit is capped at the end of the window
+	missedResp, err = queryClient.MissedBlocks(gocontext.Background(),
+		&types.QueryMissedBlocksRequest{ConsAddress: consAddr.String(), Pagination: &query.PageRequest{Offset: 10, Limit: math.MaxUint64}})
+	suite.NoError(err)
+	suite.Len(missedResp.MissedBlocks, 990)
+	suite.Nil(missedResp.Pagination.NextKey)
+
+	// pages starting out of the window are rejected
+	_, err = queryClient.MissedBlocks(gocontext.Background(),
+		&types.QueryMissedBlocksRequest{ConsAddress: consAddr.String(), Pagination: &query.PageRequest{Offset: 1000}})
+	suite.Error(err)
+	_, err = queryClient.MissedBlocks(gocontext.Background(),
+		&types.QueryMissedBlocksRequest{ConsAddress: consAddr.String(), Pagination: &query.PageRequest{Offset: math.MaxUint64, Limit: math.MaxUint64}})
+	suite.Error(err)
+
+	hugeKey := make([]byte, 8)
+	binary.BigEndian.PutUint64(hugeKey, math.MaxUint64)
+	_, err = queryClient.MissedBlocks(gocontext.Background(),
+		&types.QueryMissedBlocksRequest{ConsAddress: consAddr.String(), Pagination: &query.PageRequest{Key: hugeKey, Limit: math.MaxUint64}})
+	suite.Error(err)
+}
+
+func (suite *SlashingTestSuite) TestGRPCJailEvents() {
+	queryClient := suite.queryClient
+
+	_, err := queryClient.JailEvents(gocontext.Background(), &types.QueryJailEventsRequest{ConsAddress: ""})
+	suite.Error(err)
+
+	consAddr := sdk.ConsAddress(suite.addrDels[0])
+	jailEvents := []types.JailEvent{
+		types.NewJailEvent(5, time.Unix(5, 0).UTC(), types.AttributeValueMissingSignature, sdk.NewInt(10)),
+		types.NewJailEvent(20, time.Unix(20, 0).UTC(), types.AttributeValueDoubleSign, sdk.NewInt(50)),
+	}
+	for _, event := range jailEvents {
+		suite.app.SlashingKeeper.AddJailEvent(suite.ctx, consAddr, event)
+	}
+
+	eventsResp, err := queryClient.JailEvents(gocontext.Background(),
+		&types.QueryJailEventsRequest{ConsAddress: consAddr.String()})
+	suite.NoError(err)
+	suite.Equal(jailEvents, eventsResp.JailEvents)
+
+	eventsResp, err = queryClient.JailEvents(gocontext.Background(),
+		&types.QueryJailEventsRequest{ConsAddress: consAddr.String(), Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.NoError(err)
+	suite.Equal(jailEvents[:1], eventsResp.JailEvents)
+	suite.NotNil(eventsResp.Pagination.NextKey)
+	suite.Equal(uint64(2), eventsResp.Pagination.Total)
+
+	// other validators have no jail history
+	eventsResp, err = queryClient.JailEvents(gocontext.Background(),
+		&types.QueryJailEventsRequest{ConsAddress: sdk.ConsAddress(suite.addrDels[1]).String()})
+	suite.NoError(err)
+	suite.Empty(eventsResp.JailEvents)
+}
+
 func TestSlashingTestSuite(t *testing.T) {
 	suite.Run(t, new(SlashingTestSuite))
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/tendermint/tendermint/crypto"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -42,6 +43,38 @@ func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress)
 	k.deleteAddrPubkeyRelation(ctx, crypto.Address(address))
 }
 
+// AfterConsensusPubKeyUpdate adds the address-pubkey relation of the new
+// consensus public key of a validator and moves its signing info, missed
+// blocks and jail history to its new consensus address. The relation of the old key is kept so
+// that the votes signed with it are still handled.
+func (k Keeper) AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey) error {
+	if err := k.AddPubkey(ctx, newPubKey); err != nil {
+		return err
+	}
+
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address())
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+
+	signInfo, found := k.GetValidatorSigningInfo(ctx, oldConsAddr)
+	if found {
+		signInfo.Address = newConsAddr.String()
+		k.SetValidatorSigningInfo(ctx, newConsAddr, signInfo)
+		k.deleteValidatorSigningInfo(ctx, oldConsAddr)
+	}
+
+	for _, missedBlock := range k.GetValidatorMissedBlocks(ctx, oldConsAddr) {
+		k.SetValidatorMissedBlockBitArray(ctx, newConsAddr, missedBlock.Index, missedBlock.Missed)
+	}
+	k.clearValidatorMissedBlockBitArray(ctx, oldConsAddr)
+
+	for _, event := range k.GetValidatorJailEvents(ctx, oldConsAddr) {
+		k.AddJailEvent(ctx, newConsAddr, event)
+	}
+	k.clearValidatorJailEvents(ctx, oldConsAddr)
+
+	return nil
+}
+
 //_________________________________________________________________________________________
 
 // Hooks wrapper struct for slashing keeper
@@ -71,6 +104,14 @@ func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
 	h.k.AfterValidatorCreated(ctx, valAddr)
 }
 
+// Implements sdk.ValidatorHooks. It panics if the new consensus public key
+// could not be stored, as the signatures of the validator would not be handled.
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey) {
+	if err := h.k.AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey); err != nil {
+		panic(err)
+	}
+}
+
 func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)  {}
 func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                          {}
 func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
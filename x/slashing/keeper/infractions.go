@@ -21,6 +21,16 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 
 	// fetch signing info
 	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		// the validator may sign with a consensus public key it rotated, its
+		// signing info is kept by its current consensus address
+		if validator := k.sk.ValidatorByConsAddr(ctx, consAddr); validator != nil {
+			if rotatedConsAddr, err := validator.GetConsAddr(); err == nil {
+				consAddr = rotatedConsAddr
+				signInfo, found = k.GetValidatorSigningInfo(ctx, consAddr)
+			}
+		}
+	}
 	if !found {
 		panic(fmt.Sprintf("Expected signing info for validator %s but not found", consAddr))
 	}
@@ -90,8 +100,12 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
 				),
 			)
-			k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			slashFraction := k.SlashFractionDowntime(ctx)
+			slashAmount := k.sk.Slash(ctx, consAddr, distributionHeight, power, slashFraction)
 			k.sk.Jail(ctx, consAddr)
+			k.AddJailEvent(ctx, consAddr, types.NewJailEvent(
+				height, ctx.BlockTime(), types.AttributeValueMissingSignature, slashAmount,
+			))
 
 			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
 
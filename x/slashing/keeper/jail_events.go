@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// AddJailEvent records a jail event in the jail history of a validator
+func (k Keeper) AddJailEvent(ctx sdk.Context, address sdk.ConsAddress, event types.JailEvent) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&event)
+	store.Set(types.ValidatorJailEventKey(address, event.Height, event.Reason), bz)
+}
+
+// IterateValidatorJailEvents iterates over the jail history of a validator,
+// oldest first
+func (k Keeper) IterateValidatorJailEvents(ctx sdk.Context,
+	address sdk.ConsAddress, handler func(event types.JailEvent) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorJailEventsPrefixKey(address))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var event types.JailEvent
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &event)
+		if handler(event) {
+			break
+		}
+	}
+}
+
+// GetValidatorJailEvents returns the jail history of a validator, oldest first
+func (k Keeper) GetValidatorJailEvents(ctx sdk.Context, address sdk.ConsAddress) []types.JailEvent {
+	jailEvents := []types.JailEvent{}
+	k.IterateValidatorJailEvents(ctx, address, func(event types.JailEvent) (stop bool) {
+		jailEvents = append(jailEvents, event)
+		return false
+	})
+
+	return jailEvents
+}
+
+// clearValidatorJailEvents deletes the jail history of a validator
+func (k Keeper) clearValidatorJailEvents(ctx sdk.Context, address sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorJailEventsPrefixKey(address))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
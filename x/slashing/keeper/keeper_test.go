@@ -276,3 +276,48 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	staking.EndBlocker(ctx, app.StakingKeeper)
 	tstaking.CheckValidator(valAddr, stakingtypes.Unbonding, true)
 }
+
+// Test a validator keeps its signing info when it rotates its consensus key
+func TestHandleValidatorSignatureAfterConsPubKeyRotation(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	valAddr := simapp.ConvertAddrsToValAddrs(addrDels)[0]
+	pks := simapp.CreateTestPubKeys(2)
+	oldPk, newPk := pks[0], pks[1]
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldPk.Address()), sdk.ConsAddress(newPk.Address())
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddr, oldPk, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), 100, false)
+
+	require.NoError(t, app.StakingKeeper.RotateConsPubKey(ctx, valAddr, newPk))
+
+	// the signing info and the missed blocks are moved to the new address
+	require.False(t, app.SlashingKeeper.HasValidatorSigningInfo(ctx, oldConsAddr))
+	require.Empty(t, app.SlashingKeeper.GetValidatorMissedBlocks(ctx, oldConsAddr))
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, newConsAddr.String(), info.Address)
+	require.Equal(t, int64(1), info.MissedBlocksCounter)
+	require.True(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, newConsAddr, 0))
+
+	// the votes signed with the old key until Tendermint applies the rotation
+	// are attributed to the validator
+	ctx = ctx.WithBlockHeight(2)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), 100, false)
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, int64(2), info.MissedBlocksCounter)
+	require.False(t, app.SlashingKeeper.HasValidatorSigningInfo(ctx, oldConsAddr))
+
+	ctx = ctx.WithBlockHeight(3)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, newPk.Address(), 100, true)
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, int64(3), info.IndexOffset)
+	require.Equal(t, int64(2), info.MissedBlocksCounter)
+}
@@ -34,6 +34,12 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &pubKeyB)
 			return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", pubKeyA.Value, pubKeyB.Value)
 
+		case bytes.Equal(kvA.Key[:1], types.ValidatorJailEventKeyPrefix):
+			var eventA, eventB types.JailEvent
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &eventA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &eventB)
+			return fmt.Sprintf("%v\n%v", eventA, eventB)
+
 		default:
 			panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 		}
@@ -31,12 +31,14 @@ func TestDecodeStore(t *testing.T) {
 	info := types.NewValidatorSigningInfo(consAddr1, 0, 1, time.Now().UTC(), false, 0)
 	bechPK := sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, delPk1)
 	missed := gogotypes.BoolValue{Value: true}
+	jailEvent := types.NewJailEvent(5, time.Now().UTC(), types.AttributeValueDoubleSign, sdk.NewInt(100))
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.ValidatorSigningInfoKey(consAddr1), Value: cdc.MustMarshalBinaryBare(&info)},
 			{Key: types.ValidatorMissedBlockBitArrayKey(consAddr1, 6), Value: cdc.MustMarshalBinaryBare(&missed)},
 			{Key: types.AddrPubkeyRelationKey(delAddr1), Value: cdc.MustMarshalBinaryBare(&gogotypes.StringValue{Value: bechPK})},
+			{Key: types.ValidatorJailEventKey(consAddr1, 5, types.AttributeValueDoubleSign), Value: cdc.MustMarshalBinaryBare(&jailEvent)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -48,6 +50,7 @@ func TestDecodeStore(t *testing.T) {
 		{"ValidatorSigningInfo", fmt.Sprintf("%v\n%v", info, info)},
 		{"ValidatorMissedBlockBitArray", fmt.Sprintf("missedA: %v\nmissedB: %v", missed.Value, missed.Value)},
 		{"AddrPubkeyRelation", fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", bechPK, bechPK)},
+		{"ValidatorJailEvent", fmt.Sprintf("%v\n%v", jailEvent, jailEvent)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
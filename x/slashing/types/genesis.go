@@ -10,12 +10,14 @@ import (
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
 	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks,
+	jailEvents []ValidatorJailEvents,
 ) *GenesisState {
 
 	return &GenesisState{
 		Params:       params,
 		SigningInfos: signingInfos,
 		MissedBlocks: missedBlocks,
+		JailEvents:   jailEvents,
 	}
 }
 
@@ -27,12 +29,23 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 	}
 }
 
+// NewJailEvent creates a new JailEvent instance
+func NewJailEvent(height int64, time time.Time, reason string, slashAmount sdk.Int) JailEvent {
+	return JailEvent{
+		Height:      height,
+		Time:        time,
+		Reason:      reason,
+		SlashAmount: slashAmount,
+	}
+}
+
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		Params:       DefaultParams(),
 		SigningInfos: []SigningInfo{},
 		MissedBlocks: []ValidatorMissedBlocks{},
+		JailEvents:   []ValidatorJailEvents{},
 	}
 }
 
@@ -63,5 +76,16 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	for _, events := range data.JailEvents {
+		if _, err := sdk.ConsAddressFromBech32(events.Address); err != nil {
+			return err
+		}
+		for _, event := range events.JailEvents {
+			if event.SlashAmount.IsNil() || event.SlashAmount.IsNegative() {
+				return fmt.Errorf("jail event of %s at height %d has an invalid slash amount", events.Address, event.Height)
+			}
+		}
+	}
+
 	return nil
 }
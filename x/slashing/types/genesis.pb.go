@@ -33,6 +33,9 @@ type GenesisState struct {
 	// signing_infos represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks" yaml:"missed_blocks"`
+	// jail_events represents a map between validator addresses and their jail
+	// history.
+	JailEvents []ValidatorJailEvents `protobuf:"bytes,4,rep,name=jail_events,json=jailEvents,proto3" json:"jail_events" yaml:"jail_events"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -89,6 +92,13 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetJailEvents() []ValidatorJailEvents {
+	if m != nil {
+		return m.JailEvents
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -255,11 +265,67 @@ func (m *MissedBlock) GetMissed() bool {
 	return false
 }
 
+// ValidatorJailEvents contains the jail history of corresponding address.
+type ValidatorJailEvents struct {
+	// address is the validator address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// jail_events is the jail history of the validator, oldest first.
+	JailEvents []JailEvent `protobuf:"bytes,2,rep,name=jail_events,json=jailEvents,proto3" json:"jail_events" yaml:"jail_events"`
+}
+
+func (m *ValidatorJailEvents) Reset()         { *m = ValidatorJailEvents{} }
+func (m *ValidatorJailEvents) String() string { return proto.CompactTextString(m) }
+func (*ValidatorJailEvents) ProtoMessage()    {}
+func (*ValidatorJailEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1923b9188b635394, []int{4}
+}
+func (m *ValidatorJailEvents) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorJailEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorJailEvents.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorJailEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorJailEvents.Merge(m, src)
+}
+func (m *ValidatorJailEvents) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorJailEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorJailEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorJailEvents proto.InternalMessageInfo
+
+func (m *ValidatorJailEvents) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorJailEvents) GetJailEvents() []JailEvent {
+	if m != nil {
+		return m.JailEvents
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.slashing.v1beta1.GenesisState")
 	proto.RegisterType((*SigningInfo)(nil), "cosmos.slashing.v1beta1.SigningInfo")
 	proto.RegisterType((*ValidatorMissedBlocks)(nil), "cosmos.slashing.v1beta1.ValidatorMissedBlocks")
 	proto.RegisterType((*MissedBlock)(nil), "cosmos.slashing.v1beta1.MissedBlock")
+	proto.RegisterType((*ValidatorJailEvents)(nil), "cosmos.slashing.v1beta1.ValidatorJailEvents")
 }
 
 func init() {
@@ -267,34 +333,38 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3d, 0x8e, 0xd3, 0x40,
-	0x14, 0xf6, 0x24, 0x10, 0x60, 0x9c, 0x6d, 0x46, 0x66, 0xb1, 0x56, 0xe0, 0xac, 0x2c, 0x16, 0x6d,
-	0x13, 0x5b, 0xbb, 0x74, 0x20, 0x1a, 0x37, 0x2b, 0x0a, 0x24, 0xe4, 0x95, 0x28, 0x68, 0xa2, 0x71,
-	0x3c, 0x3b, 0x19, 0xc5, 0xf6, 0x04, 0x3f, 0x13, 0x25, 0x57, 0xa0, 0xa2, 0xe6, 0x06, 0xf4, 0x1c,
-	0x22, 0x65, 0x4a, 0xaa, 0x08, 0x25, 0x37, 0xe0, 0x04, 0x28, 0x33, 0x0e, 0x71, 0xa2, 0x98, 0x88,
-	0xca, 0x7e, 0xd2, 0xf7, 0xf7, 0xbe, 0xd1, 0xc3, 0x17, 0x7d, 0x09, 0xa9, 0x04, 0x1f, 0x12, 0x0a,
-	0x03, 0x91, 0x71, 0x7f, 0x7c, 0x15, 0xb1, 0x82, 0x5e, 0xf9, 0x9c, 0x65, 0x0c, 0x04, 0x78, 0xa3,
-	0x5c, 0x16, 0x92, 0x3c, 0xd1, 0x30, 0x6f, 0x03, 0xf3, 0x4a, 0xd8, 0x99, 0xc5, 0x25, 0x97, 0x0a,
-	0xe3, 0xaf, 0xff, 0x34, 0xfc, 0xec, 0x45, 0x9d, 0xea, 0x5f, 0xbe, 0xc2, 0xb9, 0xdf, 0x1b, 0xb8,
-	0x7d, 0xa3, 0x8d, 0x6e, 0x0b, 0x5a, 0x30, 0xf2, 0x06, 0xb7, 0x46, 0x34, 0xa7, 0x29, 0xd8, 0xe8,
-	0x1c, 0x5d, 0x9a, 0xd7, 0x1d, 0xaf, 0xc6, 0xd8, 0x7b, 0xaf, 0x60, 0xc1, 0xbd, 0xd9, 0xa2, 0x63,
-	0x84, 0x25, 0x89, 0x70, 0x7c, 0x02, 0x82, 0x67, 0x22, 0xe3, 0x3d, 0x91, 0xdd, 0x49, 0xb0, 0x1b,
-	0xe7, 0xcd, 0x4b, 0xf3, 0xfa, 0x79, 0xad, 0xca, 0xad, 0x46, 0xbf, 0xcd, 0xee, 0x64, 0xf0, 0x74,
-	0x2d, 0xf5, 0x7b, 0xd1, 0xb1, 0xa6, 0x34, 0x4d, 0x5e, 0xb9, 0x3b, 0x42, 0x6e, 0xd8, 0x86, 0x2d,
-	0x14, 0xc8, 0x27, 0x7c, 0x92, 0x0a, 0x00, 0x16, 0xf7, 0xa2, 0x44, 0xf6, 0x87, 0x60, 0x37, 0x95,
-	0x91, 0x57, 0x6b, 0xf4, 0x81, 0x26, 0x22, 0xa6, 0x85, 0xcc, 0xdf, 0x29, 0x5a, 0xa0, 0x58, 0xfb,
-	0x96, 0x3b, 0x92, 0x6e, 0xd8, 0x4e, 0x2b, 0x58, 0xf7, 0x07, 0xc2, 0x66, 0x25, 0x2e, 0xb1, 0xf1,
-	0x03, 0x1a, 0xc7, 0x39, 0x03, 0xdd, 0xd5, 0xa3, 0x70, 0x33, 0x92, 0x2f, 0x08, 0x9f, 0x8e, 0x37,
-	0x7e, 0xbd, 0xea, 0x1e, 0x76, 0x43, 0xb5, 0xda, 0x3d, 0x1e, 0xb3, 0x5a, 0xcc, 0x45, 0x99, 0xf2,
-	0x99, 0x4e, 0x79, 0x58, 0xda, 0x0d, 0xad, 0xf1, 0x01, 0xb2, 0xfb, 0x0d, 0xe1, 0xc7, 0x07, 0x97,
-	0xff, 0xc7, 0x02, 0x7c, 0xbf, 0xdd, 0x63, 0xcf, 0x58, 0xd1, 0xfd, 0xaf, 0x4e, 0x5f, 0x63, 0xb3,
-	0x42, 0x25, 0x16, 0xbe, 0x2f, 0xb2, 0x98, 0x4d, 0x54, 0x9e, 0x66, 0xa8, 0x07, 0x72, 0x8a, 0x5b,
-	0x9a, 0xa4, 0xda, 0x7b, 0x18, 0x96, 0x53, 0x70, 0x33, 0x5b, 0x3a, 0x68, 0xbe, 0x74, 0xd0, 0xaf,
-	0xa5, 0x83, 0xbe, 0xae, 0x1c, 0x63, 0xbe, 0x72, 0x8c, 0x9f, 0x2b, 0xc7, 0xf8, 0xd8, 0xe5, 0xa2,
-	0x18, 0x7c, 0x8e, 0xbc, 0xbe, 0x4c, 0xfd, 0xf2, 0x12, 0xf4, 0xa7, 0x0b, 0xf1, 0xd0, 0x9f, 0x6c,
-	0xcf, 0xa2, 0x98, 0x8e, 0x18, 0x44, 0x2d, 0x75, 0x0c, 0x2f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x03, 0x3c, 0xa8, 0xf6, 0x8c, 0x03, 0x00, 0x00,
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0x36, 0x25, 0xc0, 0x3a, 0xbd, 0x2c, 0xa1, 0x58, 0x11, 0x38, 0xd5, 0x8a, 0xa2, 0x1e,
+	0x88, 0xad, 0x96, 0x1b, 0x88, 0x8b, 0x25, 0x54, 0x81, 0x84, 0x84, 0x5c, 0x89, 0x03, 0x17, 0x6b,
+	0x13, 0x6f, 0xb7, 0xdb, 0xda, 0xde, 0x90, 0x59, 0xa2, 0xf6, 0x15, 0x38, 0xf5, 0xc0, 0x89, 0xe7,
+	0xe0, 0x21, 0x7a, 0xec, 0x91, 0x53, 0x85, 0x92, 0x37, 0xe0, 0x09, 0x50, 0x77, 0x9d, 0xd4, 0xa9,
+	0xe2, 0x84, 0x9e, 0xec, 0x95, 0xbe, 0x9f, 0x99, 0xf9, 0x46, 0x83, 0xb7, 0xfb, 0x0a, 0x32, 0x05,
+	0x01, 0xa4, 0x0c, 0x8e, 0x64, 0x2e, 0x82, 0xd1, 0x6e, 0x8f, 0x6b, 0xb6, 0x1b, 0x08, 0x9e, 0x73,
+	0x90, 0xe0, 0x0f, 0x86, 0x4a, 0x2b, 0xf2, 0xc4, 0xc2, 0xfc, 0x29, 0xcc, 0x2f, 0x60, 0xed, 0x96,
+	0x50, 0x42, 0x19, 0x4c, 0x70, 0xfd, 0x67, 0xe1, 0xed, 0x17, 0x55, 0xaa, 0x33, 0xbe, 0xc1, 0xd1,
+	0x1f, 0x75, 0xdc, 0xdc, 0xb7, 0x46, 0x07, 0x9a, 0x69, 0x4e, 0xde, 0xe2, 0xc6, 0x80, 0x0d, 0x59,
+	0x06, 0x2e, 0xda, 0x42, 0x3b, 0xce, 0x5e, 0xc7, 0xaf, 0x30, 0xf6, 0x3f, 0x19, 0x58, 0xb8, 0x7e,
+	0x71, 0xd5, 0xa9, 0x45, 0x05, 0x89, 0x08, 0xbc, 0x01, 0x52, 0xe4, 0x32, 0x17, 0xb1, 0xcc, 0x0f,
+	0x15, 0xb8, 0x6b, 0x5b, 0xf5, 0x1d, 0x67, 0xef, 0x79, 0xa5, 0xca, 0x81, 0x45, 0xbf, 0xcf, 0x0f,
+	0x55, 0xf8, 0xf4, 0x5a, 0xea, 0xef, 0x55, 0xa7, 0x75, 0xc6, 0xb2, 0xf4, 0x35, 0x9d, 0x13, 0xa2,
+	0x51, 0x13, 0x6e, 0xa0, 0x40, 0xbe, 0xe2, 0x8d, 0x4c, 0x02, 0xf0, 0x24, 0xee, 0xa5, 0xaa, 0x7f,
+	0x02, 0x6e, 0xdd, 0x18, 0xf9, 0x95, 0x46, 0x9f, 0x59, 0x2a, 0x13, 0xa6, 0xd5, 0xf0, 0xa3, 0xa1,
+	0x85, 0x86, 0x75, 0xdb, 0x72, 0x4e, 0x92, 0x46, 0xcd, 0xac, 0x84, 0x25, 0x12, 0x3b, 0xc7, 0x4c,
+	0xa6, 0x31, 0x1f, 0xf1, 0x5c, 0x83, 0xbb, 0x6e, 0x0c, 0x5f, 0xae, 0x36, 0xfc, 0xc0, 0x64, 0xfa,
+	0xce, 0x70, 0xc2, 0x76, 0x61, 0x47, 0xac, 0x5d, 0x49, 0x8e, 0x46, 0xf8, 0x78, 0x86, 0xa3, 0xbf,
+	0x10, 0x76, 0x4a, 0x93, 0x21, 0x2e, 0xbe, 0xcf, 0x92, 0x64, 0xc8, 0xc1, 0xc6, 0xf2, 0x30, 0x9a,
+	0x3e, 0xc9, 0x77, 0x84, 0x37, 0x47, 0x53, 0xa7, 0xb8, 0x3c, 0x32, 0x77, 0xcd, 0x04, 0xd8, 0x5d,
+	0x5d, 0x60, 0x39, 0x83, 0xed, 0xa2, 0xc2, 0x67, 0xb6, 0xc2, 0xc5, 0xd2, 0x34, 0x6a, 0x8d, 0x16,
+	0x90, 0xe9, 0x4f, 0x84, 0x1f, 0x2f, 0x9c, 0xf3, 0x92, 0x06, 0xc4, 0xed, 0x20, 0x57, 0x6d, 0x4c,
+	0x49, 0xf7, 0x2e, 0xf1, 0xd1, 0x37, 0xd8, 0x29, 0x51, 0x49, 0x0b, 0xdf, 0x93, 0x79, 0xc2, 0x4f,
+	0x4d, 0x3d, 0xf5, 0xc8, 0x3e, 0xc8, 0x26, 0x6e, 0x58, 0x92, 0x99, 0xde, 0x83, 0xa8, 0x78, 0xd1,
+	0x73, 0x84, 0x1f, 0x2d, 0x08, 0x74, 0x49, 0x5f, 0xf1, 0xfc, 0xb6, 0xd8, 0xae, 0x68, 0x65, 0x57,
+	0x33, 0xcd, 0xff, 0xdd, 0x91, 0x70, 0xff, 0x62, 0xec, 0xa1, 0xcb, 0xb1, 0x87, 0xfe, 0x8c, 0x3d,
+	0x74, 0x3e, 0xf1, 0x6a, 0x97, 0x13, 0xaf, 0xf6, 0x7b, 0xe2, 0xd5, 0xbe, 0x74, 0x85, 0xd4, 0x47,
+	0xdf, 0x7a, 0x7e, 0x5f, 0x65, 0x41, 0x71, 0x07, 0xec, 0xa7, 0x0b, 0xc9, 0x49, 0x70, 0x7a, 0x73,
+	0x14, 0xf4, 0xd9, 0x80, 0x43, 0xaf, 0x61, 0x4e, 0xc1, 0xab, 0x7f, 0x03, 0x00, 0xb3, 0xcc, 0x5a,
+	0xe9, 0x8a, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -317,6 +387,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.JailEvents) > 0 {
+		for iNdEx := len(m.JailEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JailEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -480,6 +564,50 @@ func (m *MissedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorJailEvents) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorJailEvents) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorJailEvents) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JailEvents) > 0 {
+		for iNdEx := len(m.JailEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JailEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -511,6 +639,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.JailEvents) > 0 {
+		for _, e := range m.JailEvents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -563,6 +697,25 @@ func (m *MissedBlock) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorJailEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.JailEvents) > 0 {
+		for _, e := range m.JailEvents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -699,6 +852,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailEvents = append(m.JailEvents, ValidatorJailEvents{})
+			if err := m.JailEvents[len(m.JailEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1052,6 +1239,125 @@ func (m *MissedBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorJailEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorJailEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorJailEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailEvents = append(m.JailEvents, JailEvent{})
+			if err := m.JailEvents[len(m.JailEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28,10 +28,13 @@ const (
 // - 0x02<consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddr_Bytes>: crypto.PubKey
+//
+// - 0x04<consAddress_Bytes><height_Bytes><reason_Bytes>: JailEvent
 var (
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	ValidatorJailEventKeyPrefix           = []byte{0x04} // Prefix for jail history
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -64,3 +67,24 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 func AddrPubkeyRelationKey(address []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address...)
 }
+
+// ValidatorJailEventsPrefixKey - stored by *Consensus* address (not operator address)
+func ValidatorJailEventsPrefixKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorJailEventKeyPrefix, v.Bytes()...)
+}
+
+// ValidatorJailEventKey - stored by *Consensus* address (not operator address),
+// then by height so that the jail history is iterated in order
+func ValidatorJailEventKey(v sdk.ConsAddress, height int64, reason string) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+	return append(append(ValidatorJailEventsPrefixKey(v), b...), reason...)
+}
+
+// ValidatorJailEventAddress - extract the address from a validator jail event key
+func ValidatorJailEventAddress(key []byte) (v sdk.ConsAddress) {
+	if len(key) < 1+sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.ConsAddress(key[1 : 1+sdk.AddrLen])
+}
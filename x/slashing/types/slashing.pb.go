@@ -120,6 +120,74 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+// JailEvent records a validator being slashed and jailed for an infraction.
+type JailEvent struct {
+	// height is the block height at which the validator was jailed.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the validator was jailed.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// reason is the infraction the validator was jailed for, missing_signature
+	// or double_sign.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// slash_amount is the amount of tokens slashed for the infraction, from the
+	// validator and from its unbonding delegations and redelegations.
+	SlashAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=slash_amount,json=slashAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"slash_amount" yaml:"slash_amount"`
+}
+
+func (m *JailEvent) Reset()         { *m = JailEvent{} }
+func (m *JailEvent) String() string { return proto.CompactTextString(m) }
+func (*JailEvent) ProtoMessage()    {}
+func (*JailEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{1}
+}
+func (m *JailEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JailEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JailEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JailEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JailEvent.Merge(m, src)
+}
+func (m *JailEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *JailEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JailEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JailEvent proto.InternalMessageInfo
+
+func (m *JailEvent) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *JailEvent) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *JailEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                                  `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty" yaml:"signed_blocks_window"`
@@ -133,7 +201,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1078e5d96a74cc52, []int{1}
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -178,6 +246,7 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
+	proto.RegisterType((*JailEvent)(nil), "cosmos.slashing.v1beta1.JailEvent")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
 }
 
@@ -186,47 +255,52 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbd, 0x6f, 0xd3, 0x4e,
-	0x18, 0xce, 0xfd, 0xd2, 0x5f, 0x29, 0x97, 0x4c, 0x6e, 0x4a, 0x4c, 0x00, 0x3b, 0x78, 0xa8, 0xc2,
-	0x50, 0x5b, 0x2d, 0x5b, 0x47, 0x53, 0x21, 0x3e, 0x24, 0x28, 0x6e, 0x01, 0x89, 0x01, 0xeb, 0x9c,
-	0xbb, 0x38, 0x47, 0xed, 0xbb, 0xc8, 0x77, 0xa1, 0x2d, 0x1b, 0x5b, 0xc7, 0x8e, 0x1d, 0x3b, 0xf2,
-	0xa7, 0x74, 0xec, 0x88, 0x18, 0x02, 0x4a, 0x17, 0xe6, 0x6e, 0x6c, 0xc8, 0x77, 0x76, 0x1b, 0xa5,
-	0x29, 0x52, 0xa7, 0xe4, 0x7d, 0xde, 0xe7, 0x7d, 0xee, 0x79, 0x3f, 0x12, 0xb8, 0xdc, 0xe5, 0x22,
-	0xe5, 0xc2, 0x13, 0x09, 0x12, 0x7d, 0xca, 0x62, 0xef, 0xf3, 0x6a, 0x44, 0x24, 0x5a, 0xbd, 0x00,
-	0xdc, 0x41, 0xc6, 0x25, 0x37, 0x9a, 0x9a, 0xe7, 0x5e, 0xc0, 0x05, 0xaf, 0xd5, 0x88, 0x79, 0xcc,
-	0x15, 0xc7, 0xcb, 0xbf, 0x69, 0x7a, 0xcb, 0x8a, 0x39, 0x8f, 0x13, 0xe2, 0xa9, 0x28, 0x1a, 0xf6,
-	0x3c, 0x3c, 0xcc, 0x90, 0xa4, 0x9c, 0x15, 0x79, 0x7b, 0x3a, 0x2f, 0x69, 0x4a, 0x84, 0x44, 0xe9,
-	0x40, 0x13, 0x9c, 0x83, 0x2a, 0x6c, 0xbc, 0x43, 0x09, 0xc5, 0x48, 0xf2, 0x6c, 0x8b, 0xc6, 0x8c,
-	0xb2, 0xf8, 0x39, 0xeb, 0x71, 0xc3, 0x84, 0xb7, 0x10, 0xc6, 0x19, 0x11, 0xc2, 0x04, 0x6d, 0xd0,
-	0xb9, 0x1d, 0x94, 0xa1, 0xb1, 0x0e, 0xeb, 0x42, 0xa2, 0x4c, 0x86, 0x7d, 0x42, 0xe3, 0xbe, 0x34,
-	0xff, 0x6b, 0x83, 0x4e, 0xd5, 0x6f, 0x9e, 0x8f, 0xec, 0xc5, 0x7d, 0x94, 0x26, 0xeb, 0xce, 0x64,
-	0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xa6, 0xa2, 0xbc, 0x96, 0x32, 0x4c, 0xf6, 0x42, 0xde, 0xeb, 0x09,
-	0x22, 0xcd, 0xea, 0x74, 0xed, 0x64, 0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xad, 0x22, 0xe3, 0x23, 0xac,
-	0x7f, 0x42, 0x34, 0x21, 0x38, 0x1c, 0x32, 0x49, 0x13, 0x73, 0xae, 0x0d, 0x3a, 0xb5, 0xb5, 0x96,
-	0xab, 0x5b, 0x74, 0xcb, 0x16, 0xdd, 0xed, 0xb2, 0x45, 0xdf, 0x3e, 0x19, 0xd9, 0x95, 0x4b, 0xed,
-	0xc9, 0x6a, 0xe7, 0xf0, 0xa7, 0x0d, 0x82, 0x9a, 0x86, 0xde, 0xe6, 0x88, 0x61, 0x41, 0x28, 0x79,
-	0x1a, 0x09, 0xc9, 0x19, 0xc1, 0xe6, 0xff, 0x6d, 0xd0, 0x59, 0x08, 0x26, 0x10, 0x63, 0x1b, 0x2e,
-	0xa5, 0x54, 0x08, 0x82, 0xc3, 0x28, 0xe1, 0xdd, 0x1d, 0x11, 0x76, 0xf9, 0x90, 0x49, 0x92, 0x99,
-	0xf3, 0xaa, 0x89, 0xf6, 0xf9, 0xc8, 0xbe, 0xaf, 0x1f, 0x9a, 0x49, 0x73, 0x82, 0x45, 0x8d, 0xfb,
-	0x0a, 0x7e, 0xa2, 0xd1, 0xf5, 0x85, 0xa3, 0x63, 0xbb, 0xf2, 0xfb, 0xd8, 0x06, 0xce, 0x9f, 0x39,
-	0x38, 0xbf, 0x89, 0x32, 0x94, 0x0a, 0xe3, 0x0d, 0x6c, 0x08, 0x1a, 0xb3, 0x4b, 0x8d, 0x5d, 0xca,
-	0x30, 0xdf, 0x55, 0x9b, 0xa8, 0xfa, 0xf6, 0xf9, 0xc8, 0xbe, 0x57, 0x8c, 0x7a, 0x06, 0xcb, 0x09,
-	0x0c, 0x0d, 0xeb, 0x87, 0xde, 0x2b, 0xd0, 0xf8, 0x0a, 0x72, 0xfb, 0x2c, 0x2c, 0x2a, 0x06, 0x24,
-	0x2b, 0x45, 0xf3, 0xfd, 0xd5, 0xfd, 0x57, 0xf9, 0xac, 0x7e, 0x8c, 0xec, 0xe5, 0x98, 0xca, 0xfe,
-	0x30, 0x72, 0xbb, 0x3c, 0xf5, 0x8a, 0x9b, 0xd5, 0x1f, 0x2b, 0x02, 0xef, 0x78, 0x72, 0x7f, 0x40,
-	0x84, 0xbb, 0x41, 0xba, 0x93, 0xcd, 0xce, 0x10, 0x75, 0x02, 0x23, 0xa5, 0x6c, 0x4b, 0xc1, 0x9b,
-	0x24, 0x2b, 0x3c, 0x7c, 0x81, 0x77, 0x30, 0xdf, 0x65, 0xf9, 0x0d, 0x86, 0xf9, 0xe4, 0xc3, 0xf2,
-	0x5a, 0xd5, 0x1d, 0xd4, 0xd6, 0xee, 0x5e, 0xd9, 0xe5, 0x46, 0x41, 0xf0, 0x1f, 0x15, 0xab, 0x7c,
-	0xa0, 0x1f, 0x9d, 0x2d, 0xe3, 0x1c, 0xe5, 0x4b, 0x6d, 0x94, 0xc9, 0x17, 0x88, 0x26, 0xa5, 0x80,
-	0x71, 0x08, 0x60, 0x4b, 0xfd, 0xa8, 0xc2, 0x5e, 0x86, 0xba, 0x39, 0x14, 0x62, 0x3e, 0x8c, 0x12,
-	0xa2, 0xcc, 0xab, 0x63, 0xaa, 0xfb, 0x5b, 0x37, 0x1e, 0xc2, 0xc3, 0x62, 0x0f, 0xd7, 0x2a, 0x3b,
-	0x41, 0x53, 0x25, 0x9f, 0x16, 0xb9, 0x0d, 0x95, 0xca, 0x27, 0x63, 0x1c, 0x00, 0xd8, 0xbc, 0x52,
-	0xa8, 0xad, 0xab, 0xf3, 0xab, 0xfb, 0x9b, 0x37, 0xf6, 0x63, 0x5d, 0xe3, 0x47, 0xcb, 0x3a, 0xc1,
-	0xd2, 0x94, 0x19, 0x8d, 0xfb, 0x2f, 0xbf, 0x8d, 0x2d, 0x70, 0x32, 0xb6, 0xc0, 0xe9, 0xd8, 0x02,
-	0xbf, 0xc6, 0x16, 0x38, 0x3c, 0xb3, 0x2a, 0xa7, 0x67, 0x56, 0xe5, 0xfb, 0x99, 0x55, 0xf9, 0xb0,
-	0xf2, 0xcf, 0xe7, 0xf7, 0x2e, 0xff, 0xd4, 0x94, 0x93, 0x68, 0x5e, 0xad, 0xef, 0xf1, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x7b, 0x78, 0xfd, 0x40, 0xf4, 0x04, 0x00, 0x00,
+	// 709 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x53, 0x13, 0x4f,
+	0x14, 0xcf, 0x11, 0xbe, 0xf9, 0x92, 0x4d, 0xaa, 0x25, 0x90, 0x33, 0xea, 0x5d, 0xbc, 0x82, 0x89,
+	0x05, 0xc9, 0x80, 0x8d, 0x43, 0xe7, 0x09, 0x8e, 0xe8, 0x8c, 0xe2, 0x81, 0x3a, 0x63, 0xe1, 0xcd,
+	0x26, 0xb7, 0xb9, 0xac, 0xdc, 0xed, 0x66, 0x6e, 0x37, 0xfc, 0xb0, 0xb3, 0xa3, 0xa4, 0xa4, 0xa4,
+	0xf4, 0x4f, 0xa1, 0xa4, 0x74, 0x2c, 0x82, 0x13, 0x1a, 0x6b, 0x3a, 0x3b, 0x67, 0x77, 0x2f, 0x70,
+	0x03, 0x51, 0x87, 0x0a, 0xde, 0xe7, 0xbd, 0xf7, 0xd9, 0xf7, 0x3e, 0x9f, 0x77, 0x01, 0x0b, 0x1d,
+	0xc6, 0x63, 0xc6, 0x5b, 0x3c, 0x42, 0xbc, 0x47, 0x68, 0xd8, 0xda, 0x59, 0x6a, 0x63, 0x81, 0x96,
+	0x2e, 0x81, 0x66, 0x3f, 0x61, 0x82, 0xc1, 0xaa, 0xae, 0x6b, 0x5e, 0xc2, 0x69, 0x5d, 0xad, 0x12,
+	0xb2, 0x90, 0xa9, 0x9a, 0x96, 0xfc, 0x4f, 0x97, 0xd7, 0xac, 0x90, 0xb1, 0x30, 0xc2, 0x2d, 0x15,
+	0xb5, 0x07, 0xdd, 0x56, 0x30, 0x48, 0x90, 0x20, 0x8c, 0xa6, 0x79, 0xfb, 0x7a, 0x5e, 0x90, 0x18,
+	0x73, 0x81, 0xe2, 0xbe, 0x2e, 0x70, 0x0e, 0xf2, 0xa0, 0xf2, 0x0e, 0x45, 0x24, 0x40, 0x82, 0x25,
+	0x9b, 0x24, 0xa4, 0x84, 0x86, 0xeb, 0xb4, 0xcb, 0xa0, 0x09, 0xfe, 0x47, 0x41, 0x90, 0x60, 0xce,
+	0x4d, 0xa3, 0x6e, 0x34, 0x8a, 0xde, 0x38, 0x84, 0x2b, 0xa0, 0xcc, 0x05, 0x4a, 0x84, 0xdf, 0xc3,
+	0x24, 0xec, 0x09, 0x73, 0xaa, 0x6e, 0x34, 0xf2, 0x6e, 0xf5, 0x62, 0x68, 0xcf, 0xee, 0xa3, 0x38,
+	0x5a, 0x71, 0xb2, 0x59, 0xc7, 0x2b, 0xa9, 0xf0, 0xb9, 0x8a, 0x64, 0x2f, 0xa1, 0x01, 0xde, 0xf3,
+	0x59, 0xb7, 0xcb, 0xb1, 0x30, 0xf3, 0xd7, 0x7b, 0xb3, 0x59, 0xc7, 0x2b, 0xa9, 0xf0, 0xb5, 0x8a,
+	0xe0, 0x47, 0x50, 0xfe, 0x84, 0x48, 0x84, 0x03, 0x7f, 0x40, 0x05, 0x89, 0xcc, 0xe9, 0xba, 0xd1,
+	0x28, 0x2d, 0xd7, 0x9a, 0x7a, 0xc5, 0xe6, 0x78, 0xc5, 0xe6, 0xd6, 0x78, 0x45, 0xd7, 0x3e, 0x19,
+	0xda, 0xb9, 0x2b, 0xee, 0x6c, 0xb7, 0x73, 0x78, 0x66, 0x1b, 0x5e, 0x49, 0x43, 0x6f, 0x25, 0x02,
+	0x2d, 0x00, 0x04, 0x8b, 0xdb, 0x5c, 0x30, 0x8a, 0x03, 0xf3, 0xbf, 0xba, 0xd1, 0x98, 0xf1, 0x32,
+	0x08, 0xdc, 0x02, 0x73, 0x31, 0xe1, 0x1c, 0x07, 0x7e, 0x3b, 0x62, 0x9d, 0x6d, 0xee, 0x77, 0xd8,
+	0x80, 0x0a, 0x9c, 0x98, 0x05, 0xb5, 0x44, 0xfd, 0x62, 0x68, 0xdf, 0xd3, 0x0f, 0x4d, 0x2c, 0x73,
+	0xbc, 0x59, 0x8d, 0xbb, 0x0a, 0x7e, 0xaa, 0xd1, 0x95, 0x99, 0xa3, 0x63, 0x3b, 0xf7, 0xf3, 0xd8,
+	0x36, 0x9c, 0x33, 0x03, 0x14, 0x5f, 0x20, 0x12, 0xad, 0xed, 0x60, 0x2a, 0xe0, 0x3c, 0x28, 0xa4,
+	0xfa, 0x4a, 0xf9, 0xf3, 0x5e, 0x1a, 0xc1, 0xc7, 0x60, 0x5a, 0x7a, 0x68, 0x4e, 0xfd, 0x73, 0xfb,
+	0x19, 0xb9, 0xbd, 0x5a, 0x53, 0x75, 0x48, 0xc6, 0x04, 0x23, 0xce, 0xa8, 0x52, 0xbd, 0xe8, 0xa5,
+	0x11, 0xec, 0x81, 0xb2, 0xba, 0x36, 0x1f, 0xc5, 0x72, 0x26, 0xa5, 0x6b, 0xd1, 0x5d, 0x93, 0xdd,
+	0xdf, 0x87, 0xf6, 0x42, 0x48, 0x44, 0x6f, 0xd0, 0x6e, 0x76, 0x58, 0xdc, 0x4a, 0x6f, 0x58, 0xff,
+	0x59, 0xe4, 0xc1, 0x76, 0x4b, 0xec, 0xf7, 0x31, 0x6f, 0xae, 0x53, 0x91, 0x71, 0x3f, 0xc3, 0x25,
+	0xdd, 0x97, 0xe1, 0x13, 0x1d, 0xfd, 0x9a, 0x06, 0x85, 0x0d, 0x94, 0xa0, 0x98, 0xc3, 0x37, 0xa0,
+	0xc2, 0x49, 0x48, 0xaf, 0x54, 0xda, 0x25, 0x34, 0x60, 0xbb, 0x7a, 0x59, 0xd7, 0xbe, 0x18, 0xda,
+	0x77, 0x53, 0xba, 0x09, 0x55, 0x8e, 0x07, 0x35, 0xac, 0xa5, 0x7c, 0xaf, 0x40, 0xf8, 0xc5, 0x90,
+	0x06, 0x51, 0x3f, 0xed, 0xe8, 0xe3, 0x64, 0x4c, 0x2a, 0xb5, 0x2a, 0xbb, 0xaf, 0x6e, 0xb1, 0xd1,
+	0x2a, 0xee, 0x64, 0xed, 0x9c, 0x40, 0xea, 0x78, 0x30, 0x26, 0x74, 0x53, 0xc1, 0x1b, 0x38, 0x49,
+	0x67, 0xf8, 0x0c, 0xe6, 0x03, 0xb6, 0x4b, 0xa5, 0xde, 0xbe, 0xbc, 0x2d, 0x7f, 0xfc, 0x3d, 0x2a,
+	0xcd, 0x4b, 0xcb, 0x77, 0x6e, 0xf8, 0xb5, 0x9a, 0x16, 0xb8, 0x0f, 0xd3, 0x63, 0xbd, 0xaf, 0x1f,
+	0x9d, 0x4c, 0xe3, 0x1c, 0x49, 0x3f, 0x2b, 0xe3, 0xa4, 0x3c, 0x97, 0x31, 0x01, 0x3c, 0x34, 0x40,
+	0x4d, 0x8b, 0xdf, 0x4d, 0x50, 0x47, 0x42, 0x7e, 0xc0, 0x06, 0xed, 0x08, 0xab, 0xe1, 0x95, 0xad,
+	0x65, 0x77, 0xf3, 0xd6, 0x22, 0x3c, 0xc8, 0xda, 0x3a, 0x89, 0xd9, 0xf1, 0xaa, 0x2a, 0xf9, 0x2c,
+	0xcd, 0xad, 0xaa, 0x94, 0x54, 0x06, 0x1e, 0x18, 0xa0, 0x7a, 0xa3, 0x51, 0x8f, 0xae, 0x3e, 0xb0,
+	0xb2, 0xbb, 0x71, 0xeb, 0x79, 0xac, 0x3f, 0xcc, 0xa3, 0x69, 0x1d, 0x6f, 0xee, 0xda, 0x30, 0x1a,
+	0x77, 0x5f, 0x7e, 0x1d, 0x59, 0xc6, 0xc9, 0xc8, 0x32, 0x4e, 0x47, 0x96, 0xf1, 0x63, 0x64, 0x19,
+	0x87, 0xe7, 0x56, 0xee, 0xf4, 0xdc, 0xca, 0x7d, 0x3b, 0xb7, 0x72, 0x1f, 0x16, 0xff, 0xfa, 0xfc,
+	0xde, 0xd5, 0xcf, 0xb6, 0x9a, 0xa4, 0x5d, 0x50, 0xf6, 0x3d, 0xfa, 0x3d, 0x00, 0xa2, 0xcf, 0x54,
+	0xd6, 0xd6, 0x05, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -268,6 +342,39 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *JailEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*JailEvent)
+	if !ok {
+		that2, ok := that.(JailEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if !this.SlashAmount.Equal(that1.SlashAmount) {
+		return false
+	}
+	return true
+}
 func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -367,6 +474,59 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JailEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JailEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JailEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashAmount.Size()
+		i -= size
+		if _, err := m.SlashAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -407,12 +567,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -471,6 +631,26 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	return n
 }
 
+func (m *JailEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = m.SlashAmount.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -692,6 +872,177 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *JailEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JailEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JailEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
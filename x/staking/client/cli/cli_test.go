@@ -855,15 +855,22 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 			"with text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`bond_denom: stake
+commission_change_cooldown: 86400s
+global_liquid_staking_cap: "0.250000000000000000"
 historical_entries: 100
+key_rotation_fee:
+  amount: "1000000"
+  denom: stake
 max_entries: 7
 max_validators: 100
-unbonding_time: 1814400s`,
+min_commission_rate: "0.000000000000000000"
+unbonding_time: 1814400s
+validator_liquid_staking_cap: "0.500000000000000000"`,
 		},
 		{
 			"with json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"unbonding_time":"1814400s","max_validators":100,"max_entries":7,"historical_entries":100,"bond_denom":"stake"}`,
+			`{"unbonding_time":"1814400s","max_validators":100,"max_entries":7,"historical_entries":100,"bond_denom":"stake","global_liquid_staking_cap":"0.250000000000000000","validator_liquid_staking_cap":"0.500000000000000000","key_rotation_fee":{"denom":"stake","amount":"1000000"},"min_commission_rate":"0.000000000000000000","commission_change_cooldown":"86400s"}`,
 		},
 	}
 	for _, tc := range testCases {
@@ -915,6 +922,68 @@ not_bonded_tokens: "0"`, cli.DefaultTokens.Mul(sdk.NewInt(2)).String()),
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQuerySimulateSlash() {
+	val := s.network.Validators[0]
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"invalid validator address",
+			[]string{"foo", "1", "0.5"},
+			true,
+		},
+		{
+			"invalid slash factor",
+			[]string{val.ValAddress.String(), "1", "1.5"},
+			true,
+		},
+		{
+			"infraction height in the future",
+			[]string{val.ValAddress.String(), "2", "0.5", fmt.Sprintf("--%s=1", flags.FlagHeight)},
+			true,
+		},
+		{
+			"valid request",
+			[]string{val.ValAddress.String(), "1", "0.5", fmt.Sprintf("--%s=1", flags.FlagHeight)},
+			false,
+		},
+		{
+			"valid request with pagination",
+			[]string{val.ValAddress.String(), "1", "0.5", fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=1", flags.FlagLimit)},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQuerySimulateSlash()
+			clientCtx := val.ClientCtx
+			args := append(tc.args, fmt.Sprintf("--%s=json", tmcli.OutputFlag))
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			var res types.QuerySimulateSlashResponse
+			s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &res))
+
+			// the validator self-delegation loses half of its tokens
+			slashed := cli.DefaultTokens.QuoRaw(2)
+			s.Require().Equal(slashed, res.SlashedTokens)
+			s.Require().Equal([]types.DelegatorSlashLoss{{
+				DelegatorAddress:        val.Address.String(),
+				DelegationLoss:          slashed,
+				UnbondingDelegationLoss: sdk.ZeroInt(),
+				RedelegationLoss:        sdk.ZeroInt(),
+			}}, res.Losses)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewCmdEditValidator() {
 	val := s.network.Validators[0]
 
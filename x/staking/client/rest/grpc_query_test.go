@@ -759,6 +759,62 @@ func (s *IntegrationTestSuite) TestQueryParamsGRPC() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestQuerySimulateSlashGRPC() {
+	val := s.network.Validators[0]
+	baseURL := val.APIAddress
+
+	testCases := []struct {
+		name  string
+		url   string
+		error bool
+	}{
+		{
+			"wrong validator address",
+			fmt.Sprintf("%s/cosmos/staking/v1beta1/validators/%s/simulate_slash?infraction_height=1&slash_factor=0.5", baseURL, "wrongValAddress"),
+			true,
+		},
+		{
+			"invalid slash factor",
+			fmt.Sprintf("%s/cosmos/staking/v1beta1/validators/%s/simulate_slash?infraction_height=1&slash_factor=1.5", baseURL, val.ValAddress.String()),
+			true,
+		},
+		{
+			"no historical info at the infraction height",
+			fmt.Sprintf("%s/cosmos/staking/v1beta1/validators/%s/simulate_slash?infraction_height=0&slash_factor=0.5", baseURL, val.ValAddress.String()),
+			true,
+		},
+		{
+			"valid request",
+			fmt.Sprintf("%s/cosmos/staking/v1beta1/validators/%s/simulate_slash?infraction_height=1&slash_factor=0.5", baseURL, val.ValAddress.String()),
+			false,
+		},
+		{
+			"valid request with pagination",
+			fmt.Sprintf("%s/cosmos/staking/v1beta1/validators/%s/simulate_slash?infraction_height=1&slash_factor=0.5&pagination.limit=1&pagination.count_total=true", baseURL, val.ValAddress.String()),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		resp, err := rest.GetRequest(tc.url)
+		s.Run(tc.name, func() {
+			s.Require().NoError(err)
+			var res types.QuerySimulateSlashResponse
+			err := val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp, &res)
+			if tc.error {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().True(res.SlashedTokens.IsPositive())
+			s.Require().Len(res.Losses, 1)
+			s.Require().Equal(uint64(1), res.Pagination.Total)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestQueryPoolGRPC() {
 	val := s.network.Validators[0]
 	baseURL := val.APIAddress
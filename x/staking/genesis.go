@@ -97,6 +97,35 @@ func InitGenesis(
 		}
 	}
 
+	// the liquid staking totals are derived from the delegations of the
+	// tokenize share records
+	totalLiquidStakedTokens := sdk.ZeroInt()
+
+	for _, record := range data.TokenizeShareRecords {
+		keeper.SetTokenizeShareRecord(ctx, record)
+
+		delegation, found := keeper.GetDelegation(ctx, record.GetModuleAddress(), record.GetValidator())
+		if !found {
+			continue
+		}
+
+		validator, found := keeper.GetValidator(ctx, record.GetValidator())
+		if !found {
+			panic(fmt.Sprintf("validator %s not found", record.Validator))
+		}
+
+		keeper.SetValidatorLiquidShares(ctx, validator.GetOperator(),
+			keeper.GetValidatorLiquidShares(ctx, validator.GetOperator()).Add(delegation.Shares))
+		totalLiquidStakedTokens = totalLiquidStakedTokens.Add(validator.TokensFromShares(delegation.Shares).TruncateInt())
+	}
+
+	keeper.SetTotalLiquidStakedTokens(ctx, totalLiquidStakedTokens)
+	keeper.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+
+	for _, history := range data.ConsPubkeyRotationHistory {
+		keeper.SetConsPubKeyRotationHistory(ctx, history)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -183,6 +212,13 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	var consPubKeyRotationHistory []types.ConsPubKeyRotationHistory
+
+	keeper.IterateConsPubKeyRotationHistory(ctx, func(history types.ConsPubKeyRotationHistory) (stop bool) {
+		consPubKeyRotationHistory = append(consPubKeyRotationHistory, history)
+		return false
+	})
+
 	return &types.GenesisState{
 		Params:               keeper.GetParams(ctx),
 		LastTotalPower:       keeper.GetLastTotalPower(ctx),
@@ -192,6 +228,10 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+
+		TokenizeShareRecords:      keeper.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId: keeper.GetLastTokenizeShareRecordID(ctx),
+		ConsPubkeyRotationHistory: consPubKeyRotationHistory,
 	}
 }
 
@@ -227,9 +267,62 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateConsPubKeyRotationHistory(data.ConsPubkeyRotationHistory, data.Validators); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateConsPubKeyRotationHistory(histories []types.ConsPubKeyRotationHistory, validators []types.Validator) error {
+	operators := make(map[string]bool, len(validators))
+	for _, validator := range validators {
+		operators[validator.OperatorAddress] = true
+	}
+
+	for _, history := range histories {
+		if !operators[history.OperatorAddress] {
+			return fmt.Errorf("consensus public key rotation of unknown validator %s in genesis state", history.OperatorAddress)
+		}
+
+		if _, err := history.GetOldConsPubKey(); err != nil {
+			return err
+		}
+
+		if _, err := history.GetNewConsPubKey(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastRecordID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+
+		if record.Id > lastRecordID {
+			return fmt.Errorf("tokenize share record id %d is greater than the last tokenize share record id %d", record.Id, lastRecordID)
+		}
+
+		ids[record.Id] = true
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
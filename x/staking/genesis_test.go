@@ -3,6 +3,7 @@ package staking_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -12,7 +13,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -27,11 +27,9 @@ func bootstrapGenesisTest(t *testing.T, power int64, numAddrs int) (*simapp.SimA
 	totalSupply := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt.MulRaw(int64(len(addrDels)))))
 
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
-	err := app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), totalSupply)
-	require.NoError(t, err)
+	require.NoError(t, simapp.FundModuleAccount(app, ctx, notBondedPool.GetName(), totalSupply))
 
 	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
-	app.BankKeeper.SetSupply(ctx, banktypes.NewSupply(totalSupply))
 
 	return app, ctx, addrDels
 }
@@ -159,6 +157,49 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate tokenize share records
+		{"tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{
+				types.NewTokenizeShareRecord(1, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address())),
+			}
+			data.LastTokenizeShareRecordId = 1
+		}, false},
+		{"duplicate tokenize share record", func(data *types.GenesisState) {
+			record := types.NewTokenizeShareRecord(1, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address()))
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record, record}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"tokenize share record id above last id", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{
+				types.NewTokenizeShareRecord(2, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address())),
+			}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"invalid tokenize share record module account", func(data *types.GenesisState) {
+			record := types.NewTokenizeShareRecord(1, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address()))
+			record.ModuleAccount = "tokenizeshare_2"
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record}
+			data.LastTokenizeShareRecordId = 2
+		}, true},
+		// validate consensus public key rotations
+		{"consensus public key rotation", func(data *types.GenesisState) {
+			validator := teststaking.NewValidator(t, sdk.ValAddress(pk.Address()), pk)
+			validator.Tokens = sdk.OneInt()
+			validator.DelegatorShares = sdk.OneDec()
+			data.Validators = []types.Validator{validator}
+			history, err := types.NewConsPubKeyRotationHistory(
+				sdk.ValAddress(pk.Address()), ed25519.GenPrivKey().PubKey(), pk, 1, time.Unix(0, 0), types.DefaultKeyRotationFee,
+			)
+			require.NoError(t, err)
+			data.ConsPubkeyRotationHistory = []types.ConsPubKeyRotationHistory{history}
+		}, false},
+		{"consensus public key rotation of unknown validator", func(data *types.GenesisState) {
+			history, err := types.NewConsPubKeyRotationHistory(
+				sdk.ValAddress(pk.Address()), ed25519.GenPrivKey().PubKey(), pk, 1, time.Unix(0, 0), types.DefaultKeyRotationFee,
+			)
+			require.NoError(t, err)
+			data.ConsPubkeyRotationHistory = []types.ConsPubKeyRotationHistory{history}
+		}, true},
 	}
 
 	for _, tt := range tests {
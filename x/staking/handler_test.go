@@ -18,7 +18,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
@@ -35,11 +34,9 @@ func bootstrapHandlerGenesisTest(t *testing.T, power int64, numAddrs int, accAmo
 	totalSupply := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt.MulRaw(int64(len(addrDels)))))
 
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
-	err := app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), totalSupply)
-	require.NoError(t, err)
+	require.NoError(t, simapp.FundModuleAccount(app, ctx, notBondedPool.GetName(), totalSupply))
 
 	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
-	app.BankKeeper.SetSupply(ctx, banktypes.NewSupply(totalSupply))
 
 	return app, ctx, addrDels, addrVals
 }
@@ -415,6 +412,55 @@ func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 	tstaking.Handle(msgEditValidator, false)
 }
 
+func TestCreateValidatorBelowMinCommissionRate(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(100)
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, sdk.TokensFromConsensusPower(initPower))
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// the zero commission of the helper is below the min commission rate
+	tstaking.CreateValidator(valAddrs[0], PKs[0], initBond, false)
+
+	tstaking.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
+	tstaking.CreateValidator(valAddrs[1], PKs[1], initBond, true)
+}
+
+func TestEditValidatorCommission(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(100)
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 1, sdk.TokensFromConsensusPower(initPower))
+	ctx = ctx.WithBlockTime(time.Unix(0, 0).UTC())
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	params.CommissionChangeCooldown = time.Hour
+	app.StakingKeeper.SetParams(ctx, params)
+
+	validatorAddr := valAddrs[0]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(5, 2))
+	tstaking.CreateValidator(validatorAddr, PKs[0], initBond, true)
+
+	// the commission can't be changed within the cooldown
+	newRate := sdk.NewDecWithPrec(6, 2)
+	tstaking.Ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour - time.Second))
+	tstaking.Handle(types.NewMsgEditValidator(validatorAddr, types.Description{}, &newRate, nil), false)
+
+	// the commission can't be lower than the min commission rate
+	lowRate := sdk.NewDecWithPrec(4, 2)
+	tstaking.Ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	tstaking.Handle(types.NewMsgEditValidator(validatorAddr, types.Description{}, &lowRate, nil), false)
+
+	tstaking.Handle(types.NewMsgEditValidator(validatorAddr, types.Description{}, &newRate, nil), true)
+	validator := tstaking.CheckValidator(validatorAddr, -1, false)
+	require.Equal(t, newRate, validator.Commission.Rate)
+}
+
 func TestIncrementsMsgUnbond(t *testing.T) {
 	initPower := int64(1000)
 
@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmstrings "github.com/tendermint/tendermint/libs/strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RotateConsPubKey replaces the consensus public key of a validator. The key
+// rotation fee is burnt from the operator account. The validator keeps being
+// found by its old consensus addresses so that the infractions committed with
+// its old keys are still attributed to it. The validator set update is
+// returned by ApplyAndReturnValidatorSetUpdates at the end of the block.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, valAddr sdk.ValAddress, newPubKey cryptotypes.PubKey) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(newPubKey)); found {
+		return types.ErrValidatorPubKeyExists
+	}
+
+	cp := ctx.ConsensusParams()
+	if cp != nil && cp.Validator != nil {
+		if !tmstrings.StringInSlice(newPubKey.Type(), cp.Validator.PubKeyTypes) {
+			return sdkerrors.Wrapf(
+				types.ErrValidatorPubKeyTypeNotSupported,
+				"got: %s, expected: %s", newPubKey.Type(), cp.Validator.PubKeyTypes,
+			)
+		}
+	}
+
+	// a validator can rotate its key once per unbonding period
+	if last, found := k.GetLastConsPubKeyRotation(ctx, valAddr); found {
+		if ctx.BlockHeader().Time.Before(last.Time.Add(k.UnbondingTime(ctx))) {
+			return types.ErrExceedingMaxConsPubKeyRotations
+		}
+	}
+
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	fee := k.KeyRotationFee(ctx)
+	if fee.IsPositive() {
+		coins := sdk.NewCoins(fee)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), types.ModuleName, coins); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return err
+	}
+
+	validator.ConsensusPubkey = pkAny
+	k.SetValidator(ctx, validator)
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+
+	history, err := types.NewConsPubKeyRotationHistory(valAddr, oldPubKey, newPubKey, ctx.BlockHeight(), ctx.BlockHeader().Time, fee)
+	if err != nil {
+		return err
+	}
+
+	k.SetConsPubKeyRotationHistory(ctx, history)
+	k.setPendingConsPubKeyRotation(ctx, history)
+
+	k.AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey)
+
+	return nil
+}
+
+// SetConsPubKeyRotationHistory sets a consensus public key rotation and indexes
+// the validator by its old consensus address.
+func (k Keeper) SetConsPubKeyRotationHistory(ctx sdk.Context, history types.ConsPubKeyRotationHistory) {
+	valAddr := history.GetOperator()
+
+	oldPubKey, err := history.GetOldConsPubKey()
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorConsPubKeyRotationHistoryKey(valAddr, history.Height), k.cdc.MustMarshalBinaryBare(&history))
+	store.Set(types.GetValidatorByConsAddrKey(sdk.GetConsAddress(oldPubKey)), valAddr)
+}
+
+// GetValidatorConsPubKeyRotationHistory returns the consensus public key
+// rotations of a validator, oldest first.
+func (k Keeper) GetValidatorConsPubKeyRotationHistory(ctx sdk.Context, valAddr sdk.ValAddress) (histories []types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetValidatorConsPubKeyRotationHistoryPrefix(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &history)
+		histories = append(histories, history)
+	}
+
+	return histories
+}
+
+// GetLastConsPubKeyRotation returns the last consensus public key rotation of
+// a validator.
+func (k Keeper) GetLastConsPubKeyRotation(ctx sdk.Context, valAddr sdk.ValAddress) (history types.ConsPubKeyRotationHistory, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetValidatorConsPubKeyRotationHistoryPrefix(valAddr))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return history, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &history)
+
+	return history, true
+}
+
+// IterateConsPubKeyRotationHistory iterates through the consensus public key
+// rotations of all the validators.
+func (k Keeper) IterateConsPubKeyRotationHistory(ctx sdk.Context, cb func(history types.ConsPubKeyRotationHistory) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorConsPubKeyRotationHistoryKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &history)
+
+		if cb(history) {
+			break
+		}
+	}
+}
+
+// deleteConsPubKeyRotationHistory deletes the consensus public key rotations of
+// a validator along with its old consensus address indexes.
+func (k Keeper) deleteConsPubKeyRotationHistory(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, history := range k.GetValidatorConsPubKeyRotationHistory(ctx, valAddr) {
+		oldPubKey, err := history.GetOldConsPubKey()
+		if err != nil {
+			panic(err)
+		}
+
+		store.Delete(types.GetValidatorByConsAddrKey(sdk.GetConsAddress(oldPubKey)))
+		store.Delete(types.GetValidatorConsPubKeyRotationHistoryKey(valAddr, history.Height))
+	}
+}
+
+// setPendingConsPubKeyRotation records a rotation to apply to the validator set
+// at the end of the block.
+func (k Keeper) setPendingConsPubKeyRotation(ctx sdk.Context, history types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingConsPubKeyRotationKey(history.GetOperator()), k.cdc.MustMarshalBinaryBare(&history))
+}
+
+// getPendingConsPubKeyRotations returns the rotations not yet applied to the
+// validator set.
+func (k Keeper) getPendingConsPubKeyRotations(ctx sdk.Context) (histories []types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingConsPubKeyRotationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &history)
+		histories = append(histories, history)
+	}
+
+	return histories
+}
+
+// applyConsPubKeyRotation rewrites the validator set updates of a validator
+// which rotated its consensus public key while in the last validator set:
+// Tendermint knows it by its old key, which must be removed, and its new key
+// must be added with the validator power if it is still bonded.
+func (k Keeper) applyConsPubKeyRotation(
+	ctx sdk.Context, updates []abci.ValidatorUpdate, history types.ConsPubKeyRotationHistory,
+) ([]abci.ValidatorUpdate, error) {
+	oldPubKey, err := history.GetOldConsPubKey()
+	if err != nil {
+		return nil, err
+	}
+	oldTmPubKey, err := cryptocodec.ToTmProtoPublicKey(oldPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	newPubKey, err := history.GetNewConsPubKey()
+	if err != nil {
+		return nil, err
+	}
+	newTmPubKey, err := cryptocodec.ToTmProtoPublicKey(newPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	rotated := make([]abci.ValidatorUpdate, 0, len(updates)+2)
+	for _, update := range updates {
+		if !update.PubKey.Equal(newTmPubKey) {
+			rotated = append(rotated, update)
+		}
+	}
+
+	rotated = append(rotated, abci.ValidatorUpdate{PubKey: oldTmPubKey, Power: 0})
+	if power := k.GetLastValidatorPower(ctx, history.GetOperator()); power > 0 {
+		rotated = append(rotated, abci.ValidatorUpdate{PubKey: newTmPubKey, Power: power})
+	}
+
+	return rotated, nil
+}
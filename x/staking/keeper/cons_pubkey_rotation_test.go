@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestRotateConsPubKey(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Unix(0, 0).UTC()})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	valAddr := sdk.ValAddress(addrs[0])
+	oldPk, newPk := PKs[0], PKs[1]
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddr, oldPk, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	fee := app.StakingKeeper.KeyRotationFee(ctx)
+	balance := app.BankKeeper.GetBalance(ctx, addrs[0], fee.Denom)
+	supply := app.BankKeeper.GetSupply(ctx, fee.Denom)
+
+	// the current key of a validator can't be reused
+	require.Equal(t, types.ErrValidatorPubKeyExists, app.StakingKeeper.RotateConsPubKey(ctx, valAddr, oldPk))
+
+	require.NoError(t, app.StakingKeeper.RotateConsPubKey(ctx, valAddr, newPk))
+
+	// the fee is burnt
+	require.Equal(t, balance.Sub(fee), app.BankKeeper.GetBalance(ctx, addrs[0], fee.Denom))
+	require.Equal(t, supply.Sub(fee), app.BankKeeper.GetSupply(ctx, fee.Denom))
+
+	// the validator is found by both its old and new consensus addresses
+	validator, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(newPk))
+	require.True(t, found)
+	require.Equal(t, valAddr, validator.GetOperator())
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	require.Equal(t, sdk.GetConsAddress(newPk), consAddr)
+	validator, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(oldPk))
+	require.True(t, found)
+	require.Equal(t, valAddr, validator.GetOperator())
+
+	histories := app.StakingKeeper.GetValidatorConsPubKeyRotationHistory(ctx, valAddr)
+	require.Len(t, histories, 1)
+	require.Equal(t, valAddr.String(), histories[0].OperatorAddress)
+	require.Equal(t, ctx.BlockHeight(), histories[0].Height)
+	require.Equal(t, fee, histories[0].Fee)
+	historyOldPk, err := histories[0].GetOldConsPubKey()
+	require.NoError(t, err)
+	require.True(t, oldPk.Equals(historyOldPk))
+	historyNewPk, err := histories[0].GetNewConsPubKey()
+	require.NoError(t, err)
+	require.True(t, newPk.Equals(historyNewPk))
+
+	// Tendermint removes the old key and adds the new one
+	oldTmPk, err := cryptocodec.ToTmProtoPublicKey(oldPk)
+	require.NoError(t, err)
+	newTmPk, err := cryptocodec.ToTmProtoPublicKey(newPk)
+	require.NoError(t, err)
+	updates := staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Equal(t, []abci.ValidatorUpdate{
+		{PubKey: oldTmPk, Power: 0},
+		{PubKey: newTmPk, Power: 100},
+	}, updates)
+	require.Empty(t, staking.EndBlocker(ctx, app.StakingKeeper))
+
+	// a single rotation is allowed per unbonding period
+	ctx = ctx.WithBlockHeight(2)
+	require.Equal(t, types.ErrExceedingMaxConsPubKeyRotations, app.StakingKeeper.RotateConsPubKey(ctx, valAddr, PKs[2]))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(app.StakingKeeper.UnbondingTime(ctx)))
+	require.NoError(t, app.StakingKeeper.RotateConsPubKey(ctx, valAddr, PKs[2]))
+	require.Len(t, app.StakingKeeper.GetValidatorConsPubKeyRotationHistory(ctx, valAddr), 2)
+}
+
+func TestRotateConsPubKeyUnbondedValidator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	valAddr := sdk.ValAddress(addrs[0])
+
+	// the validator is not bonded yet, Tendermint only learns its new key
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddr, PKs[0], 100, true)
+	require.NoError(t, app.StakingKeeper.RotateConsPubKey(ctx, valAddr, PKs[1]))
+
+	newTmPk, err := cryptocodec.ToTmProtoPublicKey(PKs[1])
+	require.NoError(t, err)
+	updates := staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Equal(t, []abci.ValidatorUpdate{{PubKey: newTmPk, Power: 100}}, updates)
+}
@@ -0,0 +1,351 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the id of the last tokenize share record.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last tokenize share record.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns a tokenize share record by its id.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordKey(id))
+	if bz == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+
+	return record, true
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record of a share
+// token denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if bz == nil {
+		return record, false
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records of an owner.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetTokenizeShareRecordIDsByOwnerKey(owner)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(prefix):])
+		record, found := k.GetTokenizeShareRecord(ctx, id)
+		if !found {
+			panic("tokenize share record indexed by owner not found")
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// SetTokenizeShareRecord stores a tokenize share record along with its owner
+// and denom indexes.
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetTokenizeShareRecordKey(record.Id), k.cdc.MustMarshalBinaryBare(&record))
+	store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(record.GetOwner(), record.Id), []byte{})
+	store.Set(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()), sdk.Uint64ToBigEndian(record.Id))
+}
+
+// DeleteTokenizeShareRecord removes a tokenize share record and its indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.GetTokenizeShareRecordKey(record.Id))
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(record.GetOwner(), record.Id))
+	store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokenized tokens, which the
+// global liquid staking cap bounds.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalLiquidStakedTokensKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &ip)
+
+	return ip.Int
+}
+
+// SetTotalLiquidStakedTokens sets the amount of tokenized tokens.
+func (k Keeper) SetTotalLiquidStakedTokens(ctx sdk.Context, tokens sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&sdk.IntProto{Int: tokens})
+	store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// GetValidatorLiquidShares returns the tokenized delegator shares of a
+// validator, which the validator liquid staking cap bounds.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &dp)
+
+	return dp.Dec
+}
+
+// SetValidatorLiquidShares sets the tokenized delegator shares of a validator.
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	if !shares.IsPositive() {
+		store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+		return
+	}
+
+	bz := k.cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: shares})
+	store.Set(types.GetValidatorLiquidSharesKey(valAddr), bz)
+}
+
+// decreaseLiquidStaking removes tokens and shares of a validator from the
+// liquid staking totals, which cannot go below zero.
+func (k Keeper) decreaseLiquidStaking(ctx sdk.Context, valAddr sdk.ValAddress, tokens sdk.Int, shares sdk.Dec) {
+	totalTokens := sdk.MaxInt(k.GetTotalLiquidStakedTokens(ctx).Sub(tokens), sdk.ZeroInt())
+	k.SetTotalLiquidStakedTokens(ctx, totalTokens)
+
+	validatorShares := sdk.MaxDec(k.GetValidatorLiquidShares(ctx, valAddr).Sub(shares), sdk.ZeroDec())
+	k.SetValidatorLiquidShares(ctx, valAddr, validatorShares)
+}
+
+// slashLiquidStakedTokens removes the tokenized part of the tokens slashed from
+// a validator from the total of the tokenized tokens.
+func (k Keeper) slashLiquidStakedTokens(ctx sdk.Context, validator types.Validator, tokensToBurn sdk.Int) {
+	liquidShares := k.GetValidatorLiquidShares(ctx, validator.GetOperator())
+	if !liquidShares.IsPositive() || !validator.DelegatorShares.IsPositive() {
+		return
+	}
+
+	slashedTokens := tokensToBurn.ToDec().Mul(liquidShares).Quo(validator.DelegatorShares).TruncateInt()
+	k.decreaseLiquidStaking(ctx, validator.GetOperator(), slashedTokens, sdk.ZeroDec())
+}
+
+// TokenizeShares moves an amount of tokens of the delegation of a delegator to
+// the module account of a new tokenize share record, and mints the share
+// tokens of the record to the delegator. The rewards of the tokenized
+// delegation belong to the owner of the record.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Int, owner sdk.AccAddress,
+) (sdk.Coin, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	// Tokenizing the self-delegation could drop it below its minimum and jail
+	// the validator.
+	if delAddr.Equals(valAddr) {
+		return sdk.Coin{}, types.ErrTokenizeSharesSelfDelegation
+	}
+
+	// Vesting tokens can't be tokenized, as the share tokens would be spendable.
+	if acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount); ok {
+		if amount.GT(acc.GetDelegatedFree().AmountOf(k.BondDenom(ctx))) {
+			return sdk.Coin{}, types.ErrExceedingFreeVestingDelegations
+		}
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	totalLiquidTokens := k.GetTotalLiquidStakedTokens(ctx).Add(amount)
+	if totalLiquidTokens.ToDec().GT(k.GlobalLiquidStakingCap(ctx).MulInt(k.TotalBondedTokens(ctx))) {
+		return sdk.Coin{}, types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	validatorLiquidShares := k.GetValidatorLiquidShares(ctx, valAddr).Add(shares)
+	if validatorLiquidShares.GT(k.ValidatorLiquidStakingCap(ctx).Mul(validator.DelegatorShares)) {
+		return sdk.Coin{}, types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	recordID := k.GetLastTokenizeShareRecordID(ctx) + 1
+	record := types.NewTokenizeShareRecord(recordID, owner, valAddr)
+
+	// The tokens are undelegated to the delegator, sent to the module account
+	// of the record and delegated from it, so that a vesting delegator tracks
+	// the undelegation of its free tokens.
+	tokens, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, tokens)
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), tokens))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, delAddr, record.GetModuleAddress(), coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	recordShares, err := k.Delegate(ctx, record.GetModuleAddress(), tokens, types.Unbonded, validator, true)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), recordShares.TruncateInt())
+	if !shareToken.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrBadSharesAmount, "tokenized shares truncate to zero share tokens")
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.SetTokenizeShareRecord(ctx, record)
+	k.SetLastTokenizeShareRecordID(ctx, recordID)
+	k.SetTotalLiquidStakedTokens(ctx, k.GetTotalLiquidStakedTokens(ctx).Add(tokens))
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(recordShares))
+
+	return shareToken, nil
+}
+
+// RedeemTokensForShares burns share tokens of a delegator, and moves the
+// shares they represent from the delegation of their tokenize share record to
+// a delegation of the delegator. Once all of its shares are redeemed, the
+// record is removed and its remaining rewards are sent to its owner.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (sdk.Coin, error) {
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if !found {
+		return sdk.Coin{}, types.ErrTokenizeShareRecordNotExists
+	}
+
+	recordAddr := record.GetModuleAddress()
+	valAddr := record.GetValidator()
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, recordAddr, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoDelegation
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// The last share tokens redeem the shares lost to truncation when minting.
+	shares := sdk.MinDec(shareToken.Amount.ToDec(), delegation.Shares)
+	if k.bankKeeper.GetSupply(ctx, shareToken.Denom).IsZero() {
+		shares = delegation.Shares
+	}
+
+	tokens, err := k.Unbond(ctx, recordAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, tokens)
+	}
+
+	k.decreaseLiquidStaking(ctx, valAddr, tokens, shares)
+
+	// The tokens are undelegated to the delegator and delegated from it, so
+	// that a vesting delegator tracks the delegation.
+	coins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), tokens))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// The validator is removed along with its last delegation once unbonded,
+	// in which case the tokens stay with the delegator.
+	validator, found = k.GetValidator(ctx, valAddr)
+	if found {
+		if _, err := k.Delegate(ctx, delAddr, tokens, types.Unbonded, validator, true); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if _, found := k.GetDelegation(ctx, recordAddr, valAddr); !found {
+		// The rewards withdrawn when unbonding the last shares go to the owner.
+		if rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr); !rewards.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, recordAddr, record.GetOwner(), rewards); err != nil {
+				return sdk.Coin{}, err
+			}
+		}
+
+		k.DeleteTokenizeShareRecord(ctx, record)
+	}
+
+	return sdk.NewCoin(k.BondDenom(ctx), tokens), nil
+}
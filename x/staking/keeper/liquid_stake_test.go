@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// bootstrapLiquidStakeTest creates a bonded validator with a self-delegation
+// and a delegation of the same power.
+func bootstrapLiquidStakeTest(t *testing.T) (*simapp.SimApp, sdk.Context, []sdk.AccAddress, sdk.ValAddress) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.TokensFromConsensusPower(200))
+	valAddr := sdk.ValAddress(addrs[0])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddr, PKs[0], 100, true)
+	tstaking.DelegateWithPower(addrs[1], valAddr, 100)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	return app, ctx, addrs, valAddr
+}
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	app, ctx, addrs, valAddr := bootstrapLiquidStakeTest(t)
+	delAddr, owner := addrs[1], addrs[2]
+
+	// the self-delegation can't be tokenized
+	_, err := app.StakingKeeper.TokenizeShares(ctx, addrs[0], valAddr, sdk.TokensFromConsensusPower(10), owner)
+	require.Equal(t, types.ErrTokenizeSharesSelfDelegation, err)
+
+	// the delegation can't be tokenized above the global cap
+	_, err = app.StakingKeeper.TokenizeShares(ctx, delAddr, valAddr, sdk.TokensFromConsensusPower(60), owner)
+	require.Equal(t, types.ErrGlobalLiquidStakingCapExceeded, err)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.OneDec()
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(25, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// the delegation can't be tokenized above the validator cap
+	_, err = app.StakingKeeper.TokenizeShares(ctx, delAddr, valAddr, sdk.TokensFromConsensusPower(60), owner)
+	require.Equal(t, types.ErrValidatorLiquidStakingCapExceeded, err)
+
+	tokens := sdk.TokensFromConsensusPower(40)
+	shareToken, err := app.StakingKeeper.TokenizeShares(ctx, delAddr, valAddr, tokens, owner)
+	require.NoError(t, err)
+
+	record, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, types.NewTokenizeShareRecord(1, owner, valAddr), record)
+	require.Equal(t, sdk.NewCoin(record.GetShareTokenDenom(), tokens), shareToken)
+	require.Equal(t, shareToken, app.BankKeeper.GetBalance(ctx, delAddr, shareToken.Denom))
+	require.Equal(t, []types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner))
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.True(t, found)
+	require.Equal(t, tokens.ToDec(), delegation.Shares)
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(60).ToDec(), delegation.Shares)
+
+	require.Equal(t, tokens, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	require.Equal(t, tokens.ToDec(), app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddr))
+
+	// the tokenized shares stay bonded
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(200), validator.Tokens)
+
+	// a part of the share tokens is redeemed by another account
+	redeemed := sdk.NewCoin(shareToken.Denom, sdk.TokensFromConsensusPower(10))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, delAddr, owner, sdk.NewCoins(redeemed)))
+	returned, err := app.StakingKeeper.RedeemTokensForShares(ctx, owner, redeemed)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, redeemed.Amount), returned)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, owner, valAddr)
+	require.True(t, found)
+	require.Equal(t, redeemed.Amount.ToDec(), delegation.Shares)
+	require.Equal(t, sdk.TokensFromConsensusPower(30), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// redeeming the last share tokens removes the record
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, delAddr, shareToken.Sub(redeemed))
+	require.NoError(t, err)
+
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner))
+	require.True(t, app.BankKeeper.GetSupply(ctx, shareToken.Denom).IsZero())
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+	require.True(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, valAddr).IsZero())
+
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, delAddr, shareToken)
+	require.Equal(t, types.ErrTokenizeShareRecordNotExists, err)
+}
+
+func TestSlashTokenizedShares(t *testing.T) {
+	app, ctx, addrs, valAddr := bootstrapLiquidStakeTest(t)
+	delAddr, owner := addrs[1], addrs[2]
+
+	shareToken, err := app.StakingKeeper.TokenizeShares(ctx, delAddr, valAddr, sdk.TokensFromConsensusPower(40), owner)
+	require.NoError(t, err)
+
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 200, sdk.NewDecWithPrec(1, 1))
+
+	// the tokenized shares take their part of the slash
+	require.Equal(t, sdk.TokensFromConsensusPower(36), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	returned, err := app.StakingKeeper.RedeemTokensForShares(ctx, delAddr, shareToken)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(36)), returned)
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+}
+
+func TestTokenizeSharesVestingAccount(t *testing.T) {
+	app, ctx, addrs, valAddr := bootstrapLiquidStakeTest(t)
+	owner := addrs[2]
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.OneDec()
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	// a vesting account delegates its vesting and free tokens
+	vestingTokens, freeTokens := sdk.TokensFromConsensusPower(60), sdk.TokensFromConsensusPower(40)
+	delAddr := sdk.AccAddress(PKs[1].Address())
+	require.NoError(t, simapp.FundAccount(app, ctx, delAddr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, vestingTokens.Add(freeTokens)))))
+	baseAcc := app.AccountKeeper.GetAccount(ctx, delAddr).(*authtypes.BaseAccount)
+	vestingAcc := vestingtypes.NewDelayedVestingAccount(baseAcc, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, vestingTokens)), ctx.BlockTime().Add(365*24*time.Hour).Unix())
+	app.AccountKeeper.SetAccount(ctx, vestingAcc)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	_, err := app.StakingKeeper.Delegate(ctx, delAddr, vestingTokens.Add(freeTokens), types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// the vesting tokens can't be tokenized
+	_, err = app.StakingKeeper.TokenizeShares(ctx, delAddr, valAddr, freeTokens.AddRaw(1), owner)
+	require.Equal(t, types.ErrExceedingFreeVestingDelegations, err)
+
+	// tokenizing the free tokens undelegates them from the vesting account
+	shareToken, err := app.StakingKeeper.TokenizeShares(ctx, delAddr, valAddr, freeTokens, owner)
+	require.NoError(t, err)
+
+	acc := app.AccountKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount)
+	require.True(t, acc.GetDelegatedFree().IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, vestingTokens)), acc.GetDelegatedVesting())
+
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, delAddr, owner, sdk.NewCoins(shareToken)))
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, owner, shareToken)
+	require.NoError(t, err)
+
+	// undelegating the vesting tokens leaves them locked
+	completionTime, err := app.StakingKeeper.Undelegate(ctx, delAddr, valAddr, vestingTokens.ToDec())
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.CompleteUnbonding(ctx.WithBlockTime(completionTime), delAddr, valAddr)
+	require.NoError(t, err)
+
+	acc = app.AccountKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount)
+	require.True(t, acc.GetDelegatedVesting().IsZero())
+	require.True(t, app.BankKeeper.SpendableCoins(ctx, delAddr).AmountOf(sdk.DefaultBondDenom).IsZero())
+}
@@ -2,14 +2,18 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	types "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Slash a validator for an infraction committed at a known height
 // Find the contributing stake at that height and burn the specified slashFactor
-// of it, updating unbonding delegations & redelegations appropriately
+// of it, updating unbonding delegations & redelegations appropriately.
+// It returns the amount of tokens burned.
 //
 // CONTRACT:
 //    slashFactor is non-negative
@@ -21,18 +25,13 @@ import (
 // CONTRACT:
 //    Infraction was committed at the current height or at a past height,
 //    not at a height in the future
-func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec) {
+func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec) sdk.Int {
 	logger := k.Logger(ctx)
 
 	if slashFactor.IsNegative() {
 		panic(fmt.Errorf("attempted to slash with a negative slash factor: %v", slashFactor))
 	}
 
-	// Amount of slashing = slash slashFactor * power at time of infraction
-	amount := sdk.TokensFromConsensusPower(power)
-	slashAmountDec := amount.ToDec().Mul(slashFactor)
-	slashAmount := slashAmountDec.TruncateInt()
-
 	// ref https://github.com/cosmos/cosmos-sdk/issues/1348
 
 	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
@@ -45,7 +44,7 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 			"WARNING: Ignored attempt to slash a nonexistent validator with address %s, we recommend you investigate immediately",
 			consAddr))
 
-		return
+		return sdk.ZeroInt()
 	}
 
 	// should not be slashing an unbonded validator
@@ -58,11 +57,6 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	// call the before-modification hook
 	k.BeforeValidatorModified(ctx, operatorAddress)
 
-	// Track remaining slash amount for the validator
-	// This will decrease when we slash unbondings and
-	// redelegations, as that stake has since unbonded
-	remainingSlashAmount := slashAmount
-
 	switch {
 	case infractionHeight > ctx.BlockHeight():
 		// Can't slash infractions in the future
@@ -75,34 +69,17 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		logger.Info(fmt.Sprintf(
 			"slashing at current height %d, not scanning unbonding delegations & redelegations",
 			infractionHeight))
+	}
 
-	case infractionHeight < ctx.BlockHeight():
-		// Iterate through unbonding delegations from slashed validator
-		unbondingDelegations := k.GetUnbondingDelegationsFromValidator(ctx, operatorAddress)
-		for _, unbondingDelegation := range unbondingDelegations {
-			amountSlashed := k.SlashUnbondingDelegation(ctx, unbondingDelegation, infractionHeight, slashFactor)
-			if amountSlashed.IsZero() {
-				continue
-			}
-
-			remainingSlashAmount = remainingSlashAmount.Sub(amountSlashed)
-		}
-
-		// Iterate through redelegations from slashed source validator
-		redelegations := k.GetRedelegationsFromSrcValidator(ctx, operatorAddress)
-		for _, redelegation := range redelegations {
-			amountSlashed := k.SlashRedelegation(ctx, validator, redelegation, infractionHeight, slashFactor)
-			if amountSlashed.IsZero() {
-				continue
-			}
+	slash := k.computeSlash(ctx, validator, infractionHeight, power, slashFactor)
 
-			remainingSlashAmount = remainingSlashAmount.Sub(amountSlashed)
-		}
+	for i, unbondingDelegation := range slash.unbondingDelegations {
+		k.applyUnbondingDelegationSlash(ctx, unbondingDelegation, slash.unbondingDelegationBurns[i])
 	}
 
-	// cannot decrease balance below zero
-	tokensToBurn := sdk.MinInt(remainingSlashAmount, validator.Tokens)
-	tokensToBurn = sdk.MaxInt(tokensToBurn, sdk.ZeroInt()) // defensive.
+	k.applyRedelegationSlash(ctx, slash.redelegationUnbonds)
+
+	tokensToBurn := slash.validatorBurn
 
 	// we need to calculate the *effective* slash fraction for distribution
 	if validator.Tokens.IsPositive() {
@@ -115,6 +92,9 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		k.BeforeValidatorSlashed(ctx, operatorAddress, effectiveFraction)
 	}
 
+	// The tokenized delegations are slashed along with the others.
+	k.slashLiquidStakedTokens(ctx, validator, tokensToBurn)
+
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
@@ -136,6 +116,247 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	logger.Info(fmt.Sprintf(
 		"validator %s slashed by slash factor of %s; burned %v tokens",
 		validator.GetOperator(), slashFactor.String(), tokensToBurn))
+
+	return slash.burnedAmount()
+}
+
+// slashEffects holds the state changes of a validator slash.
+type slashEffects struct {
+	// unbonding delegations from the validator with their slashed entries
+	unbondingDelegations []types.UnbondingDelegation
+	// tokens burnt from each of the unbonding delegations
+	unbondingDelegationBurns []sdk.Int
+	// unbondings of the destination delegations of the slashed redelegations
+	redelegationUnbonds []redelegationUnbond
+	// tokens burnt from the validator
+	validatorBurn sdk.Int
+}
+
+// burnedAmount returns the total amount of tokens burned by the slash.
+func (slash slashEffects) burnedAmount() sdk.Int {
+	burned := slash.validatorBurn
+	for _, amount := range slash.unbondingDelegationBurns {
+		burned = burned.Add(amount)
+	}
+
+	for _, unbond := range slash.redelegationUnbonds {
+		burned = burned.Add(unbond.tokens)
+	}
+
+	return burned
+}
+
+// redelegationUnbond is the unbonding of the destination delegation of a
+// slashed redelegation entry, whose tokens are burnt.
+type redelegationUnbond struct {
+	delegatorAddress    sdk.AccAddress
+	validatorDstAddress sdk.ValAddress
+	shares              sdk.Dec
+	tokens              sdk.Int
+	bonded              bool
+}
+
+// slashedDelegations caches the destination validators and delegation shares
+// of the redelegations being slashed, so that the unbondings of successive
+// entries are computed without writing them.
+type slashedDelegations struct {
+	validators map[string]types.Validator
+	shares     map[string]sdk.Dec
+}
+
+func newSlashedDelegations() slashedDelegations {
+	return slashedDelegations{
+		validators: make(map[string]types.Validator),
+		shares:     make(map[string]sdk.Dec),
+	}
+}
+
+// unbondSlashedDelegation computes the tokens unbonded for the shares of a delegation and
+// caches the updated delegation and validator. It returns false if the
+// delegation doesn't exist or has no shares left.
+func (k Keeper) unbondSlashedDelegation(
+	ctx sdk.Context, dsts slashedDelegations, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
+) (redelegationUnbond, bool) {
+	key := string(types.GetDelegationKey(delAddr, valAddr))
+	delegationShares, found := dsts.shares[key]
+	if !found {
+		delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+		if !found {
+			return redelegationUnbond{}, false
+		}
+		delegationShares = delegation.Shares
+	}
+
+	// If deleted, delegation has zero shares, and we can't unbond any more
+	if delegationShares.IsZero() {
+		return redelegationUnbond{}, false
+	}
+
+	if shares.GT(delegationShares) {
+		shares = delegationShares
+	}
+
+	validator, found := dsts.validators[valAddr.String()]
+	if !found {
+		validator, found = k.GetValidator(ctx, valAddr)
+		if !found {
+			panic("destination validator not found")
+		}
+	}
+
+	validator, tokens := validator.RemoveDelShares(shares)
+	dsts.validators[valAddr.String()] = validator
+	dsts.shares[key] = delegationShares.Sub(shares)
+
+	return redelegationUnbond{
+		delegatorAddress:    delAddr,
+		validatorDstAddress: valAddr,
+		shares:              shares,
+		tokens:              tokens,
+		bonded:              validator.IsBonded(),
+	}, true
+}
+
+// computeSlash computes the state changes of a slash of a validator for an
+// infraction committed at infractionHeight, when it had the given power,
+// without writing them.
+func (k Keeper) computeSlash(
+	ctx sdk.Context, validator types.Validator, infractionHeight int64, power int64, slashFactor sdk.Dec,
+) slashEffects {
+	var slash slashEffects
+
+	// Amount of slashing = slash slashFactor * power at time of infraction
+	amount := sdk.TokensFromConsensusPower(power)
+	slashAmountDec := amount.ToDec().Mul(slashFactor)
+	slashAmount := slashAmountDec.TruncateInt()
+
+	// Track remaining slash amount for the validator
+	// This will decrease when we slash unbondings and
+	// redelegations, as that stake has since unbonded
+	remainingSlashAmount := slashAmount
+
+	if infractionHeight < ctx.BlockHeight() {
+		now := ctx.BlockHeader().Time
+
+		// Iterate through unbonding delegations from slashed validator
+		unbondingDelegations := k.GetUnbondingDelegationsFromValidator(ctx, validator.GetOperator())
+		for _, unbondingDelegation := range unbondingDelegations {
+			slashed, amountSlashed, burnedAmount := computeUnbondingDelegationSlash(
+				now, unbondingDelegation, infractionHeight, slashFactor,
+			)
+			remainingSlashAmount = remainingSlashAmount.Sub(amountSlashed)
+
+			if burnedAmount.IsPositive() {
+				slash.unbondingDelegations = append(slash.unbondingDelegations, slashed)
+				slash.unbondingDelegationBurns = append(slash.unbondingDelegationBurns, burnedAmount)
+			}
+		}
+
+		// Iterate through redelegations from slashed source validator
+		dsts := newSlashedDelegations()
+		redelegations := k.GetRedelegationsFromSrcValidator(ctx, validator.GetOperator())
+		for _, redelegation := range redelegations {
+			amountSlashed, unbonds := k.computeRedelegationSlash(ctx, dsts, redelegation, infractionHeight, slashFactor)
+			remainingSlashAmount = remainingSlashAmount.Sub(amountSlashed)
+			slash.redelegationUnbonds = append(slash.redelegationUnbonds, unbonds...)
+		}
+	}
+
+	// cannot decrease balance below zero
+	tokensToBurn := sdk.MinInt(remainingSlashAmount, validator.Tokens)
+	slash.validatorBurn = sdk.MaxInt(tokensToBurn, sdk.ZeroInt()) // defensive.
+
+	return slash
+}
+
+// SimulateSlash returns the tokens which would be burnt if a validator was
+// slashed for an infraction committed at infractionHeight, and the loss of
+// each of its delegators, including their unbonding delegations and
+// redelegations from the validator, sorted by delegator address. The slash
+// is computed without writing it, from the validator power recorded in the
+// historical info at the infraction height.
+func (k Keeper) SimulateSlash(
+	ctx sdk.Context, valAddr sdk.ValAddress, infractionHeight int64, slashFactor sdk.Dec,
+) (slashedTokens sdk.Int, losses []types.DelegatorSlashLoss, err error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return slashedTokens, nil, types.ErrNoValidatorFound
+	}
+
+	if validator.IsUnbonded() {
+		return slashedTokens, nil, types.ErrSlashUnbondedValidator
+	}
+
+	if infractionHeight < 0 || infractionHeight > ctx.BlockHeight() {
+		return slashedTokens, nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid infraction height %d at height %d", infractionHeight, ctx.BlockHeight(),
+		)
+	}
+
+	if slashFactor.IsNegative() || slashFactor.GT(sdk.OneDec()) {
+		return slashedTokens, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid slash factor %s", slashFactor)
+	}
+
+	historicalInfo, found := k.GetHistoricalInfo(ctx, infractionHeight)
+	if !found {
+		return slashedTokens, nil, sdkerrors.Wrapf(types.ErrNoHistoricalInfo, "height %d", infractionHeight)
+	}
+
+	// The validator had no power if it wasn't in the validator set at the
+	// infraction height.
+	var power int64
+	for _, val := range historicalInfo.Valset {
+		if val.OperatorAddress == validator.OperatorAddress {
+			power = val.GetConsensusPower()
+			break
+		}
+	}
+
+	slash := k.computeSlash(ctx, validator, infractionHeight, power, slashFactor)
+
+	lossesByDelegator := make(map[string]*types.DelegatorSlashLoss)
+	delegatorLoss := func(delAddr string) *types.DelegatorSlashLoss {
+		loss, ok := lossesByDelegator[delAddr]
+		if !ok {
+			loss = &types.DelegatorSlashLoss{
+				DelegatorAddress:        delAddr,
+				DelegationLoss:          sdk.ZeroInt(),
+				UnbondingDelegationLoss: sdk.ZeroInt(),
+				RedelegationLoss:        sdk.ZeroInt(),
+			}
+			lossesByDelegator[delAddr] = loss
+		}
+		return loss
+	}
+
+	slashedValidator := validator.RemoveTokens(slash.validatorBurn)
+	for _, delegation := range k.GetValidatorDelegations(ctx, valAddr) {
+		loss := validator.TokensFromShares(delegation.Shares).Sub(slashedValidator.TokensFromShares(delegation.Shares))
+		delegatorLoss(delegation.DelegatorAddress).DelegationLoss = loss.TruncateInt()
+	}
+
+	for i, ubd := range slash.unbondingDelegations {
+		loss := delegatorLoss(ubd.DelegatorAddress)
+		loss.UnbondingDelegationLoss = loss.UnbondingDelegationLoss.Add(slash.unbondingDelegationBurns[i])
+	}
+
+	for _, unbond := range slash.redelegationUnbonds {
+		loss := delegatorLoss(unbond.delegatorAddress.String())
+		loss.RedelegationLoss = loss.RedelegationLoss.Add(unbond.tokens)
+	}
+
+	delAddrs := make([]string, 0, len(lossesByDelegator))
+	for delAddr := range lossesByDelegator {
+		delAddrs = append(delAddrs, delAddr)
+	}
+	sort.Strings(delAddrs)
+
+	losses = make([]types.DelegatorSlashLoss, 0, len(delAddrs))
+	for _, delAddr := range delAddrs {
+		losses = append(losses, *lossesByDelegator[delAddr])
+	}
+
+	return slash.burnedAmount(), losses, nil
 }
 
 // jail a validator
@@ -161,12 +382,29 @@ func (k Keeper) Unjail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 // insufficient stake remaining)
 func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation types.UnbondingDelegation,
 	infractionHeight int64, slashFactor sdk.Dec) (totalSlashAmount sdk.Int) {
-	now := ctx.BlockHeader().Time
+	unbondingDelegation, totalSlashAmount, burnedAmount := computeUnbondingDelegationSlash(
+		ctx.BlockHeader().Time, unbondingDelegation, infractionHeight, slashFactor,
+	)
+	k.applyUnbondingDelegationSlash(ctx, unbondingDelegation, burnedAmount)
+
+	return totalSlashAmount
+}
+
+// computeUnbondingDelegationSlash returns the unbonding delegation with its
+// slashed entries, the amount that would have been slashed assuming the
+// unbonding delegation had enough stake to slash, and the amount burnt.
+func computeUnbondingDelegationSlash(now time.Time, unbondingDelegation types.UnbondingDelegation,
+	infractionHeight int64, slashFactor sdk.Dec) (slashed types.UnbondingDelegation, totalSlashAmount, burnedAmount sdk.Int) {
 	totalSlashAmount = sdk.ZeroInt()
-	burnedAmount := sdk.ZeroInt()
+	burnedAmount = sdk.ZeroInt()
+
+	// the entries are copied, so that the stored unbonding delegation isn't modified
+	slashed = unbondingDelegation
+	slashed.Entries = make([]types.UnbondingDelegationEntry, len(unbondingDelegation.Entries))
+	copy(slashed.Entries, unbondingDelegation.Entries)
 
 	// perform slashing on all entries within the unbonding delegation
-	for i, entry := range unbondingDelegation.Entries {
+	for i, entry := range slashed.Entries {
 		// If unbonding started before this height, stake didn't contribute to infraction
 		if entry.CreationHeight < infractionHeight {
 			continue
@@ -195,15 +433,22 @@ func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 
 		burnedAmount = burnedAmount.Add(unbondingSlashAmount)
 		entry.Balance = entry.Balance.Sub(unbondingSlashAmount)
-		unbondingDelegation.Entries[i] = entry
+		slashed.Entries[i] = entry
+	}
+
+	return slashed, totalSlashAmount, burnedAmount
+}
+
+// applyUnbondingDelegationSlash stores a slashed unbonding delegation and
+// burns the slashed tokens.
+func (k Keeper) applyUnbondingDelegationSlash(ctx sdk.Context, unbondingDelegation types.UnbondingDelegation, burnedAmount sdk.Int) {
+	if burnedAmount.IsPositive() {
 		k.SetUnbondingDelegation(ctx, unbondingDelegation)
 	}
 
 	if err := k.burnNotBondedTokens(ctx, burnedAmount); err != nil {
 		panic(err)
 	}
-
-	return totalSlashAmount
 }
 
 // slash a redelegation and update the pool
@@ -214,9 +459,19 @@ func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 // NOTE this is only slashing for prior infractions from the source validator
 func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator, redelegation types.Redelegation,
 	infractionHeight int64, slashFactor sdk.Dec) (totalSlashAmount sdk.Int) {
+	totalSlashAmount, unbonds := k.computeRedelegationSlash(ctx, newSlashedDelegations(), redelegation, infractionHeight, slashFactor)
+	k.applyRedelegationSlash(ctx, unbonds)
+
+	return totalSlashAmount
+}
+
+// computeRedelegationSlash returns the amount that would have been slashed
+// from a redelegation assuming it had enough stake to slash, and the
+// unbondings of its destination delegation, without writing them.
+func (k Keeper) computeRedelegationSlash(ctx sdk.Context, dsts slashedDelegations, redelegation types.Redelegation,
+	infractionHeight int64, slashFactor sdk.Dec) (totalSlashAmount sdk.Int, unbonds []redelegationUnbond) {
 	now := ctx.BlockHeader().Time
 	totalSlashAmount = sdk.ZeroInt()
-	bondedBurnedAmount, notBondedBurnedAmount := sdk.ZeroInt(), sdk.ZeroInt()
 
 	// perform slashing on all entries within the redelegation
 	for _, entry := range redelegation.Entries {
@@ -251,35 +506,34 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 			panic(err)
 		}
 
-		delegation, found := k.GetDelegation(ctx, delegatorAddress, valDstAddr)
+		unbond, found := k.unbondSlashedDelegation(ctx, dsts, delegatorAddress, valDstAddr, sharesToUnbond)
 		if !found {
-			// If deleted, delegation has zero shares, and we can't unbond any more
 			continue
 		}
 
-		if sharesToUnbond.GT(delegation.Shares) {
-			sharesToUnbond = delegation.Shares
-		}
+		unbonds = append(unbonds, unbond)
+	}
+
+	return totalSlashAmount, unbonds
+}
+
+// applyRedelegationSlash unbonds the destination delegations of slashed
+// redelegations and burns their tokens.
+func (k Keeper) applyRedelegationSlash(ctx sdk.Context, unbonds []redelegationUnbond) {
+	bondedBurnedAmount, notBondedBurnedAmount := sdk.ZeroInt(), sdk.ZeroInt()
 
-		tokensToBurn, err := k.Unbond(ctx, delegatorAddress, valDstAddr, sharesToUnbond)
+	for _, unbond := range unbonds {
+		tokensToBurn, err := k.Unbond(ctx, unbond.delegatorAddress, unbond.validatorDstAddress, unbond.shares)
 		if err != nil {
 			panic(fmt.Errorf("error unbonding delegator: %v", err))
 		}
 
-		dstValidator, found := k.GetValidator(ctx, valDstAddr)
-		if !found {
-			panic("destination validator not found")
-		}
-
 		// tokens of a redelegation currently live in the destination validator
 		// therefor we must burn tokens from the destination-validator's bonding status
-		switch {
-		case dstValidator.IsBonded():
+		if unbond.bonded {
 			bondedBurnedAmount = bondedBurnedAmount.Add(tokensToBurn)
-		case dstValidator.IsUnbonded() || dstValidator.IsUnbonding():
+		} else {
 			notBondedBurnedAmount = notBondedBurnedAmount.Add(tokensToBurn)
-		default:
-			panic("unknown validator status")
 		}
 	}
 
@@ -290,6 +544,4 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 	if err := k.burnNotBondedTokens(ctx, notBondedBurnedAmount); err != nil {
 		panic(err)
 	}
-
-	return totalSlashAmount
 }
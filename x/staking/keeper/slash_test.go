@@ -10,7 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -26,8 +26,7 @@ func bootstrapSlashTest(t *testing.T, power int64) (*simapp.SimApp, sdk.Context,
 	totalSupply := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt.MulRaw(int64(len(addrDels)))))
 
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
-	err := app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), totalSupply)
-	require.NoError(t, err)
+	require.NoError(t, simapp.FundModuleAccount(app, ctx, notBondedPool.GetName(), totalSupply))
 
 	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
 
@@ -35,11 +34,9 @@ func bootstrapSlashTest(t *testing.T, power int64) (*simapp.SimApp, sdk.Context,
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt.MulRaw(numVals)))
 	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
 
-	err = app.BankKeeper.SetBalances(ctx, bondedPool.GetAddress(), bondedCoins)
-	require.NoError(t, err)
-
+	// set bonded pool balance
 	app.AccountKeeper.SetModuleAccount(ctx, bondedPool)
-	app.BankKeeper.SetSupply(ctx, banktypes.NewSupply(totalSupply))
+	require.NoError(t, simapp.FundModuleAccount(app, ctx, bondedPool.GetName(), bondedCoins))
 
 	for i := int64(0); i < numVals; i++ {
 		validator := teststaking.NewValidator(t, addrVals[i], PKs[i])
@@ -616,3 +613,128 @@ func TestSlashBoth(t *testing.T) {
 	// power not decreased, all stake was bonded since
 	require.Equal(t, int64(10), validator.GetConsensusPower())
 }
+
+// tests SimulateSlash of a validator with delegations, unbonding delegations
+// and redelegations
+func TestSimulateSlash(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Unix(0, 0).UTC()})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.TokensFromConsensusPower(200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs[:2])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], PKs[0], 100, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], PKs[1], 100, true)
+	tstaking.DelegateWithPower(addrs[2], valAddrs[0], 100)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	app.StakingKeeper.TrackHistoricalInfo(ctx)
+
+	// the delegator unbonds and redelegates a part of its delegation
+	tstaking.Ctx = ctx.WithBlockHeight(2)
+	tstaking.Undelegate(addrs[2], valAddrs[0], sdk.TokensFromConsensusPower(20), true)
+	tstaking.Handle(types.NewMsgBeginRedelegate(
+		addrs[2], valAddrs[0], valAddrs[1], sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(30)),
+	), true)
+	ctx = tstaking.TurnBlockTimeDiff(time.Hour)
+
+	ctx = ctx.WithBlockHeight(3)
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, int64(150), validator.GetConsensusPower())
+
+	// the slash factor applies to the power of 200 at the infraction height:
+	// 2 tokens of power are slashed from the unbonding delegation, 3 from the
+	// redelegation and the 15 left from the validator, shared between its 150
+	// shares
+	slashed, losses, err := app.StakingKeeper.SimulateSlash(ctx, valAddrs[0], 1, sdk.NewDecWithPrec(1, 1))
+	require.NoError(t, err)
+	require.Equal(t, sdk.TokensFromConsensusPower(20), slashed)
+
+	expected := map[string]types.DelegatorSlashLoss{
+		addrs[0].String(): {
+			DelegatorAddress:        addrs[0].String(),
+			DelegationLoss:          sdk.TokensFromConsensusPower(10),
+			UnbondingDelegationLoss: sdk.ZeroInt(),
+			RedelegationLoss:        sdk.ZeroInt(),
+		},
+		addrs[2].String(): {
+			DelegatorAddress:        addrs[2].String(),
+			DelegationLoss:          sdk.TokensFromConsensusPower(5),
+			UnbondingDelegationLoss: sdk.TokensFromConsensusPower(2),
+			RedelegationLoss:        sdk.TokensFromConsensusPower(3),
+		},
+	}
+	require.Len(t, losses, 2)
+	require.True(t, losses[0].DelegatorAddress < losses[1].DelegatorAddress)
+	for _, loss := range losses {
+		require.Equal(t, expected[loss.DelegatorAddress], loss)
+	}
+
+	// nothing is slashed
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(150), validator.Tokens)
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrs[2], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(20), ubd.Entries[0].Balance)
+
+	// invalid simulations
+	_, _, err = app.StakingKeeper.SimulateSlash(ctx, valAddrs[0], 4, sdk.NewDecWithPrec(1, 1))
+	require.Error(t, err)
+	_, _, err = app.StakingKeeper.SimulateSlash(ctx, valAddrs[0], 1, sdk.NewDec(2))
+	require.Error(t, err)
+	_, _, err = app.StakingKeeper.SimulateSlash(ctx, sdk.ValAddress(addrs[2]), 1, sdk.NewDecWithPrec(1, 1))
+	require.Equal(t, types.ErrNoValidatorFound, err)
+	_, _, err = app.StakingKeeper.SimulateSlash(ctx, valAddrs[0], 2, sdk.NewDecWithPrec(1, 1))
+	require.True(t, types.ErrNoHistoricalInfo.Is(err))
+}
+
+// tests that SimulateSlash computes the same slash as Slash, and that the
+// slash of successive redelegation entries to the same delegation matches
+// the unbondings of Slash
+func TestSimulateSlashMatchesSlash(t *testing.T) {
+	app, ctx, addrDels, addrVals := bootstrapSlashTest(t, 10)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(5, 1)
+
+	// two redelegation entries to a delegation of 5 shares, the second one
+	// can only unbond the 2 shares left by the first one
+	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 11,
+		time.Unix(5, 0), sdk.TokensFromConsensusPower(1), sdk.NewDec(6))
+	rd.AddEntry(11, time.Unix(5, 0), sdk.TokensFromConsensusPower(1), sdk.NewDec(6))
+	app.StakingKeeper.SetRedelegation(ctx, rd)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrDels[0], addrVals[1], sdk.NewDec(5)))
+
+	ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 11,
+		time.Unix(5, 0), sdk.TokensFromConsensusPower(2))
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 12, Time: time.Unix(0, 0)})
+	validator, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.True(t, found)
+	app.StakingKeeper.SetHistoricalInfo(ctx, 10, &types.HistoricalInfo{Valset: types.Validators{validator}})
+
+	slashed, losses, err := app.StakingKeeper.SimulateSlash(ctx, addrVals[0], 10, fraction)
+	require.NoError(t, err)
+
+	// 1 token of power is slashed from the unbonding delegation, the 5
+	// shares of the redelegations are unbonded and the 3 tokens of power left
+	// are slashed from the validator
+	require.Equal(t, sdk.TokensFromConsensusPower(4).AddRaw(5), slashed)
+	require.Equal(t, []types.DelegatorSlashLoss{{
+		DelegatorAddress:        addrDels[0].String(),
+		DelegationLoss:          sdk.ZeroInt(),
+		UnbondingDelegationLoss: sdk.TokensFromConsensusPower(1),
+		RedelegationLoss:        sdk.NewInt(5),
+	}}, losses)
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	supply := app.BankKeeper.GetSupply(ctx, bondDenom).Amount
+	burned := app.StakingKeeper.Slash(ctx, consAddr, 10, validator.GetConsensusPower(), fraction)
+	require.Equal(t, slashed, burned)
+	require.Equal(t, burned, supply.Sub(app.BankKeeper.GetSupply(ctx, bondDenom).Amount))
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[1])
+	require.False(t, found)
+}
@@ -119,6 +119,18 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	// (see LastValidatorPowerKey).
 	last := k.getLastValidatorsByAddr(ctx)
 
+	// Retrieve the consensus public key rotations of the block along with
+	// whether the rotated validators are in the last validator set, in which
+	// case Tendermint knows them by their old key.
+	rotations := k.getPendingConsPubKeyRotations(ctx)
+	rotatedInLast := make([]bool, len(rotations))
+	for i, rotation := range rotations {
+		var valAddrBytes [sdk.AddrLen]byte
+
+		copy(valAddrBytes[:], rotation.GetOperator())
+		_, rotatedInLast[i] = last[valAddrBytes]
+	}
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -192,6 +204,17 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		updates = append(updates, validator.ABCIValidatorUpdateZero())
 	}
 
+	for i, rotation := range rotations {
+		if rotatedInLast[i] {
+			updates, err = k.applyConsPubKeyRotation(ctx, updates, rotation)
+			if err != nil {
+				return
+			}
+		}
+
+		ctx.KVStore(k.storeKey).Delete(types.GetPendingConsPubKeyRotationKey(rotation.GetOperator()))
+	}
+
 	// Update the pools based on the recent updates in the validator set:
 	// - The tokens from the non-bonded candidates that enter the new validator set need to be transferred
 	// to the Bonded pool.
@@ -167,16 +167,21 @@ func (k Keeper) RemoveValidatorTokens(ctx sdk.Context,
 }
 
 // UpdateValidatorCommission attempts to update a validator's commission rate.
-// An error is returned if the new commission rate is invalid.
+// An error is returned if the new commission rate is invalid or below the
+// minimum commission rate.
 func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 	validator types.Validator, newRate sdk.Dec) (types.Commission, error) {
 	commission := validator.Commission
 	blockTime := ctx.BlockHeader().Time
 
-	if err := commission.ValidateNewRate(newRate, blockTime); err != nil {
+	if err := commission.ValidateNewRate(newRate, blockTime, k.CommissionChangeCooldown(ctx)); err != nil {
 		return commission, err
 	}
 
+	if newRate.LT(k.MinCommissionRate(ctx)) {
+		return commission, types.ErrCommissionLTMinRate
+	}
+
 	commission.Rate = newRate
 	commission.UpdateTime = blockTime
 
@@ -211,6 +216,7 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(types.GetValidatorKey(address))
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator))
+	k.deleteConsPubKeyRotationHistory(ctx, address)
 
 	// call hooks
 	k.AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator())
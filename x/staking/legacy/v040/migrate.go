@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	v034staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v034"
 	v038staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v038"
 	v040staking "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -124,11 +125,18 @@ func Migrate(stakingState v038staking.GenesisState) *v040staking.GenesisState {
 
 	return &v040staking.GenesisState{
 		Params: v040staking.Params{
-			UnbondingTime:     stakingState.Params.UnbondingTime,
-			MaxValidators:     uint32(stakingState.Params.MaxValidators),
-			MaxEntries:        uint32(stakingState.Params.MaxEntries),
-			HistoricalEntries: uint32(stakingState.Params.HistoricalEntries),
-			BondDenom:         stakingState.Params.BondDenom,
+			UnbondingTime:             stakingState.Params.UnbondingTime,
+			MaxValidators:             uint32(stakingState.Params.MaxValidators),
+			MaxEntries:                uint32(stakingState.Params.MaxEntries),
+			HistoricalEntries:         uint32(stakingState.Params.HistoricalEntries),
+			BondDenom:                 stakingState.Params.BondDenom,
+			GlobalLiquidStakingCap:    v040staking.DefaultGlobalLiquidStakingCap,
+			ValidatorLiquidStakingCap: v040staking.DefaultValidatorLiquidStakingCap,
+			// the fee is paid in the bond denom, and built without
+			// sdk.NewCoin which panics on an invalid bond denom
+			KeyRotationFee:           sdk.Coin{Denom: stakingState.Params.BondDenom, Amount: v040staking.DefaultKeyRotationFee.Amount},
+			MinCommissionRate:        v040staking.DefaultMinCommissionRate,
+			CommissionChangeCooldown: v040staking.DefaultCommissionChangeCooldown,
 		},
 		LastTotalPower:       stakingState.LastTotalPower,
 		LastValidatorPowers:  newLastValidatorPowers,
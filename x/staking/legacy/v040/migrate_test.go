@@ -9,9 +9,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 	v034staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v034"
 	v038staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v038"
 	v040staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v040"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestMigrate(t *testing.T) {
@@ -46,18 +49,29 @@ func TestMigrate(t *testing.T) {
 	// - consensus_pubkey: should be an any
 	// - validator's status should be 1 (new unbonded)
 	expected := `{
+  "cons_pubkey_rotation_history": [],
   "delegations": [],
   "exported": false,
+  "last_tokenize_share_record_id": "0",
   "last_total_power": "0",
   "last_validator_powers": [],
   "params": {
     "bond_denom": "",
+    "commission_change_cooldown": "86400s",
+    "global_liquid_staking_cap": "0.250000000000000000",
     "historical_entries": 0,
+    "key_rotation_fee": {
+      "amount": "1000000",
+      "denom": ""
+    },
     "max_entries": 0,
     "max_validators": 0,
-    "unbonding_time": "0s"
+    "min_commission_rate": "0.000000000000000000",
+    "unbonding_time": "0s",
+    "validator_liquid_staking_cap": "0.500000000000000000"
   },
   "redelegations": [],
+  "tokenize_share_records": [],
   "unbonding_delegations": [],
   "validators": [
     {
@@ -94,3 +108,21 @@ func TestMigrate(t *testing.T) {
 
 	require.Equal(t, expected, string(indentedBz))
 }
+
+func TestMigrateValidGenesis(t *testing.T) {
+	stakingGenState := v038staking.GenesisState{
+		Params: v038staking.Params{
+			UnbondingTime:     types.DefaultUnbondingTime,
+			MaxValidators:     100,
+			MaxEntries:        7,
+			HistoricalEntries: 0,
+			BondDenom:         "uatom",
+		},
+	}
+
+	// The params added since v0.38 are set to their default value, and the key
+	// rotation fee is paid in the bond denom.
+	migrated := v040staking.Migrate(stakingGenState)
+	require.NoError(t, staking.ValidateGenesis(migrated))
+	require.Equal(t, sdk.NewCoin("uatom", types.DefaultKeyRotationFee.Amount), migrated.Params.KeyRotationFee)
+}
@@ -0,0 +1,24 @@
+package v042
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateParams performs in-place params migrations from v0.41 to v0.42. The
+// migration includes:
+//
+// - Set the global liquid staking cap to its default value.
+// - Set the validator liquid staking cap to its default value.
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	if !paramSpace.Has(ctx, types.KeyGlobalLiquidStakingCap) {
+		paramSpace.Set(ctx, types.KeyGlobalLiquidStakingCap, types.DefaultGlobalLiquidStakingCap)
+	}
+
+	if !paramSpace.Has(ctx, types.KeyValidatorLiquidStakingCap) {
+		paramSpace.Set(ctx, types.KeyValidatorLiquidStakingCap, types.DefaultValidatorLiquidStakingCap)
+	}
+
+	return nil
+}
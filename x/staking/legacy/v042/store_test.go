@@ -0,0 +1,49 @@
+package v042_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v042staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v042"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestParamsMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, paramsTKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// Old params don't have the liquid staking caps.
+	paramSpace.Set(ctx, types.KeyUnbondingTime, types.DefaultUnbondingTime)
+	paramSpace.Set(ctx, types.KeyMaxValidators, types.DefaultMaxValidators)
+	paramSpace.Set(ctx, types.KeyMaxEntries, types.DefaultMaxEntries)
+	paramSpace.Set(ctx, types.KeyHistoricalEntries, types.DefaultHistoricalEntries)
+	paramSpace.Set(ctx, types.KeyBondDenom, sdk.DefaultBondDenom)
+	require.Panics(t, func() {
+		var params types.Params
+		paramSpace.GetParamSet(ctx, &params)
+	})
+
+	// Run migration.
+	require.NoError(t, v042staking.MigrateParams(ctx, paramSpace))
+
+	var globalCap, validatorCap sdk.Dec
+	paramSpace.Get(ctx, types.KeyGlobalLiquidStakingCap, &globalCap)
+	paramSpace.Get(ctx, types.KeyValidatorLiquidStakingCap, &validatorCap)
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, globalCap)
+	require.Equal(t, types.DefaultValidatorLiquidStakingCap, validatorCap)
+
+	// Running the migration again leaves the params untouched.
+	paramSpace.Set(ctx, types.KeyGlobalLiquidStakingCap, sdk.NewDecWithPrec(1, 1))
+	require.NoError(t, v042staking.MigrateParams(ctx, paramSpace))
+	paramSpace.Get(ctx, types.KeyGlobalLiquidStakingCap, &globalCap)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), globalCap)
+}
@@ -0,0 +1,24 @@
+package v043
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateParams performs in-place params migrations from v0.42 to v0.43. The
+// migration includes:
+//
+// - Set the consensus public key rotation fee to its default amount, in the
+// bond denom.
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	if !paramSpace.Has(ctx, types.KeyKeyRotationFee) {
+		var bondDenom string
+		paramSpace.Get(ctx, types.KeyBondDenom, &bondDenom)
+
+		fee := sdk.NewCoin(bondDenom, types.DefaultKeyRotationFee.Amount)
+		paramSpace.Set(ctx, types.KeyKeyRotationFee, fee)
+	}
+
+	return nil
+}
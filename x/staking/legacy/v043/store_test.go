@@ -0,0 +1,78 @@
+package v043_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v042staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v042"
+	v043staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v043"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestParamsMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, paramsTKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// Old params don't have the key rotation fee.
+	paramSpace.Set(ctx, types.KeyUnbondingTime, types.DefaultUnbondingTime)
+	paramSpace.Set(ctx, types.KeyMaxValidators, types.DefaultMaxValidators)
+	paramSpace.Set(ctx, types.KeyMaxEntries, types.DefaultMaxEntries)
+	paramSpace.Set(ctx, types.KeyHistoricalEntries, types.DefaultHistoricalEntries)
+	paramSpace.Set(ctx, types.KeyBondDenom, sdk.DefaultBondDenom)
+	require.NoError(t, v042staking.MigrateParams(ctx, paramSpace))
+	require.False(t, paramSpace.Has(ctx, types.KeyKeyRotationFee))
+
+	// Run migration.
+	require.NoError(t, v043staking.MigrateParams(ctx, paramSpace))
+
+	// The params up to v0.43 are set to their default value, the commission
+	// params being only set by the v0.44 migration.
+	var params types.Params
+	for _, pair := range params.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyMinCommissionRate) || bytes.Equal(pair.Key, types.KeyCommissionChangeCooldown) {
+			require.False(t, paramSpace.Has(ctx, pair.Key))
+			continue
+		}
+		paramSpace.Get(ctx, pair.Key, pair.Value)
+	}
+
+	expParams := types.DefaultParams()
+	expParams.MinCommissionRate = sdk.Dec{}
+	expParams.CommissionChangeCooldown = 0
+	require.Equal(t, expParams, params)
+
+	// Running the migration again leaves the params untouched.
+	fee := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+	paramSpace.Set(ctx, types.KeyKeyRotationFee, fee)
+	require.NoError(t, v043staking.MigrateParams(ctx, paramSpace))
+	var migratedFee sdk.Coin
+	paramSpace.Get(ctx, types.KeyKeyRotationFee, &migratedFee)
+	require.Equal(t, fee, migratedFee)
+}
+
+func TestParamsMigrationBondDenom(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, paramsTKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// The key rotation fee is paid in the bond denom of the chain.
+	paramSpace.Set(ctx, types.KeyBondDenom, "uatom")
+	require.NoError(t, v043staking.MigrateParams(ctx, paramSpace))
+
+	var fee sdk.Coin
+	paramSpace.Get(ctx, types.KeyKeyRotationFee, &fee)
+	require.Equal(t, sdk.NewCoin("uatom", types.DefaultKeyRotationFee.Amount), fee)
+}
@@ -0,0 +1,66 @@
+package v044
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// migrateParams sets the commission params to their default value, unless an
+// upgrade handler set them before the migration.
+func migrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) {
+	if !paramSpace.Has(ctx, types.KeyMinCommissionRate) {
+		paramSpace.Set(ctx, types.KeyMinCommissionRate, types.DefaultMinCommissionRate)
+	}
+
+	if !paramSpace.Has(ctx, types.KeyCommissionChangeCooldown) {
+		paramSpace.Set(ctx, types.KeyCommissionChangeCooldown, types.DefaultCommissionChangeCooldown)
+	}
+}
+
+// migrateValidatorsCommission raises the commission rate of the validators
+// below the minimum commission rate to it, along with their max rate if it is
+// below too.
+func migrateValidatorsCommission(store sdk.KVStore, cdc codec.BinaryMarshaler, minRate sdk.Dec) error {
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsKey)
+	defer iterator.Close()
+
+	var validators []types.Validator
+	for ; iterator.Valid(); iterator.Next() {
+		validator, err := types.UnmarshalValidator(cdc, iterator.Value())
+		if err != nil {
+			return err
+		}
+
+		if validator.Commission.Rate.LT(minRate) {
+			validators = append(validators, validator)
+		}
+	}
+
+	for _, validator := range validators {
+		validator.Commission.Rate = minRate
+		if validator.Commission.MaxRate.LT(minRate) {
+			validator.Commission.MaxRate = minRate
+		}
+
+		store.Set(types.GetValidatorKey(validator.GetOperator()), types.MustMarshalValidator(cdc, &validator))
+	}
+
+	return nil
+}
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.44. The
+// migration includes:
+//
+// - Set the min commission rate and the commission change cooldown params to
+// their default value, unless they are already set.
+// - Raise the commission rate of the validators below the min commission rate.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, paramSpace paramtypes.Subspace) error {
+	migrateParams(ctx, paramSpace)
+
+	var minRate sdk.Dec
+	paramSpace.Get(ctx, types.KeyMinCommissionRate, &minRate)
+
+	return migrateValidatorsCommission(ctx.KVStore(storeKey), cdc, minRate)
+}
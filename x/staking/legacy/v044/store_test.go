@@ -0,0 +1,81 @@
+package v044_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v042staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v042"
+	v043staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v043"
+	v044staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v044"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestParamsMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, paramsTKey)
+	ms := ctx.MultiStore().(sdk.CommitMultiStore)
+	ms.MountStoreWithDB(stakingKey, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// Old params don't have the commission params.
+	paramSpace.Set(ctx, types.KeyUnbondingTime, types.DefaultUnbondingTime)
+	paramSpace.Set(ctx, types.KeyMaxValidators, types.DefaultMaxValidators)
+	paramSpace.Set(ctx, types.KeyMaxEntries, types.DefaultMaxEntries)
+	paramSpace.Set(ctx, types.KeyHistoricalEntries, types.DefaultHistoricalEntries)
+	paramSpace.Set(ctx, types.KeyBondDenom, sdk.DefaultBondDenom)
+	require.NoError(t, v042staking.MigrateParams(ctx, paramSpace))
+	require.NoError(t, v043staking.MigrateParams(ctx, paramSpace))
+	require.Panics(t, func() {
+		var params types.Params
+		paramSpace.GetParamSet(ctx, &params)
+	})
+
+	// Run migration.
+	require.NoError(t, v044staking.MigrateStore(ctx, stakingKey, encCfg.Marshaler, paramSpace))
+
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+	require.Equal(t, types.DefaultParams(), params)
+}
+
+func TestValidatorsCommissionMigration(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	paramSpace := app.GetSubspace(types.ModuleName)
+
+	pk1, pk2 := ed25519.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey()
+	val1 := teststaking.NewValidator(t, sdk.ValAddress(pk1.Address()), pk1)
+	val2 := teststaking.NewValidator(t, sdk.ValAddress(pk2.Address()), pk2)
+	val2.Commission = types.NewCommission(sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(1, 1))
+	app.StakingKeeper.SetValidator(ctx, val1)
+	app.StakingKeeper.SetValidator(ctx, val2)
+
+	// The upgrade handler sets a min commission rate before the migration.
+	minRate := sdk.NewDecWithPrec(5, 2)
+	paramSpace.Set(ctx, types.KeyMinCommissionRate, minRate)
+	require.NoError(t, v044staking.MigrateStore(ctx, app.GetKey(types.StoreKey), app.AppCodec(), paramSpace))
+	require.Equal(t, minRate, app.StakingKeeper.MinCommissionRate(ctx))
+
+	// The validators below the min commission rate are raised to it.
+	migrated, found := app.StakingKeeper.GetValidator(ctx, val1.GetOperator())
+	require.True(t, found)
+	require.Equal(t, minRate, migrated.Commission.Rate)
+	require.Equal(t, minRate, migrated.Commission.MaxRate)
+
+	migrated, found = app.StakingKeeper.GetValidator(ctx, val2.GetOperator())
+	require.True(t, found)
+	require.Equal(t, val2.Commission, migrated.Commission)
+}
@@ -147,6 +147,9 @@ func SimulateMsgCreateValidator(ak types.AccountKeeper, bk types.BankKeeper, k k
 			maxCommission,
 			simtypes.RandomDecAmount(r, maxCommission),
 		)
+		if commission.Rate.LT(k.MinCommissionRate(ctx)) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateValidator, "commission rate below the minimum"), nil, nil
+		}
 
 		msg, err := types.NewMsgCreateValidator(address, simAccount.ConsKey.PubKey(), selfDelegation, description, commission, sdk.OneInt())
 		if err != nil {
@@ -196,7 +199,7 @@ func SimulateMsgEditValidator(ak types.AccountKeeper, bk types.BankKeeper, k kee
 
 		newCommissionRate := simtypes.RandomDecAmount(r, val.Commission.MaxRate)
 
-		if err := val.Commission.ValidateNewRate(newCommissionRate, ctx.BlockHeader().Time); err != nil {
+		if _, err := k.UpdateValidatorCommission(ctx, val, newCommissionRate); err != nil {
 			// skip as the commission is invalid
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgEditValidator, "invalid commission rate"), nil, nil
 		}
@@ -0,0 +1,173 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	coin100 = sdk.NewInt64Coin("steak", 100)
+	coin50  = sdk.NewInt64Coin("steak", 50)
+	delAddr = sdk.AccAddress("_____delegator _____")
+	val1    = sdk.ValAddress("_____validator1_____")
+	val2    = sdk.ValAddress("_____validator2_____")
+	val3    = sdk.ValAddress("_____validator3_____")
+)
+
+func TestAuthzAuthorizations(t *testing.T) {
+	ctx := testutil.DefaultContext(sdk.NewKVStoreKey("test"), sdk.NewTransientStoreKey("transient_test"))
+
+	// verify ValidateBasic returns error for the AUTHORIZATION_TYPE_UNSPECIFIED authorization type
+	delAuth, err := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{val1, val2}, []sdk.ValAddress{}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED, &coin100)
+	require.NoError(t, err)
+	require.Error(t, delAuth.ValidateBasic())
+
+	// verify MsgTypeURL
+	delAuth, err = stakingtypes.NewStakeAuthorization([]sdk.ValAddress{val1, val2}, []sdk.ValAddress{}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &coin100)
+	require.NoError(t, err)
+	require.Equal(t, delAuth.MsgTypeURL(), sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}))
+	require.NoError(t, delAuth.ValidateBasic())
+
+	// setting both allowed and denied validators fails
+	_, err = stakingtypes.NewStakeAuthorization([]sdk.ValAddress{val1}, []sdk.ValAddress{val2}, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &coin100)
+	require.Error(t, err)
+
+	validators1_2 := []string{val1.String(), val2.String()}
+
+	testCases := []struct {
+		msg                  string
+		allowed              []sdk.ValAddress
+		denied               []sdk.ValAddress
+		msgType              stakingtypes.AuthorizationType
+		limit                *sdk.Coin
+		srvMsg               sdk.MsgRequest
+		expectErr            bool
+		isDelete             bool
+		updatedAuthorization *stakingtypes.StakeAuthorization
+	}{
+		{
+			"delegate: expect 0 remaining coins",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			&coin100,
+			stakingtypes.NewMsgDelegate(delAddr, val1, coin100),
+			false,
+			true,
+			nil,
+		},
+		{
+			"delegate: verify remaining coins",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			&coin100,
+			stakingtypes.NewMsgDelegate(delAddr, val1, coin50),
+			false,
+			false,
+			&stakingtypes.StakeAuthorization{
+				Validators: &stakingtypes.StakeAuthorization_AllowList{
+					AllowList: &stakingtypes.StakeAuthorization_Validators{Address: validators1_2},
+				}, MaxTokens: &coin50, AuthorizationType: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE},
+		},
+		{
+			"delegate: testing with invalid validator",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			&coin100,
+			stakingtypes.NewMsgDelegate(delAddr, val3, coin100),
+			true,
+			false,
+			nil,
+		},
+		{
+			"delegate: testing delegate without spent limit",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			nil,
+			stakingtypes.NewMsgDelegate(delAddr, val2, coin100),
+			false,
+			false,
+			&stakingtypes.StakeAuthorization{
+				Validators: &stakingtypes.StakeAuthorization_AllowList{
+					AllowList: &stakingtypes.StakeAuthorization_Validators{Address: validators1_2},
+				}, MaxTokens: nil, AuthorizationType: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE},
+		},
+		{
+			"delegate: fail validator denied",
+			[]sdk.ValAddress{},
+			[]sdk.ValAddress{val1},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			nil,
+			stakingtypes.NewMsgDelegate(delAddr, val1, coin100),
+			true,
+			false,
+			nil,
+		},
+		{
+			"delegate: fail amount exceeds max tokens",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+			&coin50,
+			stakingtypes.NewMsgDelegate(delAddr, val1, coin100),
+			true,
+			false,
+			nil,
+		},
+		{
+			"undelegate: expect 0 remaining coins",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE,
+			&coin100,
+			stakingtypes.NewMsgUndelegate(delAddr, val1, coin100),
+			false,
+			true,
+			nil,
+		},
+		{
+			"redelegate: verify remaining coins",
+			[]sdk.ValAddress{val1, val2},
+			[]sdk.ValAddress{},
+			stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE,
+			&coin100,
+			stakingtypes.NewMsgBeginRedelegate(delAddr, val3, val1, coin50),
+			false,
+			false,
+			&stakingtypes.StakeAuthorization{
+				Validators: &stakingtypes.StakeAuthorization_AllowList{
+					AllowList: &stakingtypes.StakeAuthorization_Validators{Address: validators1_2},
+				}, MaxTokens: &coin50, AuthorizationType: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			delAuth, err := stakingtypes.NewStakeAuthorization(tc.allowed, tc.denied, tc.msgType, tc.limit)
+			require.NoError(t, err)
+
+			resp, err := delAuth.Accept(ctx, tc.srvMsg)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.isDelete, resp.Delete)
+			if tc.updatedAuthorization == nil {
+				require.Nil(t, resp.Updated)
+			} else {
+				require.Equal(t, tc.updatedAuthorization.String(), resp.Updated.String())
+			}
+		})
+	}
+}
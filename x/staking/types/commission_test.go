@@ -63,7 +63,7 @@ func TestCommissionValidateNewRate(t *testing.T) {
 	}
 
 	for i, tc := range testCases {
-		err := tc.input.ValidateNewRate(tc.newRate, tc.blockTime)
+		err := tc.input.ValidateNewRate(tc.newRate, tc.blockTime, types.DefaultCommissionChangeCooldown)
 		require.Equal(
 			t, tc.expectErr, err != nil,
 			"unexpected result; tc #%d, input: %v, newRate: %s, blockTime: %s",
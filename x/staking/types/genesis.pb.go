@@ -43,6 +43,13 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the records of the tokenized delegations.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records" yaml:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last created record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty" yaml:"last_tokenize_share_record_id"`
+	// cons_pubkey_rotation_history defines the consensus public key rotations of
+	// the validators.
+	ConsPubkeyRotationHistory []ConsPubKeyRotationHistory `protobuf:"bytes,11,rep,name=cons_pubkey_rotation_history,json=consPubkeyRotationHistory,proto3" json:"cons_pubkey_rotation_history" yaml:"cons_pubkey_rotation_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -127,6 +134,27 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetConsPubkeyRotationHistory() []ConsPubKeyRotationHistory {
+	if m != nil {
+		return m.ConsPubkeyRotationHistory
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -178,38 +206,47 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 493 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xc7, 0x7d, 0xa4, 0x49, 0xc3, 0xa5, 0x20, 0x74, 0xa4, 0x60, 0x45, 0xc8, 0x0e, 0x56, 0x84,
-	0x22, 0x5e, 0x6c, 0xb5, 0x6c, 0x15, 0x53, 0x84, 0xa8, 0x8a, 0x10, 0x8a, 0x8e, 0x97, 0x81, 0x25,
-	0xba, 0xd4, 0x27, 0x63, 0xd5, 0xf1, 0x59, 0x7e, 0x2e, 0xa5, 0xdd, 0x11, 0x62, 0xe4, 0x23, 0xf4,
-	0xe3, 0x74, 0xec, 0xc0, 0x80, 0x18, 0x2c, 0x94, 0x2c, 0xcc, 0xfd, 0x04, 0xc8, 0xe7, 0x17, 0x4c,
-	0x52, 0x33, 0x25, 0x77, 0xfa, 0xfd, 0x7f, 0x7f, 0xfb, 0xfc, 0x1c, 0x1e, 0x1c, 0x0a, 0x98, 0x09,
-	0x70, 0x40, 0xb2, 0x23, 0x3f, 0xf4, 0x9c, 0xe3, 0x9d, 0x29, 0x97, 0x6c, 0xc7, 0xf1, 0x78, 0xc8,
-	0xc1, 0x07, 0x3b, 0x8a, 0x85, 0x14, 0xe4, 0x4e, 0x46, 0xd9, 0x39, 0x65, 0xe7, 0x54, 0xaf, 0xeb,
-	0x09, 0x4f, 0x28, 0xc4, 0x49, 0xff, 0x65, 0x74, 0xaf, 0xce, 0x59, 0xa4, 0x15, 0x65, 0x7d, 0x6f,
-	0xe2, 0xad, 0xfd, 0xac, 0xe5, 0x8d, 0x64, 0x92, 0x93, 0x67, 0xb8, 0x15, 0xb1, 0x98, 0xcd, 0x40,
-	0x47, 0x7d, 0x34, 0xec, 0xec, 0x1a, 0xf6, 0xd5, 0xad, 0xf6, 0x58, 0x51, 0xa3, 0x8d, 0xf3, 0xc4,
-	0xd4, 0x68, 0x9e, 0x21, 0x80, 0x6f, 0x05, 0x0c, 0xe4, 0x44, 0x0a, 0xc9, 0x82, 0x49, 0x24, 0x3e,
-	0xf1, 0x58, 0xbf, 0xd6, 0x47, 0xc3, 0xad, 0xd1, 0x41, 0xca, 0xfd, 0x4c, 0xcc, 0x07, 0x9e, 0x2f,
-	0x3f, 0xce, 0xa7, 0xf6, 0xa1, 0x98, 0x39, 0xf9, 0x13, 0x66, 0x3f, 0x4f, 0xc0, 0x3d, 0x72, 0xe4,
-	0x69, 0xc4, 0xc1, 0x3e, 0x08, 0xe5, 0x65, 0x62, 0xde, 0x3d, 0x65, 0xb3, 0x60, 0xcf, 0x5a, 0xf5,
-	0x59, 0xf4, 0x66, 0xba, 0xf5, 0x36, 0xdd, 0x19, 0xa7, 0x1b, 0xe4, 0x33, 0xc2, 0xdb, 0x8a, 0x3a,
-	0x66, 0x81, 0xef, 0x32, 0x29, 0xe2, 0x8c, 0x04, 0xbd, 0xd1, 0x6f, 0x0c, 0x3b, 0xbb, 0x0f, 0xeb,
-	0x5e, 0xe1, 0x15, 0x03, 0xf9, 0xbe, 0xc8, 0x28, 0xd7, 0x68, 0x90, 0x3e, 0xe6, 0x65, 0x62, 0xde,
-	0xab, 0x94, 0xaf, 0x6a, 0x2d, 0x7a, 0x3b, 0x58, 0x4b, 0x02, 0xd9, 0xc7, 0xb8, 0x24, 0x41, 0xdf,
-	0x50, 0xd5, 0xf7, 0xeb, 0xaa, 0xcb, 0x70, 0x7e, 0x80, 0x95, 0x28, 0x79, 0x89, 0x3b, 0x2e, 0x0f,
-	0xb8, 0xc7, 0xa4, 0x2f, 0x42, 0xd0, 0x9b, 0xca, 0x64, 0xd5, 0x99, 0x9e, 0x97, 0x68, 0xae, 0xaa,
-	0x86, 0xc9, 0x17, 0x84, 0xb7, 0xe7, 0xe1, 0x54, 0x84, 0xae, 0x1f, 0x7a, 0x93, 0xaa, 0xb6, 0xa5,
-	0xb4, 0x8f, 0xea, 0xb4, 0xef, 0x8a, 0x50, 0xc5, 0xbf, 0x72, 0x38, 0x57, 0x7a, 0x2d, 0xda, 0x9d,
-	0xaf, 0x47, 0x81, 0x8c, 0xf1, 0x8d, 0x98, 0x57, 0xfb, 0x37, 0x55, 0xff, 0xa0, 0xae, 0x9f, 0x56,
-	0xe0, 0xfc, 0xc5, 0xfe, 0x15, 0x90, 0x1e, 0x6e, 0xf3, 0x93, 0x48, 0xc4, 0x92, 0xbb, 0x7a, 0xbb,
-	0x8f, 0x86, 0x6d, 0x5a, 0xae, 0xad, 0xd7, 0x98, 0xac, 0x7f, 0x5c, 0xa2, 0xe3, 0x4d, 0xe6, 0xba,
-	0x31, 0x87, 0x6c, 0xb8, 0xaf, 0xd3, 0x62, 0x49, 0xba, 0xb8, 0xf9, 0x77, 0x58, 0x1b, 0x34, 0x5b,
-	0xec, 0xb5, 0xbf, 0x9e, 0x99, 0xda, 0xef, 0x33, 0x53, 0x1b, 0xbd, 0x38, 0x5f, 0x18, 0xe8, 0x62,
-	0x61, 0xa0, 0x5f, 0x0b, 0x03, 0x7d, 0x5b, 0x1a, 0xda, 0xc5, 0xd2, 0xd0, 0x7e, 0x2c, 0x0d, 0xed,
-	0xc3, 0xe3, 0xff, 0xce, 0xf3, 0x49, 0x79, 0xfd, 0xd4, 0x64, 0x4f, 0x5b, 0xea, 0xd6, 0x3d, 0xfd,
-	0x13, 0x00, 0x00, 0xff, 0xff, 0xff, 0x85, 0xad, 0xc8, 0xf1, 0x03, 0x00, 0x00,
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x6f, 0xd3, 0x30,
+	0x1c, 0xc5, 0x6b, 0xf6, 0xab, 0x73, 0x07, 0x42, 0xa6, 0x1b, 0xd9, 0xb4, 0x25, 0x25, 0x14, 0x54,
+	0x31, 0x48, 0xb5, 0x71, 0x9b, 0x38, 0x05, 0xc4, 0x18, 0x20, 0x54, 0x79, 0x83, 0x03, 0x97, 0xc8,
+	0x6d, 0xac, 0x2c, 0xb4, 0x8d, 0x2b, 0xdb, 0x1d, 0x2b, 0x67, 0x84, 0x76, 0xe4, 0x3f, 0x60, 0x7f,
+	0xce, 0x8e, 0x3b, 0x22, 0x0e, 0x15, 0xda, 0x2e, 0x5c, 0xb8, 0xec, 0x2f, 0x40, 0xb1, 0xd3, 0xd2,
+	0x35, 0x4d, 0x4f, 0xad, 0xad, 0xf7, 0x3e, 0xcf, 0xcf, 0x72, 0xbe, 0xb0, 0xdc, 0x60, 0xa2, 0xcd,
+	0x44, 0x55, 0x48, 0xd2, 0x0c, 0xa3, 0xa0, 0x7a, 0xb4, 0x55, 0xa7, 0x92, 0x6c, 0x55, 0x03, 0x1a,
+	0x51, 0x11, 0x0a, 0xa7, 0xc3, 0x99, 0x64, 0x68, 0x45, 0xab, 0x9c, 0x44, 0xe5, 0x24, 0xaa, 0xb5,
+	0x62, 0xc0, 0x02, 0xa6, 0x24, 0xd5, 0xf8, 0x9f, 0x56, 0xaf, 0x65, 0x31, 0x07, 0x6e, 0xa5, 0xb2,
+	0xff, 0xe6, 0xe1, 0xd2, 0xae, 0x4e, 0xd9, 0x97, 0x44, 0x52, 0xf4, 0x0c, 0xce, 0x77, 0x08, 0x27,
+	0x6d, 0x61, 0x80, 0x12, 0xa8, 0x14, 0xb6, 0x4d, 0x67, 0x72, 0xaa, 0x53, 0x53, 0x2a, 0x77, 0xf6,
+	0xac, 0x6f, 0xe5, 0x70, 0xe2, 0x41, 0x02, 0xde, 0x6e, 0x11, 0x21, 0x3d, 0xc9, 0x24, 0x69, 0x79,
+	0x1d, 0xf6, 0x99, 0x72, 0xe3, 0x46, 0x09, 0x54, 0x96, 0xdc, 0xbd, 0x58, 0xf7, 0xab, 0x6f, 0x3d,
+	0x0c, 0x42, 0x79, 0xd8, 0xad, 0x3b, 0x0d, 0xd6, 0xae, 0x26, 0x27, 0xd4, 0x3f, 0x4f, 0x84, 0xdf,
+	0xac, 0xca, 0x5e, 0x87, 0x0a, 0x67, 0x2f, 0x92, 0x57, 0x7d, 0xeb, 0x6e, 0x8f, 0xb4, 0x5b, 0x3b,
+	0xf6, 0x38, 0xcf, 0xc6, 0xb7, 0xe2, 0xad, 0x83, 0x78, 0xa7, 0x16, 0x6f, 0xa0, 0xaf, 0x00, 0x2e,
+	0x2b, 0xd5, 0x11, 0x69, 0x85, 0x3e, 0x91, 0x8c, 0x6b, 0xa5, 0x30, 0x66, 0x4a, 0x33, 0x95, 0xc2,
+	0xf6, 0xa3, 0xac, 0x0a, 0x6f, 0x89, 0x90, 0x1f, 0x06, 0x1e, 0xc5, 0x72, 0xcb, 0xf1, 0x31, 0xaf,
+	0xfa, 0xd6, 0xfa, 0x48, 0xf8, 0x38, 0xd6, 0xc6, 0x77, 0x5a, 0x29, 0xa7, 0x40, 0xbb, 0x10, 0x0e,
+	0x95, 0xc2, 0x98, 0x55, 0xd1, 0xf7, 0xb2, 0xa2, 0x87, 0xe6, 0xe4, 0x02, 0x47, 0xac, 0xe8, 0x35,
+	0x2c, 0xf8, 0xb4, 0x45, 0x03, 0x22, 0x43, 0x16, 0x09, 0x63, 0x4e, 0x91, 0xec, 0x2c, 0xd2, 0x8b,
+	0xa1, 0x34, 0x41, 0x8d, 0x9a, 0xd1, 0x37, 0x00, 0x97, 0xbb, 0x51, 0x9d, 0x45, 0x7e, 0x18, 0x05,
+	0xde, 0x28, 0x76, 0x5e, 0x61, 0x37, 0xb3, 0xb0, 0xef, 0x07, 0xa6, 0x11, 0xfe, 0xd8, 0xe5, 0x4c,
+	0xe4, 0xda, 0xb8, 0xd8, 0x4d, 0x5b, 0x05, 0xaa, 0xc1, 0x9b, 0x9c, 0x8e, 0xe6, 0x2f, 0xa8, 0xfc,
+	0x72, 0x56, 0x3e, 0xa6, 0xfe, 0x78, 0xb1, 0xeb, 0x00, 0xb4, 0x06, 0xf3, 0xf4, 0xb8, 0xc3, 0xb8,
+	0xa4, 0xbe, 0x91, 0x2f, 0x81, 0x4a, 0x1e, 0x0f, 0xd7, 0xe8, 0x04, 0xc0, 0x15, 0xc9, 0x9a, 0x34,
+	0x0a, 0xbf, 0x50, 0x4f, 0x1c, 0x12, 0x4e, 0x3d, 0x4e, 0x1b, 0x8c, 0xfb, 0xc2, 0x58, 0x9c, 0xde,
+	0xfb, 0x20, 0x71, 0xed, 0xc7, 0x26, 0xac, 0x3c, 0xee, 0x83, 0xa4, 0xf7, 0x86, 0xee, 0x3d, 0x19,
+	0x6c, 0xe3, 0xa2, 0x4c, 0x7b, 0x05, 0xfa, 0x04, 0x37, 0x92, 0x27, 0x3c, 0xc1, 0xe5, 0x85, 0xbe,
+	0x01, 0x4b, 0xa0, 0x32, 0xeb, 0x56, 0xae, 0xfa, 0x56, 0xf9, 0xda, 0x8b, 0x9f, 0x2c, 0xb7, 0xf1,
+	0xaa, 0x7e, 0xfe, 0xa9, 0xa8, 0x3d, 0x1f, 0xfd, 0x00, 0x70, 0xbd, 0xc1, 0x22, 0xe1, 0x75, 0xba,
+	0xf5, 0x26, 0xed, 0x79, 0x9c, 0x49, 0x75, 0x59, 0xde, 0x61, 0x28, 0x24, 0xe3, 0x3d, 0xa3, 0xa0,
+	0xca, 0x6f, 0x65, 0x95, 0x7f, 0xce, 0x22, 0x51, 0xeb, 0xd6, 0xdf, 0xd0, 0x1e, 0x4e, 0x9c, 0xaf,
+	0xb4, 0xd1, 0xdd, 0x4c, 0xae, 0xe0, 0xbe, 0x3e, 0xe2, 0xb4, 0x10, 0x1b, 0xaf, 0x36, 0x34, 0xa7,
+	0x99, 0xe2, 0xd8, 0xef, 0x20, 0x4a, 0x7f, 0x75, 0xc8, 0x80, 0x0b, 0xc4, 0xf7, 0x39, 0x15, 0x7a,
+	0xea, 0x2c, 0xe2, 0xc1, 0x12, 0x15, 0xe1, 0xdc, 0xff, 0x29, 0x32, 0x83, 0xf5, 0x62, 0x27, 0x7f,
+	0x72, 0x6a, 0xe5, 0xfe, 0x9c, 0x5a, 0x39, 0xf7, 0xe5, 0xd9, 0x85, 0x09, 0xce, 0x2f, 0x4c, 0xf0,
+	0xfb, 0xc2, 0x04, 0xdf, 0x2f, 0xcd, 0xdc, 0xf9, 0xa5, 0x99, 0xfb, 0x79, 0x69, 0xe6, 0x3e, 0x3e,
+	0x9e, 0x3a, 0x68, 0x8e, 0x87, 0x73, 0x51, 0x8d, 0x9c, 0xfa, 0xbc, 0x1a, 0x87, 0x4f, 0xff, 0x0d,
+	0x00, 0x0a, 0xae, 0x45, 0xee, 0x8a, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -232,6 +269,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsPubkeyRotationHistory) > 0 {
+		for iNdEx := len(m.ConsPubkeyRotationHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsPubkeyRotationHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -424,6 +494,21 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	if len(m.ConsPubkeyRotationHistory) > 0 {
+		for _, e := range m.ConsPubkeyRotationHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -734,6 +819,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubkeyRotationHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubkeyRotationHistory = append(m.ConsPubkeyRotationHistory, ConsPubKeyRotationHistory{})
+			if err := m.ConsPubkeyRotationHistory[len(m.ConsPubkeyRotationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -46,6 +46,16 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	TokenizeShareRecordPrefix          = []byte{0x61} // prefix for the tokenize share records
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x62} // prefix for the tokenize share record ids, by owner
+	TokenizeShareRecordIDByDenomPrefix = []byte{0x63} // prefix for the tokenize share record ids, by share token denom
+	LastTokenizeShareRecordIDKey       = []byte{0x64} // key for the id of the last tokenize share record
+	TotalLiquidStakedTokensKey         = []byte{0x65} // key for the total of the tokenized bonded tokens
+	ValidatorLiquidSharesPrefix        = []byte{0x66} // prefix for the tokenized shares of each validator
+
+	ValidatorConsPubKeyRotationHistoryKey = []byte{0x70} // prefix for the consensus public key rotations of each validator
+	PendingConsPubKeyRotationKey          = []byte{0x71} // prefix for the rotations not yet applied to the validator set
 )
 
 // gets the key for the validator with address
@@ -324,3 +334,56 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetTokenizeShareRecordKey returns the key of a tokenize share record.
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerKey returns the key prefix of the tokenize
+// share record ids of an owner.
+func GetTokenizeShareRecordIDsByOwnerKey(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, owner.Bytes()...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey returns the index key of a tokenize
+// share record by its owner.
+// VALUE: none (the id is the suffix of the key)
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerKey(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the index key of a tokenize share
+// record by its share token denom.
+// VALUE: tokenize share record id (big endian uint64)
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
+
+// GetValidatorLiquidSharesKey returns the key of the tokenized shares of a
+// validator.
+// VALUE: sdk.Dec
+func GetValidatorLiquidSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesPrefix, valAddr.Bytes()...)
+}
+
+// GetValidatorConsPubKeyRotationHistoryPrefix returns the key prefix of the
+// consensus public key rotations of a validator.
+func GetValidatorConsPubKeyRotationHistoryPrefix(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorConsPubKeyRotationHistoryKey, valAddr.Bytes()...)
+}
+
+// GetValidatorConsPubKeyRotationHistoryKey returns the key of the consensus
+// public key rotation of a validator at a height.
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetValidatorConsPubKeyRotationHistoryKey(valAddr sdk.ValAddress, height int64) []byte {
+	return append(GetValidatorConsPubKeyRotationHistoryPrefix(valAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetPendingConsPubKeyRotationKey returns the key of the rotation of a
+// validator which is not yet applied to the validator set.
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetPendingConsPubKeyRotationKey(valAddr sdk.ValAddress) []byte {
+	return append(PendingConsPubKeyRotationKey, valAddr.Bytes()...)
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 )
@@ -20,3 +21,15 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestParamsValidateKeyRotationFee(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+
+	// the key rotation fee is paid in the bond denom
+	params.BondDenom = "uatom"
+	require.Error(t, params.Validate())
+
+	params.KeyRotationFee = sdk.NewInt64Coin("uatom", 10)
+	require.NoError(t, params.Validate())
+}
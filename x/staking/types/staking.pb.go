@@ -15,10 +15,10 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_protoc_gen_gogo_descriptor "github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
-	_ "github.com/golang/protobuf/ptypes/duration"
-	_ "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/regen-network/cosmos-proto"
 	types "github.com/tendermint/tendermint/proto/tendermint/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	io_ioutil "io/ioutil"
 	math "math"
@@ -800,6 +800,20 @@ type Params struct {
 	MaxEntries        uint32        `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty" yaml:"max_entries"`
 	HistoricalEntries uint32        `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
 	BondDenom         string        `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	// global_liquid_staking_cap is the maximum ratio of the total bonded tokens
+	// which can be tokenized.
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap" yaml:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum ratio of the delegator shares
+	// of a validator which can be tokenized.
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap" yaml:"validator_liquid_staking_cap"`
+	// key_rotation_fee is the fee paid by a validator to rotate its consensus
+	// public key.
+	KeyRotationFee types2.Coin `protobuf:"bytes,8,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee" yaml:"key_rotation_fee"`
+	// min_commission_rate is the minimum commission rate of the validators.
+	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// commission_change_cooldown is the minimum duration between two commission
+	// rate changes of a validator.
+	CommissionChangeCooldown time.Duration `protobuf:"bytes,10,opt,name=commission_change_cooldown,json=commissionChangeCooldown,proto3,stdduration" json:"commission_change_cooldown" yaml:"commission_change_cooldown"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -869,6 +883,20 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetKeyRotationFee() types2.Coin {
+	if m != nil {
+		return m.KeyRotationFee
+	}
+	return types2.Coin{}
+}
+
+func (m *Params) GetCommissionChangeCooldown() time.Duration {
+	if m != nil {
+		return m.CommissionChangeCooldown
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1065,6 +1093,98 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// TokenizeShareRecord represents a delegation tokenized into a bank denom. The
+// delegation is held by the module account of the record, and its rewards are
+// claimable by the owner of the record.
+type TokenizeShareRecord struct {
+	Id            uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner         string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty" yaml:"module_account"`
+	Validator     string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()      { *m = TokenizeShareRecord{} }
+func (*TokenizeShareRecord) ProtoMessage() {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+// ConsPubKeyRotationHistory records the rotation of the consensus public key
+// of a validator.
+type ConsPubKeyRotationHistory struct {
+	// operator_address defines the address of the validator's operator; bech encoded in JSON.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
+	// old_cons_pubkey is the consensus public key of the validator before the rotation.
+	OldConsPubkey *types1.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty" yaml:"old_cons_pubkey"`
+	// new_cons_pubkey is the consensus public key of the validator after the rotation.
+	NewConsPubkey *types1.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty" yaml:"new_cons_pubkey"`
+	// height is the height of the block which included the rotation.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time of the block which included the rotation.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// fee is the fee paid for the rotation.
+	Fee types2.Coin `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *ConsPubKeyRotationHistory) Reset()         { *m = ConsPubKeyRotationHistory{} }
+func (m *ConsPubKeyRotationHistory) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotationHistory) ProtoMessage()    {}
+func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotationHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotationHistory.Merge(m, src)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotationHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotationHistory proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
@@ -1087,6 +1207,8 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
 }
 
 func init() {
@@ -1094,120 +1216,142 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1796 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4d, 0x6c, 0x23, 0x49,
-	0x15, 0x76, 0x3b, 0x5e, 0xc7, 0x7e, 0x4e, 0xe2, 0xa4, 0x26, 0x33, 0xeb, 0x98, 0xc1, 0xed, 0x6d,
-	0x56, 0x4b, 0x40, 0xbb, 0x0e, 0x93, 0x45, 0x8b, 0xc8, 0x05, 0xc6, 0x71, 0x86, 0x58, 0xbb, 0x0c,
-	0xa1, 0x93, 0x09, 0x12, 0xac, 0xb0, 0xca, 0xdd, 0x15, 0xa7, 0x89, 0xbb, 0xdb, 0x74, 0x95, 0x87,
-	0x58, 0xda, 0x03, 0xc7, 0x65, 0x10, 0x62, 0xb9, 0xed, 0x65, 0xa4, 0x91, 0xf6, 0xba, 0x12, 0x17,
-	0xc4, 0x95, 0xeb, 0x02, 0x97, 0xe1, 0x86, 0x10, 0x32, 0x68, 0xe6, 0x82, 0x38, 0x21, 0x1f, 0x10,
-	0x37, 0x50, 0xfd, 0xf4, 0x4f, 0xda, 0xf1, 0xcc, 0x78, 0xb4, 0x87, 0x91, 0xd8, 0x4b, 0xe2, 0x7a,
-	0xf5, 0xde, 0xf7, 0xea, 0xfd, 0xd6, 0xab, 0x86, 0x57, 0x2d, 0x9f, 0xba, 0x3e, 0xdd, 0xa2, 0x0c,
-	0x9f, 0x39, 0x5e, 0x6f, 0xeb, 0xee, 0x8d, 0x2e, 0x61, 0xf8, 0x46, 0xb8, 0x6e, 0x0c, 0x02, 0x9f,
-	0xf9, 0xe8, 0x9a, 0xe4, 0x6a, 0x84, 0x54, 0xc5, 0x55, 0x5d, 0xef, 0xf9, 0x3d, 0x5f, 0xb0, 0x6c,
-	0xf1, 0x5f, 0x92, 0xbb, 0xba, 0xd1, 0xf3, 0xfd, 0x5e, 0x9f, 0x6c, 0x89, 0x55, 0x77, 0x78, 0xb2,
-	0x85, 0xbd, 0x91, 0xda, 0xaa, 0xa5, 0xb7, 0xec, 0x61, 0x80, 0x99, 0xe3, 0x7b, 0x6a, 0x5f, 0x4f,
-	0xef, 0x33, 0xc7, 0x25, 0x94, 0x61, 0x77, 0x10, 0x62, 0xcb, 0x93, 0x74, 0xa4, 0x52, 0x75, 0x2c,
-	0x85, 0xad, 0x4c, 0xe9, 0x62, 0x4a, 0x22, 0x3b, 0x2c, 0xdf, 0x09, 0xb1, 0xaf, 0x33, 0xe2, 0xd9,
-	0x24, 0x70, 0x1d, 0x8f, 0x6d, 0xb1, 0xd1, 0x80, 0x50, 0xf9, 0x57, 0xee, 0x1a, 0x3f, 0xd3, 0x60,
-	0x65, 0xdf, 0xa1, 0xcc, 0x0f, 0x1c, 0x0b, 0xf7, 0xdb, 0xde, 0x89, 0x8f, 0xde, 0x82, 0xfc, 0x29,
-	0xc1, 0x36, 0x09, 0x2a, 0x5a, 0x5d, 0xdb, 0x2c, 0x6d, 0x57, 0x1a, 0x31, 0x42, 0x43, 0xca, 0xee,
-	0x8b, 0xfd, 0x66, 0xee, 0x93, 0xb1, 0x9e, 0x31, 0x15, 0x37, 0xfa, 0x06, 0xe4, 0xef, 0xe2, 0x3e,
-	0x25, 0xac, 0x92, 0xad, 0x2f, 0x6c, 0x96, 0xb6, 0x5f, 0x69, 0x5c, 0xee, 0xbe, 0xc6, 0x31, 0xee,
-	0x3b, 0x36, 0x66, 0x7e, 0x04, 0x20, 0xc5, 0x8c, 0x5f, 0x67, 0xa1, 0xbc, 0xeb, 0xbb, 0xae, 0x43,
-	0xa9, 0xe3, 0x7b, 0x26, 0x66, 0x84, 0xa2, 0x26, 0xe4, 0x02, 0xcc, 0x88, 0x38, 0x4a, 0xb1, 0xd9,
-	0xe0, 0xfc, 0x7f, 0x19, 0xeb, 0xaf, 0xf5, 0x1c, 0x76, 0x3a, 0xec, 0x36, 0x2c, 0xdf, 0x55, 0xce,
-	0x50, 0xff, 0xde, 0xa0, 0xf6, 0x99, 0xb2, 0xaf, 0x45, 0x2c, 0x53, 0xc8, 0xa2, 0x77, 0xa1, 0xe0,
-	0xe2, 0xf3, 0x8e, 0xc0, 0xc9, 0x0a, 0x9c, 0x9b, 0xf3, 0xe1, 0x4c, 0xc6, 0x7a, 0x79, 0x84, 0xdd,
-	0xfe, 0x8e, 0x11, 0xe2, 0x18, 0xe6, 0xa2, 0x8b, 0xcf, 0xf9, 0x11, 0xd1, 0x00, 0xca, 0x9c, 0x6a,
-	0x9d, 0x62, 0xaf, 0x47, 0xa4, 0x92, 0x05, 0xa1, 0x64, 0x7f, 0x6e, 0x25, 0xd7, 0x62, 0x25, 0x09,
-	0x38, 0xc3, 0x5c, 0x76, 0xf1, 0xf9, 0xae, 0x20, 0x70, 0x8d, 0x3b, 0x85, 0x0f, 0x1f, 0xe8, 0x99,
-	0x7f, 0x3c, 0xd0, 0x35, 0xe3, 0x4f, 0x1a, 0x40, 0xec, 0x31, 0xf4, 0x2e, 0xac, 0x5a, 0xd1, 0x4a,
-	0xc8, 0x52, 0x15, 0xc3, 0x2f, 0xce, 0x8a, 0x45, 0xca, 0xdf, 0xcd, 0x02, 0x3f, 0xf4, 0xc3, 0xb1,
-	0xae, 0x99, 0x65, 0x2b, 0x15, 0x8a, 0x1f, 0x40, 0x69, 0x38, 0xb0, 0x31, 0x23, 0x1d, 0x9e, 0x9d,
-	0xc2, 0x93, 0xa5, 0xed, 0x6a, 0x43, 0xa6, 0x6e, 0x23, 0x4c, 0xdd, 0xc6, 0x51, 0x98, 0xba, 0xcd,
-	0x1a, 0xc7, 0x9a, 0x8c, 0x75, 0x24, 0xcd, 0x4a, 0x08, 0x1b, 0x1f, 0xfc, 0x4d, 0xd7, 0x4c, 0x90,
-	0x14, 0x2e, 0x90, 0xb0, 0xe9, 0xf7, 0x1a, 0x94, 0x5a, 0x84, 0x5a, 0x81, 0x33, 0xe0, 0x15, 0x82,
-	0x2a, 0xb0, 0xe8, 0xfa, 0x9e, 0x73, 0xa6, 0xf2, 0xb1, 0x68, 0x86, 0x4b, 0x54, 0x85, 0x82, 0x63,
-	0x13, 0x8f, 0x39, 0x6c, 0x24, 0xe3, 0x6a, 0x46, 0x6b, 0x2e, 0xf5, 0x13, 0xd2, 0xa5, 0x4e, 0x18,
-	0x0d, 0x33, 0x5c, 0xa2, 0x5b, 0xb0, 0x4a, 0x89, 0x35, 0x0c, 0x1c, 0x36, 0xea, 0x58, 0xbe, 0xc7,
-	0xb0, 0xc5, 0x2a, 0x39, 0x11, 0xb0, 0xcf, 0x4d, 0xc6, 0xfa, 0xcb, 0xf2, 0xac, 0x69, 0x0e, 0xc3,
-	0x2c, 0x87, 0xa4, 0x5d, 0x49, 0xe1, 0x1a, 0x6c, 0xc2, 0xb0, 0xd3, 0xa7, 0x95, 0x97, 0xa4, 0x06,
-	0xb5, 0x4c, 0xd8, 0xf2, 0xf1, 0x22, 0x14, 0xa3, 0x6c, 0xe7, 0x9a, 0xfd, 0x01, 0x09, 0xf8, 0xef,
-	0x0e, 0xb6, 0xed, 0x80, 0x50, 0xaa, 0xf2, 0x3a, 0xa1, 0x39, 0xcd, 0x61, 0x98, 0xe5, 0x90, 0x74,
-	0x53, 0x52, 0x10, 0xe3, 0x61, 0xf6, 0x28, 0xf1, 0xe8, 0x90, 0x76, 0x06, 0xc3, 0xee, 0x19, 0x19,
-	0xa9, 0x68, 0xac, 0x4f, 0x45, 0xe3, 0xa6, 0x37, 0x6a, 0xbe, 0x19, 0xa3, 0xa7, 0xe5, 0x8c, 0x3f,
-	0xfc, 0xe6, 0x8d, 0x75, 0x95, 0x1a, 0x56, 0x30, 0x1a, 0x30, 0xbf, 0x71, 0x30, 0xec, 0xbe, 0x4d,
-	0x46, 0x3c, 0xfc, 0x8a, 0xf5, 0x40, 0x70, 0xa2, 0x6b, 0x90, 0xff, 0x11, 0x76, 0xfa, 0xc4, 0x16,
-	0x0e, 0x2d, 0x98, 0x6a, 0x85, 0x76, 0x20, 0x4f, 0x19, 0x66, 0x43, 0x2a, 0xbc, 0xb8, 0xb2, 0x6d,
-	0xcc, 0x4a, 0xb5, 0xa6, 0xef, 0xd9, 0x87, 0x82, 0xd3, 0x54, 0x12, 0xe8, 0x16, 0xe4, 0x99, 0x7f,
-	0x46, 0x3c, 0xe5, 0xc2, 0xb9, 0xea, 0xbb, 0xed, 0x31, 0x53, 0x49, 0x73, 0x8f, 0xd8, 0xa4, 0x4f,
-	0x7a, 0xc2, 0x71, 0xf4, 0x14, 0x07, 0x84, 0x56, 0xf2, 0x02, 0xb1, 0x3d, 0x77, 0x11, 0x2a, 0x4f,
-	0xa5, 0xf1, 0x0c, 0xb3, 0x1c, 0x91, 0x0e, 0x05, 0x05, 0xbd, 0x0d, 0x25, 0x3b, 0x4e, 0xd4, 0xca,
-	0xa2, 0x08, 0xc1, 0x17, 0x66, 0x99, 0x9f, 0xc8, 0x69, 0xd5, 0xf7, 0x92, 0xd2, 0x3c, 0x39, 0x86,
-	0x5e, 0xd7, 0xf7, 0x6c, 0xc7, 0xeb, 0x75, 0x4e, 0x89, 0xd3, 0x3b, 0x65, 0x95, 0x42, 0x5d, 0xdb,
-	0x5c, 0x48, 0x26, 0x47, 0x9a, 0xc3, 0x30, 0xcb, 0x11, 0x69, 0x5f, 0x50, 0x90, 0x0d, 0x2b, 0x31,
-	0x97, 0x28, 0xd4, 0xe2, 0x53, 0x0b, 0xf5, 0x15, 0x55, 0xa8, 0x57, 0xd3, 0x5a, 0xe2, 0x5a, 0x5d,
-	0x8e, 0x88, 0x5c, 0x0c, 0xed, 0x03, 0xc4, 0xed, 0xa1, 0x02, 0x42, 0x83, 0xf1, 0xf4, 0x1e, 0xa3,
-	0x0c, 0x4f, 0xc8, 0xa2, 0xf7, 0xe0, 0x8a, 0xeb, 0x78, 0x1d, 0x4a, 0xfa, 0x27, 0x1d, 0xe5, 0x60,
-	0x0e, 0x59, 0x12, 0xd1, 0x7b, 0x67, 0xbe, 0x7c, 0x98, 0x8c, 0xf5, 0xaa, 0x6a, 0xa1, 0xd3, 0x90,
-	0x86, 0xb9, 0xe6, 0x3a, 0xde, 0x21, 0xe9, 0x9f, 0xb4, 0x22, 0xda, 0xce, 0xd2, 0xfb, 0x0f, 0xf4,
-	0x8c, 0x2a, 0xd7, 0x8c, 0xf1, 0x16, 0x2c, 0x1d, 0xe3, 0xbe, 0x2a, 0x33, 0x42, 0xd1, 0x75, 0x28,
-	0xe2, 0x70, 0x51, 0xd1, 0xea, 0x0b, 0x9b, 0x45, 0x33, 0x26, 0xc8, 0x32, 0xff, 0xe9, 0x5f, 0xeb,
-	0x9a, 0xf1, 0xb1, 0x06, 0xf9, 0xd6, 0xf1, 0x01, 0x76, 0x02, 0xd4, 0x86, 0xb5, 0x38, 0x73, 0x2e,
-	0x16, 0xf9, 0xf5, 0xc9, 0x58, 0xaf, 0xa4, 0x93, 0x2b, 0xaa, 0xf2, 0x38, 0x81, 0xc3, 0x32, 0x6f,
-	0xc3, 0xda, 0xdd, 0xb0, 0x77, 0x44, 0x50, 0xd9, 0x34, 0xd4, 0x14, 0x8b, 0x61, 0xae, 0x46, 0x34,
-	0x05, 0x95, 0x32, 0x73, 0x0f, 0x16, 0xe5, 0x69, 0x29, 0xda, 0x81, 0x97, 0x06, 0xfc, 0x87, 0xb0,
-	0xae, 0xb4, 0x5d, 0x9b, 0x99, 0xbc, 0x82, 0x5f, 0x85, 0x4f, 0x8a, 0x18, 0xbf, 0xca, 0x02, 0xb4,
-	0x8e, 0x8f, 0x8f, 0x02, 0x67, 0xd0, 0x27, 0xec, 0xd3, 0xb4, 0xfc, 0x08, 0xae, 0xc6, 0x66, 0xd1,
-	0xc0, 0x4a, 0x59, 0x5f, 0x9f, 0x8c, 0xf5, 0xeb, 0x69, 0xeb, 0x13, 0x6c, 0x86, 0x79, 0x25, 0xa2,
-	0x1f, 0x06, 0xd6, 0xa5, 0xa8, 0x36, 0x65, 0x11, 0xea, 0xc2, 0x6c, 0xd4, 0x04, 0x5b, 0x12, 0xb5,
-	0x45, 0xd9, 0xe5, 0xae, 0x3d, 0x84, 0x52, 0xec, 0x12, 0x8a, 0x5a, 0x50, 0x60, 0xea, 0xb7, 0xf2,
-	0xb0, 0x31, 0xdb, 0xc3, 0xa1, 0x98, 0xf2, 0x72, 0x24, 0x69, 0xfc, 0x47, 0x03, 0x88, 0x73, 0xf6,
-	0xc5, 0x4c, 0x31, 0xde, 0xca, 0x55, 0xe3, 0x5d, 0x78, 0xae, 0x51, 0x4d, 0x49, 0xa7, 0xfc, 0xf9,
-	0xf3, 0x2c, 0x5c, 0xb9, 0x13, 0x76, 0x9e, 0x17, 0xde, 0x07, 0x07, 0xb0, 0x48, 0x3c, 0x16, 0x38,
-	0xc2, 0x09, 0x3c, 0xda, 0x5f, 0x99, 0x15, 0xed, 0x4b, 0x6c, 0xda, 0xf3, 0x58, 0x30, 0x52, 0xb1,
-	0x0f, 0x61, 0x52, 0xde, 0xf8, 0xe5, 0x02, 0x54, 0x66, 0x49, 0xa2, 0x5d, 0x28, 0x5b, 0x01, 0x11,
-	0x84, 0xf0, 0xfe, 0xd0, 0xc4, 0xfd, 0x51, 0x8d, 0x27, 0xcb, 0x14, 0x83, 0x61, 0xae, 0x84, 0x14,
-	0x75, 0x7b, 0xf4, 0x80, 0x8f, 0x7d, 0x3c, 0xed, 0x38, 0xd7, 0x33, 0xce, 0x79, 0x86, 0xba, 0x3e,
-	0x42, 0x25, 0x17, 0x01, 0xe4, 0xfd, 0xb1, 0x12, 0x53, 0xc5, 0x05, 0xf2, 0x63, 0x28, 0x3b, 0x9e,
-	0xc3, 0x1c, 0xdc, 0xef, 0x74, 0x71, 0x1f, 0x7b, 0xd6, 0xf3, 0x4c, 0xcd, 0xb2, 0xe5, 0x2b, 0xb5,
-	0x29, 0x38, 0xc3, 0x5c, 0x51, 0x94, 0xa6, 0x24, 0xa0, 0x7d, 0x58, 0x0c, 0x55, 0xe5, 0x9e, 0x6b,
-	0xda, 0x08, 0xc5, 0x13, 0x03, 0xde, 0x2f, 0x16, 0x60, 0xcd, 0x24, 0xf6, 0x67, 0xa1, 0x98, 0x2f,
-	0x14, 0xdf, 0x06, 0x90, 0xe5, 0xce, 0x1b, 0xec, 0x73, 0x44, 0x83, 0x37, 0x8c, 0xa2, 0x44, 0x68,
-	0x51, 0x96, 0x88, 0xc7, 0x38, 0x0b, 0x4b, 0xc9, 0x78, 0xfc, 0x9f, 0xde, 0x4a, 0xa8, 0x1d, 0x77,
-	0xa2, 0x9c, 0xe8, 0x44, 0x5f, 0x9a, 0xd5, 0x89, 0xa6, 0xb2, 0xf7, 0xc9, 0x2d, 0xe8, 0xdf, 0x59,
-	0xc8, 0x1f, 0xe0, 0x00, 0xbb, 0x14, 0x59, 0x53, 0x93, 0xa6, 0x7c, 0x6b, 0x6e, 0x4c, 0xe5, 0x67,
-	0x4b, 0x7d, 0xed, 0x78, 0xca, 0xa0, 0xf9, 0xe1, 0x25, 0x83, 0xe6, 0x37, 0x61, 0x85, 0x3f, 0x87,
-	0x23, 0x1b, 0xa5, 0xb7, 0x97, 0x9b, 0x1b, 0x31, 0xca, 0xc5, 0x7d, 0xf9, 0x5a, 0x8e, 0x1e, 0x5d,
-	0x14, 0x7d, 0x0d, 0x4a, 0x9c, 0x23, 0x6e, 0xcc, 0x5c, 0xfc, 0x5a, 0xfc, 0x2c, 0x4d, 0x6c, 0x1a,
-	0x26, 0xb8, 0xf8, 0x7c, 0x4f, 0x2e, 0xd0, 0x3b, 0x80, 0x4e, 0xa3, 0x2f, 0x23, 0x9d, 0xd8, 0x9d,
-	0x5c, 0xfe, 0xf3, 0x93, 0xb1, 0xbe, 0x21, 0xe5, 0xa7, 0x79, 0x0c, 0x73, 0x2d, 0x26, 0x86, 0x68,
-	0x5f, 0x05, 0xe0, 0x76, 0x75, 0x6c, 0xe2, 0xf9, 0xae, 0x7a, 0xee, 0x5c, 0x9d, 0x8c, 0xf5, 0x35,
-	0x89, 0x12, 0xef, 0x19, 0x66, 0x91, 0x2f, 0x5a, 0xfc, 0x77, 0x22, 0xb3, 0x3f, 0xd2, 0x00, 0xc5,
-	0x2d, 0xdf, 0x24, 0x74, 0xc0, 0xdf, 0x67, 0x7c, 0x10, 0x4f, 0x4c, 0xcd, 0xda, 0x93, 0x07, 0xf1,
-	0x58, 0x3e, 0x1c, 0xc4, 0x13, 0x95, 0xf2, 0xf5, 0xb8, 0x3d, 0x66, 0x55, 0x1c, 0x15, 0x4c, 0x17,
-	0x53, 0x92, 0x18, 0xe6, 0x9d, 0x50, 0x7a, 0xaa, 0x1f, 0x66, 0x8c, 0x3f, 0x6a, 0xb0, 0x31, 0x95,
-	0x51, 0xd1, 0x61, 0x7f, 0x08, 0x28, 0x48, 0x6c, 0x0a, 0x7f, 0x8d, 0xd4, 0xa1, 0xe7, 0x4e, 0xd0,
-	0xb5, 0x60, 0xaa, 0xef, 0x7e, 0x7a, 0x1d, 0x3e, 0x27, 0x7c, 0xfe, 0x3b, 0x0d, 0xd6, 0x93, 0xea,
-	0x23, 0x43, 0x6e, 0xc3, 0x52, 0x52, 0xbb, 0x32, 0xe1, 0xd5, 0x67, 0x31, 0x41, 0x9d, 0xfe, 0x82,
-	0x3c, 0xfa, 0x6e, 0x5c, 0xae, 0xf2, 0xdb, 0xd9, 0x8d, 0x67, 0xf6, 0x46, 0x78, 0xa6, 0x74, 0xd9,
-	0xe6, 0x44, 0x3c, 0xfe, 0xab, 0x41, 0xee, 0xc0, 0xf7, 0xfb, 0xc8, 0x87, 0x35, 0xcf, 0x67, 0x1d,
-	0x9e, 0x59, 0xc4, 0xee, 0xa8, 0x47, 0xb7, 0xec, 0x83, 0xbb, 0xf3, 0x39, 0xe9, 0x9f, 0x63, 0x7d,
-	0x1a, 0xca, 0x2c, 0x7b, 0x3e, 0x6b, 0x0a, 0xca, 0x91, 0x7c, 0x92, 0xbf, 0x07, 0xcb, 0x17, 0x95,
-	0xc9, 0x2e, 0xf9, 0xbd, 0xb9, 0x95, 0x5d, 0x84, 0x99, 0x8c, 0xf5, 0xf5, 0xb8, 0x62, 0x22, 0xb2,
-	0x61, 0x2e, 0x75, 0x13, 0xda, 0x77, 0x0a, 0x3c, 0x7e, 0xff, 0x7a, 0xa0, 0x6b, 0x5f, 0xfe, 0xad,
-	0x06, 0x10, 0x7f, 0x79, 0x40, 0xaf, 0xc3, 0xcb, 0xcd, 0xef, 0xdc, 0x6e, 0x75, 0x0e, 0x8f, 0x6e,
-	0x1e, 0xdd, 0x39, 0xec, 0xdc, 0xb9, 0x7d, 0x78, 0xb0, 0xb7, 0xdb, 0xbe, 0xd5, 0xde, 0x6b, 0xad,
-	0x66, 0xaa, 0xe5, 0x7b, 0xf7, 0xeb, 0xa5, 0x3b, 0x1e, 0x1d, 0x10, 0xcb, 0x39, 0x71, 0x88, 0x8d,
-	0x5e, 0x83, 0xf5, 0x8b, 0xdc, 0x7c, 0xb5, 0xd7, 0x5a, 0xd5, 0xaa, 0x4b, 0xf7, 0xee, 0xd7, 0x0b,
-	0x72, 0x16, 0x23, 0x36, 0xda, 0x84, 0xab, 0xd3, 0x7c, 0xed, 0xdb, 0xdf, 0x5a, 0xcd, 0x56, 0x97,
-	0xef, 0xdd, 0xaf, 0x17, 0xa3, 0xa1, 0x0d, 0x19, 0x80, 0x92, 0x9c, 0x0a, 0x6f, 0xa1, 0x0a, 0xf7,
-	0xee, 0xd7, 0xf3, 0xd2, 0x81, 0xd5, 0xdc, 0xfb, 0x1f, 0xd5, 0x32, 0xcd, 0x5b, 0x9f, 0x3c, 0xaa,
-	0x69, 0x0f, 0x1f, 0xd5, 0xb4, 0xbf, 0x3f, 0xaa, 0x69, 0x1f, 0x3c, 0xae, 0x65, 0x1e, 0x3e, 0xae,
-	0x65, 0xfe, 0xfc, 0xb8, 0x96, 0xf9, 0xfe, 0xeb, 0x4f, 0xf4, 0xdd, 0x79, 0xf4, 0x51, 0x5b, 0x78,
-	0xb1, 0x9b, 0x17, 0x6d, 0xf8, 0xcd, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x48, 0x4c, 0x86,
-	0xf3, 0x16, 0x00, 0x00,
+	// 2152 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x39, 0x4f, 0x6c, 0x1b, 0x59,
+	0xf9, 0x19, 0xdb, 0x75, 0x9c, 0xcf, 0x49, 0x9c, 0xbc, 0xa6, 0x59, 0xc7, 0xbf, 0xfe, 0x6c, 0x77,
+	0x76, 0xb5, 0x14, 0xb4, 0x75, 0x68, 0x16, 0x2d, 0x90, 0x0b, 0xd4, 0x71, 0x4a, 0xa2, 0x2d, 0x25,
+	0x4c, 0xd2, 0x22, 0xc1, 0x8a, 0xd1, 0x78, 0xe6, 0xc5, 0x19, 0x32, 0x9e, 0xe7, 0x9d, 0xf7, 0xdc,
+	0xd6, 0x68, 0x0f, 0x9c, 0x50, 0x29, 0xac, 0x58, 0x2e, 0x68, 0x2f, 0x95, 0x2a, 0xed, 0x09, 0x69,
+	0x25, 0x2e, 0x88, 0x2b, 0xd7, 0x05, 0x2e, 0xe5, 0x82, 0x10, 0x42, 0x06, 0xb5, 0x17, 0xc4, 0x09,
+	0xe5, 0xc4, 0x0d, 0xf4, 0xfe, 0xcc, 0x1f, 0x8f, 0xe3, 0x36, 0xae, 0x7a, 0x58, 0x09, 0x2e, 0xc9,
+	0xbc, 0xef, 0x7d, 0x7f, 0xde, 0xf7, 0xf7, 0xbd, 0xef, 0x33, 0xbc, 0x66, 0x13, 0xda, 0x25, 0x74,
+	0x9d, 0x32, 0xeb, 0xd8, 0xf5, 0x3b, 0xeb, 0x77, 0xae, 0xb6, 0x31, 0xb3, 0xae, 0x86, 0xeb, 0x46,
+	0x2f, 0x20, 0x8c, 0xa0, 0x55, 0x89, 0xd5, 0x08, 0xa1, 0x0a, 0xab, 0xb2, 0xd2, 0x21, 0x1d, 0x22,
+	0x50, 0xd6, 0xf9, 0x97, 0xc4, 0xae, 0xac, 0x75, 0x08, 0xe9, 0x78, 0x78, 0x5d, 0xac, 0xda, 0xfd,
+	0xc3, 0x75, 0xcb, 0x1f, 0xa8, 0xad, 0x6a, 0x7a, 0xcb, 0xe9, 0x07, 0x16, 0x73, 0x89, 0xaf, 0xf6,
+	0x6b, 0xe9, 0x7d, 0xe6, 0x76, 0x31, 0x65, 0x56, 0xb7, 0x17, 0xf2, 0x96, 0x27, 0x31, 0xa5, 0x50,
+	0x75, 0x2c, 0xc5, 0x5b, 0xa9, 0xd2, 0xb6, 0x28, 0x8e, 0xf4, 0xb0, 0x89, 0x1b, 0xf2, 0xbe, 0xc8,
+	0xb0, 0xef, 0xe0, 0xa0, 0xeb, 0xfa, 0x6c, 0x9d, 0x0d, 0x7a, 0x98, 0xca, 0xbf, 0x72, 0x57, 0xff,
+	0x91, 0x06, 0x8b, 0x3b, 0x2e, 0x65, 0x24, 0x70, 0x6d, 0xcb, 0xdb, 0xf5, 0x0f, 0x09, 0x7a, 0x0b,
+	0xf2, 0x47, 0xd8, 0x72, 0x70, 0x50, 0xd6, 0xea, 0xda, 0xe5, 0xe2, 0x46, 0xb9, 0x11, 0x73, 0x68,
+	0x48, 0xda, 0x1d, 0xb1, 0xdf, 0xcc, 0x7d, 0x32, 0xac, 0xcd, 0x18, 0x0a, 0x1b, 0x7d, 0x05, 0xf2,
+	0x77, 0x2c, 0x8f, 0x62, 0x56, 0xce, 0xd4, 0xb3, 0x97, 0x8b, 0x1b, 0x97, 0x1a, 0xa7, 0x9b, 0xaf,
+	0x71, 0xdb, 0xf2, 0x5c, 0xc7, 0x62, 0x24, 0x62, 0x20, 0xc9, 0xf4, 0x5f, 0x66, 0xa0, 0xb4, 0x45,
+	0xba, 0x5d, 0x97, 0x52, 0x97, 0xf8, 0x86, 0xc5, 0x30, 0x45, 0x4d, 0xc8, 0x05, 0x16, 0xc3, 0xe2,
+	0x28, 0x73, 0xcd, 0x06, 0xc7, 0xff, 0xf3, 0xb0, 0xf6, 0x7a, 0xc7, 0x65, 0x47, 0xfd, 0x76, 0xc3,
+	0x26, 0x5d, 0x65, 0x0c, 0xf5, 0xef, 0x0a, 0x75, 0x8e, 0x95, 0x7e, 0x2d, 0x6c, 0x1b, 0x82, 0x16,
+	0xbd, 0x03, 0x85, 0xae, 0x75, 0xcf, 0x14, 0x7c, 0x32, 0x82, 0xcf, 0xb5, 0xe9, 0xf8, 0x9c, 0x0c,
+	0x6b, 0xa5, 0x81, 0xd5, 0xf5, 0x36, 0xf5, 0x90, 0x8f, 0x6e, 0xcc, 0x76, 0xad, 0x7b, 0xfc, 0x88,
+	0xa8, 0x07, 0x25, 0x0e, 0xb5, 0x8f, 0x2c, 0xbf, 0x83, 0xa5, 0x90, 0xac, 0x10, 0xb2, 0x33, 0xb5,
+	0x90, 0xd5, 0x58, 0x48, 0x82, 0x9d, 0x6e, 0x2c, 0x74, 0xad, 0x7b, 0x5b, 0x02, 0xc0, 0x25, 0x6e,
+	0x16, 0x3e, 0x7c, 0x54, 0x9b, 0xf9, 0xfb, 0xa3, 0x9a, 0xa6, 0xff, 0x41, 0x03, 0x88, 0x2d, 0x86,
+	0xde, 0x81, 0x25, 0x3b, 0x5a, 0x09, 0x5a, 0xaa, 0x7c, 0xf8, 0x99, 0x49, 0xbe, 0x48, 0xd9, 0xbb,
+	0x59, 0xe0, 0x87, 0x7e, 0x3c, 0xac, 0x69, 0x46, 0xc9, 0x4e, 0xb9, 0xe2, 0x3b, 0x50, 0xec, 0xf7,
+	0x1c, 0x8b, 0x61, 0x93, 0x47, 0xa7, 0xb0, 0x64, 0x71, 0xa3, 0xd2, 0x90, 0xa1, 0xdb, 0x08, 0x43,
+	0xb7, 0x71, 0x10, 0x86, 0x6e, 0xb3, 0xca, 0x79, 0x9d, 0x0c, 0x6b, 0x48, 0xaa, 0x95, 0x20, 0xd6,
+	0x3f, 0xf8, 0x6b, 0x4d, 0x33, 0x40, 0x42, 0x38, 0x41, 0x42, 0xa7, 0xdf, 0x6a, 0x50, 0x6c, 0x61,
+	0x6a, 0x07, 0x6e, 0x8f, 0x67, 0x08, 0x2a, 0xc3, 0x6c, 0x97, 0xf8, 0xee, 0xb1, 0x8a, 0xc7, 0x39,
+	0x23, 0x5c, 0xa2, 0x0a, 0x14, 0x5c, 0x07, 0xfb, 0xcc, 0x65, 0x03, 0xe9, 0x57, 0x23, 0x5a, 0x73,
+	0xaa, 0xbb, 0xb8, 0x4d, 0xdd, 0xd0, 0x1b, 0x46, 0xb8, 0x44, 0xd7, 0x61, 0x89, 0x62, 0xbb, 0x1f,
+	0xb8, 0x6c, 0x60, 0xda, 0xc4, 0x67, 0x96, 0xcd, 0xca, 0x39, 0xe1, 0xb0, 0xff, 0x3b, 0x19, 0xd6,
+	0x5e, 0x91, 0x67, 0x4d, 0x63, 0xe8, 0x46, 0x29, 0x04, 0x6d, 0x49, 0x08, 0x97, 0xe0, 0x60, 0x66,
+	0xb9, 0x1e, 0x2d, 0x9f, 0x93, 0x12, 0xd4, 0x32, 0xa1, 0xcb, 0xc7, 0xb3, 0x30, 0x17, 0x45, 0x3b,
+	0x97, 0x4c, 0x7a, 0x38, 0xe0, 0xdf, 0xa6, 0xe5, 0x38, 0x01, 0xa6, 0xb4, 0xac, 0xa5, 0x25, 0xa7,
+	0x31, 0x74, 0xa3, 0x14, 0x82, 0xae, 0x49, 0x08, 0x62, 0xdc, 0xcd, 0x3e, 0xc5, 0x3e, 0xed, 0x53,
+	0xb3, 0xd7, 0x6f, 0x1f, 0xe3, 0x81, 0xf2, 0xc6, 0xca, 0x98, 0x37, 0xae, 0xf9, 0x83, 0xe6, 0x9b,
+	0x31, 0xf7, 0x34, 0x9d, 0xfe, 0xbb, 0x5f, 0x5d, 0x59, 0x51, 0xa1, 0x61, 0x07, 0x83, 0x1e, 0x23,
+	0x8d, 0xbd, 0x7e, 0xfb, 0x6d, 0x3c, 0x30, 0x4a, 0x11, 0xea, 0x9e, 0xc0, 0x44, 0xab, 0x90, 0xff,
+	0x9e, 0xe5, 0x7a, 0xd8, 0x11, 0x06, 0x2d, 0x18, 0x6a, 0x85, 0x36, 0x21, 0x4f, 0x99, 0xc5, 0xfa,
+	0x54, 0x58, 0x71, 0x71, 0x43, 0x9f, 0x14, 0x6a, 0x4d, 0xe2, 0x3b, 0xfb, 0x02, 0xd3, 0x50, 0x14,
+	0xe8, 0x3a, 0xe4, 0x19, 0x39, 0xc6, 0xbe, 0x32, 0xe1, 0x54, 0xf9, 0xbd, 0xeb, 0x33, 0x43, 0x51,
+	0x73, 0x8b, 0x38, 0xd8, 0xc3, 0x1d, 0x61, 0x38, 0x7a, 0x64, 0x05, 0x98, 0x96, 0xf3, 0x82, 0xe3,
+	0xee, 0xd4, 0x49, 0xa8, 0x2c, 0x95, 0xe6, 0xa7, 0x1b, 0xa5, 0x08, 0xb4, 0x2f, 0x20, 0xe8, 0x6d,
+	0x28, 0x3a, 0x71, 0xa0, 0x96, 0x67, 0x85, 0x0b, 0x5e, 0x9d, 0xa4, 0x7e, 0x22, 0xa6, 0x55, 0xdd,
+	0x4b, 0x52, 0xf3, 0xe0, 0xe8, 0xfb, 0x6d, 0xe2, 0x3b, 0xae, 0xdf, 0x31, 0x8f, 0xb0, 0xdb, 0x39,
+	0x62, 0xe5, 0x42, 0x5d, 0xbb, 0x9c, 0x4d, 0x06, 0x47, 0x1a, 0x43, 0x37, 0x4a, 0x11, 0x68, 0x47,
+	0x40, 0x90, 0x03, 0x8b, 0x31, 0x96, 0x48, 0xd4, 0xb9, 0xe7, 0x26, 0xea, 0x25, 0x95, 0xa8, 0x17,
+	0xd2, 0x52, 0xe2, 0x5c, 0x5d, 0x88, 0x80, 0x9c, 0x0c, 0xed, 0x00, 0xc4, 0xe5, 0xa1, 0x0c, 0x42,
+	0x82, 0xfe, 0xfc, 0x1a, 0xa3, 0x14, 0x4f, 0xd0, 0xa2, 0xf7, 0xe0, 0x7c, 0xd7, 0xf5, 0x4d, 0x8a,
+	0xbd, 0x43, 0x53, 0x19, 0x98, 0xb3, 0x2c, 0x0a, 0xef, 0xdd, 0x98, 0x2e, 0x1e, 0x4e, 0x86, 0xb5,
+	0x8a, 0x2a, 0xa1, 0xe3, 0x2c, 0x75, 0x63, 0xb9, 0xeb, 0xfa, 0xfb, 0xd8, 0x3b, 0x6c, 0x45, 0xb0,
+	0xcd, 0xf9, 0xfb, 0x8f, 0x6a, 0x33, 0x2a, 0x5d, 0x67, 0xf4, 0xb7, 0x60, 0xfe, 0xb6, 0xe5, 0xa9,
+	0x34, 0xc3, 0x14, 0x5d, 0x84, 0x39, 0x2b, 0x5c, 0x94, 0xb5, 0x7a, 0xf6, 0xf2, 0x9c, 0x11, 0x03,
+	0x64, 0x9a, 0xff, 0xe0, 0x2f, 0x75, 0x4d, 0xff, 0x58, 0x83, 0x7c, 0xeb, 0xf6, 0x9e, 0xe5, 0x06,
+	0x68, 0x17, 0x96, 0xe3, 0xc8, 0x19, 0x4d, 0xf2, 0x8b, 0x27, 0xc3, 0x5a, 0x39, 0x1d, 0x5c, 0x51,
+	0x96, 0xc7, 0x01, 0x1c, 0xa6, 0xf9, 0x2e, 0x2c, 0xdf, 0x09, 0x6b, 0x47, 0xc4, 0x2a, 0x93, 0x66,
+	0x35, 0x86, 0xa2, 0x1b, 0x4b, 0x11, 0x4c, 0xb1, 0x4a, 0xa9, 0xb9, 0x0d, 0xb3, 0xf2, 0xb4, 0x14,
+	0x6d, 0xc2, 0xb9, 0x1e, 0xff, 0x10, 0xda, 0x15, 0x37, 0xaa, 0x13, 0x83, 0x57, 0xe0, 0x2b, 0xf7,
+	0x49, 0x12, 0xfd, 0x67, 0x19, 0x80, 0xd6, 0xed, 0xdb, 0x07, 0x81, 0xdb, 0xf3, 0x30, 0x7b, 0x99,
+	0x9a, 0x1f, 0xc0, 0x85, 0x58, 0x2d, 0x1a, 0xd8, 0x29, 0xed, 0xeb, 0x27, 0xc3, 0xda, 0xc5, 0xb4,
+	0xf6, 0x09, 0x34, 0xdd, 0x38, 0x1f, 0xc1, 0xf7, 0x03, 0xfb, 0x54, 0xae, 0x0e, 0x65, 0x11, 0xd7,
+	0xec, 0x64, 0xae, 0x09, 0xb4, 0x24, 0xd7, 0x16, 0x65, 0xa7, 0x9b, 0x76, 0x1f, 0x8a, 0xb1, 0x49,
+	0x28, 0x6a, 0x41, 0x81, 0xa9, 0x6f, 0x65, 0x61, 0x7d, 0xb2, 0x85, 0x43, 0x32, 0x65, 0xe5, 0x88,
+	0x52, 0xff, 0x97, 0x06, 0x10, 0xc7, 0xec, 0xa7, 0x33, 0xc4, 0x78, 0x29, 0x57, 0x85, 0x37, 0xfb,
+	0x42, 0x4f, 0x35, 0x45, 0x9d, 0xb2, 0xe7, 0x8f, 0x33, 0x70, 0xfe, 0x56, 0x58, 0x79, 0x3e, 0xf5,
+	0x36, 0xd8, 0x83, 0x59, 0xec, 0xb3, 0xc0, 0x15, 0x46, 0xe0, 0xde, 0xfe, 0xfc, 0x24, 0x6f, 0x9f,
+	0xa2, 0xd3, 0xb6, 0xcf, 0x82, 0x81, 0xf2, 0x7d, 0xc8, 0x26, 0x65, 0x8d, 0x9f, 0x66, 0xa1, 0x3c,
+	0x89, 0x12, 0x6d, 0x41, 0xc9, 0x0e, 0xb0, 0x00, 0x84, 0xf7, 0x87, 0x26, 0xee, 0x8f, 0x4a, 0xfc,
+	0xb2, 0x4c, 0x21, 0xe8, 0xc6, 0x62, 0x08, 0x51, 0xb7, 0x47, 0x07, 0xf8, 0xb3, 0x8f, 0x87, 0x1d,
+	0xc7, 0x3a, 0xe3, 0x3b, 0x4f, 0x57, 0xd7, 0x47, 0x28, 0x64, 0x94, 0x81, 0xbc, 0x3f, 0x16, 0x63,
+	0xa8, 0xb8, 0x40, 0xde, 0x85, 0x92, 0xeb, 0xbb, 0xcc, 0xb5, 0x3c, 0xb3, 0x6d, 0x79, 0x96, 0x6f,
+	0xbf, 0xc8, 0xab, 0x59, 0x96, 0x7c, 0x25, 0x36, 0xc5, 0x4e, 0x37, 0x16, 0x15, 0xa4, 0x29, 0x01,
+	0x68, 0x07, 0x66, 0x43, 0x51, 0xb9, 0x17, 0x7a, 0x6d, 0x84, 0xe4, 0x89, 0x07, 0xde, 0xfb, 0x59,
+	0x58, 0x36, 0xb0, 0xf3, 0x3f, 0x57, 0x4c, 0xe7, 0x8a, 0xaf, 0x03, 0xc8, 0x74, 0xe7, 0x05, 0xb6,
+	0x9c, 0x7b, 0xa1, 0x82, 0x31, 0x27, 0x39, 0xb4, 0x28, 0x4b, 0xf8, 0x63, 0x98, 0x81, 0xf9, 0xa4,
+	0x3f, 0xfe, 0x4b, 0x6f, 0x25, 0xb4, 0x1b, 0x57, 0xa2, 0x9c, 0xa8, 0x44, 0x9f, 0x9d, 0x54, 0x89,
+	0xc6, 0xa2, 0xf7, 0xd9, 0x25, 0xe8, 0x27, 0x05, 0xc8, 0xef, 0x59, 0x81, 0xd5, 0xa5, 0xc8, 0x1e,
+	0x7b, 0x69, 0xca, 0x5e, 0x73, 0x6d, 0x2c, 0x3e, 0x5b, 0x6a, 0xda, 0xf1, 0x9c, 0x87, 0xe6, 0x87,
+	0xa7, 0x3c, 0x34, 0xbf, 0x0a, 0x8b, 0xbc, 0x1d, 0x8e, 0x74, 0x94, 0xd6, 0x5e, 0x68, 0xae, 0xc5,
+	0x5c, 0x46, 0xf7, 0x65, 0xb7, 0x1c, 0x35, 0x5d, 0x14, 0x7d, 0x11, 0x8a, 0x1c, 0x23, 0x2e, 0xcc,
+	0x9c, 0x7c, 0x35, 0x6e, 0x4b, 0x13, 0x9b, 0xba, 0x01, 0x5d, 0xeb, 0xde, 0xb6, 0x5c, 0xa0, 0x1b,
+	0x80, 0x8e, 0xa2, 0xc9, 0x88, 0x19, 0x9b, 0x93, 0xd3, 0xff, 0xff, 0xc9, 0xb0, 0xb6, 0x26, 0xe9,
+	0xc7, 0x71, 0x74, 0x63, 0x39, 0x06, 0x86, 0xdc, 0xbe, 0x00, 0xc0, 0xf5, 0x32, 0x1d, 0xec, 0x93,
+	0xae, 0x6a, 0x77, 0x2e, 0x9c, 0x0c, 0x6b, 0xcb, 0x92, 0x4b, 0xbc, 0xa7, 0x1b, 0x73, 0x7c, 0xd1,
+	0xe2, 0xdf, 0xe8, 0x7d, 0x0d, 0xd6, 0x3a, 0x1e, 0x69, 0x5b, 0x9e, 0xe9, 0xb9, 0xef, 0xf6, 0x5d,
+	0xc7, 0x54, 0xfe, 0x33, 0x6d, 0xab, 0xa7, 0x5a, 0x1c, 0x63, 0xea, 0x16, 0xa7, 0x2e, 0x65, 0x4e,
+	0x64, 0xac, 0x1b, 0xab, 0x72, 0xef, 0x86, 0xd8, 0xda, 0x97, 0x3b, 0x5b, 0x56, 0x0f, 0xfd, 0x5c,
+	0x83, 0x8b, 0x71, 0x1c, 0x9e, 0x72, 0xa4, 0x59, 0x71, 0xa4, 0x5b, 0x53, 0x1f, 0xe9, 0xd5, 0x74,
+	0x8c, 0x9f, 0x76, 0xaa, 0xb5, 0x68, 0x7b, 0xec, 0x60, 0x0e, 0x2c, 0x1d, 0xe3, 0x81, 0x19, 0x10,
+	0x26, 0xab, 0xea, 0x21, 0xc6, 0xe5, 0x82, 0x0a, 0x47, 0x15, 0xf9, 0x6d, 0x8b, 0xe2, 0x44, 0x4f,
+	0xe2, 0xfa, 0xcd, 0x9a, 0x0a, 0x47, 0xd5, 0x5d, 0xa5, 0x19, 0xe8, 0xc6, 0xe2, 0x31, 0x1e, 0x18,
+	0x0a, 0x72, 0x1d, 0xe3, 0xb0, 0x59, 0x49, 0x0d, 0x59, 0xca, 0x73, 0x53, 0x37, 0x2b, 0x52, 0xe9,
+	0x44, 0xb3, 0x92, 0x62, 0x29, 0x9b, 0x95, 0xd1, 0xe1, 0x0c, 0xfa, 0xa1, 0x06, 0x95, 0x04, 0x9e,
+	0x1a, 0x11, 0xd9, 0x84, 0x78, 0x0e, 0xb9, 0x1b, 0x76, 0x61, 0xcf, 0xc8, 0xbe, 0x2b, 0x4a, 0xdd,
+	0x4b, 0xd1, 0xe5, 0x30, 0x81, 0x95, 0xcc, 0xc4, 0x72, 0x8c, 0x20, 0x87, 0x4f, 0x5b, 0x6a, 0x3b,
+	0x51, 0x6f, 0x3f, 0xd2, 0x00, 0xc5, 0x0f, 0x11, 0x03, 0xd3, 0x1e, 0xf1, 0xa9, 0x68, 0x0f, 0x13,
+	0xbd, 0x9c, 0xf6, 0xec, 0xf6, 0x30, 0xa6, 0x0f, 0xdb, 0xc3, 0x98, 0x16, 0x7d, 0x39, 0xbe, 0xb4,
+	0x33, 0xcf, 0x73, 0xa7, 0x2a, 0x5c, 0xe9, 0x5b, 0x7a, 0x46, 0xff, 0xbd, 0x06, 0x6b, 0x63, 0x75,
+	0x2e, 0x3a, 0xec, 0x77, 0x01, 0x05, 0x89, 0x4d, 0x91, 0xc5, 0x03, 0x75, 0xe8, 0xa9, 0xcb, 0xe6,
+	0x72, 0x90, 0xde, 0x78, 0x89, 0xef, 0x8e, 0x9c, 0xb0, 0xf9, 0x6f, 0x34, 0x58, 0x49, 0x8a, 0x8f,
+	0x14, 0xb9, 0x09, 0xf3, 0x49, 0xe9, 0x4a, 0x85, 0xd7, 0xce, 0xa2, 0x82, 0x3a, 0xfd, 0x08, 0x3d,
+	0xfa, 0x66, 0x7c, 0x89, 0xc8, 0x89, 0xee, 0xd5, 0x33, 0x5b, 0x23, 0x3c, 0x53, 0xfa, 0x32, 0xc9,
+	0x09, 0x7f, 0xfc, 0x5b, 0x83, 0xdc, 0x1e, 0x21, 0x1e, 0x22, 0xb0, 0xec, 0x13, 0x66, 0xf2, 0x7a,
+	0x87, 0x1d, 0x53, 0x8d, 0x82, 0xe4, 0xed, 0xbc, 0x35, 0x9d, 0x91, 0xfe, 0x31, 0xac, 0x8d, 0xb3,
+	0x32, 0x4a, 0x3e, 0x61, 0x4d, 0x01, 0x39, 0x10, 0x00, 0xf4, 0x1e, 0x2c, 0x8c, 0x0a, 0x93, 0x77,
+	0xf7, 0xb7, 0xa6, 0x16, 0x36, 0xca, 0xe6, 0x64, 0x58, 0x5b, 0x89, 0xeb, 0x78, 0x04, 0xd6, 0x8d,
+	0xf9, 0x76, 0x42, 0xfa, 0x66, 0x81, 0xfb, 0xef, 0x9f, 0xdc, 0x87, 0xbf, 0xd0, 0xe0, 0xbc, 0x00,
+	0xba, 0xdf, 0xc7, 0x62, 0x9a, 0x64, 0x60, 0x9b, 0x04, 0x0e, 0x5a, 0x84, 0x8c, 0xeb, 0x08, 0x0b,
+	0xe4, 0x8c, 0x8c, 0xeb, 0xa0, 0x15, 0x38, 0x47, 0xee, 0xfa, 0x38, 0x50, 0xf3, 0x4d, 0xb9, 0x10,
+	0x97, 0x22, 0x71, 0xfa, 0x1e, 0x36, 0x2d, 0xdb, 0x26, 0x7d, 0x9f, 0xa9, 0xc7, 0x42, 0xf2, 0x52,
+	0x1c, 0xd9, 0xe7, 0x97, 0xa2, 0x00, 0x5c, 0x93, 0x6b, 0x3e, 0xd9, 0x88, 0x6a, 0xa9, 0x8c, 0x4a,
+	0x23, 0x06, 0x8c, 0x5c, 0xf9, 0x9a, 0xfe, 0xc7, 0x2c, 0xac, 0x6d, 0x11, 0x9f, 0xaa, 0xc1, 0xa0,
+	0x2a, 0x87, 0xf2, 0x47, 0x83, 0xc1, 0x4b, 0x1b, 0x6a, 0xf6, 0xa0, 0x44, 0x3c, 0x87, 0xcf, 0x5b,
+	0xcf, 0x34, 0xd3, 0xdc, 0x88, 0x5f, 0x9b, 0x29, 0xb2, 0xc9, 0x23, 0xcd, 0x05, 0xe2, 0x39, 0x4a,
+	0x11, 0x3e, 0xd0, 0xec, 0x41, 0xc9, 0xc7, 0x77, 0x47, 0x24, 0x66, 0xcf, 0x26, 0x31, 0x45, 0xf6,
+	0x0c, 0x89, 0x3e, 0xbe, 0x9b, 0x90, 0xb8, 0xca, 0x7f, 0x59, 0x11, 0xed, 0x00, 0x37, 0x79, 0xd6,
+	0x50, 0x2b, 0xf4, 0x25, 0xc8, 0x89, 0xf7, 0xd3, 0xb9, 0xe7, 0xbe, 0xef, 0xc5, 0x78, 0x5e, 0xbc,
+	0xe2, 0x05, 0x05, 0xba, 0x0a, 0x59, 0x7e, 0xd3, 0xe5, 0xcf, 0x56, 0x1a, 0x39, 0xee, 0x66, 0xe1,
+	0xbe, 0x2a, 0x8b, 0x9f, 0xfb, 0xb5, 0x06, 0x10, 0x0f, 0x65, 0xd1, 0x1b, 0xf0, 0x4a, 0xf3, 0x1b,
+	0x37, 0x5b, 0xe6, 0xfe, 0xc1, 0xb5, 0x83, 0x5b, 0xfb, 0xe6, 0xad, 0x9b, 0xfb, 0x7b, 0xdb, 0x5b,
+	0xbb, 0xd7, 0x77, 0xb7, 0x5b, 0x4b, 0x33, 0x95, 0xd2, 0x83, 0x87, 0xf5, 0xe2, 0x2d, 0x9f, 0xf6,
+	0xb0, 0xed, 0x1e, 0xba, 0xd8, 0x41, 0xaf, 0xc3, 0xca, 0x28, 0x36, 0x5f, 0x6d, 0xb7, 0x96, 0xb4,
+	0xca, 0xfc, 0x83, 0x87, 0xf5, 0x82, 0x6c, 0x53, 0xb1, 0x83, 0x2e, 0xc3, 0x85, 0x71, 0xbc, 0xdd,
+	0x9b, 0x5f, 0x5b, 0xca, 0x54, 0x16, 0x1e, 0x3c, 0xac, 0xcf, 0x45, 0xfd, 0x2c, 0xd2, 0x01, 0x25,
+	0x31, 0x15, 0xbf, 0x6c, 0x05, 0x1e, 0x3c, 0xac, 0xe7, 0x65, 0x16, 0x57, 0x72, 0xf7, 0x3f, 0xaa,
+	0xce, 0x34, 0xaf, 0x7f, 0xf2, 0xa4, 0xaa, 0x3d, 0x7e, 0x52, 0xd5, 0xfe, 0xf6, 0xa4, 0xaa, 0x7d,
+	0xf0, 0xb4, 0x3a, 0xf3, 0xf8, 0x69, 0x75, 0xe6, 0x4f, 0x4f, 0xab, 0x33, 0xdf, 0x7e, 0xe3, 0x99,
+	0x09, 0x7c, 0x2f, 0xfa, 0xbd, 0x4f, 0xa4, 0x72, 0x3b, 0x2f, 0x2c, 0xfc, 0xe6, 0x7f, 0x06, 0x00,
+	0x8f, 0x36, 0x0c, 0xd5, 0x0e, 0x1c, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1216,608 +1360,673 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 9603 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x24, 0xd7,
-		0x71, 0xd8, 0xcd, 0xee, 0x02, 0xd8, 0x6d, 0x2c, 0x80, 0xc5, 0x03, 0xee, 0x6e, 0x6f, 0x79, 0x04,
-		0xc0, 0xe1, 0xd7, 0xf1, 0x48, 0x02, 0xe4, 0x91, 0x77, 0x24, 0xf7, 0x24, 0xd2, 0x58, 0x60, 0x0f,
-		0x07, 0x1e, 0xbe, 0x38, 0x00, 0x8e, 0xd4, 0x87, 0xb3, 0x35, 0x98, 0x7d, 0x58, 0x0c, 0xb1, 0x3b,
-		0x33, 0x9c, 0x99, 0xbd, 0x3b, 0x50, 0x52, 0x15, 0x2d, 0x29, 0x8a, 0x44, 0xc7, 0x91, 0x14, 0xb9,
-		0x1c, 0x89, 0xd6, 0x29, 0x92, 0xe5, 0x44, 0x8e, 0xac, 0xc4, 0x1f, 0x52, 0x94, 0x38, 0x49, 0x55,
-		0xa4, 0x24, 0x8e, 0x25, 0xa5, 0xe2, 0x92, 0x2a, 0xae, 0xc4, 0x71, 0x25, 0x67, 0x87, 0x52, 0x39,
-		0x8c, 0xa2, 0xc4, 0xf2, 0x59, 0x4e, 0x9c, 0x52, 0xa5, 0x92, 0x7a, 0x5f, 0xf3, 0xb5, 0x1f, 0xb3,
-		0x0b, 0xdd, 0x49, 0x72, 0x9c, 0x5f, 0xd8, 0xd7, 0xaf, 0xbb, 0x5f, 0xbf, 0x7e, 0xfd, 0xba, 0xfb,
-		0x7d, 0x0d, 0xe0, 0x9f, 0x9f, 0x87, 0x99, 0x9a, 0x69, 0xd6, 0xea, 0x78, 0xce, 0xb2, 0x4d, 0xd7,
-		0xdc, 0x69, 0xee, 0xce, 0x55, 0xb1, 0xa3, 0xd9, 0xba, 0xe5, 0x9a, 0xf6, 0x2c, 0x85, 0xa1, 0x31,
-		0x86, 0x31, 0x2b, 0x30, 0xe4, 0x55, 0x18, 0xbf, 0xa0, 0xd7, 0xf1, 0xa2, 0x87, 0xb8, 0x89, 0x5d,
-		0xf4, 0x24, 0xa4, 0x76, 0xf5, 0x3a, 0xce, 0x4b, 0x33, 0xc9, 0x53, 0xc3, 0x67, 0xee, 0x99, 0x8d,
-		0x10, 0xcd, 0x86, 0x29, 0x36, 0x08, 0x58, 0xa1, 0x14, 0xf2, 0xb7, 0x52, 0x30, 0xd1, 0xa6, 0x16,
-		0x21, 0x48, 0x19, 0x6a, 0x83, 0x70, 0x94, 0x4e, 0x65, 0x14, 0xfa, 0x1b, 0xe5, 0x61, 0xc8, 0x52,
-		0xb5, 0x7d, 0xb5, 0x86, 0xf3, 0x09, 0x0a, 0x16, 0x45, 0x34, 0x05, 0x50, 0xc5, 0x16, 0x36, 0xaa,
-		0xd8, 0xd0, 0x0e, 0xf2, 0xc9, 0x99, 0xe4, 0xa9, 0x8c, 0x12, 0x80, 0xa0, 0x07, 0x61, 0xdc, 0x6a,
-		0xee, 0xd4, 0x75, 0xad, 0x12, 0x40, 0x83, 0x99, 0xe4, 0xa9, 0x01, 0x25, 0xc7, 0x2a, 0x16, 0x7d,
-		0xe4, 0xfb, 0x61, 0xec, 0x2a, 0x56, 0xf7, 0x83, 0xa8, 0xc3, 0x14, 0x75, 0x94, 0x80, 0x03, 0x88,
-		0x0b, 0x90, 0x6d, 0x60, 0xc7, 0x51, 0x6b, 0xb8, 0xe2, 0x1e, 0x58, 0x38, 0x9f, 0xa2, 0xbd, 0x9f,
-		0x69, 0xe9, 0x7d, 0xb4, 0xe7, 0xc3, 0x9c, 0x6a, 0xeb, 0xc0, 0xc2, 0x68, 0x1e, 0x32, 0xd8, 0x68,
-		0x36, 0x18, 0x87, 0x81, 0x0e, 0xfa, 0x2b, 0x1b, 0xcd, 0x46, 0x94, 0x4b, 0x9a, 0x90, 0x71, 0x16,
-		0x43, 0x0e, 0xb6, 0xaf, 0xe8, 0x1a, 0xce, 0x0f, 0x52, 0x06, 0xf7, 0xb7, 0x30, 0xd8, 0x64, 0xf5,
-		0x51, 0x1e, 0x82, 0x0e, 0x2d, 0x40, 0x06, 0x5f, 0x73, 0xb1, 0xe1, 0xe8, 0xa6, 0x91, 0x1f, 0xa2,
-		0x4c, 0xee, 0x6d, 0x33, 0x8a, 0xb8, 0x5e, 0x8d, 0xb2, 0xf0, 0xe9, 0xd0, 0x39, 0x18, 0x32, 0x2d,
-		0x57, 0x37, 0x0d, 0x27, 0x9f, 0x9e, 0x91, 0x4e, 0x0d, 0x9f, 0x39, 0xd9, 0xd6, 0x10, 0xd6, 0x19,
-		0x8e, 0x22, 0x90, 0xd1, 0x32, 0xe4, 0x1c, 0xb3, 0x69, 0x6b, 0xb8, 0xa2, 0x99, 0x55, 0x5c, 0xd1,
-		0x8d, 0x5d, 0x33, 0x9f, 0xa1, 0x0c, 0xa6, 0x5b, 0x3b, 0x42, 0x11, 0x17, 0xcc, 0x2a, 0x5e, 0x36,
-		0x76, 0x4d, 0x65, 0xd4, 0x09, 0x95, 0xd1, 0x31, 0x18, 0x74, 0x0e, 0x0c, 0x57, 0xbd, 0x96, 0xcf,
-		0x52, 0x0b, 0xe1, 0x25, 0xf9, 0x37, 0x06, 0x61, 0xac, 0x17, 0x13, 0x3b, 0x0f, 0x03, 0xbb, 0xa4,
-		0x97, 0xf9, 0x44, 0x3f, 0x3a, 0x60, 0x34, 0x61, 0x25, 0x0e, 0x1e, 0x52, 0x89, 0xf3, 0x30, 0x6c,
-		0x60, 0xc7, 0xc5, 0x55, 0x66, 0x11, 0xc9, 0x1e, 0x6d, 0x0a, 0x18, 0x51, 0xab, 0x49, 0xa5, 0x0e,
-		0x65, 0x52, 0x2f, 0xc0, 0x98, 0x27, 0x52, 0xc5, 0x56, 0x8d, 0x9a, 0xb0, 0xcd, 0xb9, 0x38, 0x49,
-		0x66, 0xcb, 0x82, 0x4e, 0x21, 0x64, 0xca, 0x28, 0x0e, 0x95, 0xd1, 0x22, 0x80, 0x69, 0x60, 0x73,
-		0xb7, 0x52, 0xc5, 0x5a, 0x3d, 0x9f, 0xee, 0xa0, 0xa5, 0x75, 0x82, 0xd2, 0xa2, 0x25, 0x93, 0x41,
-		0xb5, 0x3a, 0x7a, 0xca, 0x37, 0xb5, 0xa1, 0x0e, 0x96, 0xb2, 0xca, 0x26, 0x59, 0x8b, 0xb5, 0x6d,
-		0xc3, 0xa8, 0x8d, 0x89, 0xdd, 0xe3, 0x2a, 0xef, 0x59, 0x86, 0x0a, 0x31, 0x1b, 0xdb, 0x33, 0x85,
-		0x93, 0xb1, 0x8e, 0x8d, 0xd8, 0xc1, 0x22, 0xba, 0x1b, 0x3c, 0x40, 0x85, 0x9a, 0x15, 0x50, 0x2f,
-		0x94, 0x15, 0xc0, 0x35, 0xb5, 0x81, 0x0b, 0x2f, 0xc3, 0x68, 0x58, 0x3d, 0x68, 0x12, 0x06, 0x1c,
-		0x57, 0xb5, 0x5d, 0x6a, 0x85, 0x03, 0x0a, 0x2b, 0xa0, 0x1c, 0x24, 0xb1, 0x51, 0xa5, 0x5e, 0x6e,
-		0x40, 0x21, 0x3f, 0xd1, 0x4f, 0xf8, 0x1d, 0x4e, 0xd2, 0x0e, 0xdf, 0xd7, 0x3a, 0xa2, 0x21, 0xce,
-		0xd1, 0x7e, 0x17, 0x9e, 0x80, 0x91, 0x50, 0x07, 0x7a, 0x6d, 0x5a, 0x7e, 0x27, 0x1c, 0x6d, 0xcb,
-		0x1a, 0xbd, 0x00, 0x93, 0x4d, 0x43, 0x37, 0x5c, 0x6c, 0x5b, 0x36, 0x26, 0x16, 0xcb, 0x9a, 0xca,
-		0xff, 0xe7, 0xa1, 0x0e, 0x36, 0xb7, 0x1d, 0xc4, 0x66, 0x5c, 0x94, 0x89, 0x66, 0x2b, 0xf0, 0x74,
-		0x26, 0xfd, 0xc6, 0x50, 0xee, 0x95, 0x57, 0x5e, 0x79, 0x25, 0x21, 0x7f, 0x79, 0x10, 0x26, 0xdb,
-		0xcd, 0x99, 0xb6, 0xd3, 0xf7, 0x18, 0x0c, 0x1a, 0xcd, 0xc6, 0x0e, 0xb6, 0xa9, 0x92, 0x06, 0x14,
-		0x5e, 0x42, 0xf3, 0x30, 0x50, 0x57, 0x77, 0x70, 0x3d, 0x9f, 0x9a, 0x91, 0x4e, 0x8d, 0x9e, 0x79,
-		0xb0, 0xa7, 0x59, 0x39, 0xbb, 0x42, 0x48, 0x14, 0x46, 0x89, 0x9e, 0x86, 0x14, 0x77, 0xd1, 0x84,
-		0xc3, 0xe9, 0xde, 0x38, 0x90, 0xb9, 0xa4, 0x50, 0x3a, 0x74, 0x07, 0x64, 0xc8, 0x5f, 0x66, 0x1b,
-		0x83, 0x54, 0xe6, 0x34, 0x01, 0x10, 0xbb, 0x40, 0x05, 0x48, 0xd3, 0x69, 0x52, 0xc5, 0x22, 0xb4,
-		0x79, 0x65, 0x62, 0x58, 0x55, 0xbc, 0xab, 0x36, 0xeb, 0x6e, 0xe5, 0x8a, 0x5a, 0x6f, 0x62, 0x6a,
-		0xf0, 0x19, 0x25, 0xcb, 0x81, 0x97, 0x09, 0x0c, 0x4d, 0xc3, 0x30, 0x9b, 0x55, 0xba, 0x51, 0xc5,
-		0xd7, 0xa8, 0xf7, 0x1c, 0x50, 0xd8, 0x44, 0x5b, 0x26, 0x10, 0xd2, 0xfc, 0x8b, 0x8e, 0x69, 0x08,
-		0xd3, 0xa4, 0x4d, 0x10, 0x00, 0x6d, 0xfe, 0x89, 0xa8, 0xe3, 0xbe, 0xb3, 0x7d, 0xf7, 0x5a, 0xe6,
-		0xd2, 0xfd, 0x30, 0x46, 0x31, 0x1e, 0xe3, 0x43, 0xaf, 0xd6, 0xf3, 0xe3, 0x33, 0xd2, 0xa9, 0xb4,
-		0x32, 0xca, 0xc0, 0xeb, 0x1c, 0x2a, 0x7f, 0x31, 0x01, 0x29, 0xea, 0x58, 0xc6, 0x60, 0x78, 0xeb,
-		0x2d, 0x1b, 0xe5, 0xca, 0xe2, 0xfa, 0x76, 0x69, 0xa5, 0x9c, 0x93, 0xd0, 0x28, 0x00, 0x05, 0x5c,
-		0x58, 0x59, 0x9f, 0xdf, 0xca, 0x25, 0xbc, 0xf2, 0xf2, 0xda, 0xd6, 0xb9, 0xc7, 0x73, 0x49, 0x8f,
-		0x60, 0x9b, 0x01, 0x52, 0x41, 0x84, 0xc7, 0xce, 0xe4, 0x06, 0x50, 0x0e, 0xb2, 0x8c, 0xc1, 0xf2,
-		0x0b, 0xe5, 0xc5, 0x73, 0x8f, 0xe7, 0x06, 0xc3, 0x90, 0xc7, 0xce, 0xe4, 0x86, 0xd0, 0x08, 0x64,
-		0x28, 0xa4, 0xb4, 0xbe, 0xbe, 0x92, 0x4b, 0x7b, 0x3c, 0x37, 0xb7, 0x94, 0xe5, 0xb5, 0xa5, 0x5c,
-		0xc6, 0xe3, 0xb9, 0xa4, 0xac, 0x6f, 0x6f, 0xe4, 0xc0, 0xe3, 0xb0, 0x5a, 0xde, 0xdc, 0x9c, 0x5f,
-		0x2a, 0xe7, 0x86, 0x3d, 0x8c, 0xd2, 0x5b, 0xb6, 0xca, 0x9b, 0xb9, 0x6c, 0x48, 0xac, 0xc7, 0xce,
-		0xe4, 0x46, 0xbc, 0x26, 0xca, 0x6b, 0xdb, 0xab, 0xb9, 0x51, 0x34, 0x0e, 0x23, 0xac, 0x09, 0x21,
-		0xc4, 0x58, 0x04, 0x74, 0xee, 0xf1, 0x5c, 0xce, 0x17, 0x84, 0x71, 0x19, 0x0f, 0x01, 0xce, 0x3d,
-		0x9e, 0x43, 0xf2, 0x02, 0x0c, 0x50, 0x33, 0x44, 0x08, 0x46, 0x57, 0xe6, 0x4b, 0xe5, 0x95, 0xca,
-		0xfa, 0xc6, 0xd6, 0xf2, 0xfa, 0xda, 0xfc, 0x4a, 0x4e, 0xf2, 0x61, 0x4a, 0xf9, 0xb9, 0xed, 0x65,
-		0xa5, 0xbc, 0x98, 0x4b, 0x04, 0x61, 0x1b, 0xe5, 0xf9, 0xad, 0xf2, 0x62, 0x2e, 0x29, 0x6b, 0x30,
-		0xd9, 0xce, 0xa1, 0xb6, 0x9d, 0x42, 0x01, 0x5b, 0x48, 0x74, 0xb0, 0x05, 0xca, 0x2b, 0x6a, 0x0b,
-		0xf2, 0x37, 0x13, 0x30, 0xd1, 0x26, 0xa8, 0xb4, 0x6d, 0xe4, 0x19, 0x18, 0x60, 0xb6, 0xcc, 0xc2,
-		0xec, 0x03, 0x6d, 0xa3, 0x13, 0xb5, 0xec, 0x96, 0x50, 0x4b, 0xe9, 0x82, 0xa9, 0x46, 0xb2, 0x43,
-		0xaa, 0x41, 0x58, 0xb4, 0x18, 0xec, 0x4f, 0xb6, 0x38, 0x7f, 0x16, 0x1f, 0xcf, 0xf5, 0x12, 0x1f,
-		0x29, 0xac, 0xbf, 0x20, 0x30, 0xd0, 0x26, 0x08, 0x9c, 0x87, 0xf1, 0x16, 0x46, 0x3d, 0x3b, 0xe3,
-		0xf7, 0x48, 0x90, 0xef, 0xa4, 0x9c, 0x18, 0x97, 0x98, 0x08, 0xb9, 0xc4, 0xf3, 0x51, 0x0d, 0xde,
-		0xd5, 0x79, 0x10, 0x5a, 0xc6, 0xfa, 0x33, 0x12, 0x1c, 0x6b, 0x9f, 0x52, 0xb6, 0x95, 0xe1, 0x69,
-		0x18, 0x6c, 0x60, 0x77, 0xcf, 0x14, 0x69, 0xd5, 0x7d, 0x6d, 0x82, 0x35, 0xa9, 0x8e, 0x0e, 0x36,
-		0xa7, 0x0a, 0x46, 0xfb, 0x64, 0xa7, 0xbc, 0x90, 0x49, 0xd3, 0x22, 0xe9, 0x07, 0x12, 0x70, 0xb4,
-		0x2d, 0xf3, 0xb6, 0x82, 0xde, 0x09, 0xa0, 0x1b, 0x56, 0xd3, 0x65, 0xa9, 0x13, 0xf3, 0xc4, 0x19,
-		0x0a, 0xa1, 0xce, 0x8b, 0x78, 0xd9, 0xa6, 0xeb, 0xd5, 0x27, 0x69, 0x3d, 0x30, 0x10, 0x45, 0x78,
-		0xd2, 0x17, 0x34, 0x45, 0x05, 0x9d, 0xea, 0xd0, 0xd3, 0x16, 0xc3, 0x7c, 0x04, 0x72, 0x5a, 0x5d,
-		0xc7, 0x86, 0x5b, 0x71, 0x5c, 0x1b, 0xab, 0x0d, 0xdd, 0xa8, 0xd1, 0x50, 0x93, 0x2e, 0x0e, 0xec,
-		0xaa, 0x75, 0x07, 0x2b, 0x63, 0xac, 0x7a, 0x53, 0xd4, 0x12, 0x0a, 0x6a, 0x40, 0x76, 0x80, 0x62,
-		0x30, 0x44, 0xc1, 0xaa, 0x3d, 0x0a, 0xf9, 0xc3, 0x19, 0x18, 0x0e, 0x24, 0xe0, 0xe8, 0x2e, 0xc8,
-		0xbe, 0xa8, 0x5e, 0x51, 0x2b, 0x62, 0x51, 0xc5, 0x34, 0x31, 0x4c, 0x60, 0x1b, 0x7c, 0x61, 0xf5,
-		0x08, 0x4c, 0x52, 0x14, 0xb3, 0xe9, 0x62, 0xbb, 0xa2, 0xd5, 0x55, 0xc7, 0xa1, 0x4a, 0x4b, 0x53,
-		0x54, 0x44, 0xea, 0xd6, 0x49, 0xd5, 0x82, 0xa8, 0x41, 0x67, 0x61, 0x82, 0x52, 0x34, 0x9a, 0x75,
-		0x57, 0xb7, 0xea, 0xb8, 0x42, 0x96, 0x79, 0x0e, 0x0d, 0x39, 0x9e, 0x64, 0xe3, 0x04, 0x63, 0x95,
-		0x23, 0x10, 0x89, 0x1c, 0xb4, 0x08, 0x77, 0x52, 0xb2, 0x1a, 0x36, 0xb0, 0xad, 0xba, 0xb8, 0x82,
-		0x5f, 0x6a, 0xaa, 0x75, 0xa7, 0xa2, 0x1a, 0xd5, 0xca, 0x9e, 0xea, 0xec, 0xe5, 0x27, 0x09, 0x83,
-		0x52, 0x22, 0x2f, 0x29, 0x27, 0x08, 0xe2, 0x12, 0xc7, 0x2b, 0x53, 0xb4, 0x79, 0xa3, 0x7a, 0x51,
-		0x75, 0xf6, 0x50, 0x11, 0x8e, 0x51, 0x2e, 0x8e, 0x6b, 0xeb, 0x46, 0xad, 0xa2, 0xed, 0x61, 0x6d,
-		0xbf, 0xd2, 0x74, 0x77, 0x9f, 0xcc, 0xdf, 0x11, 0x6c, 0x9f, 0x4a, 0xb8, 0x49, 0x71, 0x16, 0x08,
-		0xca, 0xb6, 0xbb, 0xfb, 0x24, 0xda, 0x84, 0x2c, 0x19, 0x8c, 0x86, 0xfe, 0x32, 0xae, 0xec, 0x9a,
-		0x36, 0x8d, 0xa1, 0xa3, 0x6d, 0x5c, 0x53, 0x40, 0x83, 0xb3, 0xeb, 0x9c, 0x60, 0xd5, 0xac, 0xe2,
-		0xe2, 0xc0, 0xe6, 0x46, 0xb9, 0xbc, 0xa8, 0x0c, 0x0b, 0x2e, 0x17, 0x4c, 0x9b, 0x18, 0x54, 0xcd,
-		0xf4, 0x14, 0x3c, 0xcc, 0x0c, 0xaa, 0x66, 0x0a, 0xf5, 0x9e, 0x85, 0x09, 0x4d, 0x63, 0x7d, 0xd6,
-		0xb5, 0x0a, 0x5f, 0x8c, 0x39, 0xf9, 0x5c, 0x48, 0x59, 0x9a, 0xb6, 0xc4, 0x10, 0xb8, 0x8d, 0x3b,
-		0xe8, 0x29, 0x38, 0xea, 0x2b, 0x2b, 0x48, 0x38, 0xde, 0xd2, 0xcb, 0x28, 0xe9, 0x59, 0x98, 0xb0,
-		0x0e, 0x5a, 0x09, 0x51, 0xa8, 0x45, 0xeb, 0x20, 0x4a, 0xf6, 0x04, 0x4c, 0x5a, 0x7b, 0x56, 0x2b,
-		0xdd, 0xe9, 0x20, 0x1d, 0xb2, 0xf6, 0xac, 0x28, 0xe1, 0xbd, 0x74, 0x65, 0x6e, 0x63, 0x4d, 0x75,
-		0x71, 0x35, 0x7f, 0x3c, 0x88, 0x1e, 0xa8, 0x40, 0xb3, 0x90, 0xd3, 0xb4, 0x0a, 0x36, 0xd4, 0x9d,
-		0x3a, 0xae, 0xa8, 0x36, 0x36, 0x54, 0x27, 0x3f, 0x4d, 0x91, 0x53, 0xae, 0xdd, 0xc4, 0xca, 0xa8,
-		0xa6, 0x95, 0x69, 0xe5, 0x3c, 0xad, 0x43, 0xa7, 0x61, 0xdc, 0xdc, 0x79, 0x51, 0x63, 0x16, 0x59,
-		0xb1, 0x6c, 0xbc, 0xab, 0x5f, 0xcb, 0xdf, 0x43, 0xd5, 0x3b, 0x46, 0x2a, 0xa8, 0x3d, 0x6e, 0x50,
-		0x30, 0x7a, 0x00, 0x72, 0x9a, 0xb3, 0xa7, 0xda, 0x16, 0x75, 0xc9, 0x8e, 0xa5, 0x6a, 0x38, 0x7f,
-		0x2f, 0x43, 0x65, 0xf0, 0x35, 0x01, 0x26, 0x33, 0xc2, 0xb9, 0xaa, 0xef, 0xba, 0x82, 0xe3, 0xfd,
-		0x6c, 0x46, 0x50, 0x18, 0xe7, 0x76, 0x0a, 0x72, 0x44, 0x13, 0xa1, 0x86, 0x4f, 0x51, 0xb4, 0x51,
-		0x6b, 0xcf, 0x0a, 0xb6, 0x7b, 0x37, 0x8c, 0x10, 0x4c, 0xbf, 0xd1, 0x07, 0x58, 0xe2, 0x66, 0xed,
-		0x05, 0x5a, 0x7c, 0x1c, 0x8e, 0x11, 0xa4, 0x06, 0x76, 0xd5, 0xaa, 0xea, 0xaa, 0x01, 0xec, 0x87,
-		0x28, 0x36, 0x51, 0xfb, 0x2a, 0xaf, 0x0c, 0xc9, 0x69, 0x37, 0x77, 0x0e, 0x3c, 0xc3, 0x7a, 0x98,
-		0xc9, 0x49, 0x60, 0xc2, 0xb4, 0x6e, 0x5b, 0x72, 0x2e, 0x17, 0x21, 0x1b, 0xb4, 0x7b, 0x94, 0x01,
-		0x66, 0xf9, 0x39, 0x89, 0x24, 0x41, 0x0b, 0xeb, 0x8b, 0x24, 0x7d, 0x79, 0x6b, 0x39, 0x97, 0x20,
-		0x69, 0xd4, 0xca, 0xf2, 0x56, 0xb9, 0xa2, 0x6c, 0xaf, 0x6d, 0x2d, 0xaf, 0x96, 0x73, 0xc9, 0x40,
-		0x62, 0xff, 0x6c, 0x2a, 0x7d, 0x5f, 0xee, 0x7e, 0xf9, 0x1b, 0x09, 0x18, 0x0d, 0xaf, 0xd4, 0xd0,
-		0x9b, 0xe0, 0xb8, 0xd8, 0x56, 0x71, 0xb0, 0x5b, 0xb9, 0xaa, 0xdb, 0x74, 0x42, 0x36, 0x54, 0x16,
-		0x1c, 0x3d, 0xfb, 0x99, 0xe4, 0x58, 0x9b, 0xd8, 0x7d, 0x5e, 0xb7, 0xc9, 0x74, 0x6b, 0xa8, 0x2e,
-		0x5a, 0x81, 0x69, 0xc3, 0xac, 0x38, 0xae, 0x6a, 0x54, 0x55, 0xbb, 0x5a, 0xf1, 0x37, 0xb4, 0x2a,
-		0xaa, 0xa6, 0x61, 0xc7, 0x31, 0x59, 0x20, 0xf4, 0xb8, 0x9c, 0x34, 0xcc, 0x4d, 0x8e, 0xec, 0x47,
-		0x88, 0x79, 0x8e, 0x1a, 0x31, 0xdf, 0x64, 0x27, 0xf3, 0xbd, 0x03, 0x32, 0x0d, 0xd5, 0xaa, 0x60,
-		0xc3, 0xb5, 0x0f, 0x68, 0x7e, 0x9e, 0x56, 0xd2, 0x0d, 0xd5, 0x2a, 0x93, 0xf2, 0x0f, 0x65, 0x99,
-		0xf4, 0x6c, 0x2a, 0x9d, 0xce, 0x65, 0x9e, 0x4d, 0xa5, 0x33, 0x39, 0x90, 0x5f, 0x4f, 0x42, 0x36,
-		0x98, 0xaf, 0x93, 0xe5, 0x8f, 0x46, 0x23, 0x96, 0x44, 0x7d, 0xda, 0xdd, 0x5d, 0xb3, 0xfb, 0xd9,
-		0x05, 0x12, 0xca, 0x8a, 0x83, 0x2c, 0x39, 0x56, 0x18, 0x25, 0x49, 0x23, 0x88, 0xb1, 0x61, 0x96,
-		0x8c, 0xa4, 0x15, 0x5e, 0x42, 0x4b, 0x30, 0xf8, 0xa2, 0x43, 0x79, 0x0f, 0x52, 0xde, 0xf7, 0x74,
-		0xe7, 0xfd, 0xec, 0x26, 0x65, 0x9e, 0x79, 0x76, 0xb3, 0xb2, 0xb6, 0xae, 0xac, 0xce, 0xaf, 0x28,
-		0x9c, 0x1c, 0x9d, 0x80, 0x54, 0x5d, 0x7d, 0xf9, 0x20, 0x1c, 0xf4, 0x28, 0xa8, 0xd7, 0x41, 0x38,
-		0x01, 0xa9, 0xab, 0x58, 0xdd, 0x0f, 0x87, 0x1a, 0x0a, 0xba, 0x8d, 0x93, 0x61, 0x0e, 0x06, 0xa8,
-		0xbe, 0x10, 0x00, 0xd7, 0x58, 0xee, 0x08, 0x4a, 0x43, 0x6a, 0x61, 0x5d, 0x21, 0x13, 0x22, 0x07,
-		0x59, 0x06, 0xad, 0x6c, 0x2c, 0x97, 0x17, 0xca, 0xb9, 0x84, 0x7c, 0x16, 0x06, 0x99, 0x12, 0xc8,
-		0x64, 0xf1, 0xd4, 0x90, 0x3b, 0xc2, 0x8b, 0x9c, 0x87, 0x24, 0x6a, 0xb7, 0x57, 0x4b, 0x65, 0x25,
-		0x97, 0x08, 0x0f, 0x75, 0x2a, 0x37, 0x20, 0x3b, 0x90, 0x0d, 0xe6, 0xe1, 0x3f, 0x9c, 0xc5, 0xf8,
-		0x97, 0x24, 0x18, 0x0e, 0xe4, 0xd5, 0x24, 0x21, 0x52, 0xeb, 0x75, 0xf3, 0x6a, 0x45, 0xad, 0xeb,
-		0xaa, 0xc3, 0x4d, 0x03, 0x28, 0x68, 0x9e, 0x40, 0x7a, 0x1d, 0xba, 0x1f, 0xd2, 0x14, 0x19, 0xc8,
-		0x0d, 0xca, 0x9f, 0x90, 0x20, 0x17, 0x4d, 0x6c, 0x23, 0x62, 0x4a, 0x3f, 0x4a, 0x31, 0xe5, 0x8f,
-		0x4b, 0x30, 0x1a, 0xce, 0x66, 0x23, 0xe2, 0xdd, 0xf5, 0x23, 0x15, 0xef, 0x0f, 0x12, 0x30, 0x12,
-		0xca, 0x61, 0x7b, 0x95, 0xee, 0x25, 0x18, 0xd7, 0xab, 0xb8, 0x61, 0x99, 0x2e, 0x36, 0xb4, 0x83,
-		0x4a, 0x1d, 0x5f, 0xc1, 0xf5, 0xbc, 0x4c, 0x9d, 0xc6, 0x5c, 0xf7, 0x2c, 0x79, 0x76, 0xd9, 0xa7,
-		0x5b, 0x21, 0x64, 0xc5, 0x89, 0xe5, 0xc5, 0xf2, 0xea, 0xc6, 0xfa, 0x56, 0x79, 0x6d, 0xe1, 0x2d,
-		0x95, 0xed, 0xb5, 0x4b, 0x6b, 0xeb, 0xcf, 0xaf, 0x29, 0x39, 0x3d, 0x82, 0x76, 0x1b, 0xa7, 0xfd,
-		0x06, 0xe4, 0xa2, 0x42, 0xa1, 0xe3, 0xd0, 0x4e, 0xac, 0xdc, 0x11, 0x34, 0x01, 0x63, 0x6b, 0xeb,
-		0x95, 0xcd, 0xe5, 0xc5, 0x72, 0xa5, 0x7c, 0xe1, 0x42, 0x79, 0x61, 0x6b, 0x93, 0xed, 0x7b, 0x78,
-		0xd8, 0x5b, 0xa1, 0x09, 0x2e, 0xbf, 0x96, 0x84, 0x89, 0x36, 0x92, 0xa0, 0x79, 0xbe, 0x62, 0x61,
-		0x8b, 0xa8, 0x87, 0x7b, 0x91, 0x7e, 0x96, 0xe4, 0x0c, 0x1b, 0xaa, 0xed, 0xf2, 0x05, 0xce, 0x03,
-		0x40, 0xb4, 0x64, 0xb8, 0xfa, 0xae, 0x8e, 0x6d, 0xbe, 0x9f, 0xc4, 0x96, 0x31, 0x63, 0x3e, 0x9c,
-		0x6d, 0x29, 0x3d, 0x04, 0xc8, 0x32, 0x1d, 0xdd, 0xd5, 0xaf, 0xe0, 0x8a, 0x6e, 0x88, 0xcd, 0x27,
-		0xb2, 0xac, 0x49, 0x29, 0x39, 0x51, 0xb3, 0x6c, 0xb8, 0x1e, 0xb6, 0x81, 0x6b, 0x6a, 0x04, 0x9b,
-		0x38, 0xf3, 0xa4, 0x92, 0x13, 0x35, 0x1e, 0xf6, 0x5d, 0x90, 0xad, 0x9a, 0x4d, 0x92, 0xeb, 0x31,
-		0x3c, 0x12, 0x3b, 0x24, 0x65, 0x98, 0xc1, 0x3c, 0x14, 0x9e, 0xc5, 0xfb, 0xbb, 0x5e, 0x59, 0x65,
-		0x98, 0xc1, 0x18, 0xca, 0xfd, 0x30, 0xa6, 0xd6, 0x6a, 0x36, 0x61, 0x2e, 0x18, 0xb1, 0x75, 0xc9,
-		0xa8, 0x07, 0xa6, 0x88, 0x85, 0x67, 0x21, 0x2d, 0xf4, 0x40, 0x42, 0x35, 0xd1, 0x44, 0xc5, 0x62,
-		0x8b, 0xed, 0xc4, 0xa9, 0x8c, 0x92, 0x36, 0x44, 0xe5, 0x5d, 0x90, 0xd5, 0x9d, 0x8a, 0xbf, 0x89,
-		0x9f, 0x98, 0x49, 0x9c, 0x4a, 0x2b, 0xc3, 0xba, 0xe3, 0x6d, 0x80, 0xca, 0x9f, 0x49, 0xc0, 0x68,
-		0xf8, 0x10, 0x02, 0x2d, 0x42, 0xba, 0x6e, 0x6a, 0x2a, 0x35, 0x2d, 0x76, 0x02, 0x76, 0x2a, 0xe6,
-		0xdc, 0x62, 0x76, 0x85, 0xe3, 0x2b, 0x1e, 0x65, 0xe1, 0xb7, 0x25, 0x48, 0x0b, 0x30, 0x3a, 0x06,
-		0x29, 0x4b, 0x75, 0xf7, 0x28, 0xbb, 0x81, 0x52, 0x22, 0x27, 0x29, 0xb4, 0x4c, 0xe0, 0x8e, 0xa5,
-		0x1a, 0xd4, 0x04, 0x38, 0x9c, 0x94, 0xc9, 0xb8, 0xd6, 0xb1, 0x5a, 0xa5, 0x8b, 0x1e, 0xb3, 0xd1,
-		0xc0, 0x86, 0xeb, 0x88, 0x71, 0xe5, 0xf0, 0x05, 0x0e, 0x46, 0x0f, 0xc2, 0xb8, 0x6b, 0xab, 0x7a,
-		0x3d, 0x84, 0x9b, 0xa2, 0xb8, 0x39, 0x51, 0xe1, 0x21, 0x17, 0xe1, 0x84, 0xe0, 0x5b, 0xc5, 0xae,
-		0xaa, 0xed, 0xe1, 0xaa, 0x4f, 0x34, 0x48, 0x37, 0x37, 0x8e, 0x73, 0x84, 0x45, 0x5e, 0x2f, 0x68,
-		0xe5, 0x6f, 0x48, 0x30, 0x2e, 0x96, 0x69, 0x55, 0x4f, 0x59, 0xab, 0x00, 0xaa, 0x61, 0x98, 0x6e,
-		0x50, 0x5d, 0xad, 0xa6, 0xdc, 0x42, 0x37, 0x3b, 0xef, 0x11, 0x29, 0x01, 0x06, 0x85, 0x06, 0x80,
-		0x5f, 0xd3, 0x51, 0x6d, 0xd3, 0x30, 0xcc, 0x4f, 0x98, 0xe8, 0x31, 0x25, 0x5b, 0xd8, 0x03, 0x03,
-		0x91, 0xf5, 0x1c, 0x9a, 0x84, 0x81, 0x1d, 0x5c, 0xd3, 0x0d, 0xbe, 0x6f, 0xcc, 0x0a, 0x62, 0xfb,
-		0x25, 0xe5, 0x6d, 0xbf, 0x94, 0x3e, 0x28, 0xc1, 0x84, 0x66, 0x36, 0xa2, 0xf2, 0x96, 0x72, 0x91,
-		0xdd, 0x05, 0xe7, 0xa2, 0xf4, 0xd6, 0xa7, 0x6b, 0xba, 0xbb, 0xd7, 0xdc, 0x99, 0xd5, 0xcc, 0xc6,
-		0x5c, 0xcd, 0xac, 0xab, 0x46, 0xcd, 0x3f, 0x67, 0xa5, 0x3f, 0xb4, 0x87, 0x6b, 0xd8, 0x78, 0xb8,
-		0x66, 0x06, 0x4e, 0x5d, 0xcf, 0xfb, 0x3f, 0xff, 0x4c, 0x92, 0x7e, 0x21, 0x91, 0x5c, 0xda, 0x28,
-		0x7d, 0x36, 0x51, 0x58, 0x62, 0xcd, 0x6d, 0x08, 0xf5, 0x28, 0x78, 0xb7, 0x8e, 0x35, 0xd2, 0x65,
-		0xf8, 0xf6, 0x83, 0x30, 0x59, 0x33, 0x6b, 0x26, 0xe5, 0x38, 0x47, 0x7e, 0xf1, 0x93, 0xdb, 0x8c,
-		0x07, 0x2d, 0xc4, 0x1e, 0xf3, 0x16, 0xd7, 0x60, 0x82, 0x23, 0x57, 0xe8, 0xd1, 0x11, 0x5b, 0xd8,
-		0xa0, 0xae, 0xbb, 0x6a, 0xf9, 0x5f, 0xfb, 0x16, 0x0d, 0xe8, 0xca, 0x38, 0x27, 0x25, 0x75, 0x6c,
-		0xed, 0x53, 0x54, 0xe0, 0x68, 0x88, 0x1f, 0x9b, 0xb6, 0xd8, 0x8e, 0xe1, 0xf8, 0x9b, 0x9c, 0xe3,
-		0x44, 0x80, 0xe3, 0x26, 0x27, 0x2d, 0x2e, 0xc0, 0x48, 0x3f, 0xbc, 0xfe, 0x25, 0xe7, 0x95, 0xc5,
-		0x41, 0x26, 0x4b, 0x30, 0x46, 0x99, 0x68, 0x4d, 0xc7, 0x35, 0x1b, 0xd4, 0x27, 0x76, 0x67, 0xf3,
-		0x5b, 0xdf, 0x62, 0xf3, 0x68, 0x94, 0x90, 0x2d, 0x78, 0x54, 0xc5, 0x22, 0xd0, 0xd3, 0xb2, 0x2a,
-		0xd6, 0xea, 0x31, 0x1c, 0xbe, 0xc2, 0x05, 0xf1, 0xf0, 0x8b, 0x97, 0x61, 0x92, 0xfc, 0xa6, 0x2e,
-		0x2b, 0x28, 0x49, 0xfc, 0x16, 0x5c, 0xfe, 0x1b, 0xef, 0x61, 0x53, 0x75, 0xc2, 0x63, 0x10, 0x90,
-		0x29, 0x30, 0x8a, 0x35, 0xec, 0xba, 0xd8, 0x76, 0x2a, 0x6a, 0xbd, 0x9d, 0x78, 0x81, 0x3d, 0x8c,
-		0xfc, 0xc7, 0xbe, 0x13, 0x1e, 0xc5, 0x25, 0x46, 0x39, 0x5f, 0xaf, 0x17, 0xb7, 0xe1, 0x78, 0x1b,
-		0xab, 0xe8, 0x81, 0xe7, 0x6b, 0x9c, 0xe7, 0x64, 0x8b, 0x65, 0x10, 0xb6, 0x1b, 0x20, 0xe0, 0xde,
-		0x58, 0xf6, 0xc0, 0xf3, 0xe7, 0x39, 0x4f, 0xc4, 0x69, 0xc5, 0x90, 0x12, 0x8e, 0xcf, 0xc2, 0xf8,
-		0x15, 0x6c, 0xef, 0x98, 0x0e, 0xdf, 0x37, 0xea, 0x81, 0xdd, 0xc7, 0x39, 0xbb, 0x31, 0x4e, 0x48,
-		0x37, 0x92, 0x08, 0xaf, 0xa7, 0x20, 0xbd, 0xab, 0x6a, 0xb8, 0x07, 0x16, 0xd7, 0x39, 0x8b, 0x21,
-		0x82, 0x4f, 0x48, 0xe7, 0x21, 0x5b, 0x33, 0x79, 0xd4, 0x8a, 0x27, 0xff, 0x04, 0x27, 0x1f, 0x16,
-		0x34, 0x9c, 0x85, 0x65, 0x5a, 0xcd, 0x3a, 0x09, 0x69, 0xf1, 0x2c, 0xfe, 0xa6, 0x60, 0x21, 0x68,
-		0x38, 0x8b, 0x3e, 0xd4, 0xfa, 0x49, 0xc1, 0xc2, 0x09, 0xe8, 0xf3, 0x19, 0x18, 0x36, 0x8d, 0xfa,
-		0x81, 0x69, 0xf4, 0x22, 0xc4, 0xa7, 0x38, 0x07, 0xe0, 0x24, 0x84, 0xc1, 0x79, 0xc8, 0xf4, 0x3a,
-		0x10, 0x7f, 0xeb, 0x3b, 0x62, 0x7a, 0x88, 0x11, 0x58, 0x82, 0x31, 0xe1, 0xa0, 0x74, 0xd3, 0xe8,
-		0x81, 0xc5, 0xdf, 0xe6, 0x2c, 0x46, 0x03, 0x64, 0xbc, 0x1b, 0x2e, 0x76, 0xdc, 0x1a, 0xee, 0x85,
-		0xc9, 0x67, 0x44, 0x37, 0x38, 0x09, 0x57, 0xe5, 0x0e, 0x36, 0xb4, 0xbd, 0xde, 0x38, 0xfc, 0x92,
-		0x50, 0xa5, 0xa0, 0x21, 0x2c, 0x16, 0x60, 0xa4, 0xa1, 0xda, 0xce, 0x9e, 0x5a, 0xef, 0x69, 0x38,
-		0xfe, 0x0e, 0xe7, 0x91, 0xf5, 0x88, 0xb8, 0x46, 0x9a, 0x46, 0x3f, 0x6c, 0x3e, 0x2b, 0x34, 0x12,
-		0x20, 0xe3, 0x53, 0xcf, 0x71, 0xe9, 0x26, 0x5b, 0x3f, 0xdc, 0x7e, 0x59, 0x4c, 0x3d, 0x46, 0xbb,
-		0x1a, 0xe4, 0x78, 0x1e, 0x32, 0x8e, 0xfe, 0x72, 0x4f, 0x6c, 0x3e, 0x27, 0x46, 0x9a, 0x12, 0x10,
-		0xe2, 0xb7, 0xc0, 0x89, 0xb6, 0x61, 0xa2, 0x07, 0x66, 0x7f, 0x97, 0x33, 0x3b, 0xd6, 0x26, 0x54,
-		0x70, 0x97, 0xd0, 0x2f, 0xcb, 0xbf, 0x27, 0x5c, 0x02, 0x8e, 0xf0, 0xda, 0x20, 0xeb, 0x08, 0x47,
-		0xdd, 0xed, 0x4f, 0x6b, 0xbf, 0x22, 0xb4, 0xc6, 0x68, 0x43, 0x5a, 0xdb, 0x82, 0x63, 0x9c, 0x63,
-		0x7f, 0xe3, 0xfa, 0xab, 0xc2, 0xb1, 0x32, 0xea, 0xed, 0xf0, 0xe8, 0xbe, 0x0d, 0x0a, 0x9e, 0x3a,
-		0x45, 0xc2, 0xea, 0x54, 0x1a, 0xaa, 0xd5, 0x03, 0xe7, 0x5f, 0xe3, 0x9c, 0x85, 0xc7, 0xf7, 0x32,
-		0x5e, 0x67, 0x55, 0xb5, 0x08, 0xf3, 0x17, 0x20, 0x2f, 0x98, 0x37, 0x0d, 0x1b, 0x6b, 0x66, 0xcd,
-		0xd0, 0x5f, 0xc6, 0xd5, 0x1e, 0x58, 0xff, 0x7a, 0x64, 0xa8, 0xb6, 0x03, 0xe4, 0x84, 0xf3, 0x32,
-		0xe4, 0xbc, 0x5c, 0xa5, 0xa2, 0x37, 0x2c, 0xd3, 0x76, 0x63, 0x38, 0x7e, 0x5e, 0x8c, 0x94, 0x47,
-		0xb7, 0x4c, 0xc9, 0x8a, 0x65, 0x60, 0x27, 0xcf, 0xbd, 0x9a, 0xe4, 0x17, 0x38, 0xa3, 0x11, 0x9f,
-		0x8a, 0x3b, 0x0e, 0xcd, 0x6c, 0x58, 0xaa, 0xdd, 0x8b, 0xff, 0xfb, 0xfb, 0xc2, 0x71, 0x70, 0x12,
-		0xee, 0x38, 0xdc, 0x03, 0x0b, 0x93, 0x68, 0xdf, 0x03, 0x87, 0x2f, 0x0a, 0xc7, 0x21, 0x68, 0x38,
-		0x0b, 0x91, 0x30, 0xf4, 0xc0, 0xe2, 0x1f, 0x08, 0x16, 0x82, 0x86, 0xb0, 0x78, 0xce, 0x0f, 0xb4,
-		0x36, 0xae, 0xe9, 0x8e, 0x6b, 0xb3, 0x34, 0xb9, 0x3b, 0xab, 0x7f, 0xf8, 0x9d, 0x70, 0x12, 0xa6,
-		0x04, 0x48, 0x89, 0x27, 0xe2, 0xdb, 0xae, 0x74, 0x15, 0x15, 0x2f, 0xd8, 0x6f, 0x08, 0x4f, 0x14,
-		0x20, 0x23, 0xb2, 0x05, 0x32, 0x44, 0xa2, 0x76, 0x8d, 0xac, 0x1d, 0x7a, 0x60, 0xf7, 0x8f, 0x22,
-		0xc2, 0x6d, 0x0a, 0x5a, 0xc2, 0x33, 0x90, 0xff, 0x34, 0x8d, 0x7d, 0x7c, 0xd0, 0x93, 0x75, 0xfe,
-		0xe3, 0x48, 0xfe, 0xb3, 0xcd, 0x28, 0x99, 0x0f, 0x19, 0x8b, 0xe4, 0x53, 0x28, 0xee, 0x9e, 0x51,
-		0xfe, 0xa7, 0xbe, 0xc7, 0xfb, 0x1b, 0x4e, 0xa7, 0x8a, 0x2b, 0xc4, 0xc8, 0xc3, 0x49, 0x4f, 0x3c,
-		0xb3, 0xf7, 0x7c, 0xcf, 0xb3, 0xf3, 0x50, 0xce, 0x53, 0xbc, 0x00, 0x23, 0xa1, 0x84, 0x27, 0x9e,
-		0xd5, 0x7b, 0x39, 0xab, 0x6c, 0x30, 0xdf, 0x29, 0x9e, 0x85, 0x14, 0x49, 0x5e, 0xe2, 0xc9, 0xff,
-		0x32, 0x27, 0xa7, 0xe8, 0xc5, 0x37, 0x43, 0x5a, 0x24, 0x2d, 0xf1, 0xa4, 0xef, 0xe3, 0xa4, 0x1e,
-		0x09, 0x21, 0x17, 0x09, 0x4b, 0x3c, 0xf9, 0x5f, 0x11, 0xe4, 0x82, 0x84, 0x90, 0xf7, 0xae, 0xc2,
-		0x2f, 0xfd, 0x74, 0x8a, 0x07, 0x1d, 0xa1, 0xbb, 0xf3, 0x30, 0xc4, 0x33, 0x95, 0x78, 0xea, 0x0f,
-		0xf0, 0xc6, 0x05, 0x45, 0xf1, 0x09, 0x18, 0xe8, 0x51, 0xe1, 0x3f, 0xc3, 0x49, 0x19, 0x7e, 0x71,
-		0x01, 0x86, 0x03, 0xd9, 0x49, 0x3c, 0xf9, 0x5f, 0xe3, 0xe4, 0x41, 0x2a, 0x22, 0x3a, 0xcf, 0x4e,
-		0xe2, 0x19, 0x7c, 0x50, 0x88, 0xce, 0x29, 0x88, 0xda, 0x44, 0x62, 0x12, 0x4f, 0xfd, 0x21, 0xa1,
-		0x75, 0x41, 0x52, 0x7c, 0x06, 0x32, 0x5e, 0xb0, 0x89, 0xa7, 0xff, 0x30, 0xa7, 0xf7, 0x69, 0x88,
-		0x06, 0x02, 0xc1, 0x2e, 0x9e, 0xc5, 0x5f, 0x17, 0x1a, 0x08, 0x50, 0x91, 0x69, 0x14, 0x4d, 0x60,
-		0xe2, 0x39, 0x7d, 0x44, 0x4c, 0xa3, 0x48, 0xfe, 0x42, 0x46, 0x93, 0xfa, 0xfc, 0x78, 0x16, 0x3f,
-		0x2b, 0x46, 0x93, 0xe2, 0x13, 0x31, 0xa2, 0x19, 0x41, 0x3c, 0x8f, 0xbf, 0x21, 0xc4, 0x88, 0x24,
-		0x04, 0xc5, 0x0d, 0x40, 0xad, 0xd9, 0x40, 0x3c, 0xbf, 0x8f, 0x72, 0x7e, 0xe3, 0x2d, 0xc9, 0x40,
-		0xf1, 0x79, 0x38, 0xd6, 0x3e, 0x13, 0x88, 0xe7, 0xfa, 0xb1, 0xef, 0x45, 0xd6, 0x6e, 0xc1, 0x44,
-		0xa0, 0xb8, 0xe5, 0x87, 0x94, 0x60, 0x16, 0x10, 0xcf, 0xf6, 0xb5, 0xef, 0x85, 0x1d, 0x77, 0x30,
-		0x09, 0x28, 0xce, 0x03, 0xf8, 0x01, 0x38, 0x9e, 0xd7, 0xc7, 0x39, 0xaf, 0x00, 0x11, 0x99, 0x1a,
-		0x3c, 0xfe, 0xc6, 0xd3, 0x5f, 0x17, 0x53, 0x83, 0x53, 0x90, 0xa9, 0x21, 0x42, 0x6f, 0x3c, 0xf5,
-		0x27, 0xc4, 0xd4, 0x10, 0x24, 0xc4, 0xb2, 0x03, 0xd1, 0x2d, 0x9e, 0xc3, 0xa7, 0x84, 0x65, 0x07,
-		0xa8, 0x8a, 0x6b, 0x30, 0xde, 0x12, 0x10, 0xe3, 0x59, 0xfd, 0x02, 0x67, 0x95, 0x8b, 0xc6, 0xc3,
-		0x60, 0xf0, 0xe2, 0xc1, 0x30, 0x9e, 0xdb, 0xa7, 0x23, 0xc1, 0x8b, 0xc7, 0xc2, 0xe2, 0x79, 0x48,
-		0x1b, 0xcd, 0x7a, 0x9d, 0x4c, 0x1e, 0xd4, 0xfd, 0x6e, 0x60, 0xfe, 0xbf, 0x7c, 0x9f, 0x6b, 0x47,
-		0x10, 0x14, 0xcf, 0xc2, 0x00, 0x6e, 0xec, 0xe0, 0x6a, 0x1c, 0xe5, 0xb7, 0xbf, 0x2f, 0x1c, 0x26,
-		0xc1, 0x2e, 0x3e, 0x03, 0xc0, 0xb6, 0x46, 0xe8, 0xf1, 0x60, 0x0c, 0xed, 0x7f, 0xfd, 0x3e, 0xbf,
-		0x8c, 0xe3, 0x93, 0xf8, 0x0c, 0xd8, 0xd5, 0x9e, 0xee, 0x0c, 0xbe, 0x13, 0x66, 0x40, 0x47, 0xe4,
-		0x29, 0x18, 0x7a, 0xd1, 0x31, 0x0d, 0x57, 0xad, 0xc5, 0x51, 0xff, 0x37, 0x4e, 0x2d, 0xf0, 0x89,
-		0xc2, 0x1a, 0xa6, 0x8d, 0x5d, 0xb5, 0xe6, 0xc4, 0xd1, 0xfe, 0x77, 0x4e, 0xeb, 0x11, 0x10, 0x62,
-		0x4d, 0x75, 0xdc, 0x5e, 0xfa, 0xfd, 0x47, 0x82, 0x58, 0x10, 0x10, 0xa1, 0xc9, 0xef, 0x7d, 0x7c,
-		0x10, 0x47, 0xfb, 0x5d, 0x21, 0x34, 0xc7, 0x2f, 0xbe, 0x19, 0x32, 0xe4, 0x27, 0xbb, 0x61, 0x17,
-		0x43, 0xfc, 0xc7, 0x9c, 0xd8, 0xa7, 0x20, 0x2d, 0x3b, 0x6e, 0xd5, 0xd5, 0xe3, 0x95, 0x7d, 0x93,
-		0x8f, 0xb4, 0xc0, 0x2f, 0xce, 0xc3, 0xb0, 0xe3, 0x56, 0xab, 0x4d, 0x9e, 0x9f, 0xc6, 0x90, 0xff,
-		0xc9, 0xf7, 0xbd, 0x2d, 0x0b, 0x8f, 0x86, 0x8c, 0xf6, 0xd5, 0x7d, 0xd7, 0x32, 0xe9, 0x11, 0x48,
-		0x1c, 0x87, 0xef, 0x71, 0x0e, 0x01, 0x92, 0xe2, 0x02, 0x64, 0x49, 0x5f, 0x6c, 0x6c, 0x61, 0x7a,
-		0x5e, 0x15, 0xc3, 0xe2, 0x4f, 0xb9, 0x02, 0x42, 0x44, 0xa5, 0x9f, 0xfc, 0xca, 0xeb, 0x53, 0xd2,
-		0xd7, 0x5f, 0x9f, 0x92, 0xfe, 0xe0, 0xf5, 0x29, 0xe9, 0x43, 0xdf, 0x9c, 0x3a, 0xf2, 0xf5, 0x6f,
-		0x4e, 0x1d, 0xf9, 0xdd, 0x6f, 0x4e, 0x1d, 0x69, 0xbf, 0x6d, 0x0c, 0x4b, 0xe6, 0x92, 0xc9, 0x36,
-		0x8c, 0xdf, 0x2a, 0x87, 0xb6, 0x8b, 0x6b, 0xa6, 0xbf, 0x5b, 0xeb, 0x2d, 0x72, 0xe0, 0x4f, 0x25,
-		0xb2, 0x60, 0x0e, 0xef, 0xe5, 0xaa, 0xc6, 0x41, 0x87, 0xb7, 0x3a, 0x85, 0xb6, 0x1b, 0xc3, 0xf2,
-		0x9b, 0x20, 0x39, 0x6f, 0x1c, 0xa0, 0x13, 0xcc, 0xe7, 0x55, 0x9a, 0x76, 0x9d, 0xdf, 0xfc, 0x1a,
-		0x22, 0xe5, 0x6d, 0xbb, 0x8e, 0x26, 0xfd, 0xeb, 0x99, 0xd2, 0xa9, 0x2c, 0xbf, 0x73, 0x59, 0x4c,
-		0x7d, 0xf7, 0x53, 0xd3, 0x47, 0x4a, 0xfb, 0xd1, 0x1e, 0x7e, 0x29, 0xb6, 0x97, 0xe9, 0x79, 0xe3,
-		0x80, 0x76, 0x72, 0x43, 0x7a, 0xeb, 0x00, 0x69, 0xc3, 0x11, 0x1b, 0xdb, 0x53, 0xd1, 0x8d, 0xed,
-		0xe7, 0x71, 0xbd, 0x7e, 0xc9, 0x30, 0xaf, 0x1a, 0x5b, 0x04, 0x6d, 0x67, 0x90, 0x5d, 0x23, 0x86,
-		0xbf, 0x9a, 0x80, 0xa9, 0x96, 0x3d, 0x6c, 0x3e, 0xf2, 0x9d, 0x1e, 0x2a, 0x15, 0x21, 0xbd, 0x28,
-		0x0c, 0x2a, 0x0f, 0x43, 0x0e, 0xd6, 0x4c, 0xa3, 0xea, 0xd0, 0xae, 0x26, 0x15, 0x51, 0x24, 0x5d,
-		0x35, 0x54, 0xc3, 0x74, 0xf8, 0xed, 0x48, 0x56, 0x28, 0xfd, 0xac, 0xd4, 0xdf, 0x38, 0x8e, 0x88,
-		0x96, 0x44, 0x37, 0x4f, 0x77, 0xdb, 0xfb, 0xa7, 0x2a, 0xf0, 0xe4, 0x0f, 0xec, 0xf3, 0xf7, 0xaa,
-		0x8e, 0x0f, 0x25, 0x60, 0x3a, 0xaa, 0x0e, 0x32, 0x8f, 0x1c, 0x57, 0x6d, 0x58, 0x9d, 0xf4, 0x71,
-		0x1e, 0x32, 0x5b, 0x02, 0xa7, 0x6f, 0x85, 0xfc, 0x5c, 0x9f, 0x0a, 0x19, 0xf5, 0x9a, 0x12, 0x1a,
-		0x79, 0x30, 0x5e, 0x23, 0x5e, 0x17, 0x0e, 0xa1, 0x92, 0x77, 0x27, 0xe1, 0x84, 0x66, 0x3a, 0x0d,
-		0xd3, 0xa9, 0x30, 0x83, 0x67, 0x05, 0xae, 0x8c, 0x6c, 0xb0, 0xaa, 0x87, 0xe3, 0x90, 0x8b, 0x30,
-		0x4a, 0x9d, 0x02, 0xdd, 0x08, 0xa6, 0x7e, 0x38, 0x36, 0x74, 0x7e, 0xf5, 0xdf, 0x0e, 0xd0, 0x49,
-		0x34, 0xe2, 0x11, 0xd2, 0x9b, 0x2e, 0x5b, 0x30, 0xa9, 0x37, 0xac, 0x3a, 0xa6, 0x47, 0x62, 0x15,
-		0xaf, 0x2e, 0x9e, 0xdf, 0xd7, 0x38, 0xbf, 0x09, 0x9f, 0x7c, 0x59, 0x50, 0x17, 0x57, 0x60, 0x5c,
-		0xd5, 0x34, 0x6c, 0x85, 0x58, 0xc6, 0x38, 0x2c, 0x21, 0x60, 0x8e, 0x53, 0x7a, 0xdc, 0x4a, 0xcf,
-		0x74, 0x1a, 0xdb, 0xb7, 0xde, 0x1b, 0x18, 0x34, 0x1b, 0xd7, 0xb0, 0xf1, 0xb0, 0x81, 0xdd, 0xab,
-		0xa6, 0xbd, 0xcf, 0xd5, 0xfb, 0x30, 0x6b, 0x4a, 0x0c, 0xc2, 0x7b, 0x93, 0x30, 0xc5, 0x2a, 0xe6,
-		0x76, 0x54, 0x07, 0xcf, 0x5d, 0x79, 0x74, 0x07, 0xbb, 0xea, 0xa3, 0x73, 0x9a, 0xa9, 0x8b, 0x69,
-		0x3a, 0xc1, 0xc7, 0x85, 0xd4, 0xcf, 0xf2, 0xfa, 0x0e, 0x7e, 0x6a, 0x09, 0x52, 0x0b, 0xa6, 0x6e,
-		0x10, 0x8b, 0xac, 0x62, 0xc3, 0x6c, 0x70, 0x2f, 0xc5, 0x0a, 0xe8, 0x6e, 0x18, 0x54, 0x1b, 0x66,
-		0xd3, 0x70, 0xd9, 0x69, 0x5e, 0x69, 0xf8, 0x2b, 0x37, 0xa6, 0x8f, 0xfc, 0xde, 0x8d, 0xe9, 0xe4,
-		0xb2, 0xe1, 0x2a, 0xbc, 0xaa, 0x98, 0x7a, 0xe3, 0x93, 0xd3, 0x92, 0xfc, 0x2c, 0x0c, 0x2d, 0x62,
-		0xed, 0x30, 0xbc, 0x16, 0xb1, 0x16, 0xe1, 0xf5, 0x00, 0xa4, 0x97, 0x0d, 0x97, 0xdd, 0x20, 0xbe,
-		0x13, 0x92, 0xba, 0xc1, 0x2e, 0xa5, 0x45, 0xda, 0x27, 0x70, 0x82, 0xba, 0x88, 0x35, 0x0f, 0xb5,
-		0x8a, 0xb5, 0x28, 0x2a, 0x61, 0x4f, 0xe0, 0xa5, 0xc5, 0xdf, 0xfd, 0x4f, 0x53, 0x47, 0x5e, 0x79,
-		0x7d, 0xea, 0x48, 0xc7, 0x91, 0x08, 0x46, 0x07, 0xae, 0x62, 0x3e, 0x04, 0x4e, 0x75, 0x7f, 0xce,
-		0x0d, 0xcd, 0x85, 0xcf, 0xa6, 0xe0, 0x4e, 0xfa, 0x78, 0xc4, 0x6e, 0xe8, 0x86, 0x3b, 0xa7, 0xd9,
-		0x07, 0x96, 0x4b, 0xc3, 0x89, 0xb9, 0xcb, 0x47, 0x61, 0xdc, 0xaf, 0x9e, 0x65, 0xd5, 0x1d, 0xc6,
-		0x60, 0x17, 0x06, 0x36, 0x08, 0x1d, 0x51, 0x9c, 0x6b, 0xba, 0x6a, 0x9d, 0xbb, 0x0b, 0x56, 0x20,
-		0x50, 0xf6, 0xe0, 0x24, 0xc1, 0xa0, 0xba, 0x78, 0x6b, 0x52, 0xc7, 0xea, 0x2e, 0xbb, 0xb7, 0x9b,
-		0xa4, 0x21, 0x24, 0x4d, 0x00, 0xf4, 0x8a, 0xee, 0x24, 0x0c, 0xa8, 0x4d, 0x76, 0xe4, 0x9c, 0x24,
-		0xb1, 0x85, 0x16, 0xe4, 0x4b, 0x30, 0xc4, 0x8f, 0xb9, 0x50, 0x0e, 0x92, 0xfb, 0xf8, 0x80, 0xb6,
-		0x93, 0x55, 0xc8, 0x4f, 0x34, 0x0b, 0x03, 0x54, 0x78, 0xfe, 0x20, 0x21, 0x3f, 0xdb, 0x22, 0xfd,
-		0x2c, 0x15, 0x52, 0x61, 0x68, 0xf2, 0xb3, 0x90, 0x5e, 0x34, 0x1b, 0xba, 0x61, 0x86, 0xb9, 0x65,
-		0x18, 0x37, 0x2a, 0xb3, 0xd5, 0xe4, 0x63, 0xad, 0xb0, 0x02, 0x3a, 0x06, 0x83, 0xec, 0x1e, 0x37,
-		0x3f, 0x36, 0xe7, 0x25, 0x79, 0x01, 0x86, 0x28, 0xef, 0x75, 0x0b, 0x21, 0xfe, 0x02, 0x88, 0x5f,
-		0x18, 0xa7, 0x6e, 0x81, 0xb3, 0x4f, 0xf8, 0xc2, 0x22, 0x48, 0x55, 0x55, 0x57, 0xe5, 0xfd, 0xa6,
-		0xbf, 0xe5, 0xa7, 0x21, 0xcd, 0x99, 0x38, 0xe8, 0x0c, 0x24, 0x4d, 0xcb, 0xe1, 0x07, 0xdf, 0x85,
-		0x4e, 0x5d, 0x59, 0xb7, 0x4a, 0x29, 0x62, 0x25, 0x0a, 0x41, 0x2e, 0x29, 0x1d, 0xcd, 0xe2, 0xc9,
-		0x80, 0x59, 0x04, 0x86, 0x3c, 0xf0, 0x93, 0x0d, 0x69, 0x8b, 0x39, 0x78, 0xc6, 0xf2, 0xa9, 0x04,
-		0x4c, 0x05, 0x6a, 0xaf, 0x60, 0x9b, 0xac, 0xf5, 0x98, 0x45, 0x71, 0x6b, 0x41, 0x01, 0x21, 0x79,
-		0x7d, 0x07, 0x73, 0x79, 0x33, 0x24, 0xe7, 0x2d, 0x0b, 0x15, 0x20, 0xcd, 0x0e, 0xb8, 0x4d, 0x66,
-		0x2f, 0x29, 0xc5, 0x2b, 0x93, 0x3a, 0xc7, 0xdc, 0x75, 0xaf, 0xaa, 0xb6, 0xf7, 0xd4, 0x49, 0x94,
-		0xe5, 0xa7, 0x20, 0xb3, 0x60, 0x1a, 0x0e, 0x36, 0x9c, 0x26, 0x0d, 0x44, 0x3b, 0x75, 0x53, 0xdb,
-		0xe7, 0x1c, 0x58, 0x81, 0x28, 0x5c, 0xb5, 0x2c, 0x4a, 0x99, 0x52, 0xc8, 0x4f, 0x36, 0x2f, 0x4b,
-		0x9b, 0x1d, 0x55, 0xf4, 0x54, 0xff, 0x2a, 0xe2, 0x9d, 0xf4, 0x74, 0xf4, 0xbf, 0x25, 0x38, 0xd9,
-		0x3a, 0xa1, 0xf6, 0xf1, 0x81, 0xd3, 0xef, 0x7c, 0x7a, 0x01, 0x32, 0x1b, 0xf4, 0xbd, 0xf1, 0x25,
-		0x7c, 0x80, 0x0a, 0x30, 0x84, 0xab, 0x67, 0xce, 0x9e, 0x7d, 0xf4, 0x29, 0x66, 0xed, 0x17, 0x8f,
-		0x28, 0x02, 0x80, 0xa6, 0x20, 0xe3, 0x60, 0xcd, 0x3a, 0x73, 0xf6, 0xdc, 0xfe, 0xa3, 0xcc, 0xbc,
-		0x2e, 0x1e, 0x51, 0x7c, 0x50, 0x31, 0x4d, 0x7a, 0xfd, 0xc6, 0xa7, 0xa6, 0xa5, 0xd2, 0x00, 0x24,
-		0x9d, 0x66, 0xe3, 0xb6, 0xda, 0xc8, 0x6b, 0x03, 0x30, 0x13, 0xa4, 0xa4, 0xd1, 0xfa, 0x8a, 0x5a,
-		0xd7, 0xab, 0xaa, 0xff, 0x52, 0x3c, 0x17, 0xd0, 0x01, 0xc5, 0x68, 0xaf, 0x82, 0x42, 0x57, 0x4d,
-		0xca, 0xbf, 0x2e, 0x41, 0xf6, 0xb2, 0xe0, 0xbc, 0x89, 0x5d, 0x74, 0x1e, 0xc0, 0x6b, 0x49, 0x4c,
-		0x9b, 0x3b, 0x66, 0xa3, 0x6d, 0xcd, 0x7a, 0x34, 0x4a, 0x00, 0x1d, 0x3d, 0x41, 0x0d, 0xd1, 0x32,
-		0x1d, 0xfe, 0xfc, 0x25, 0x86, 0xd4, 0x43, 0x46, 0x0f, 0x01, 0xa2, 0x1e, 0xae, 0x72, 0xc5, 0x74,
-		0x75, 0xa3, 0x56, 0xb1, 0xcc, 0xab, 0xfc, 0x51, 0x61, 0x52, 0xc9, 0xd1, 0x9a, 0xcb, 0xb4, 0x62,
-		0x83, 0xc0, 0x89, 0xd0, 0x19, 0x8f, 0x0b, 0xc9, 0xad, 0xd4, 0x6a, 0xd5, 0xc6, 0x8e, 0xc3, 0x9d,
-		0x98, 0x28, 0xa2, 0xf3, 0x30, 0x64, 0x35, 0x77, 0x2a, 0xc2, 0x63, 0x0c, 0x9f, 0x39, 0xd9, 0x6e,
-		0xfe, 0x0b, 0xfb, 0xe0, 0x1e, 0x60, 0xd0, 0x6a, 0xee, 0x10, 0x6b, 0xb9, 0x0b, 0xb2, 0x6d, 0x84,
-		0x19, 0xbe, 0xe2, 0xcb, 0x41, 0x9f, 0xb9, 0xf3, 0x1e, 0x54, 0x2c, 0x5b, 0x37, 0x6d, 0xdd, 0x3d,
-		0xa0, 0xb7, 0x57, 0x92, 0x4a, 0x4e, 0x54, 0x6c, 0x70, 0xb8, 0xbc, 0x0f, 0x63, 0x9b, 0x34, 0xb7,
-		0xf0, 0x25, 0x3f, 0xeb, 0xcb, 0x27, 0xc5, 0xcb, 0xd7, 0x51, 0xb2, 0x44, 0x8b, 0x64, 0xa5, 0xe7,
-		0x3a, 0x5a, 0xe7, 0x13, 0xfd, 0x5b, 0x67, 0x38, 0xda, 0xfd, 0xd1, 0x89, 0xd0, 0xe4, 0xe4, 0xa9,
-		0x64, 0xc0, 0x7d, 0xf5, 0x6a, 0x98, 0x71, 0x29, 0x75, 0xa1, 0x7b, 0x50, 0x2d, 0xc4, 0xb8, 0xd1,
-		0x42, 0xec, 0x14, 0x92, 0x9f, 0x82, 0x91, 0x0d, 0xd5, 0x76, 0x37, 0xb1, 0x7b, 0x11, 0xab, 0x55,
-		0x6c, 0x87, 0xa3, 0xee, 0x88, 0x88, 0xba, 0x08, 0x52, 0x34, 0xb4, 0xb2, 0xa8, 0x43, 0x7f, 0xcb,
-		0x7b, 0x90, 0xa2, 0x37, 0xd8, 0xbc, 0x88, 0xcc, 0x29, 0x58, 0x44, 0x26, 0xbe, 0xf4, 0xc0, 0xc5,
-		0x8e, 0x58, 0xd0, 0xd1, 0x02, 0x7a, 0x5c, 0xc4, 0xd5, 0x64, 0xf7, 0xb8, 0xca, 0x0d, 0x91, 0x47,
-		0xd7, 0x3a, 0x0c, 0x95, 0x88, 0x2b, 0x5e, 0x5e, 0xf4, 0x04, 0x91, 0x7c, 0x41, 0xd0, 0x2a, 0x8c,
-		0x59, 0xaa, 0xed, 0xd2, 0xab, 0xfb, 0x7b, 0xb4, 0x17, 0xdc, 0xd6, 0xa7, 0x5b, 0x67, 0x5e, 0xa8,
-		0xb3, 0xbc, 0x95, 0x11, 0x2b, 0x08, 0x94, 0xff, 0x30, 0x05, 0x83, 0x5c, 0x19, 0x6f, 0x86, 0x21,
-		0xae, 0x56, 0x6e, 0x9d, 0x77, 0xce, 0xb6, 0x06, 0xa6, 0x59, 0x2f, 0x80, 0x70, 0x7e, 0x82, 0x06,
-		0xdd, 0x07, 0x69, 0x6d, 0x4f, 0xd5, 0x8d, 0x8a, 0x5e, 0x15, 0x69, 0xde, 0xeb, 0x37, 0xa6, 0x87,
-		0x16, 0x08, 0x6c, 0x79, 0x51, 0x19, 0xa2, 0x95, 0xcb, 0x55, 0x92, 0x09, 0xec, 0x61, 0xbd, 0xb6,
-		0xe7, 0xf2, 0x19, 0xc6, 0x4b, 0xe8, 0x49, 0x48, 0x11, 0x83, 0xe0, 0x0f, 0xbb, 0x0a, 0x2d, 0xc9,
-		0xb6, 0xb7, 0xe2, 0x29, 0xa5, 0x49, 0xc3, 0x1f, 0xfa, 0xfd, 0x69, 0x49, 0xa1, 0x14, 0x68, 0x01,
-		0x46, 0xea, 0xaa, 0xe3, 0x56, 0x68, 0x04, 0x23, 0xcd, 0x0f, 0x50, 0x16, 0x27, 0x5a, 0x15, 0xc2,
-		0x15, 0xcb, 0x45, 0x1f, 0x26, 0x54, 0x0c, 0x54, 0x45, 0xa7, 0x20, 0x47, 0x99, 0x68, 0x66, 0xa3,
-		0xa1, 0xbb, 0x2c, 0xb7, 0x1a, 0xa4, 0x7a, 0x1f, 0x25, 0xf0, 0x05, 0x0a, 0xa6, 0x19, 0xd6, 0x1d,
-		0x90, 0xa1, 0x4f, 0x49, 0x28, 0x0a, 0xbb, 0x36, 0x99, 0x26, 0x00, 0x5a, 0x79, 0x3f, 0x8c, 0xf9,
-		0xfe, 0x91, 0xa1, 0xa4, 0x19, 0x17, 0x1f, 0x4c, 0x11, 0x1f, 0x81, 0x49, 0x03, 0x5f, 0xa3, 0x17,
-		0x39, 0x43, 0xd8, 0x19, 0x8a, 0x8d, 0x48, 0xdd, 0xe5, 0x30, 0xc5, 0xbd, 0x30, 0xaa, 0x09, 0xe5,
-		0x33, 0x5c, 0xa0, 0xb8, 0x23, 0x1e, 0x94, 0xa2, 0x9d, 0x80, 0xb4, 0x6a, 0x59, 0x0c, 0x61, 0x98,
-		0xfb, 0x47, 0xcb, 0xa2, 0x55, 0xa7, 0x61, 0x9c, 0xf6, 0xd1, 0xc6, 0x4e, 0xb3, 0xee, 0x72, 0x26,
-		0x59, 0x8a, 0x33, 0x46, 0x2a, 0x14, 0x06, 0xa7, 0xb8, 0x77, 0xc3, 0x08, 0xbe, 0xa2, 0x57, 0xb1,
-		0xa1, 0x61, 0x86, 0x37, 0x42, 0xf1, 0xb2, 0x02, 0x48, 0x91, 0x1e, 0x00, 0xcf, 0xef, 0x55, 0x84,
-		0x4f, 0x1e, 0x65, 0xfc, 0x04, 0x7c, 0x9e, 0x81, 0xe5, 0x3c, 0xa4, 0x16, 0x55, 0x57, 0x25, 0x09,
-		0x86, 0x7b, 0x8d, 0x05, 0x9a, 0xac, 0x42, 0x7e, 0xca, 0x6f, 0x24, 0x20, 0x75, 0xd9, 0x74, 0x31,
-		0x7a, 0x2c, 0x90, 0x00, 0x8e, 0xb6, 0xb3, 0xe7, 0x4d, 0xbd, 0x66, 0xe0, 0xea, 0xaa, 0x53, 0x0b,
-		0xbc, 0xfb, 0xf6, 0xcd, 0x29, 0x11, 0x32, 0xa7, 0x49, 0x18, 0xb0, 0xcd, 0xa6, 0x51, 0x15, 0x37,
-		0x0e, 0x69, 0x01, 0x95, 0x21, 0xed, 0x59, 0x49, 0x2a, 0xce, 0x4a, 0xc6, 0x88, 0x95, 0x10, 0x1b,
-		0xe6, 0x00, 0x65, 0x68, 0x87, 0x1b, 0x4b, 0x09, 0x32, 0x9e, 0xf3, 0xe2, 0xd6, 0xd6, 0x9b, 0xc1,
-		0xfa, 0x64, 0x24, 0x98, 0x78, 0x63, 0xef, 0x29, 0x8f, 0x59, 0x5c, 0xce, 0xab, 0xe0, 0xda, 0x0b,
-		0x99, 0x15, 0x7f, 0x83, 0x3e, 0x44, 0xfb, 0xe5, 0x9b, 0x15, 0x7b, 0x87, 0x7e, 0x12, 0x32, 0x8e,
-		0x5e, 0x33, 0x54, 0xb7, 0x69, 0x63, 0x6e, 0x79, 0x3e, 0x40, 0xfe, 0x92, 0x04, 0x83, 0xcc, 0x92,
-		0x03, 0x7a, 0x93, 0xda, 0xeb, 0x2d, 0xd1, 0x49, 0x6f, 0xc9, 0xc3, 0xeb, 0x6d, 0x1e, 0xc0, 0x13,
-		0xc6, 0xe1, 0x4f, 0x83, 0xdb, 0x64, 0x0c, 0x4c, 0xc4, 0x4d, 0xbd, 0xc6, 0x27, 0x6a, 0x80, 0x48,
-		0xfe, 0x8f, 0x12, 0x49, 0x62, 0x79, 0x3d, 0x9a, 0x87, 0x11, 0x21, 0x57, 0x65, 0xb7, 0xae, 0xd6,
-		0xb8, 0xed, 0xdc, 0xd9, 0x51, 0xb8, 0x0b, 0x75, 0xb5, 0xa6, 0x0c, 0x73, 0x79, 0x48, 0xa1, 0xfd,
-		0x38, 0x24, 0x3a, 0x8c, 0x43, 0x68, 0xe0, 0x93, 0x87, 0x1b, 0xf8, 0xd0, 0x10, 0xa5, 0xa2, 0x43,
-		0xf4, 0xf9, 0x04, 0x5d, 0xcc, 0x58, 0xa6, 0xa3, 0xd6, 0x7f, 0x18, 0x33, 0xe2, 0x0e, 0xc8, 0x58,
-		0x66, 0xbd, 0xc2, 0x6a, 0xd8, 0x4d, 0xdc, 0xb4, 0x65, 0xd6, 0x95, 0x96, 0x61, 0x1f, 0xb8, 0x45,
-		0xd3, 0x65, 0xf0, 0x16, 0x68, 0x6d, 0x28, 0xaa, 0x35, 0x1b, 0xb2, 0x4c, 0x15, 0x3c, 0x96, 0x3d,
-		0x42, 0x74, 0x40, 0x83, 0xa3, 0xd4, 0x1a, 0x7b, 0x99, 0xd8, 0x0c, 0x53, 0xe1, 0x78, 0x84, 0x82,
-		0xb9, 0xfe, 0x76, 0xab, 0xe0, 0xa0, 0x59, 0x2a, 0x1c, 0x4f, 0xfe, 0x39, 0x09, 0x60, 0x85, 0x68,
-		0x96, 0xf6, 0x97, 0x44, 0x21, 0x87, 0x8a, 0x50, 0x09, 0xb5, 0x3c, 0xd5, 0x69, 0xd0, 0x78, 0xfb,
-		0x59, 0x27, 0x28, 0xf7, 0x02, 0x8c, 0xf8, 0xc6, 0xe8, 0x60, 0x21, 0xcc, 0x54, 0x97, 0xac, 0x7a,
-		0x13, 0xbb, 0x4a, 0xf6, 0x4a, 0xa0, 0x24, 0xff, 0x33, 0x09, 0x32, 0x54, 0xa6, 0x55, 0xec, 0xaa,
-		0xa1, 0x31, 0x94, 0x0e, 0x3f, 0x86, 0x77, 0x02, 0x30, 0x36, 0x8e, 0xfe, 0x32, 0xe6, 0x96, 0x95,
-		0xa1, 0x90, 0x4d, 0xfd, 0x65, 0x8c, 0xce, 0x79, 0x0a, 0x4f, 0x76, 0x57, 0xb8, 0xc8, 0xba, 0xb9,
-		0xda, 0x8f, 0xc3, 0x10, 0xfd, 0x94, 0xce, 0x35, 0x87, 0x27, 0xd2, 0x83, 0x46, 0xb3, 0xb1, 0x75,
-		0xcd, 0x91, 0x5f, 0x84, 0xa1, 0xad, 0x6b, 0x6c, 0x6f, 0xe4, 0x0e, 0xc8, 0xd8, 0xa6, 0xc9, 0x63,
-		0x32, 0xcb, 0x85, 0xd2, 0x04, 0x40, 0x43, 0x90, 0xd8, 0x0f, 0x48, 0xf8, 0xfb, 0x01, 0xfe, 0x86,
-		0x46, 0xb2, 0xa7, 0x0d, 0x8d, 0xd3, 0xff, 0x4e, 0x82, 0xe1, 0x80, 0x7f, 0x40, 0x8f, 0xc2, 0xd1,
-		0xd2, 0xca, 0xfa, 0xc2, 0xa5, 0xca, 0xf2, 0x62, 0xe5, 0xc2, 0xca, 0xfc, 0x92, 0xff, 0xd6, 0xa4,
-		0x70, 0xec, 0xd5, 0xeb, 0x33, 0x28, 0x80, 0xbb, 0x6d, 0xec, 0x1b, 0xe6, 0x55, 0x03, 0xcd, 0xc1,
-		0x64, 0x98, 0x64, 0xbe, 0xb4, 0x59, 0x5e, 0xdb, 0xca, 0x49, 0x85, 0xa3, 0xaf, 0x5e, 0x9f, 0x19,
-		0x0f, 0x50, 0xcc, 0xef, 0x38, 0xd8, 0x70, 0x5b, 0x09, 0x16, 0xd6, 0x57, 0x57, 0x97, 0xb7, 0x72,
-		0x89, 0x16, 0x02, 0xee, 0xb0, 0x1f, 0x80, 0xf1, 0x30, 0xc1, 0xda, 0xf2, 0x4a, 0x2e, 0x59, 0x40,
-		0xaf, 0x5e, 0x9f, 0x19, 0x0d, 0x60, 0xaf, 0xe9, 0xf5, 0x42, 0xfa, 0xfd, 0x9f, 0x9e, 0x3a, 0xf2,
-		0x4b, 0xbf, 0x38, 0x25, 0x91, 0x9e, 0x8d, 0x84, 0x7c, 0x04, 0x7a, 0x08, 0x8e, 0x6f, 0x2e, 0x2f,
-		0xad, 0x95, 0x17, 0x2b, 0xab, 0x9b, 0x4b, 0x15, 0xf6, 0x8d, 0x0d, 0xaf, 0x77, 0x63, 0xaf, 0x5e,
-		0x9f, 0x19, 0xe6, 0x5d, 0xea, 0x84, 0xbd, 0xa1, 0x94, 0x2f, 0xaf, 0x6f, 0x95, 0x73, 0x12, 0xc3,
-		0xde, 0xb0, 0xf1, 0x15, 0xd3, 0x65, 0xdf, 0xda, 0x7a, 0x04, 0x4e, 0xb4, 0xc1, 0xf6, 0x3a, 0x36,
-		0xfe, 0xea, 0xf5, 0x99, 0x91, 0x0d, 0x1b, 0xb3, 0xf9, 0x43, 0x29, 0x66, 0x21, 0xdf, 0x4a, 0xb1,
-		0xbe, 0xb1, 0xbe, 0x39, 0xbf, 0x92, 0x9b, 0x29, 0xe4, 0x5e, 0xbd, 0x3e, 0x93, 0x15, 0xce, 0x90,
-		0xe0, 0xfb, 0x3d, 0xbb, 0x9d, 0x2b, 0x9e, 0x3f, 0x79, 0x18, 0xee, 0xe1, 0x7b, 0x80, 0x8e, 0xab,
-		0xee, 0xeb, 0x46, 0xcd, 0xdb, 0x69, 0xe5, 0x65, 0xbe, 0xf2, 0x39, 0xc6, 0x37, 0x5b, 0x05, 0xb4,
-		0xeb, 0x7e, 0x6b, 0xa1, 0xf3, 0xc9, 0x52, 0x21, 0xe6, 0xf0, 0x25, 0x7e, 0xe9, 0xd4, 0x79, 0x6f,
-		0xbe, 0x10, 0xb3, 0x63, 0x5c, 0xe8, 0xba, 0xb8, 0x93, 0x3f, 0x20, 0xc1, 0xe8, 0x45, 0xdd, 0x71,
-		0x4d, 0x5b, 0xd7, 0xd4, 0x3a, 0x7d, 0x61, 0x72, 0xae, 0x57, 0xdf, 0x1a, 0x99, 0xea, 0xcf, 0xc0,
-		0xe0, 0x15, 0xb5, 0xce, 0x9c, 0x5a, 0x92, 0x7e, 0x10, 0xa3, 0xbd, 0xfa, 0x7c, 0xd7, 0x26, 0x18,
-		0x30, 0x32, 0xf9, 0x57, 0x12, 0x30, 0x46, 0x27, 0x83, 0xc3, 0x3e, 0x95, 0x44, 0xd6, 0x58, 0x25,
-		0x48, 0xd9, 0xaa, 0xcb, 0x37, 0x0d, 0x4b, 0xb3, 0x7c, 0xe7, 0xf7, 0xbe, 0xf8, 0xdd, 0xdc, 0xd9,
-		0x45, 0xac, 0x29, 0x94, 0x16, 0xbd, 0x1d, 0xd2, 0x0d, 0xf5, 0x5a, 0x85, 0xf2, 0x61, 0x2b, 0x97,
-		0xf9, 0xfe, 0xf8, 0xdc, 0xbc, 0x31, 0x3d, 0x76, 0xa0, 0x36, 0xea, 0x45, 0x59, 0xf0, 0x91, 0x95,
-		0xa1, 0x86, 0x7a, 0x8d, 0x88, 0x88, 0x2c, 0x18, 0x23, 0x50, 0x6d, 0x4f, 0x35, 0x6a, 0x98, 0x35,
-		0x42, 0xb7, 0x40, 0x4b, 0x17, 0xfb, 0x6e, 0xe4, 0x98, 0xdf, 0x48, 0x80, 0x9d, 0xac, 0x8c, 0x34,
-		0xd4, 0x6b, 0x0b, 0x14, 0x40, 0x5a, 0x2c, 0xa6, 0x3f, 0xfa, 0xc9, 0xe9, 0x23, 0x74, 0x37, 0xfd,
-		0x1b, 0x12, 0x80, 0xaf, 0x31, 0xf4, 0x76, 0xc8, 0x69, 0x5e, 0x89, 0xd2, 0x3a, 0x7c, 0x0c, 0xef,
-		0xef, 0x34, 0x16, 0x11, 0x7d, 0xb3, 0xd8, 0xfc, 0xf5, 0x1b, 0xd3, 0x92, 0x32, 0xa6, 0x45, 0x86,
-		0xe2, 0x6d, 0x30, 0xdc, 0xb4, 0xaa, 0xaa, 0x8b, 0x2b, 0x74, 0x1d, 0x97, 0x88, 0x8d, 0xf3, 0x53,
-		0x84, 0xd7, 0xcd, 0x1b, 0xd3, 0x88, 0x75, 0x2b, 0x40, 0x2c, 0xd3, 0xe8, 0x0f, 0x0c, 0x42, 0x08,
-		0x02, 0x7d, 0xfa, 0xaa, 0x04, 0xc3, 0x8b, 0x81, 0x9b, 0x5e, 0x79, 0x18, 0x6a, 0x98, 0x86, 0xbe,
-		0xcf, 0xed, 0x31, 0xa3, 0x88, 0x22, 0x2a, 0x40, 0x9a, 0x3d, 0xba, 0x73, 0x0f, 0xc4, 0x56, 0xa8,
-		0x28, 0x13, 0xaa, 0xab, 0x78, 0xc7, 0xd1, 0xc5, 0x68, 0x28, 0xa2, 0x88, 0x2e, 0x40, 0xce, 0xc1,
-		0x5a, 0xd3, 0xd6, 0xdd, 0x83, 0x8a, 0x66, 0x1a, 0xae, 0xaa, 0xb9, 0xec, 0xf9, 0x56, 0xe9, 0x8e,
-		0x9b, 0x37, 0xa6, 0x8f, 0x33, 0x59, 0xa3, 0x18, 0xb2, 0x32, 0x26, 0x40, 0x0b, 0x0c, 0x42, 0x5a,
-		0xa8, 0x62, 0x57, 0xd5, 0xeb, 0x4e, 0x9e, 0x1d, 0x0c, 0x89, 0x62, 0xa0, 0x2f, 0x9f, 0x1b, 0x0a,
-		0x6e, 0x6c, 0x5d, 0x80, 0x9c, 0x69, 0x61, 0x3b, 0x94, 0x88, 0x4a, 0xd1, 0x96, 0xa3, 0x18, 0xb2,
-		0x32, 0x26, 0x40, 0x22, 0x49, 0x75, 0xc9, 0x30, 0x8b, 0x85, 0xa2, 0xd5, 0xdc, 0xf1, 0xf7, 0xc3,
-		0x26, 0x5b, 0x46, 0x63, 0xde, 0x38, 0x28, 0x3d, 0xe6, 0x73, 0x8f, 0xd2, 0xc9, 0x5f, 0xfb, 0xc2,
-		0xc3, 0x93, 0xdc, 0x34, 0xfc, 0xfd, 0xa9, 0x4b, 0xf8, 0x80, 0x0c, 0x3f, 0x47, 0xdd, 0xa0, 0x98,
-		0x24, 0xed, 0x7c, 0x51, 0xd5, 0xeb, 0xe2, 0x19, 0xb2, 0xc2, 0x4b, 0xa8, 0x08, 0x83, 0x8e, 0xab,
-		0xba, 0x4d, 0x87, 0x7f, 0x1c, 0x4c, 0xee, 0x64, 0x6a, 0x25, 0xd3, 0xa8, 0x6e, 0x52, 0x4c, 0x85,
-		0x53, 0xa0, 0x0b, 0x30, 0xe8, 0x9a, 0xfb, 0xd8, 0xe0, 0x2a, 0xec, 0x6b, 0x7e, 0xd3, 0x73, 0x2a,
-		0x46, 0x4d, 0x34, 0x52, 0xc5, 0x75, 0x5c, 0x63, 0x69, 0xd5, 0x9e, 0x4a, 0x56, 0x1f, 0xf4, 0x1b,
-		0x61, 0xa5, 0xe5, 0xbe, 0x27, 0x21, 0xd7, 0x54, 0x94, 0x9f, 0xac, 0x8c, 0x79, 0xa0, 0x4d, 0x0a,
-		0x41, 0x97, 0x42, 0x57, 0x12, 0xf9, 0x87, 0xf4, 0xee, 0xee, 0xd4, 0xfd, 0x80, 0x4d, 0x8b, 0xfd,
-		0x89, 0xe0, 0x85, 0xc6, 0x0b, 0x90, 0x6b, 0x1a, 0x3b, 0xa6, 0x41, 0xdf, 0x0a, 0xf2, 0xfc, 0x9e,
-		0xac, 0xef, 0x92, 0x41, 0xe3, 0x88, 0x62, 0xc8, 0xca, 0x98, 0x07, 0xba, 0xc8, 0x56, 0x01, 0x55,
-		0x18, 0xf5, 0xb1, 0xe8, 0x44, 0xcd, 0xc4, 0x4e, 0xd4, 0xbb, 0xf8, 0x44, 0x3d, 0x1a, 0x6d, 0xc5,
-		0x9f, 0xab, 0x23, 0x1e, 0x90, 0x90, 0xa1, 0x8b, 0x00, 0xbe, 0x7b, 0xa0, 0xfb, 0x14, 0xc3, 0x9d,
-		0x07, 0xde, 0xf7, 0x31, 0x62, 0xbd, 0xe7, 0xd3, 0xa2, 0x77, 0xc2, 0x44, 0x43, 0x37, 0x2a, 0x0e,
-		0xae, 0xef, 0x56, 0xb8, 0x82, 0x09, 0x4b, 0xfa, 0xa9, 0x97, 0xd2, 0x4a, 0x7f, 0xf6, 0x70, 0xf3,
-		0xc6, 0x74, 0x81, 0xbb, 0xd0, 0x56, 0x96, 0xb2, 0x32, 0xde, 0xd0, 0x8d, 0x4d, 0x5c, 0xdf, 0x5d,
-		0xf4, 0x60, 0xc5, 0xec, 0xfb, 0x3f, 0x39, 0x7d, 0x84, 0x4f, 0xd7, 0x23, 0xf2, 0x39, 0xba, 0x77,
-		0xce, 0xa7, 0x19, 0x76, 0xc8, 0x9a, 0x44, 0x15, 0x05, 0xba, 0xa3, 0x91, 0x51, 0x7c, 0x00, 0x9b,
-		0xe6, 0xaf, 0xfc, 0x87, 0x19, 0x49, 0xfe, 0x9c, 0x04, 0x83, 0x8b, 0x97, 0x37, 0x54, 0xdd, 0x46,
-		0xcb, 0x30, 0xee, 0x5b, 0x4e, 0x78, 0x92, 0x9f, 0xbc, 0x79, 0x63, 0x3a, 0x1f, 0x35, 0x2e, 0x6f,
-		0x96, 0xfb, 0x06, 0x2c, 0xa6, 0xf9, 0x72, 0xa7, 0x85, 0x6b, 0x88, 0x55, 0x0b, 0x8a, 0xdc, 0xba,
-		0xac, 0x8d, 0x74, 0xb3, 0x0c, 0x43, 0x4c, 0x5a, 0x07, 0x15, 0x61, 0xc0, 0x22, 0x3f, 0xf8, 0xc1,
-		0xc0, 0x54, 0x47, 0xe3, 0xa5, 0xf8, 0xde, 0x46, 0x26, 0x21, 0x91, 0x3f, 0x9c, 0x00, 0x58, 0xbc,
-		0x7c, 0x79, 0xcb, 0xd6, 0xad, 0x3a, 0x76, 0x6f, 0x65, 0xcf, 0xb7, 0xe0, 0x68, 0x60, 0x95, 0x64,
-		0x6b, 0x91, 0xde, 0xcf, 0xdc, 0xbc, 0x31, 0x7d, 0x32, 0xda, 0xfb, 0x00, 0x9a, 0xac, 0x4c, 0xf8,
-		0xeb, 0x25, 0x5b, 0x6b, 0xcb, 0xb5, 0xea, 0xb8, 0x1e, 0xd7, 0x64, 0x67, 0xae, 0x01, 0xb4, 0x20,
-		0xd7, 0x45, 0xc7, 0x6d, 0xaf, 0xda, 0x4d, 0x18, 0xf6, 0x55, 0xe2, 0xa0, 0x45, 0x48, 0xbb, 0xfc,
-		0x37, 0xd7, 0xb0, 0xdc, 0x59, 0xc3, 0x82, 0x8c, 0x6b, 0xd9, 0xa3, 0x94, 0xff, 0x4c, 0x02, 0xf0,
-		0x6d, 0xf6, 0xc7, 0xd3, 0xc4, 0x88, 0x2b, 0xe7, 0x8e, 0x37, 0x79, 0xa8, 0x54, 0x8d, 0x53, 0x47,
-		0xf4, 0xf9, 0xd3, 0x09, 0x98, 0xd8, 0x16, 0x9e, 0xe7, 0xc7, 0x5e, 0x07, 0x1b, 0x30, 0x84, 0x0d,
-		0xd7, 0xd6, 0xa9, 0x12, 0xc8, 0x68, 0x3f, 0xd2, 0x69, 0xb4, 0xdb, 0xf4, 0x89, 0x7e, 0xec, 0x46,
-		0x6c, 0xba, 0x73, 0x36, 0x11, 0x6d, 0x7c, 0x30, 0x09, 0xf9, 0x4e, 0x94, 0x68, 0x01, 0xc6, 0x34,
-		0x1b, 0x53, 0x40, 0x25, 0xb8, 0xf3, 0x57, 0x2a, 0xf8, 0x99, 0x65, 0x04, 0x41, 0x56, 0x46, 0x05,
-		0x84, 0x47, 0x8f, 0x1a, 0x90, 0xb4, 0x8f, 0x98, 0x1d, 0xc1, 0xea, 0x31, 0xcf, 0x93, 0x79, 0xf8,
-		0x10, 0x8d, 0x84, 0x19, 0xb0, 0xf8, 0x31, 0xea, 0x43, 0x69, 0x00, 0x79, 0x09, 0xc6, 0x74, 0x43,
-		0x77, 0x75, 0xb5, 0x5e, 0xd9, 0x51, 0xeb, 0xaa, 0xa1, 0x1d, 0x26, 0x6b, 0x66, 0x2e, 0x9f, 0x37,
-		0x1b, 0x61, 0x27, 0x2b, 0xa3, 0x1c, 0x52, 0x62, 0x00, 0x74, 0x11, 0x86, 0x44, 0x53, 0xa9, 0x43,
-		0x65, 0x1b, 0x82, 0x3c, 0x90, 0xe0, 0xfd, 0x4c, 0x12, 0xc6, 0x15, 0x5c, 0xfd, 0xff, 0x43, 0xd1,
-		0xdf, 0x50, 0xac, 0x02, 0xb0, 0xe9, 0x4e, 0x1c, 0xec, 0x21, 0x46, 0x83, 0x38, 0x8c, 0x0c, 0xe3,
-		0xb0, 0xe8, 0xb8, 0x81, 0xf1, 0xb8, 0x91, 0x80, 0x6c, 0x70, 0x3c, 0xfe, 0x82, 0x46, 0x25, 0xb4,
-		0xec, 0x7b, 0xa2, 0x14, 0xff, 0x44, 0x68, 0x07, 0x4f, 0xd4, 0x62, 0xbd, 0xdd, 0x5d, 0xd0, 0xff,
-		0x48, 0xc0, 0xe0, 0x86, 0x6a, 0xab, 0x0d, 0x07, 0x69, 0x2d, 0x99, 0xa6, 0xd8, 0x7e, 0x6c, 0xf9,
-		0x10, 0x34, 0xdf, 0xed, 0x88, 0x49, 0x34, 0x3f, 0xda, 0x26, 0xd1, 0xfc, 0x09, 0x18, 0x25, 0xcb,
-		0xe1, 0xc0, 0x15, 0x06, 0xa2, 0xed, 0x91, 0xd2, 0x09, 0x9f, 0x4b, 0xb8, 0x9e, 0xad, 0x96, 0x2f,
-		0x07, 0xef, 0x30, 0x0c, 0x13, 0x0c, 0xdf, 0x31, 0x13, 0xf2, 0x63, 0xfe, 0xb2, 0x34, 0x50, 0x29,
-		0x2b, 0xd0, 0x50, 0xaf, 0x95, 0x59, 0x01, 0xad, 0x00, 0xda, 0xf3, 0x76, 0x46, 0x2a, 0xbe, 0x3a,
-		0x09, 0xfd, 0x9d, 0x37, 0x6f, 0x4c, 0x9f, 0x60, 0xf4, 0xad, 0x38, 0xb2, 0x32, 0xee, 0x03, 0x05,
-		0xb7, 0xc7, 0x01, 0x48, 0xbf, 0x2a, 0xec, 0xfa, 0x1c, 0x5b, 0xee, 0x1c, 0xbd, 0x79, 0x63, 0x7a,
-		0x9c, 0x71, 0xf1, 0xeb, 0x64, 0x25, 0x43, 0x0a, 0x8b, 0xe4, 0x77, 0xc0, 0xb2, 0x3f, 0x2d, 0x01,
-		0xf2, 0x5d, 0xbe, 0x82, 0x1d, 0x8b, 0xac, 0xcf, 0x48, 0x22, 0x1e, 0xc8, 0x9a, 0xa5, 0xee, 0x89,
-		0xb8, 0x4f, 0x2f, 0x12, 0xf1, 0xc0, 0x4c, 0x79, 0xca, 0x77, 0x8f, 0x09, 0x3e, 0x8e, 0x6d, 0xee,
-		0x1a, 0xce, 0x2e, 0x98, 0xba, 0xa0, 0x6e, 0xf1, 0x87, 0x47, 0xe4, 0x7f, 0x25, 0xc1, 0x89, 0x16,
-		0x8b, 0xf2, 0x84, 0xfd, 0x4b, 0x80, 0xec, 0x40, 0x25, 0xff, 0xde, 0x1b, 0x13, 0xba, 0x6f, 0x03,
-		0x1d, 0xb7, 0x5b, 0xfc, 0xee, 0xad, 0xf3, 0xf0, 0xec, 0xb2, 0xe2, 0x3f, 0x95, 0x60, 0x32, 0xd8,
-		0xbc, 0xd7, 0x91, 0x35, 0xc8, 0x06, 0x5b, 0xe7, 0x5d, 0xb8, 0xa7, 0x97, 0x2e, 0x70, 0xe9, 0x43,
-		0xf4, 0xe8, 0x39, 0x7f, 0xba, 0xb2, 0xbd, 0xb3, 0x47, 0x7b, 0xd6, 0x86, 0x90, 0x29, 0x3a, 0x6d,
-		0x53, 0x74, 0x3c, 0xfe, 0x8f, 0x04, 0xa9, 0x0d, 0xd3, 0xac, 0x23, 0x13, 0xc6, 0x0d, 0xd3, 0xad,
-		0x10, 0xcb, 0xc2, 0xd5, 0x0a, 0x5f, 0x74, 0x33, 0x3f, 0xb8, 0xd0, 0x9f, 0x92, 0xbe, 0x7d, 0x63,
-		0xba, 0x95, 0x95, 0x32, 0x66, 0x98, 0x6e, 0x89, 0x42, 0xb6, 0xd8, 0x92, 0xfc, 0x9d, 0x30, 0x12,
-		0x6e, 0x8c, 0x79, 0xc9, 0xe7, 0xfb, 0x6e, 0x2c, 0xcc, 0xe6, 0xe6, 0x8d, 0xe9, 0x49, 0x7f, 0xc6,
-		0x78, 0x60, 0x59, 0xc9, 0xee, 0x04, 0x5a, 0x67, 0xd7, 0xbb, 0xbe, 0xfb, 0xc9, 0x69, 0xe9, 0xf4,
-		0x17, 0x25, 0x00, 0x7f, 0xe7, 0x01, 0x3d, 0x04, 0xc7, 0x4b, 0xeb, 0x6b, 0x8b, 0x95, 0xcd, 0xad,
-		0xf9, 0xad, 0xed, 0xcd, 0xca, 0xf6, 0xda, 0xe6, 0x46, 0x79, 0x61, 0xf9, 0xc2, 0x72, 0x79, 0xd1,
-		0xdf, 0x1e, 0x77, 0x2c, 0xac, 0xe9, 0xbb, 0x3a, 0xae, 0xa2, 0xfb, 0x60, 0x32, 0x8c, 0x4d, 0x4a,
-		0xe5, 0xc5, 0x9c, 0x54, 0xc8, 0xbe, 0x7a, 0x7d, 0x26, 0xcd, 0x72, 0x31, 0x5c, 0x45, 0xa7, 0xe0,
-		0x68, 0x2b, 0xde, 0xf2, 0xda, 0x52, 0x2e, 0x51, 0x18, 0x79, 0xf5, 0xfa, 0x4c, 0xc6, 0x4b, 0xda,
-		0x90, 0x0c, 0x28, 0x88, 0xc9, 0xf9, 0x25, 0x0b, 0xf0, 0xea, 0xf5, 0x99, 0x41, 0xa6, 0xc0, 0x42,
-		0xea, 0xfd, 0x9f, 0x9e, 0x3a, 0x52, 0xba, 0xd0, 0x71, 0x03, 0xfc, 0xa1, 0xae, 0xba, 0xbb, 0xe6,
-		0x6d, 0x6a, 0x87, 0x77, 0xbd, 0xff, 0x78, 0xa8, 0xe3, 0xae, 0x77, 0x0d, 0x1b, 0xd8, 0xd1, 0x9d,
-		0x43, 0xed, 0x7a, 0xf7, 0xb4, 0x93, 0x2e, 0xff, 0xce, 0x00, 0x64, 0x97, 0x58, 0x2b, 0x64, 0x20,
-		0x30, 0x7a, 0x13, 0x0c, 0x5a, 0x34, 0x8c, 0x78, 0xc7, 0x68, 0x1d, 0x0c, 0x9e, 0x05, 0x1b, 0xef,
-		0x2e, 0x17, 0x0b, 0x3d, 0x0e, 0xbf, 0xcc, 0xc1, 0xee, 0x98, 0xf9, 0xb7, 0xa6, 0xb2, 0x7d, 0xed,
-		0xf7, 0xb0, 0x9c, 0x85, 0x6f, 0xad, 0x44, 0xf9, 0xc9, 0xec, 0x5e, 0xc8, 0x16, 0x81, 0xb0, 0xdb,
-		0x61, 0xef, 0x95, 0xe0, 0x28, 0xc5, 0xf2, 0x03, 0x31, 0xc5, 0x14, 0xc9, 0xfe, 0xe9, 0x4e, 0x5d,
-		0x58, 0x51, 0x1d, 0xff, 0xae, 0x07, 0xbb, 0xcf, 0x75, 0x0f, 0x0f, 0x84, 0x27, 0x03, 0x8d, 0x47,
-		0xd9, 0xca, 0xca, 0x44, 0xbd, 0x85, 0xd2, 0x41, 0x4b, 0xa1, 0x0b, 0x7d, 0xa9, 0xfe, 0xb6, 0xda,
-		0x83, 0x97, 0xfb, 0x9e, 0x85, 0x61, 0xdf, 0x97, 0x38, 0xfc, 0xff, 0x53, 0xf4, 0x1e, 0x3b, 0x82,
-		0xc4, 0xe8, 0x7d, 0x12, 0x1c, 0xf5, 0xa3, 0x79, 0x90, 0x2d, 0xfb, 0x3f, 0x1e, 0x0f, 0xf6, 0xb1,
-		0x10, 0x8a, 0x2a, 0xa7, 0x2d, 0x5f, 0x59, 0x99, 0x6c, 0xb6, 0x92, 0x92, 0x25, 0xd8, 0x48, 0xd0,
-		0xb3, 0x3a, 0x79, 0xf1, 0xa9, 0xba, 0xde, 0x5d, 0x73, 0x98, 0x01, 0xfb, 0xdf, 0x02, 0x96, 0x69,
-		0xbb, 0xb8, 0x4a, 0x37, 0xe4, 0xd2, 0x8a, 0x57, 0x96, 0xd7, 0x00, 0xb5, 0x0e, 0x6e, 0xf4, 0x02,
-		0x63, 0xc6, 0xbf, 0xc0, 0x38, 0x09, 0x03, 0xc1, 0x2b, 0x7e, 0xac, 0x50, 0x4c, 0xbf, 0x9f, 0x87,
-		0xcf, 0x5b, 0x3e, 0xe7, 0xff, 0x45, 0x02, 0x4e, 0x07, 0x8f, 0x87, 0x5e, 0x6a, 0x62, 0xfb, 0xc0,
-		0x9b, 0xa2, 0x96, 0x5a, 0xd3, 0x8d, 0xe0, 0x1b, 0xa0, 0x13, 0xc1, 0x80, 0x4f, 0x71, 0x85, 0x9e,
-		0x64, 0x03, 0x86, 0x37, 0xd4, 0x1a, 0x56, 0xf0, 0x4b, 0x4d, 0xec, 0xb8, 0x6d, 0x2e, 0x99, 0x1f,
-		0x83, 0x41, 0x73, 0x77, 0x57, 0x1c, 0x69, 0xa7, 0x14, 0x5e, 0x22, 0x5d, 0xae, 0xeb, 0x0d, 0x9d,
-		0xdd, 0x06, 0x4b, 0x29, 0xac, 0x80, 0xa6, 0x61, 0x58, 0x33, 0x9b, 0x06, 0x9f, 0x71, 0xf9, 0x94,
-		0xf8, 0x00, 0x44, 0xd3, 0x60, 0x33, 0x4e, 0x7e, 0x06, 0xb2, 0xac, 0x3d, 0x1e, 0x71, 0x4f, 0x40,
-		0x9a, 0x5e, 0xa7, 0xf2, 0x5b, 0x1d, 0x22, 0xe5, 0x4b, 0xec, 0x42, 0x3a, 0xe3, 0xc2, 0x1a, 0x66,
-		0x85, 0x52, 0xa9, 0xa3, 0x2a, 0x4f, 0xc5, 0xbb, 0x06, 0xa6, 0x28, 0x4f, 0x8d, 0xbf, 0x39, 0x00,
-		0x47, 0xf9, 0x09, 0x9d, 0x6a, 0xe9, 0x73, 0x7b, 0xae, 0x2b, 0x5e, 0x09, 0x01, 0x4f, 0x75, 0x55,
-		0x4b, 0x97, 0x0f, 0x20, 0x75, 0xd1, 0x75, 0x2d, 0x74, 0x1a, 0x06, 0xec, 0x66, 0x1d, 0x8b, 0x1d,
-		0x1f, 0x6f, 0x4f, 0x5e, 0xb5, 0xf4, 0x59, 0x82, 0xa0, 0x34, 0xeb, 0x58, 0x61, 0x28, 0xa8, 0x0c,
-		0xd3, 0xbb, 0xcd, 0x7a, 0xfd, 0xa0, 0x52, 0xc5, 0xf4, 0x7f, 0xf7, 0x78, 0x5f, 0xbf, 0xc7, 0xd7,
-		0x2c, 0x55, 0x7c, 0x43, 0x8f, 0xe8, 0xe6, 0x24, 0x45, 0x5b, 0xa4, 0x58, 0xe2, 0xcb, 0xf7, 0x65,
-		0x81, 0x23, 0xff, 0x5e, 0x02, 0xd2, 0x82, 0x35, 0xbd, 0x21, 0x8e, 0xeb, 0x58, 0x73, 0x4d, 0x71,
-		0x62, 0xe2, 0x95, 0x11, 0x82, 0x64, 0x8d, 0x0f, 0x51, 0xe6, 0xe2, 0x11, 0x85, 0x14, 0x08, 0xcc,
-		0xbb, 0xb7, 0x4f, 0x60, 0x56, 0x93, 0x8c, 0x5a, 0xca, 0x32, 0xc5, 0xd2, 0xec, 0xe2, 0x11, 0x85,
-		0x96, 0x50, 0x1e, 0x06, 0xc9, 0xcc, 0x70, 0xd9, 0x87, 0x09, 0x09, 0x9c, 0x97, 0xd1, 0x31, 0x18,
-		0xb0, 0x54, 0x57, 0x63, 0x57, 0xea, 0x48, 0x05, 0x2b, 0xa2, 0x27, 0x60, 0x90, 0x3d, 0x08, 0x8d,
-		0xfe, 0x63, 0x0c, 0xa2, 0x0c, 0xf6, 0xe5, 0x2d, 0x22, 0xf7, 0x86, 0xea, 0xba, 0xd8, 0x36, 0x08,
-		0x43, 0x86, 0x8e, 0x10, 0xa4, 0x76, 0xcc, 0xea, 0x01, 0xff, 0x67, 0x1d, 0xf4, 0x37, 0xff, 0xef,
-		0x00, 0xd4, 0x1e, 0x2a, 0xb4, 0x92, 0xfd, 0x8f, 0xa2, 0xac, 0x00, 0x96, 0x08, 0x52, 0x19, 0x26,
-		0xd4, 0x6a, 0x55, 0x67, 0xff, 0x37, 0xa3, 0xb2, 0xa3, 0x53, 0x0f, 0xe1, 0xd0, 0xff, 0x40, 0xd5,
-		0x69, 0x2c, 0x90, 0x4f, 0x50, 0xe2, 0xf8, 0xa5, 0x0c, 0x0c, 0x59, 0x4c, 0x28, 0xf9, 0x3c, 0x8c,
-		0xb7, 0x48, 0x4a, 0xe4, 0xdb, 0xd7, 0x8d, 0xaa, 0x78, 0xcc, 0x40, 0x7e, 0x13, 0x18, 0xfd, 0x7a,
-		0x1e, 0x3b, 0x8b, 0xa2, 0xbf, 0x4b, 0xef, 0xee, 0xfc, 0xf0, 0x6b, 0x34, 0xf0, 0xf0, 0x4b, 0xb5,
-		0xf4, 0x52, 0x86, 0xf2, 0xe7, 0xcf, 0xbd, 0xe6, 0x79, 0x05, 0x7b, 0xea, 0x35, 0x6b, 0xda, 0x35,
-		0x12, 0xa5, 0x45, 0xf4, 0x25, 0x55, 0xaa, 0xa5, 0x3b, 0xd4, 0x1c, 0xfd, 0xaf, 0xf9, 0x39, 0xe7,
-		0x03, 0xbf, 0xe9, 0x23, 0xb0, 0xd4, 0xd2, 0xfc, 0xc6, 0xb2, 0x67, 0xc7, 0x5f, 0x4e, 0xc0, 0xc9,
-		0x80, 0x1d, 0x07, 0x90, 0x5b, 0xcd, 0xb9, 0xd0, 0xde, 0xe2, 0x7b, 0x78, 0xfc, 0x75, 0x09, 0x52,
-		0x04, 0x1f, 0xc5, 0x7c, 0xbb, 0x3f, 0xff, 0xab, 0x5f, 0xfb, 0x27, 0x72, 0xf8, 0xd4, 0x2a, 0x34,
-		0x2a, 0x94, 0x49, 0xe9, 0x7d, 0xbd, 0xeb, 0x2f, 0xe7, 0x7f, 0xc8, 0xd0, 0xb9, 0x75, 0x6a, 0x8c,
-		0xea, 0xf0, 0x5b, 0x67, 0x41, 0xee, 0x90, 0xf2, 0x30, 0x8f, 0xd9, 0x3d, 0x89, 0xea, 0xc3, 0x1d,
-		0x77, 0xba, 0xff, 0xdf, 0x6d, 0x04, 0x7b, 0x4c, 0xc7, 0xae, 0xc1, 0xb1, 0xe7, 0x48, 0xdb, 0xfe,
-		0x32, 0x59, 0x38, 0xf6, 0x63, 0xde, 0x69, 0x9e, 0xc4, 0xff, 0x01, 0x98, 0x38, 0xa9, 0x03, 0x5f,
-		0x3e, 0xbe, 0x40, 0xbc, 0x6f, 0xb6, 0x63, 0xbc, 0x98, 0x0d, 0x04, 0x0b, 0x25, 0x40, 0x29, 0xff,
-		0xb2, 0x04, 0xc7, 0x5b, 0x9a, 0xe6, 0x3e, 0x7e, 0xa9, 0xcd, 0x53, 0x85, 0x43, 0x65, 0x36, 0x4b,
-		0x6d, 0x84, 0xbd, 0x3f, 0x56, 0x58, 0x26, 0x45, 0x48, 0xda, 0xa7, 0xe1, 0x68, 0x58, 0x58, 0xa1,
-		0xa6, 0x7b, 0x61, 0x34, 0xbc, 0x23, 0xcc, 0xd5, 0x35, 0x12, 0xda, 0x13, 0x96, 0x2b, 0x51, 0x3d,
-		0x7b, 0x7d, 0x2d, 0x43, 0xc6, 0x43, 0xe5, 0x29, 0x70, 0xcf, 0x5d, 0xf5, 0x29, 0xe5, 0x0f, 0x4b,
-		0x30, 0x13, 0x6e, 0x21, 0x90, 0x0c, 0xf5, 0x27, 0xec, 0x2d, 0x1b, 0xe2, 0x37, 0x24, 0xb8, 0xab,
-		0x8b, 0x4c, 0x5c, 0x01, 0x2f, 0xc3, 0x64, 0x60, 0x27, 0x40, 0xb8, 0x70, 0x31, 0xec, 0xa7, 0xe3,
-		0xd3, 0x50, 0x6f, 0xe1, 0x7b, 0x07, 0x51, 0xca, 0x67, 0x7f, 0x7f, 0x7a, 0xa2, 0xb5, 0xce, 0x51,
-		0x26, 0x5a, 0x57, 0xef, 0xb7, 0xd0, 0x3e, 0x5e, 0x93, 0xe0, 0x81, 0x70, 0x57, 0xdb, 0xe4, 0xb3,
-		0x3f, 0xaa, 0x71, 0xf8, 0xf7, 0x12, 0x9c, 0xee, 0x45, 0x38, 0x3e, 0x20, 0x3b, 0x30, 0xe1, 0x67,
-		0xda, 0xd1, 0xf1, 0xe8, 0x2b, 0x7f, 0x67, 0x56, 0x8a, 0x3c, 0x6e, 0xb7, 0x41, 0xf1, 0x16, 0x9f,
-		0x58, 0xc1, 0x21, 0xf7, 0x94, 0x1c, 0xde, 0xcd, 0x15, 0x4a, 0x0e, 0xed, 0xe7, 0xb6, 0x19, 0x8b,
-		0x44, 0x9b, 0xb1, 0xf0, 0x53, 0x73, 0xf9, 0x0a, 0xf7, 0x5b, 0x6d, 0xf6, 0xe0, 0xde, 0x06, 0x13,
-		0x6d, 0x4c, 0x99, 0xcf, 0xea, 0x3e, 0x2c, 0x59, 0x41, 0xad, 0xc6, 0x2a, 0x1f, 0xc0, 0x34, 0x6d,
-		0xb7, 0x8d, 0xa2, 0x6f, 0x77, 0x97, 0x1b, 0xdc, 0xb7, 0xb4, 0x6d, 0x9a, 0xf7, 0x7d, 0x19, 0x06,
-		0xd9, 0x38, 0xf3, 0xee, 0x1e, 0xc2, 0x50, 0x38, 0x03, 0xf9, 0xe7, 0x85, 0x2f, 0x5b, 0x14, 0x62,
-		0xb7, 0x9f, 0x43, 0xbd, 0xf4, 0xf5, 0x16, 0xcd, 0xa1, 0x80, 0x32, 0xbe, 0x21, 0xbc, 0x5a, 0x7b,
-		0xe9, 0xb8, 0x3a, 0xb4, 0x5b, 0xe6, 0xd5, 0x98, 0x6e, 0x6e, 0xaf, 0xfb, 0xfa, 0x45, 0xe1, 0xbe,
-		0xbc, 0x3e, 0xc5, 0xb8, 0xaf, 0x1f, 0x8d, 0xea, 0x3d, 0x47, 0x16, 0x23, 0xe6, 0x9f, 0x47, 0x47,
-		0xf6, 0x5d, 0x09, 0x4e, 0xd0, 0xbe, 0x05, 0x37, 0x22, 0xfa, 0x55, 0xf9, 0x43, 0x80, 0x1c, 0x5b,
-		0xab, 0xb4, 0x9d, 0xdd, 0x39, 0xc7, 0xd6, 0x2e, 0x87, 0xe2, 0xcb, 0x43, 0x80, 0xaa, 0xa1, 0xed,
-		0x26, 0x8a, 0xcd, 0x6e, 0xc9, 0xe5, 0xaa, 0x81, 0xdd, 0x8c, 0x36, 0xc3, 0x99, 0xba, 0x05, 0xc3,
-		0xf9, 0x75, 0x09, 0x0a, 0xed, 0xba, 0xcc, 0x87, 0x4f, 0x87, 0x63, 0xa1, 0x43, 0x82, 0xe8, 0x08,
-		0x3e, 0xd4, 0xcb, 0x56, 0x4e, 0x64, 0x1a, 0x1d, 0xb5, 0xf1, 0xed, 0xce, 0x03, 0xa6, 0xc3, 0x16,
-		0xda, 0x9a, 0x59, 0xff, 0xc8, 0xa6, 0xcf, 0x17, 0x5a, 0xfc, 0xea, 0x9f, 0x8b, 0xdc, 0xfb, 0x1a,
-		0x4c, 0x75, 0x90, 0xfa, 0x76, 0xc7, 0xbd, 0xbd, 0x8e, 0x83, 0x79, 0xab, 0xd3, 0xf7, 0xc7, 0xf9,
-		0x4c, 0x08, 0xdf, 0xc0, 0x0e, 0xac, 0xc5, 0xda, 0x3d, 0xe1, 0x92, 0xdf, 0x02, 0x77, 0xb4, 0xa5,
-		0xe2, 0xb2, 0x15, 0x21, 0xb5, 0xa7, 0x3b, 0x2e, 0x17, 0xeb, 0xbe, 0x4e, 0x62, 0x45, 0xa8, 0x29,
-		0x8d, 0x8c, 0x20, 0x47, 0x59, 0x6f, 0x98, 0x66, 0x9d, 0x8b, 0x21, 0x5f, 0x82, 0xf1, 0x00, 0x8c,
-		0x37, 0x72, 0x0e, 0x52, 0x96, 0xc9, 0x3f, 0x4f, 0x30, 0x7c, 0xe6, 0x64, 0xc7, 0xdd, 0x7b, 0xd3,
-		0xac, 0xf3, 0x6e, 0x53, 0x7c, 0x79, 0x12, 0x10, 0x63, 0x46, 0x37, 0xf2, 0x45, 0x13, 0x9b, 0x30,
-		0x11, 0x82, 0xf2, 0x46, 0x7e, 0xa0, 0x43, 0x82, 0x33, 0xdf, 0x3e, 0x0a, 0x03, 0x94, 0x2b, 0xfa,
-		0x98, 0x04, 0x10, 0x38, 0x11, 0x9e, 0xed, 0xc4, 0xa6, 0xfd, 0x9a, 0xb8, 0x30, 0xd7, 0x33, 0x3e,
-		0xcf, 0xd9, 0x4e, 0xbf, 0xfb, 0xdf, 0x7c, 0xeb, 0x23, 0x89, 0x7b, 0x90, 0x3c, 0xd7, 0x61, 0x35,
-		0x1e, 0x98, 0x2f, 0x9f, 0x09, 0xbd, 0x7d, 0x7f, 0xb8, 0xb7, 0xa6, 0x84, 0x64, 0xb3, 0xbd, 0xa2,
-		0x73, 0xc1, 0xce, 0x53, 0xc1, 0xce, 0xa2, 0xc7, 0xe2, 0x05, 0x9b, 0x7b, 0x47, 0x78, 0xd2, 0xbc,
-		0x0b, 0xfd, 0x8e, 0x04, 0x93, 0xed, 0x96, 0x74, 0xe8, 0xc9, 0xde, 0xa4, 0x68, 0x4d, 0x29, 0x0a,
-		0x4f, 0x1d, 0x82, 0x92, 0x77, 0x65, 0x89, 0x76, 0x65, 0x1e, 0x3d, 0x73, 0x88, 0xae, 0xcc, 0x05,
-		0xf7, 0xf7, 0xff, 0x97, 0x04, 0x77, 0x76, 0x5d, 0x21, 0xa1, 0xf9, 0xde, 0xa4, 0xec, 0x92, 0x3b,
-		0x15, 0x4a, 0x3f, 0x08, 0x0b, 0xde, 0xe3, 0xe7, 0x68, 0x8f, 0x2f, 0xa1, 0xe5, 0xc3, 0xf4, 0xb8,
-		0xed, 0x21, 0x0a, 0xfa, 0xad, 0xf0, 0xcd, 0xc2, 0xee, 0xe6, 0xd4, 0xb2, 0xf0, 0x88, 0x99, 0x18,
-		0xad, 0x49, 0xad, 0xfc, 0x02, 0xed, 0x82, 0x82, 0x36, 0x7e, 0xc0, 0x41, 0x9b, 0x7b, 0x47, 0xd8,
-		0xf1, 0xbf, 0x0b, 0xfd, 0x4f, 0xa9, 0xfd, 0x45, 0xc1, 0x27, 0xba, 0x8a, 0xd8, 0x79, 0x51, 0x55,
-		0x78, 0xb2, 0x7f, 0x42, 0xde, 0xc9, 0x06, 0xed, 0x64, 0x0d, 0xe1, 0x5b, 0xdd, 0xc9, 0xb6, 0x83,
-		0x88, 0xbe, 0x2a, 0xc1, 0x64, 0xbb, 0x35, 0x49, 0xcc, 0xb4, 0xec, 0xb2, 0xc8, 0x8a, 0x99, 0x96,
-		0xdd, 0x16, 0x40, 0xf2, 0x9b, 0x68, 0xe7, 0xcf, 0xa1, 0xc7, 0x3b, 0x75, 0xbe, 0xeb, 0x28, 0x92,
-		0xb9, 0xd8, 0x35, 0xc9, 0x8f, 0x99, 0x8b, 0xbd, 0xac, 0x63, 0x62, 0xe6, 0x62, 0x4f, 0x6b, 0x8c,
-		0xf8, 0xb9, 0xe8, 0xf5, 0xac, 0xc7, 0x61, 0x74, 0xd0, 0x97, 0x25, 0x18, 0x09, 0x65, 0xc4, 0xe8,
-		0xd1, 0xae, 0x82, 0xb6, 0x5b, 0x30, 0x14, 0xce, 0xf4, 0x43, 0xc2, 0xfb, 0xb2, 0x4c, 0xfb, 0xb2,
-		0x80, 0xe6, 0x0f, 0xd3, 0x97, 0xf0, 0x59, 0xe9, 0xd7, 0x25, 0x98, 0x68, 0x93, 0x65, 0xc6, 0xcc,
-		0xc2, 0xce, 0x49, 0x73, 0xe1, 0xc9, 0xfe, 0x09, 0x79, 0xaf, 0x2e, 0xd0, 0x5e, 0xfd, 0x04, 0x7a,
-		0xfa, 0x30, 0xbd, 0x0a, 0xc4, 0xe7, 0x1b, 0xfe, 0xbd, 0xab, 0x40, 0x3b, 0xe8, 0x5c, 0x9f, 0x82,
-		0x89, 0x0e, 0x3d, 0xd1, 0x37, 0x1d, 0xef, 0xcf, 0xf3, 0xb4, 0x3f, 0xcf, 0xa1, 0xf5, 0x1f, 0xac,
-		0x3f, 0xad, 0x61, 0xfd, 0xf3, 0xad, 0x2f, 0x00, 0xbb, 0x5b, 0x51, 0xdb, 0x64, 0xb5, 0xf0, 0x58,
-		0x5f, 0x34, 0xbc, 0x53, 0x4f, 0xd2, 0x4e, 0x9d, 0x41, 0x8f, 0x74, 0xea, 0x54, 0xe0, 0x72, 0x9d,
-		0x6e, 0xec, 0x9a, 0x73, 0xef, 0x60, 0x29, 0xf0, 0xbb, 0xd0, 0x4f, 0x89, 0x8b, 0x4d, 0xa7, 0xba,
-		0xb6, 0x1b, 0xc8, 0x63, 0x0b, 0x0f, 0xf4, 0x80, 0xc9, 0xe5, 0xba, 0x87, 0xca, 0x35, 0x85, 0x4e,
-		0x76, 0x92, 0x8b, 0xe4, 0xb2, 0xe8, 0x03, 0x92, 0x77, 0x17, 0xf2, 0x74, 0x77, 0xde, 0xc1, 0x64,
-		0xb7, 0xf0, 0x60, 0x4f, 0xb8, 0x5c, 0x92, 0xfb, 0xa8, 0x24, 0x33, 0x68, 0xaa, 0xa3, 0x24, 0x2c,
-		0xf5, 0xbd, 0xd5, 0x37, 0x07, 0x5e, 0x3d, 0x0e, 0xd3, 0x1d, 0x5a, 0x74, 0xaf, 0xc5, 0x9c, 0x71,
-		0x75, 0x79, 0x08, 0x1b, 0xfb, 0xd0, 0xb5, 0xc3, 0xd3, 0xda, 0xc3, 0x3f, 0x7f, 0xed, 0xed, 0x40,
-		0xec, 0x5f, 0xa7, 0x00, 0xad, 0x3a, 0xb5, 0x05, 0x1b, 0xb3, 0x7f, 0x7a, 0xc7, 0x67, 0x79, 0xe4,
-		0x85, 0x97, 0xf4, 0x03, 0xbd, 0xf0, 0x5a, 0x0d, 0xbd, 0x99, 0x4a, 0xf4, 0xf7, 0x2e, 0xb3, 0xe7,
-		0x87, 0x53, 0xc9, 0x1f, 0xca, 0xc3, 0xa9, 0xf6, 0xf7, 0xaa, 0x53, 0xb7, 0xee, 0x01, 0xc6, 0xc0,
-		0x61, 0x1f, 0xa1, 0xf0, 0xf7, 0x90, 0x83, 0x5d, 0xde, 0x43, 0xe6, 0x3b, 0x3e, 0x7a, 0xe4, 0xd4,
-		0xe8, 0xac, 0xf8, 0x80, 0xef, 0x50, 0x6f, 0x37, 0x61, 0xf9, 0x17, 0x7e, 0xfd, 0x2d, 0x84, 0x93,
-		0x50, 0x68, 0x35, 0x27, 0x6f, 0x52, 0x7f, 0x24, 0x09, 0xb9, 0x55, 0xa7, 0x56, 0xae, 0xea, 0xee,
-		0x6d, 0xb2, 0xb5, 0x67, 0x3a, 0x3f, 0x6a, 0x41, 0x37, 0x6f, 0x4c, 0x8f, 0x32, 0x9d, 0x76, 0xd1,
-		0x64, 0x03, 0xc6, 0x22, 0x4f, 0x89, 0xb9, 0x65, 0x2d, 0x1e, 0xe6, 0x45, 0x73, 0x84, 0x95, 0x4c,
-		0xdf, 0x20, 0x04, 0xec, 0x1b, 0x5d, 0x6b, 0x6f, 0xcc, 0xcc, 0xa0, 0x2e, 0xde, 0xce, 0x17, 0x80,
-		0xfe, 0x98, 0x15, 0x20, 0x1f, 0x1d, 0x14, 0x6f, 0xc4, 0xfe, 0x50, 0x82, 0xe1, 0x55, 0x47, 0xa4,
-		0x82, 0xf8, 0xc7, 0xf4, 0xfd, 0xd1, 0x13, 0xde, 0x77, 0x58, 0x93, 0xbd, 0xd9, 0xad, 0xf8, 0x36,
-		0xab, 0xaf, 0x84, 0xa3, 0x30, 0x11, 0xe8, 0xa7, 0xd7, 0xff, 0xdf, 0x4e, 0x50, 0xff, 0x58, 0xc2,
-		0x35, 0xdd, 0xf0, 0xb2, 0x48, 0xfc, 0x17, 0xf5, 0x75, 0x85, 0xaf, 0xe7, 0xd4, 0x61, 0xf5, 0xbc,
-		0x4f, 0x1d, 0x44, 0x44, 0x9f, 0xde, 0xc6, 0xd7, 0x6a, 0xeb, 0xdb, 0x1f, 0xa9, 0x8f, 0xcf, 0xea,
-		0x44, 0x5e, 0xf8, 0xc8, 0x6f, 0x48, 0x30, 0xb2, 0xea, 0xd4, 0xb6, 0x8d, 0xea, 0xff, 0xf3, 0xf6,
-		0xbb, 0x0b, 0x47, 0x43, 0x3d, 0xbd, 0x4d, 0x2a, 0x3d, 0xf3, 0x5a, 0x0a, 0x92, 0xab, 0x4e, 0x0d,
-		0xbd, 0x04, 0x63, 0xd1, 0xa4, 0xa1, 0x63, 0x2e, 0xd8, 0x1a, 0x11, 0x3a, 0xaf, 0xd7, 0x3a, 0x47,
-		0x0f, 0xb4, 0x0f, 0x23, 0xe1, 0xc8, 0x71, 0xaa, 0x0b, 0x93, 0x10, 0x66, 0xe1, 0x91, 0x5e, 0x31,
-		0xbd, 0xc6, 0xde, 0x0e, 0x69, 0xcf, 0xe9, 0xdd, 0xdd, 0x85, 0x5a, 0x20, 0x75, 0xce, 0x6e, 0xdb,
-		0xb8, 0x15, 0xa2, 0xbd, 0xa8, 0x4b, 0xe9, 0xa6, 0xbd, 0x08, 0x6e, 0x57, 0xed, 0x75, 0x9a, 0x5a,
-		0x3b, 0x00, 0x81, 0x79, 0x70, 0x6f, 0x17, 0x0e, 0x3e, 0x5a, 0xe1, 0xe1, 0x9e, 0xd0, 0xbc, 0x43,
-		0xa7, 0x5b, 0x9c, 0x8c, 0xff, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd5, 0xca, 0x34, 0x9e, 0x6a,
-		0x94, 0x00, 0x00,
+		// 10654 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x74, 0x1c, 0xe7,
+		0x75, 0x18, 0x67, 0x77, 0x01, 0xec, 0x5e, 0xbc, 0x16, 0x1f, 0x40, 0x6a, 0xb1, 0x24, 0x01, 0x68,
+		0xf4, 0xa2, 0x28, 0x09, 0x94, 0x28, 0x91, 0xa2, 0x96, 0x7a, 0x18, 0x0b, 0x2c, 0x41, 0x50, 0x78,
+		0x69, 0x00, 0x52, 0xb2, 0xec, 0x74, 0xcf, 0x60, 0xf7, 0xc3, 0x62, 0x84, 0xdd, 0x99, 0xd1, 0xcc,
+		0x2c, 0x49, 0xc8, 0x56, 0x8f, 0x62, 0xbb, 0xae, 0xad, 0xd4, 0x89, 0x5d, 0xf7, 0x24, 0x7e, 0xd1,
+		0x8f, 0x38, 0xad, 0x1d, 0xc7, 0xad, 0x93, 0xd8, 0x75, 0x9a, 0xb6, 0xe7, 0xd4, 0x4e, 0x9b, 0xc6,
+		0x76, 0x4f, 0x73, 0xec, 0xb6, 0x27, 0x4d, 0x73, 0x52, 0xc6, 0x95, 0x7d, 0x52, 0xd7, 0x75, 0x1a,
+		0x97, 0x75, 0x4e, 0xd3, 0xe3, 0xd3, 0xd3, 0x9e, 0xef, 0x35, 0xaf, 0x9d, 0xd9, 0x07, 0x44, 0xda,
+		0xce, 0xe3, 0x17, 0x30, 0xf7, 0xbb, 0xf7, 0x7e, 0xf7, 0xde, 0xef, 0x7e, 0xf7, 0xbb, 0xdf, 0x73,
+		0xe1, 0x5f, 0x9e, 0x85, 0x99, 0x9a, 0x61, 0xd4, 0xea, 0xf8, 0x84, 0x69, 0x19, 0x8e, 0xb1, 0xd5,
+		0xdc, 0x3e, 0x51, 0xc5, 0x76, 0xc5, 0xd2, 0x4c, 0xc7, 0xb0, 0x66, 0x29, 0x0c, 0x8d, 0x32, 0x8c,
+		0x59, 0x81, 0x21, 0xaf, 0xc0, 0xd8, 0x39, 0xad, 0x8e, 0x17, 0x5c, 0xc4, 0x0d, 0xec, 0xa0, 0x33,
+		0x90, 0xda, 0xd6, 0xea, 0x38, 0x27, 0xcd, 0x24, 0x8f, 0x0d, 0x9e, 0xbc, 0x73, 0x36, 0x44, 0x34,
+		0x1b, 0xa4, 0x58, 0x27, 0x60, 0x85, 0x52, 0xc8, 0xdf, 0x4e, 0xc1, 0x78, 0x44, 0x29, 0x42, 0x90,
+		0xd2, 0xd5, 0x06, 0xe1, 0x28, 0x1d, 0xcb, 0x28, 0xf4, 0x7f, 0x94, 0x83, 0x01, 0x53, 0xad, 0xec,
+		0xaa, 0x35, 0x9c, 0x4b, 0x50, 0xb0, 0xf8, 0x44, 0x53, 0x00, 0x55, 0x6c, 0x62, 0xbd, 0x8a, 0xf5,
+		0xca, 0x5e, 0x2e, 0x39, 0x93, 0x3c, 0x96, 0x51, 0x7c, 0x10, 0x74, 0x1f, 0x8c, 0x99, 0xcd, 0xad,
+		0xba, 0x56, 0x29, 0xfb, 0xd0, 0x60, 0x26, 0x79, 0xac, 0x4f, 0xc9, 0xb2, 0x82, 0x05, 0x0f, 0xf9,
+		0x1e, 0x18, 0xbd, 0x82, 0xd5, 0x5d, 0x3f, 0xea, 0x20, 0x45, 0x1d, 0x21, 0x60, 0x1f, 0xe2, 0x3c,
+		0x0c, 0x35, 0xb0, 0x6d, 0xab, 0x35, 0x5c, 0x76, 0xf6, 0x4c, 0x9c, 0x4b, 0x51, 0xed, 0x67, 0x5a,
+		0xb4, 0x0f, 0x6b, 0x3e, 0xc8, 0xa9, 0x36, 0xf7, 0x4c, 0x8c, 0xe6, 0x20, 0x83, 0xf5, 0x66, 0x83,
+		0x71, 0xe8, 0x8b, 0xb1, 0x5f, 0x49, 0x6f, 0x36, 0xc2, 0x5c, 0xd2, 0x84, 0x8c, 0xb3, 0x18, 0xb0,
+		0xb1, 0x75, 0x59, 0xab, 0xe0, 0x5c, 0x3f, 0x65, 0x70, 0x4f, 0x0b, 0x83, 0x0d, 0x56, 0x1e, 0xe6,
+		0x21, 0xe8, 0xd0, 0x3c, 0x64, 0xf0, 0x55, 0x07, 0xeb, 0xb6, 0x66, 0xe8, 0xb9, 0x01, 0xca, 0xe4,
+		0xae, 0x88, 0x56, 0xc4, 0xf5, 0x6a, 0x98, 0x85, 0x47, 0x87, 0x4e, 0xc3, 0x80, 0x61, 0x3a, 0x9a,
+		0xa1, 0xdb, 0xb9, 0xf4, 0x8c, 0x74, 0x6c, 0xf0, 0xe4, 0x91, 0x48, 0x47, 0x58, 0x63, 0x38, 0x8a,
+		0x40, 0x46, 0x4b, 0x90, 0xb5, 0x8d, 0xa6, 0x55, 0xc1, 0xe5, 0x8a, 0x51, 0xc5, 0x65, 0x4d, 0xdf,
+		0x36, 0x72, 0x19, 0xca, 0x60, 0xba, 0x55, 0x11, 0x8a, 0x38, 0x6f, 0x54, 0xf1, 0x92, 0xbe, 0x6d,
+		0x28, 0x23, 0x76, 0xe0, 0x1b, 0x1d, 0x82, 0x7e, 0x7b, 0x4f, 0x77, 0xd4, 0xab, 0xb9, 0x21, 0xea,
+		0x21, 0xfc, 0x4b, 0xfe, 0xcd, 0x7e, 0x18, 0xed, 0xc6, 0xc5, 0xce, 0x42, 0xdf, 0x36, 0xd1, 0x32,
+		0x97, 0xe8, 0xc5, 0x06, 0x8c, 0x26, 0x68, 0xc4, 0xfe, 0x7d, 0x1a, 0x71, 0x0e, 0x06, 0x75, 0x6c,
+		0x3b, 0xb8, 0xca, 0x3c, 0x22, 0xd9, 0xa5, 0x4f, 0x01, 0x23, 0x6a, 0x75, 0xa9, 0xd4, 0xbe, 0x5c,
+		0xea, 0x39, 0x18, 0x75, 0x45, 0x2a, 0x5b, 0xaa, 0x5e, 0x13, 0xbe, 0x79, 0xa2, 0x93, 0x24, 0xb3,
+		0x25, 0x41, 0xa7, 0x10, 0x32, 0x65, 0x04, 0x07, 0xbe, 0xd1, 0x02, 0x80, 0xa1, 0x63, 0x63, 0xbb,
+		0x5c, 0xc5, 0x95, 0x7a, 0x2e, 0x1d, 0x63, 0xa5, 0x35, 0x82, 0xd2, 0x62, 0x25, 0x83, 0x41, 0x2b,
+		0x75, 0xf4, 0x98, 0xe7, 0x6a, 0x03, 0x31, 0x9e, 0xb2, 0xc2, 0x3a, 0x59, 0x8b, 0xb7, 0x5d, 0x84,
+		0x11, 0x0b, 0x13, 0xbf, 0xc7, 0x55, 0xae, 0x59, 0x86, 0x0a, 0x31, 0xdb, 0x51, 0x33, 0x85, 0x93,
+		0x31, 0xc5, 0x86, 0x2d, 0xff, 0x27, 0xba, 0x03, 0x5c, 0x40, 0x99, 0xba, 0x15, 0xd0, 0x28, 0x34,
+		0x24, 0x80, 0xab, 0x6a, 0x03, 0xe7, 0x5f, 0x82, 0x91, 0xa0, 0x79, 0xd0, 0x04, 0xf4, 0xd9, 0x8e,
+		0x6a, 0x39, 0xd4, 0x0b, 0xfb, 0x14, 0xf6, 0x81, 0xb2, 0x90, 0xc4, 0x7a, 0x95, 0x46, 0xb9, 0x3e,
+		0x85, 0xfc, 0x8b, 0xde, 0xe0, 0x29, 0x9c, 0xa4, 0x0a, 0xdf, 0xdd, 0xda, 0xa2, 0x01, 0xce, 0x61,
+		0xbd, 0xf3, 0x8f, 0xc2, 0x70, 0x40, 0x81, 0x6e, 0xab, 0x96, 0xdf, 0x0a, 0x07, 0x23, 0x59, 0xa3,
+		0xe7, 0x60, 0xa2, 0xa9, 0x6b, 0xba, 0x83, 0x2d, 0xd3, 0xc2, 0xc4, 0x63, 0x59, 0x55, 0xb9, 0xff,
+		0x3a, 0x10, 0xe3, 0x73, 0x17, 0xfd, 0xd8, 0x8c, 0x8b, 0x32, 0xde, 0x6c, 0x05, 0x1e, 0xcf, 0xa4,
+		0xbf, 0x33, 0x90, 0x7d, 0xe5, 0x95, 0x57, 0x5e, 0x49, 0xc8, 0x5f, 0xee, 0x87, 0x89, 0xa8, 0x3e,
+		0x13, 0xd9, 0x7d, 0x0f, 0x41, 0xbf, 0xde, 0x6c, 0x6c, 0x61, 0x8b, 0x1a, 0xa9, 0x4f, 0xe1, 0x5f,
+		0x68, 0x0e, 0xfa, 0xea, 0xea, 0x16, 0xae, 0xe7, 0x52, 0x33, 0xd2, 0xb1, 0x91, 0x93, 0xf7, 0x75,
+		0xd5, 0x2b, 0x67, 0x97, 0x09, 0x89, 0xc2, 0x28, 0xd1, 0x93, 0x90, 0xe2, 0x21, 0x9a, 0x70, 0x38,
+		0xde, 0x1d, 0x07, 0xd2, 0x97, 0x14, 0x4a, 0x87, 0x0e, 0x43, 0x86, 0xfc, 0x65, 0xbe, 0xd1, 0x4f,
+		0x65, 0x4e, 0x13, 0x00, 0xf1, 0x0b, 0x94, 0x87, 0x34, 0xed, 0x26, 0x55, 0x2c, 0x86, 0x36, 0xf7,
+		0x9b, 0x38, 0x56, 0x15, 0x6f, 0xab, 0xcd, 0xba, 0x53, 0xbe, 0xac, 0xd6, 0x9b, 0x98, 0x3a, 0x7c,
+		0x46, 0x19, 0xe2, 0xc0, 0x4b, 0x04, 0x86, 0xa6, 0x61, 0x90, 0xf5, 0x2a, 0x4d, 0xaf, 0xe2, 0xab,
+		0x34, 0x7a, 0xf6, 0x29, 0xac, 0xa3, 0x2d, 0x11, 0x08, 0xa9, 0xfe, 0x05, 0xdb, 0xd0, 0x85, 0x6b,
+		0xd2, 0x2a, 0x08, 0x80, 0x56, 0xff, 0x68, 0x38, 0x70, 0x1f, 0x8d, 0x56, 0xaf, 0xa5, 0x2f, 0xdd,
+		0x03, 0xa3, 0x14, 0xe3, 0x61, 0xde, 0xf4, 0x6a, 0x3d, 0x37, 0x36, 0x23, 0x1d, 0x4b, 0x2b, 0x23,
+		0x0c, 0xbc, 0xc6, 0xa1, 0xf2, 0x17, 0x13, 0x90, 0xa2, 0x81, 0x65, 0x14, 0x06, 0x37, 0xdf, 0xb8,
+		0x5e, 0x2a, 0x2f, 0xac, 0x5d, 0x2c, 0x2e, 0x97, 0xb2, 0x12, 0x1a, 0x01, 0xa0, 0x80, 0x73, 0xcb,
+		0x6b, 0x73, 0x9b, 0xd9, 0x84, 0xfb, 0xbd, 0xb4, 0xba, 0x79, 0xfa, 0x91, 0x6c, 0xd2, 0x25, 0xb8,
+		0xc8, 0x00, 0x29, 0x3f, 0xc2, 0xc3, 0x27, 0xb3, 0x7d, 0x28, 0x0b, 0x43, 0x8c, 0xc1, 0xd2, 0x73,
+		0xa5, 0x85, 0xd3, 0x8f, 0x64, 0xfb, 0x83, 0x90, 0x87, 0x4f, 0x66, 0x07, 0xd0, 0x30, 0x64, 0x28,
+		0xa4, 0xb8, 0xb6, 0xb6, 0x9c, 0x4d, 0xbb, 0x3c, 0x37, 0x36, 0x95, 0xa5, 0xd5, 0xc5, 0x6c, 0xc6,
+		0xe5, 0xb9, 0xa8, 0xac, 0x5d, 0x5c, 0xcf, 0x82, 0xcb, 0x61, 0xa5, 0xb4, 0xb1, 0x31, 0xb7, 0x58,
+		0xca, 0x0e, 0xba, 0x18, 0xc5, 0x37, 0x6e, 0x96, 0x36, 0xb2, 0x43, 0x01, 0xb1, 0x1e, 0x3e, 0x99,
+		0x1d, 0x76, 0xab, 0x28, 0xad, 0x5e, 0x5c, 0xc9, 0x8e, 0xa0, 0x31, 0x18, 0x66, 0x55, 0x08, 0x21,
+		0x46, 0x43, 0xa0, 0xd3, 0x8f, 0x64, 0xb3, 0x9e, 0x20, 0x8c, 0xcb, 0x58, 0x00, 0x70, 0xfa, 0x91,
+		0x2c, 0x92, 0xe7, 0xa1, 0x8f, 0xba, 0x21, 0x42, 0x30, 0xb2, 0x3c, 0x57, 0x2c, 0x2d, 0x97, 0xd7,
+		0xd6, 0x37, 0x97, 0xd6, 0x56, 0xe7, 0x96, 0xb3, 0x92, 0x07, 0x53, 0x4a, 0xcf, 0x5c, 0x5c, 0x52,
+		0x4a, 0x0b, 0xd9, 0x84, 0x1f, 0xb6, 0x5e, 0x9a, 0xdb, 0x2c, 0x2d, 0x64, 0x93, 0x72, 0x05, 0x26,
+		0xa2, 0x02, 0x6a, 0x64, 0x17, 0xf2, 0xf9, 0x42, 0x22, 0xc6, 0x17, 0x28, 0xaf, 0xb0, 0x2f, 0xc8,
+		0xdf, 0x4a, 0xc0, 0x78, 0xc4, 0xa0, 0x12, 0x59, 0xc9, 0x53, 0xd0, 0xc7, 0x7c, 0x99, 0x0d, 0xb3,
+		0xf7, 0x46, 0x8e, 0x4e, 0xd4, 0xb3, 0x5b, 0x86, 0x5a, 0x4a, 0xe7, 0x4f, 0x35, 0x92, 0x31, 0xa9,
+		0x06, 0x61, 0xd1, 0xe2, 0xb0, 0x3f, 0xd5, 0x12, 0xfc, 0xd9, 0xf8, 0x78, 0xba, 0x9b, 0xf1, 0x91,
+		0xc2, 0x7a, 0x1b, 0x04, 0xfa, 0x22, 0x06, 0x81, 0xb3, 0x30, 0xd6, 0xc2, 0xa8, 0xeb, 0x60, 0xfc,
+		0x76, 0x09, 0x72, 0x71, 0xc6, 0xe9, 0x10, 0x12, 0x13, 0x81, 0x90, 0x78, 0x36, 0x6c, 0xc1, 0xdb,
+		0xe3, 0x1b, 0xa1, 0xa5, 0xad, 0x3f, 0x25, 0xc1, 0xa1, 0xe8, 0x94, 0x32, 0x52, 0x86, 0x27, 0xa1,
+		0xbf, 0x81, 0x9d, 0x1d, 0x43, 0xa4, 0x55, 0x77, 0x47, 0x0c, 0xd6, 0xa4, 0x38, 0xdc, 0xd8, 0x9c,
+		0x0a, 0x3d, 0x16, 0x96, 0x75, 0x3a, 0x2e, 0xc1, 0x6d, 0x91, 0xf4, 0xdd, 0x09, 0x38, 0x18, 0xc9,
+		0x3c, 0x52, 0xd0, 0xa3, 0x00, 0x9a, 0x6e, 0x36, 0x1d, 0x96, 0x3a, 0xb1, 0x48, 0x9c, 0xa1, 0x10,
+		0x1a, 0xbc, 0x48, 0x94, 0x6d, 0x3a, 0x6e, 0x79, 0x92, 0x96, 0x03, 0x03, 0x51, 0x84, 0x33, 0x9e,
+		0xa0, 0x29, 0x2a, 0xe8, 0x54, 0x8c, 0xa6, 0x2d, 0x8e, 0xf9, 0x20, 0x64, 0x2b, 0x75, 0x0d, 0xeb,
+		0x4e, 0xd9, 0x76, 0x2c, 0xac, 0x36, 0x34, 0xbd, 0x46, 0x87, 0x9a, 0x74, 0xa1, 0x6f, 0x5b, 0xad,
+		0xdb, 0x58, 0x19, 0x65, 0xc5, 0x1b, 0xa2, 0x94, 0x50, 0x50, 0x07, 0xb2, 0x7c, 0x14, 0xfd, 0x01,
+		0x0a, 0x56, 0xec, 0x52, 0xc8, 0xef, 0xcb, 0xc0, 0xa0, 0x2f, 0x01, 0x47, 0xb7, 0xc3, 0xd0, 0x0b,
+		0xea, 0x65, 0xb5, 0x2c, 0x26, 0x55, 0xcc, 0x12, 0x83, 0x04, 0xb6, 0xce, 0x40, 0xe8, 0x41, 0x98,
+		0xa0, 0x28, 0x46, 0xd3, 0xc1, 0x56, 0xb9, 0x52, 0x57, 0x6d, 0x9b, 0x1a, 0x2d, 0x4d, 0x51, 0x11,
+		0x29, 0x5b, 0x23, 0x45, 0xf3, 0xa2, 0x04, 0x9d, 0x82, 0x71, 0x4a, 0xd1, 0x68, 0xd6, 0x1d, 0xcd,
+		0xac, 0xe3, 0x32, 0x99, 0xe6, 0xd9, 0x39, 0xf0, 0x4b, 0x36, 0x46, 0x30, 0x56, 0x38, 0x02, 0x91,
+		0xc8, 0x46, 0x0b, 0x70, 0x94, 0x92, 0xd5, 0xb0, 0x8e, 0x2d, 0xd5, 0xc1, 0x65, 0xfc, 0x62, 0x53,
+		0xad, 0xdb, 0x65, 0x55, 0xaf, 0x96, 0x77, 0x54, 0x7b, 0x27, 0x37, 0x41, 0x18, 0x14, 0x13, 0x39,
+		0x49, 0x99, 0x24, 0x88, 0x8b, 0x1c, 0xaf, 0x44, 0xd1, 0xe6, 0xf4, 0xea, 0x79, 0xd5, 0xde, 0x41,
+		0x05, 0x38, 0x44, 0xb9, 0xd8, 0x8e, 0xa5, 0xe9, 0xb5, 0x72, 0x65, 0x07, 0x57, 0x76, 0xcb, 0x4d,
+		0x67, 0xfb, 0x4c, 0xee, 0xb0, 0xbf, 0x7e, 0x2a, 0xe1, 0x06, 0xc5, 0x99, 0x27, 0x28, 0x17, 0x9d,
+		0xed, 0x33, 0x68, 0x03, 0x86, 0x48, 0x63, 0x34, 0xb4, 0x97, 0x70, 0x79, 0xdb, 0xb0, 0xe8, 0x18,
+		0x3a, 0x12, 0x11, 0x9a, 0x7c, 0x16, 0x9c, 0x5d, 0xe3, 0x04, 0x2b, 0x46, 0x15, 0x17, 0xfa, 0x36,
+		0xd6, 0x4b, 0xa5, 0x05, 0x65, 0x50, 0x70, 0x39, 0x67, 0x58, 0xc4, 0xa1, 0x6a, 0x86, 0x6b, 0xe0,
+		0x41, 0xe6, 0x50, 0x35, 0x43, 0x98, 0xf7, 0x14, 0x8c, 0x57, 0x2a, 0x4c, 0x67, 0xad, 0x52, 0xe6,
+		0x93, 0x31, 0x3b, 0x97, 0x0d, 0x18, 0xab, 0x52, 0x59, 0x64, 0x08, 0xdc, 0xc7, 0x6d, 0xf4, 0x18,
+		0x1c, 0xf4, 0x8c, 0xe5, 0x27, 0x1c, 0x6b, 0xd1, 0x32, 0x4c, 0x7a, 0x0a, 0xc6, 0xcd, 0xbd, 0x56,
+		0x42, 0x14, 0xa8, 0xd1, 0xdc, 0x0b, 0x93, 0x3d, 0x0a, 0x13, 0xe6, 0x8e, 0xd9, 0x4a, 0x77, 0xdc,
+		0x4f, 0x87, 0xcc, 0x1d, 0x33, 0x4c, 0x78, 0x17, 0x9d, 0x99, 0x5b, 0xb8, 0xa2, 0x3a, 0xb8, 0x9a,
+		0xbb, 0xcd, 0x8f, 0xee, 0x2b, 0x40, 0xb3, 0x90, 0xad, 0x54, 0xca, 0x58, 0x57, 0xb7, 0xea, 0xb8,
+		0xac, 0x5a, 0x58, 0x57, 0xed, 0xdc, 0x34, 0x45, 0x4e, 0x39, 0x56, 0x13, 0x2b, 0x23, 0x95, 0x4a,
+		0x89, 0x16, 0xce, 0xd1, 0x32, 0x74, 0x1c, 0xc6, 0x8c, 0xad, 0x17, 0x2a, 0xcc, 0x23, 0xcb, 0xa6,
+		0x85, 0xb7, 0xb5, 0xab, 0xb9, 0x3b, 0xa9, 0x79, 0x47, 0x49, 0x01, 0xf5, 0xc7, 0x75, 0x0a, 0x46,
+		0xf7, 0x42, 0xb6, 0x62, 0xef, 0xa8, 0x96, 0x49, 0x43, 0xb2, 0x6d, 0xaa, 0x15, 0x9c, 0xbb, 0x8b,
+		0xa1, 0x32, 0xf8, 0xaa, 0x00, 0x93, 0x1e, 0x61, 0x5f, 0xd1, 0xb6, 0x1d, 0xc1, 0xf1, 0x1e, 0xd6,
+		0x23, 0x28, 0x8c, 0x73, 0x3b, 0x06, 0x59, 0x62, 0x89, 0x40, 0xc5, 0xc7, 0x28, 0xda, 0x88, 0xb9,
+		0x63, 0xfa, 0xeb, 0xbd, 0x03, 0x86, 0xcd, 0x1d, 0x7f, 0xa5, 0xf7, 0xb2, 0xc4, 0xcd, 0xdc, 0xf1,
+		0xd5, 0xf8, 0x08, 0x1c, 0x22, 0x48, 0x0d, 0xec, 0xa8, 0x55, 0xd5, 0x51, 0x7d, 0xd8, 0xf7, 0x53,
+		0x6c, 0x62, 0xf6, 0x15, 0x5e, 0x18, 0x90, 0xd3, 0x6a, 0x6e, 0xed, 0xb9, 0x8e, 0xf5, 0x00, 0x93,
+		0x93, 0xc0, 0x84, 0x6b, 0xdd, 0xb2, 0xe4, 0x5c, 0x2e, 0xc0, 0x90, 0xdf, 0xef, 0x51, 0x06, 0x98,
+		0xe7, 0x67, 0x25, 0x92, 0x04, 0xcd, 0xaf, 0x2d, 0x90, 0xf4, 0xe5, 0xf9, 0x52, 0x36, 0x41, 0xd2,
+		0xa8, 0xe5, 0xa5, 0xcd, 0x52, 0x59, 0xb9, 0xb8, 0xba, 0xb9, 0xb4, 0x52, 0xca, 0x26, 0x7d, 0x89,
+		0xfd, 0x85, 0x54, 0xfa, 0xee, 0xec, 0x3d, 0x24, 0x6b, 0x18, 0x09, 0xce, 0xd4, 0xd0, 0xe3, 0x70,
+		0x9b, 0x58, 0x56, 0xb1, 0xb1, 0x53, 0xbe, 0xa2, 0x59, 0xb4, 0x43, 0x36, 0x54, 0x36, 0x38, 0xba,
+		0xfe, 0x33, 0xc1, 0xb1, 0x36, 0xb0, 0xf3, 0xac, 0x66, 0x91, 0xee, 0xd6, 0x50, 0x1d, 0xb4, 0x0c,
+		0xd3, 0xba, 0x51, 0xb6, 0x1d, 0x55, 0xaf, 0xaa, 0x56, 0xb5, 0xec, 0x2d, 0x68, 0x95, 0xd5, 0x4a,
+		0x05, 0xdb, 0xb6, 0xc1, 0x06, 0x42, 0x97, 0xcb, 0x11, 0xdd, 0xd8, 0xe0, 0xc8, 0xde, 0x08, 0x31,
+		0xc7, 0x51, 0x43, 0xee, 0x9b, 0x8c, 0x73, 0xdf, 0xc3, 0x90, 0x69, 0xa8, 0x66, 0x19, 0xeb, 0x8e,
+		0xb5, 0x47, 0xf3, 0xf3, 0xb4, 0x92, 0x6e, 0xa8, 0x66, 0x89, 0x7c, 0xff, 0x48, 0xa6, 0x49, 0x17,
+		0x52, 0xe9, 0x54, 0xb6, 0xef, 0x42, 0x2a, 0xdd, 0x97, 0xed, 0xbf, 0x90, 0x4a, 0xf7, 0x67, 0x07,
+		0x2e, 0xa4, 0xd2, 0xe9, 0x6c, 0xe6, 0x42, 0x2a, 0x9d, 0xc9, 0x82, 0xfc, 0x5a, 0x12, 0x86, 0xfc,
+		0x19, 0x3c, 0x99, 0x10, 0x55, 0xe8, 0x18, 0x26, 0xd1, 0x28, 0x77, 0x47, 0xdb, 0x7c, 0x7f, 0x76,
+		0x9e, 0x0c, 0x6e, 0x85, 0x7e, 0x96, 0x2e, 0x2b, 0x8c, 0x92, 0x24, 0x16, 0xc4, 0xfd, 0x30, 0x4b,
+		0x4f, 0xd2, 0x0a, 0xff, 0x42, 0x8b, 0xd0, 0xff, 0x82, 0x4d, 0x79, 0xf7, 0x53, 0xde, 0x77, 0xb6,
+		0xe7, 0x7d, 0x61, 0x83, 0x32, 0xcf, 0x5c, 0xd8, 0x28, 0xaf, 0xae, 0x29, 0x2b, 0x73, 0xcb, 0x0a,
+		0x27, 0x47, 0x93, 0x90, 0xaa, 0xab, 0x2f, 0xed, 0x05, 0x87, 0x41, 0x0a, 0xea, 0xb6, 0x59, 0x26,
+		0x21, 0x45, 0x96, 0xec, 0x82, 0x83, 0x0f, 0x05, 0xdd, 0xc2, 0xee, 0x71, 0x02, 0xfa, 0xa8, 0xbd,
+		0x10, 0x00, 0xb7, 0x58, 0xf6, 0x00, 0x4a, 0x43, 0x6a, 0x7e, 0x4d, 0x21, 0x5d, 0x24, 0x0b, 0x43,
+		0x0c, 0x5a, 0x5e, 0x5f, 0x2a, 0xcd, 0x97, 0xb2, 0x09, 0xf9, 0x14, 0xf4, 0x33, 0x23, 0x90, 0xee,
+		0xe3, 0x9a, 0x21, 0x7b, 0x80, 0x7f, 0x72, 0x1e, 0x92, 0x28, 0xbd, 0xb8, 0x52, 0x2c, 0x29, 0xd9,
+		0x44, 0x4b, 0xe3, 0xcb, 0x36, 0x0c, 0xf9, 0x33, 0xf3, 0x1f, 0xcd, 0xf4, 0xfc, 0x4b, 0x12, 0x0c,
+		0xfa, 0x32, 0x6d, 0x92, 0x22, 0xa9, 0xf5, 0xba, 0x71, 0xa5, 0xac, 0xd6, 0x35, 0xd5, 0xe6, 0xae,
+		0x01, 0x14, 0x34, 0x47, 0x20, 0xdd, 0x36, 0xdd, 0x8f, 0xa8, 0xd3, 0xf4, 0x65, 0xfb, 0xe5, 0x8f,
+		0x4a, 0x90, 0x0d, 0xa7, 0xba, 0x21, 0x31, 0xa5, 0x1f, 0xa7, 0x98, 0xf2, 0x47, 0x24, 0x18, 0x09,
+		0xe6, 0xb7, 0x21, 0xf1, 0x6e, 0xff, 0xb1, 0x8a, 0xf7, 0xcd, 0x04, 0x0c, 0x07, 0xb2, 0xda, 0x6e,
+		0xa5, 0x7b, 0x11, 0xc6, 0xb4, 0x2a, 0x6e, 0x98, 0x86, 0x43, 0x96, 0xd3, 0xcb, 0x75, 0x7c, 0x19,
+		0xd7, 0x73, 0x32, 0x0d, 0x1a, 0x27, 0xda, 0xe7, 0xcd, 0xb3, 0x4b, 0x1e, 0xdd, 0x32, 0x21, 0x2b,
+		0x8c, 0x2f, 0x2d, 0x94, 0x56, 0xd6, 0xd7, 0x36, 0x4b, 0xab, 0xf3, 0x6f, 0x2c, 0x5f, 0x5c, 0x7d,
+		0x7a, 0x75, 0xed, 0xd9, 0x55, 0x25, 0xab, 0x85, 0xd0, 0x6e, 0x61, 0xb7, 0x5f, 0x87, 0x6c, 0x58,
+		0x28, 0x74, 0x1b, 0x44, 0x89, 0x95, 0x3d, 0x80, 0xc6, 0x61, 0x74, 0x75, 0xad, 0xbc, 0xb1, 0xb4,
+		0x50, 0x2a, 0x97, 0xce, 0x9d, 0x2b, 0xcd, 0x6f, 0x6e, 0xb0, 0x95, 0x10, 0x17, 0x7b, 0x33, 0xd0,
+		0xc1, 0xe5, 0x0f, 0x25, 0x61, 0x3c, 0x42, 0x12, 0x34, 0xc7, 0xe7, 0x30, 0x6c, 0x5a, 0xf5, 0x40,
+		0x37, 0xd2, 0xcf, 0x92, 0x2c, 0x62, 0x5d, 0xb5, 0x1c, 0x3e, 0xe5, 0xb9, 0x17, 0x88, 0x95, 0x74,
+		0x47, 0xdb, 0xd6, 0xb0, 0xc5, 0x57, 0x98, 0xd8, 0xc4, 0x66, 0xd4, 0x83, 0xb3, 0x45, 0xa6, 0xfb,
+		0x01, 0x99, 0x86, 0xad, 0x39, 0xda, 0x65, 0xb2, 0x48, 0x2f, 0x96, 0xa3, 0xc8, 0x44, 0x27, 0xa5,
+		0x64, 0x45, 0xc9, 0x92, 0xee, 0xb8, 0xd8, 0x3a, 0xae, 0xa9, 0x21, 0x6c, 0x12, 0xcc, 0x93, 0x4a,
+		0x56, 0x94, 0xb8, 0xd8, 0xb7, 0xc3, 0x50, 0xd5, 0x68, 0x92, 0xec, 0x8f, 0xe1, 0x91, 0xb1, 0x43,
+		0x52, 0x06, 0x19, 0xcc, 0x45, 0xe1, 0x79, 0xbd, 0xb7, 0x0e, 0x36, 0xa4, 0x0c, 0x32, 0x18, 0x43,
+		0xb9, 0x07, 0x46, 0xd5, 0x5a, 0xcd, 0x22, 0xcc, 0x05, 0x23, 0x36, 0x53, 0x19, 0x71, 0xc1, 0x14,
+		0x31, 0x7f, 0x01, 0xd2, 0xc2, 0x0e, 0x64, 0xf0, 0x26, 0x96, 0x28, 0x9b, 0x6c, 0xfa, 0x9d, 0x20,
+		0x4b, 0x63, 0xba, 0x28, 0xbc, 0x1d, 0x86, 0x34, 0xbb, 0xec, 0x2d, 0xeb, 0x27, 0x66, 0x12, 0xc7,
+		0xd2, 0xca, 0xa0, 0x66, 0xbb, 0x4b, 0xa2, 0xf2, 0xa7, 0x12, 0x30, 0x12, 0xdc, 0x96, 0x40, 0x0b,
+		0x90, 0xae, 0x1b, 0x15, 0x95, 0xba, 0x16, 0xdb, 0x13, 0x3b, 0xd6, 0x61, 0x27, 0x63, 0x76, 0x99,
+		0xe3, 0x2b, 0x2e, 0x65, 0xfe, 0x77, 0x25, 0x48, 0x0b, 0x30, 0x3a, 0x04, 0x29, 0x53, 0x75, 0x76,
+		0x28, 0xbb, 0xbe, 0x62, 0x22, 0x2b, 0x29, 0xf4, 0x9b, 0xc0, 0x6d, 0x53, 0xd5, 0x73, 0x09, 0x0f,
+		0x4e, 0xbe, 0x49, 0xbb, 0xd6, 0xb1, 0x5a, 0xa5, 0xd3, 0x20, 0xa3, 0xd1, 0xc0, 0xba, 0x63, 0x8b,
+		0x76, 0xe5, 0xf0, 0x79, 0x0e, 0x26, 0xbb, 0x63, 0x8e, 0xa5, 0x6a, 0xf5, 0x00, 0x6e, 0x8a, 0xe2,
+		0x66, 0x45, 0x81, 0x8b, 0x5c, 0x80, 0x49, 0xc1, 0xb7, 0x8a, 0x1d, 0xb5, 0xb2, 0x83, 0xab, 0x1e,
+		0x51, 0x3f, 0x5d, 0xee, 0xb8, 0x8d, 0x23, 0x2c, 0xf0, 0x72, 0x41, 0x2b, 0x7f, 0x43, 0x82, 0x31,
+		0x31, 0x71, 0xab, 0xba, 0xc6, 0x5a, 0x01, 0x50, 0x75, 0xdd, 0x70, 0xfc, 0xe6, 0x6a, 0x75, 0xe5,
+		0x16, 0xba, 0xd9, 0x39, 0x97, 0x48, 0xf1, 0x31, 0xc8, 0x37, 0x00, 0xbc, 0x92, 0x58, 0xb3, 0x4d,
+		0xc3, 0x20, 0xdf, 0x73, 0xa2, 0x1b, 0x97, 0x6c, 0xaa, 0x0f, 0x0c, 0x44, 0x66, 0x78, 0x64, 0x41,
+		0x66, 0x0b, 0xd7, 0x34, 0x9d, 0xaf, 0x24, 0xb3, 0x0f, 0xb1, 0x20, 0x93, 0x72, 0x17, 0x64, 0x8a,
+		0x7f, 0x13, 0xc6, 0x2b, 0x46, 0x23, 0x2c, 0x6e, 0x31, 0x1b, 0x5a, 0x6e, 0xb0, 0xcf, 0x4b, 0xcf,
+		0x3f, 0xc0, 0x91, 0x6a, 0x46, 0x5d, 0xd5, 0x6b, 0xb3, 0x86, 0x55, 0xf3, 0x36, 0x5e, 0x49, 0xc6,
+		0x63, 0xfb, 0xb6, 0x5f, 0xcd, 0xad, 0x3f, 0x97, 0xa4, 0x5f, 0x4c, 0x24, 0x17, 0xd7, 0x8b, 0x9f,
+		0x49, 0xe4, 0x17, 0x19, 0xe1, 0xba, 0x30, 0x86, 0x82, 0xb7, 0xeb, 0xb8, 0x42, 0x14, 0x84, 0xef,
+		0xde, 0x07, 0x13, 0x35, 0xa3, 0x66, 0x50, 0x4e, 0x27, 0xc8, 0x7f, 0x7c, 0xe7, 0x36, 0xe3, 0x42,
+		0xf3, 0x1d, 0xb7, 0x79, 0x0b, 0xab, 0x30, 0xce, 0x91, 0xcb, 0x74, 0xeb, 0x88, 0x4d, 0x6c, 0x50,
+		0xdb, 0x55, 0xb5, 0xdc, 0xaf, 0x7d, 0x9b, 0x0e, 0xdf, 0xca, 0x18, 0x27, 0x25, 0x65, 0x6c, 0xee,
+		0x53, 0x50, 0xe0, 0x60, 0x80, 0x1f, 0xeb, 0xa4, 0xd8, 0xea, 0xc0, 0xf1, 0xb7, 0x39, 0xc7, 0x71,
+		0x1f, 0xc7, 0x0d, 0x4e, 0x5a, 0x98, 0x87, 0xe1, 0x5e, 0x78, 0xfd, 0x6b, 0xce, 0x6b, 0x08, 0xfb,
+		0x99, 0x2c, 0xc2, 0x28, 0x65, 0x52, 0x69, 0xda, 0x8e, 0xd1, 0xa0, 0x11, 0xb0, 0x3d, 0x9b, 0xdf,
+		0xf9, 0x36, 0xeb, 0x35, 0x23, 0x84, 0x6c, 0xde, 0xa5, 0x2a, 0x14, 0x80, 0xee, 0x96, 0x91, 0x5d,
+		0xac, 0x0e, 0x1c, 0xbe, 0xc2, 0x05, 0x71, 0xf1, 0x0b, 0x97, 0x60, 0x82, 0xfc, 0x4f, 0x03, 0x94,
+		0x5f, 0x92, 0xce, 0x4b, 0x70, 0xb9, 0x6f, 0xbc, 0x9d, 0x75, 0xcc, 0x71, 0x97, 0x81, 0x4f, 0x26,
+		0x5f, 0x2b, 0xd6, 0xb0, 0xe3, 0x60, 0xcb, 0x2e, 0xab, 0xf5, 0x28, 0xf1, 0x7c, 0x6b, 0x18, 0xb9,
+		0x0f, 0x7e, 0x2f, 0xd8, 0x8a, 0x8b, 0x8c, 0x72, 0xae, 0x5e, 0x2f, 0x5c, 0x84, 0xdb, 0x22, 0xbc,
+		0xa2, 0x0b, 0x9e, 0x1f, 0xe2, 0x3c, 0x27, 0x5a, 0x3c, 0x83, 0xb0, 0x5d, 0x07, 0x01, 0x77, 0xdb,
+		0xb2, 0x0b, 0x9e, 0x1f, 0xe6, 0x3c, 0x11, 0xa7, 0x15, 0x4d, 0x4a, 0x38, 0x5e, 0x80, 0xb1, 0xcb,
+		0xd8, 0xda, 0x32, 0x6c, 0xbe, 0x6e, 0xd4, 0x05, 0xbb, 0x8f, 0x70, 0x76, 0xa3, 0x9c, 0x90, 0x2e,
+		0x24, 0x11, 0x5e, 0x8f, 0x41, 0x7a, 0x5b, 0xad, 0xe0, 0x2e, 0x58, 0x5c, 0xe3, 0x2c, 0x06, 0x08,
+		0x3e, 0x21, 0x9d, 0x83, 0xa1, 0x9a, 0xc1, 0xc7, 0xa8, 0xce, 0xe4, 0x1f, 0xe5, 0xe4, 0x83, 0x82,
+		0x86, 0xb3, 0x30, 0x0d, 0xb3, 0x59, 0x27, 0x03, 0x58, 0x67, 0x16, 0x1f, 0x13, 0x2c, 0x04, 0x0d,
+		0x67, 0xd1, 0x83, 0x59, 0x3f, 0x2e, 0x58, 0xd8, 0x3e, 0x7b, 0x3e, 0x45, 0xb6, 0x93, 0xea, 0x7b,
+		0x86, 0xde, 0x8d, 0x10, 0x9f, 0xe0, 0x1c, 0x80, 0x93, 0x10, 0x06, 0x67, 0x21, 0xd3, 0x6d, 0x43,
+		0xfc, 0xfd, 0xef, 0x89, 0xee, 0x21, 0x5a, 0x60, 0x11, 0x46, 0x45, 0x80, 0x22, 0xdb, 0xcf, 0x9d,
+		0x59, 0xfc, 0x03, 0xce, 0x62, 0xc4, 0x47, 0xc6, 0xd5, 0x70, 0xb0, 0xed, 0xd4, 0x70, 0x37, 0x4c,
+		0x3e, 0x25, 0xd4, 0xe0, 0x24, 0xdc, 0x94, 0x5b, 0x58, 0xaf, 0xec, 0x74, 0xc7, 0xe1, 0xd3, 0xc2,
+		0x94, 0x82, 0x86, 0xb0, 0x98, 0x87, 0xe1, 0x86, 0x6a, 0xd9, 0x3b, 0x6a, 0xbd, 0xab, 0xe6, 0xf8,
+		0x65, 0xce, 0x63, 0xc8, 0x25, 0xe2, 0x16, 0x69, 0xea, 0xbd, 0xb0, 0xf9, 0x8c, 0xb0, 0x48, 0x53,
+		0x0f, 0x30, 0x5a, 0x87, 0x09, 0xdb, 0xa1, 0x8b, 0x6c, 0xbd, 0x70, 0xfb, 0x15, 0xd1, 0xf5, 0x18,
+		0xed, 0x8a, 0x9f, 0xe3, 0x59, 0xc8, 0xd8, 0xda, 0x4b, 0x5d, 0xb1, 0xf9, 0xac, 0x68, 0x69, 0x4a,
+		0x40, 0x88, 0xdf, 0x08, 0x93, 0x91, 0xc3, 0x44, 0x17, 0xcc, 0xfe, 0x21, 0x67, 0x76, 0x28, 0x62,
+		0xa8, 0xe0, 0x21, 0xa1, 0x57, 0x96, 0xff, 0x48, 0x84, 0x04, 0x1c, 0xe2, 0xb5, 0x4e, 0x66, 0x0d,
+		0xb6, 0xba, 0xdd, 0x9b, 0xd5, 0x3e, 0x27, 0xac, 0xc6, 0x68, 0x03, 0x56, 0xdb, 0x84, 0x43, 0x9c,
+		0x63, 0x6f, 0xed, 0xfa, 0xab, 0x22, 0xb0, 0x32, 0xea, 0x8b, 0xc1, 0xd6, 0x7d, 0x13, 0xe4, 0x5d,
+		0x73, 0x8a, 0xf4, 0xd4, 0x2e, 0x93, 0x95, 0xa9, 0xce, 0x9c, 0x7f, 0x8d, 0x73, 0x16, 0x11, 0xdf,
+		0xcd, 0x6f, 0xed, 0x15, 0xd5, 0x24, 0xcc, 0x9f, 0x83, 0x9c, 0x60, 0xde, 0xd4, 0x2d, 0x5c, 0x31,
+		0x6a, 0xba, 0xf6, 0x12, 0xae, 0x76, 0xc1, 0xfa, 0xd7, 0x43, 0x4d, 0x75, 0xd1, 0x47, 0x4e, 0x38,
+		0x2f, 0x41, 0xd6, 0xcd, 0x55, 0xca, 0x5a, 0xc3, 0x34, 0x2c, 0xa7, 0x03, 0xc7, 0xcf, 0x8b, 0x96,
+		0x72, 0xe9, 0x96, 0x28, 0x59, 0xa1, 0x04, 0x6c, 0xe7, 0xb9, 0x5b, 0x97, 0xfc, 0x02, 0x67, 0x34,
+		0xec, 0x51, 0xf1, 0xc0, 0x51, 0x31, 0x1a, 0xa6, 0x6a, 0x75, 0x13, 0xff, 0xfe, 0xb1, 0x08, 0x1c,
+		0x9c, 0x84, 0x07, 0x0e, 0x92, 0xd1, 0x91, 0xd1, 0xbe, 0x0b, 0x0e, 0x5f, 0x14, 0x81, 0x43, 0xd0,
+		0x70, 0x16, 0x22, 0x61, 0xe8, 0x82, 0xc5, 0x6f, 0x08, 0x16, 0x82, 0x86, 0xb0, 0x78, 0xc6, 0x1b,
+		0x68, 0x2d, 0x5c, 0xd3, 0x6c, 0xc7, 0x62, 0x49, 0x71, 0x7b, 0x56, 0xff, 0xe4, 0x7b, 0xc1, 0x24,
+		0x4c, 0xf1, 0x91, 0x92, 0x48, 0xc4, 0x97, 0x5d, 0xe9, 0x9c, 0xa9, 0xb3, 0x60, 0xbf, 0x29, 0x22,
+		0x91, 0x8f, 0x8c, 0xc8, 0xe6, 0xcb, 0x10, 0x89, 0xd9, 0x2b, 0x64, 0xa6, 0xd0, 0x05, 0xbb, 0x7f,
+		0x1a, 0x12, 0x6e, 0x43, 0xd0, 0x12, 0x9e, 0xbe, 0xfc, 0xa7, 0xa9, 0xef, 0xe2, 0xbd, 0xae, 0xbc,
+		0xf3, 0x9f, 0x85, 0xf2, 0x9f, 0x8b, 0x8c, 0x92, 0xc5, 0x90, 0xd1, 0x50, 0x3e, 0x85, 0x3a, 0x9d,
+		0x33, 0xca, 0xfd, 0xf4, 0x0f, 0xb8, 0xbe, 0xc1, 0x74, 0xaa, 0xb0, 0x0c, 0x59, 0x0e, 0xf1, 0x12,
+		0xd8, 0x8e, 0xcc, 0xde, 0xfe, 0x03, 0xd7, 0xcf, 0x03, 0x39, 0x4f, 0xe1, 0x1c, 0x0c, 0x07, 0x12,
+		0x9e, 0xce, 0xac, 0xde, 0xc1, 0x59, 0x0d, 0xf9, 0xf3, 0x9d, 0xc2, 0x29, 0x48, 0x91, 0xe4, 0xa5,
+		0x33, 0xf9, 0xdf, 0xe2, 0xe4, 0x14, 0xbd, 0xf0, 0x04, 0xa4, 0x45, 0xd2, 0xd2, 0x99, 0xf4, 0x9d,
+		0x9c, 0xd4, 0x25, 0x21, 0xe4, 0x22, 0x61, 0xe9, 0x4c, 0xfe, 0xb7, 0x05, 0xb9, 0x20, 0x21, 0xe4,
+		0xdd, 0x9b, 0xf0, 0x4b, 0x3f, 0x93, 0x62, 0xe4, 0x82, 0xa4, 0x40, 0x76, 0xbe, 0x59, 0xa6, 0xd2,
+		0x99, 0xfa, 0xdd, 0xbc, 0x72, 0x41, 0x51, 0x78, 0x14, 0xfa, 0xba, 0x34, 0xf8, 0x7b, 0x38, 0x29,
+		0xc3, 0x2f, 0xcc, 0xc3, 0xa0, 0x2f, 0x3b, 0xe9, 0x4c, 0xfe, 0xb3, 0x9c, 0xdc, 0x4f, 0x45, 0x44,
+		0xe7, 0xd9, 0x49, 0x67, 0x06, 0x3f, 0x27, 0x44, 0xe7, 0x14, 0xc4, 0x6c, 0x22, 0x31, 0xe9, 0x4c,
+		0xfd, 0x5e, 0x61, 0x75, 0x41, 0x52, 0x78, 0x0a, 0x32, 0xee, 0x60, 0xd3, 0x99, 0xfe, 0x7d, 0x9c,
+		0xde, 0xa3, 0x21, 0x16, 0x68, 0xea, 0x3d, 0xb0, 0xf8, 0xbb, 0xc2, 0x02, 0x3e, 0x2a, 0xd2, 0x8d,
+		0xc2, 0x09, 0x4c, 0x67, 0x4e, 0xef, 0x17, 0xdd, 0x28, 0x94, 0xbf, 0x90, 0xd6, 0xa4, 0x31, 0xbf,
+		0x33, 0x8b, 0xbf, 0x27, 0x5a, 0x93, 0xe2, 0x13, 0x31, 0xc2, 0x19, 0x41, 0x67, 0x1e, 0xbf, 0x20,
+		0xc4, 0x08, 0x25, 0x04, 0x85, 0x75, 0x40, 0xad, 0xd9, 0x40, 0x67, 0x7e, 0x1f, 0xe0, 0xfc, 0xc6,
+		0x5a, 0x92, 0x81, 0xc2, 0xb3, 0x70, 0x28, 0x3a, 0x13, 0xe8, 0xcc, 0xf5, 0x83, 0x3f, 0x08, 0xcd,
+		0xdd, 0xfc, 0x89, 0x40, 0x61, 0x13, 0x26, 0xa2, 0xb2, 0x80, 0xce, 0x6c, 0x3f, 0xf4, 0x83, 0x60,
+		0xe0, 0xf6, 0x27, 0x01, 0x85, 0x39, 0x00, 0x6f, 0x00, 0xee, 0xcc, 0xeb, 0x23, 0x9c, 0x97, 0x8f,
+		0x88, 0x74, 0x0d, 0x3e, 0xfe, 0x76, 0xa6, 0xbf, 0x26, 0xba, 0x06, 0xa7, 0x20, 0x5d, 0x43, 0x0c,
+		0xbd, 0x9d, 0xa9, 0x3f, 0x2a, 0xba, 0x86, 0x20, 0x21, 0x9e, 0xed, 0x1b, 0xdd, 0x3a, 0x73, 0xf8,
+		0x84, 0xf0, 0x6c, 0x1f, 0x55, 0x61, 0x15, 0xc6, 0x5a, 0x06, 0xc4, 0xce, 0xac, 0x7e, 0x91, 0xb3,
+		0xca, 0x86, 0xc7, 0x43, 0xff, 0xe0, 0xc5, 0x07, 0xc3, 0xce, 0xdc, 0x3e, 0x19, 0x1a, 0xbc, 0xf8,
+		0x58, 0x58, 0x38, 0x0b, 0x69, 0xbd, 0x59, 0xaf, 0x93, 0xce, 0x83, 0xda, 0x9f, 0x0d, 0xcc, 0xfd,
+		0xb7, 0x1f, 0x72, 0xeb, 0x08, 0x82, 0xc2, 0x29, 0xe8, 0xc3, 0x8d, 0x2d, 0x5c, 0xed, 0x44, 0xf9,
+		0xdd, 0x1f, 0x8a, 0x80, 0x49, 0xb0, 0x0b, 0x4f, 0x01, 0xb0, 0xa5, 0x11, 0xba, 0x19, 0xd8, 0x81,
+		0xf6, 0xbf, 0xff, 0x90, 0x1f, 0xc6, 0xf1, 0x48, 0x3c, 0x06, 0xec, 0x68, 0x4f, 0x7b, 0x06, 0xdf,
+		0x0b, 0x32, 0xa0, 0x2d, 0xf2, 0x18, 0x0c, 0x90, 0x23, 0x92, 0x8e, 0x5a, 0xeb, 0x44, 0xfd, 0x27,
+		0x9c, 0x5a, 0xe0, 0x13, 0x83, 0x35, 0x0c, 0x0b, 0x3b, 0x6a, 0xcd, 0xee, 0x44, 0xfb, 0x3f, 0x38,
+		0xad, 0x4b, 0x40, 0x88, 0x2b, 0xaa, 0xed, 0x74, 0xa3, 0xf7, 0x9f, 0x0a, 0x62, 0x41, 0x40, 0x84,
+		0x26, 0xff, 0xef, 0xe2, 0xbd, 0x4e, 0xb4, 0xdf, 0x17, 0x42, 0x73, 0xfc, 0xc2, 0x13, 0x90, 0x21,
+		0xff, 0xb2, 0x13, 0x76, 0x1d, 0x88, 0xff, 0x27, 0x27, 0xf6, 0x28, 0x48, 0xcd, 0xb6, 0x53, 0x75,
+		0xb4, 0xce, 0xc6, 0xbe, 0xc1, 0x5b, 0x5a, 0xe0, 0x17, 0xe6, 0x60, 0xd0, 0x76, 0xaa, 0xd5, 0x26,
+		0xcf, 0x4f, 0x3b, 0x90, 0xff, 0xaf, 0x1f, 0xba, 0x4b, 0x16, 0x2e, 0x0d, 0x69, 0xed, 0x2b, 0xbb,
+		0x8e, 0x69, 0xd0, 0x0d, 0x8f, 0x4e, 0x1c, 0x7e, 0xc0, 0x39, 0xf8, 0x48, 0x0a, 0xf3, 0x30, 0x44,
+		0x74, 0xb1, 0xb0, 0x89, 0xe9, 0xee, 0x54, 0x07, 0x16, 0x7f, 0xc6, 0x0d, 0x10, 0x20, 0x2a, 0xfe,
+		0xd4, 0x57, 0x5e, 0x9b, 0x92, 0xbe, 0xfe, 0xda, 0x94, 0xf4, 0xcd, 0xd7, 0xa6, 0xa4, 0xf7, 0x7e,
+		0x6b, 0xea, 0xc0, 0xd7, 0xbf, 0x35, 0x75, 0xe0, 0xf7, 0xbf, 0x35, 0x75, 0x20, 0x7a, 0x95, 0x18,
+		0x16, 0x8d, 0x45, 0x83, 0xad, 0x0f, 0x3f, 0x2f, 0xd7, 0x34, 0x67, 0xa7, 0xb9, 0x35, 0x5b, 0x31,
+		0x1a, 0x74, 0x19, 0xd7, 0x5b, 0xad, 0x75, 0x27, 0x39, 0xf0, 0xb6, 0x24, 0x4c, 0x56, 0x0c, 0xbb,
+		0x61, 0xd8, 0x65, 0xb6, 0xde, 0xcb, 0x3e, 0x18, 0x43, 0x34, 0xe4, 0x2f, 0xea, 0x62, 0xd1, 0xf7,
+		0x3c, 0x8c, 0x50, 0xd5, 0xe9, 0x72, 0x17, 0xf5, 0xb6, 0x8e, 0x01, 0xe2, 0xab, 0xbf, 0xd7, 0x47,
+		0xb5, 0x1e, 0x76, 0x09, 0xe9, 0xee, 0xfd, 0x26, 0x4c, 0x68, 0x0d, 0xb3, 0x8e, 0xe9, 0x32, 0x7f,
+		0xd9, 0x2d, 0xeb, 0xcc, 0xef, 0x6b, 0x9c, 0xdf, 0xb8, 0x47, 0xbe, 0x24, 0xa8, 0x0b, 0xcb, 0x30,
+		0x46, 0xce, 0x6c, 0x98, 0x01, 0x96, 0x1d, 0x9a, 0x45, 0x08, 0x98, 0xe5, 0x94, 0x2e, 0xb7, 0xe2,
+		0x53, 0x71, 0x4d, 0xf3, 0xfc, 0x5d, 0x3e, 0xcb, 0x5b, 0xb8, 0x86, 0xf5, 0x07, 0x74, 0xec, 0x5c,
+		0x31, 0xac, 0x5d, 0x6e, 0xde, 0x07, 0x58, 0x55, 0xfd, 0xf4, 0xcf, 0xc3, 0xf0, 0x8e, 0x24, 0x4c,
+		0xb1, 0x82, 0x13, 0x5b, 0xaa, 0x8d, 0x4f, 0x5c, 0x7e, 0x68, 0x0b, 0x3b, 0xea, 0x43, 0x27, 0x2a,
+		0x86, 0xa6, 0xf3, 0x96, 0x18, 0xe7, 0xed, 0x42, 0xca, 0x67, 0x79, 0x79, 0x3e, 0x72, 0x99, 0x5e,
+		0x5e, 0x84, 0xd4, 0xbc, 0xa1, 0xe9, 0x64, 0xbf, 0xa1, 0x8a, 0x75, 0xa3, 0xc1, 0x4f, 0xe1, 0xb1,
+		0x0f, 0x74, 0x07, 0xf4, 0xab, 0x0d, 0xa3, 0xa9, 0x3b, 0x6c, 0x87, 0xa2, 0x38, 0xf8, 0x95, 0xeb,
+		0xd3, 0x07, 0xfe, 0xe0, 0xfa, 0x74, 0x72, 0x49, 0x77, 0x14, 0x5e, 0x54, 0x48, 0x7d, 0xe7, 0xe3,
+		0xd3, 0x92, 0x7c, 0x01, 0x06, 0x16, 0x70, 0x65, 0x3f, 0xbc, 0x16, 0x70, 0x25, 0xc4, 0xeb, 0x5e,
+		0x48, 0x2f, 0xe9, 0x0e, 0x3b, 0x27, 0x79, 0x14, 0x92, 0x9a, 0xce, 0x8e, 0xde, 0x84, 0xea, 0x27,
+		0x70, 0x82, 0xba, 0x80, 0x2b, 0x2e, 0x6a, 0x15, 0x57, 0x72, 0x52, 0x2b, 0x7b, 0x02, 0x2f, 0x2e,
+		0xfc, 0xfe, 0x7f, 0x99, 0x3a, 0xf0, 0xca, 0x6b, 0x53, 0x07, 0x62, 0x5b, 0xc2, 0xdf, 0x07, 0xb8,
+		0x89, 0x79, 0x13, 0xd8, 0xd5, 0x5d, 0xb6, 0x47, 0xe2, 0x36, 0xc3, 0xc7, 0xfb, 0x41, 0xe6, 0x38,
+		0xb6, 0xa3, 0xee, 0x6a, 0x7a, 0xcd, 0x6d, 0x09, 0xb5, 0xe9, 0xec, 0xbc, 0xc4, 0x9b, 0xe2, 0x10,
+		0x6f, 0x0a, 0x8e, 0xd3, 0xbe, 0x35, 0xf2, 0xf1, 0xbd, 0x2b, 0xdf, 0xa1, 0xcd, 0xe5, 0x8f, 0x25,
+		0x01, 0x6d, 0x38, 0xea, 0x2e, 0x9e, 0x6b, 0x3a, 0x3b, 0x86, 0xa5, 0xbd, 0xc4, 0x62, 0xd9, 0x19,
+		0x80, 0x86, 0x7a, 0xb5, 0xec, 0x18, 0xbb, 0x58, 0xb7, 0xa9, 0x69, 0x06, 0x4f, 0x4e, 0xce, 0x46,
+		0xf8, 0xc7, 0x2c, 0x69, 0x3a, 0x92, 0x2f, 0x5f, 0xdd, 0xa4, 0xb8, 0xe8, 0x12, 0xb0, 0x73, 0x13,
+		0xe5, 0xba, 0x66, 0x3b, 0xfc, 0x30, 0xf6, 0xa9, 0xd9, 0x68, 0x75, 0x66, 0x5b, 0x6b, 0x9e, 0xbd,
+		0xa4, 0xd6, 0xb5, 0xaa, 0xea, 0x18, 0x96, 0x7d, 0xfe, 0x80, 0x92, 0xa1, 0xac, 0x96, 0x35, 0xdb,
+		0x41, 0x9b, 0x90, 0xa9, 0x62, 0x7d, 0x8f, 0xb1, 0x4d, 0xbe, 0x3e, 0xb6, 0x69, 0xc2, 0x89, 0x72,
+		0x7d, 0x0e, 0x90, 0xea, 0xc7, 0x13, 0xb7, 0x8f, 0xd8, 0x21, 0xca, 0x18, 0xf6, 0x01, 0xce, 0xf4,
+		0xb2, 0xc4, 0x98, 0x1a, 0x06, 0xe5, 0xef, 0x06, 0xf0, 0xea, 0x24, 0x97, 0x00, 0xd5, 0x6a, 0xd5,
+		0xc2, 0xb6, 0x4d, 0xf7, 0xf4, 0x32, 0x8a, 0xf8, 0x2c, 0x8c, 0xfd, 0xbb, 0x2f, 0x3c, 0x30, 0x1c,
+		0xe0, 0x58, 0x1c, 0x02, 0xb8, 0xec, 0x92, 0x1e, 0xff, 0xa8, 0x04, 0x63, 0x2d, 0x35, 0x22, 0x19,
+		0xa6, 0xe6, 0x2e, 0x6e, 0x9e, 0x5f, 0x53, 0x96, 0x9e, 0x9f, 0x23, 0x27, 0xeb, 0xcb, 0xec, 0x5c,
+		0xff, 0xea, 0xc6, 0x7a, 0x69, 0x7e, 0xe9, 0xdc, 0x52, 0x69, 0x21, 0x7b, 0x00, 0x4d, 0xc3, 0xe1,
+		0x08, 0x9c, 0x85, 0xd2, 0x72, 0x69, 0x71, 0x6e, 0x93, 0xdc, 0x62, 0xb8, 0x1d, 0x8e, 0x46, 0x32,
+		0x71, 0x51, 0x12, 0x31, 0x28, 0x4a, 0xc9, 0x45, 0x49, 0x16, 0xcf, 0xc5, 0x76, 0x8c, 0xfb, 0xdb,
+		0x76, 0x8c, 0xab, 0x6e, 0x0f, 0x08, 0x76, 0x91, 0x3f, 0x93, 0x60, 0x92, 0x45, 0x4b, 0x6f, 0x14,
+		0x50, 0xf5, 0xbd, 0x98, 0xab, 0x9d, 0x31, 0x01, 0xea, 0x71, 0x48, 0xce, 0xe9, 0x7b, 0x68, 0x92,
+		0xa5, 0xc8, 0xe5, 0xa6, 0x55, 0xe7, 0x61, 0x65, 0x80, 0x7c, 0x5f, 0xb4, 0xea, 0x24, 0xdc, 0x88,
+		0xd3, 0xfc, 0x64, 0x47, 0x9e, 0x7d, 0x14, 0x52, 0xdf, 0xff, 0xc4, 0xf4, 0x81, 0xe2, 0x6e, 0x58,
+		0xa5, 0x2f, 0x75, 0x1c, 0x14, 0xd3, 0x73, 0xfa, 0x1e, 0x8d, 0x27, 0xeb, 0xd2, 0xf3, 0x7d, 0x54,
+		0x21, 0xb1, 0x0f, 0x3a, 0x15, 0xde, 0x07, 0x7d, 0x16, 0xd7, 0xeb, 0x4f, 0xeb, 0xc6, 0x15, 0x7d,
+		0x33, 0xa0, 0xf7, 0xfb, 0x13, 0x30, 0xd5, 0x32, 0xfa, 0xf1, 0x44, 0x21, 0xee, 0x5e, 0x6b, 0x01,
+		0xd2, 0x0b, 0x1c, 0x85, 0xf8, 0x98, 0x8d, 0x2b, 0x86, 0x5e, 0x65, 0x1d, 0x36, 0xa9, 0x88, 0x4f,
+		0xa2, 0xaa, 0xae, 0xea, 0x86, 0xcd, 0x0f, 0xd3, 0xb3, 0x8f, 0xe2, 0x87, 0xa5, 0xde, 0x86, 0xfd,
+		0x61, 0x51, 0x93, 0x50, 0xf3, 0xa1, 0x8e, 0x3b, 0xc3, 0xbb, 0x44, 0x4b, 0x57, 0x89, 0xc0, 0xee,
+		0x70, 0xb7, 0x56, 0xf9, 0x85, 0x04, 0x4c, 0x87, 0xad, 0x42, 0xb2, 0x2f, 0xdb, 0x51, 0x1b, 0x66,
+		0x9c, 0x59, 0xce, 0x42, 0x66, 0x53, 0xe0, 0xf4, 0x6c, 0x97, 0x6b, 0x3d, 0xda, 0x65, 0xc4, 0xad,
+		0x4a, 0x18, 0xe6, 0x64, 0x97, 0x86, 0x71, 0xf5, 0xd8, 0x97, 0x65, 0x3e, 0x93, 0x82, 0xa3, 0xf4,
+		0xb6, 0x95, 0xd5, 0xd0, 0x74, 0xe7, 0x44, 0xc5, 0xda, 0x33, 0x1d, 0x9a, 0x7f, 0x19, 0xdb, 0xdc,
+		0x2e, 0x63, 0x5e, 0xf1, 0x2c, 0x2b, 0x8e, 0xe9, 0x2d, 0xdb, 0xd0, 0xb7, 0x4e, 0xe8, 0x88, 0x45,
+		0x1c, 0xc3, 0x51, 0xeb, 0xdc, 0x52, 0xec, 0x83, 0x40, 0xd9, 0x0d, 0xad, 0x04, 0x83, 0x6a, 0xe2,
+		0x72, 0x56, 0x1d, 0xab, 0xdb, 0xec, 0xa0, 0x7b, 0x92, 0x76, 0xa2, 0x34, 0x01, 0xd0, 0x33, 0xed,
+		0x13, 0xd0, 0xa7, 0x36, 0xd9, 0x89, 0x8c, 0x24, 0xe9, 0x5d, 0xf4, 0x43, 0x7e, 0x1a, 0x06, 0xf8,
+		0xbe, 0x30, 0x39, 0x93, 0xb0, 0x8b, 0xf7, 0x68, 0x3d, 0x43, 0x0a, 0xf9, 0x17, 0xcd, 0x42, 0x1f,
+		0x15, 0x9e, 0x0f, 0x1a, 0xb9, 0xd9, 0x16, 0xe9, 0x67, 0xa9, 0x90, 0x0a, 0x43, 0x93, 0x2f, 0x40,
+		0x7a, 0xc1, 0x68, 0x68, 0xba, 0x11, 0xe4, 0x96, 0x61, 0xdc, 0xa8, 0xcc, 0x66, 0x93, 0xa7, 0x0d,
+		0x0a, 0xfb, 0x20, 0xc7, 0x3f, 0xd9, 0xc5, 0x07, 0x7e, 0xaa, 0x84, 0x7f, 0xc9, 0xf3, 0x30, 0x40,
+		0x79, 0xaf, 0x99, 0xe4, 0x86, 0x85, 0x7b, 0xc6, 0x34, 0xc3, 0xaf, 0xc1, 0x71, 0xf6, 0x09, 0x4f,
+		0x58, 0x04, 0xa9, 0xaa, 0xea, 0xa8, 0x5c, 0x6f, 0xfa, 0xbf, 0xfc, 0x24, 0xa4, 0x39, 0x13, 0x1b,
+		0x9d, 0x84, 0xa4, 0x61, 0xda, 0xfc, 0x5c, 0x48, 0x3e, 0x4e, 0x95, 0x35, 0xb3, 0x98, 0x22, 0x09,
+		0x87, 0x42, 0x90, 0x8b, 0x4a, 0x6c, 0x20, 0x3d, 0xe3, 0x0b, 0xa4, 0xbe, 0x26, 0xf7, 0xfd, 0xcb,
+		0x9a, 0xb4, 0xc5, 0x1d, 0x5c, 0x67, 0xf9, 0x44, 0x02, 0xa6, 0x7c, 0xa5, 0x97, 0xb1, 0x45, 0x16,
+		0x47, 0x98, 0x37, 0x72, 0x6f, 0x41, 0x3e, 0x21, 0x79, 0x79, 0x8c, 0xbb, 0x3c, 0x01, 0xc9, 0x39,
+		0xd3, 0x24, 0xf7, 0xff, 0xe8, 0x77, 0xc5, 0x60, 0xfe, 0x92, 0x52, 0xdc, 0x6f, 0x52, 0x66, 0x1b,
+		0xdb, 0xce, 0x15, 0xd5, 0x72, 0xef, 0x06, 0x8a, 0x6f, 0xf9, 0x31, 0xc8, 0xcc, 0x1b, 0xba, 0x8d,
+		0x75, 0xbb, 0x49, 0xfb, 0xe0, 0x56, 0xdd, 0xa8, 0xec, 0x72, 0x0e, 0xec, 0x83, 0x18, 0x5c, 0x35,
+		0x4d, 0x4a, 0x99, 0x52, 0xc8, 0xbf, 0x2c, 0xc5, 0x2b, 0x6e, 0xc4, 0x9a, 0xe8, 0xb1, 0xde, 0x4d,
+		0xc4, 0x95, 0x74, 0x6d, 0xf4, 0x7f, 0x25, 0x38, 0xd2, 0xda, 0xa1, 0x76, 0xf1, 0x9e, 0xdd, 0x6b,
+		0x7f, 0x7a, 0x0e, 0x32, 0xeb, 0xf4, 0x82, 0xfe, 0xd3, 0x78, 0x0f, 0xe5, 0x61, 0x00, 0x57, 0x4f,
+		0x9e, 0x3a, 0xf5, 0xd0, 0x63, 0xcc, 0xdb, 0xcf, 0x1f, 0x50, 0x04, 0x00, 0x4d, 0x41, 0xc6, 0xc6,
+		0x15, 0xf3, 0xe4, 0xa9, 0xd3, 0xbb, 0x0f, 0x31, 0xf7, 0x22, 0x59, 0x8f, 0x0b, 0x2a, 0xa4, 0x89,
+		0xd6, 0xdf, 0xf9, 0xc4, 0xb4, 0x54, 0xec, 0x83, 0xa4, 0xdd, 0x6c, 0xdc, 0x52, 0x1f, 0xf9, 0x50,
+		0x1f, 0xcc, 0xf8, 0x29, 0x69, 0xa4, 0x72, 0x33, 0x11, 0x6e, 0x83, 0xac, 0xcf, 0x06, 0x14, 0x23,
+		0x26, 0x27, 0x6d, 0x6b, 0x49, 0xf9, 0xd7, 0x25, 0x18, 0x72, 0xd3, 0x23, 0xf2, 0x16, 0xc3, 0x59,
+		0x7f, 0xce, 0xc3, 0xbb, 0xcd, 0xe1, 0xd9, 0x70, 0x5d, 0x5e, 0x1a, 0xa7, 0xf8, 0xd0, 0xd1, 0xa3,
+		0xd4, 0x11, 0x4d, 0xc3, 0xe6, 0xf7, 0xc5, 0x3a, 0x90, 0xba, 0xc8, 0xe4, 0xb4, 0x1f, 0x8d, 0x70,
+		0xe5, 0xcb, 0x86, 0x43, 0x8e, 0x3f, 0x98, 0xc6, 0x15, 0x7e, 0x0b, 0x37, 0xa9, 0x64, 0x69, 0xc9,
+		0x25, 0x5a, 0xb0, 0x4e, 0xe0, 0x44, 0xe8, 0x8c, 0xcb, 0x25, 0x98, 0xd2, 0x91, 0x20, 0x20, 0x3e,
+		0xc9, 0x25, 0x35, 0xb3, 0xb9, 0x55, 0x16, 0x11, 0x83, 0x5c, 0xf3, 0x8b, 0xe8, 0xff, 0xc2, 0x3f,
+		0x78, 0x04, 0xe8, 0x37, 0x9b, 0x5b, 0xc4, 0x5b, 0x6e, 0x87, 0xa1, 0x08, 0x61, 0x06, 0x2f, 0x7b,
+		0x72, 0xd0, 0x77, 0x21, 0xb8, 0x06, 0x65, 0xd3, 0xd2, 0x0c, 0x4b, 0x73, 0xf6, 0x68, 0xce, 0x9a,
+		0x54, 0xb2, 0xa2, 0x60, 0x9d, 0xc3, 0xe5, 0x5d, 0x18, 0xdd, 0xa0, 0xd3, 0x54, 0x4f, 0xf2, 0x53,
+		0x9e, 0x7c, 0x52, 0x67, 0xf9, 0x62, 0x25, 0x4b, 0xb4, 0x48, 0x56, 0x7c, 0x26, 0xd6, 0x3b, 0x1f,
+		0xed, 0xdd, 0x3b, 0x83, 0x59, 0xe1, 0x9f, 0x4e, 0xc2, 0x91, 0x70, 0x61, 0x20, 0x7c, 0x75, 0xeb,
+		0x98, 0x9d, 0xb2, 0x89, 0x7c, 0xfb, 0x41, 0x35, 0xdf, 0x21, 0x8c, 0xe6, 0x3b, 0x76, 0x21, 0xf9,
+		0x31, 0x18, 0x26, 0xa7, 0x34, 0x37, 0xb0, 0x73, 0x1e, 0xab, 0x55, 0x6c, 0x05, 0x47, 0xdd, 0x61,
+		0x31, 0xea, 0x22, 0x48, 0xd1, 0xa1, 0x95, 0x8d, 0x3a, 0xf4, 0x7f, 0x79, 0x07, 0x52, 0x84, 0xd4,
+		0x1b, 0x91, 0x39, 0x05, 0xfd, 0x20, 0xd0, 0xad, 0x3d, 0x07, 0xdb, 0x22, 0xa5, 0xa5, 0x1f, 0xe8,
+		0x11, 0x31, 0xae, 0x26, 0xdb, 0x8f, 0xab, 0xdc, 0x11, 0xf9, 0xe8, 0x5a, 0x87, 0x81, 0x22, 0x09,
+		0xc5, 0x4b, 0x0b, 0xae, 0x20, 0x92, 0x27, 0x08, 0x5a, 0x81, 0x51, 0x53, 0xb5, 0x1c, 0x7a, 0xd7,
+		0x65, 0x87, 0x6a, 0xc1, 0x7d, 0x7d, 0xba, 0xb5, 0xe7, 0x05, 0x94, 0xe5, 0xb5, 0x0c, 0x9b, 0x7e,
+		0xa0, 0xfc, 0xc7, 0x29, 0xe8, 0xe7, 0xc6, 0x78, 0x02, 0x06, 0xb8, 0x59, 0xb9, 0x77, 0x1e, 0x9d,
+		0x6d, 0x1d, 0x98, 0x66, 0xdd, 0x01, 0x84, 0xf3, 0x13, 0x34, 0xe8, 0x6e, 0x48, 0x57, 0x76, 0x54,
+		0x4d, 0x2f, 0x6b, 0x55, 0xb1, 0x62, 0xf0, 0xda, 0xf5, 0xe9, 0x81, 0x79, 0x02, 0x5b, 0x5a, 0x50,
+		0x06, 0x68, 0xe1, 0x52, 0x95, 0x64, 0x02, 0x3b, 0x58, 0xab, 0xed, 0x38, 0xbc, 0x87, 0xf1, 0x2f,
+		0xf2, 0x28, 0x0c, 0x71, 0x08, 0x7e, 0x13, 0x32, 0xdf, 0xb2, 0x6e, 0xe3, 0x26, 0x7b, 0xc5, 0x34,
+		0xa9, 0xf8, 0xbd, 0x7f, 0x34, 0x2d, 0x29, 0x94, 0x02, 0xcd, 0xc3, 0x70, 0x5d, 0xb5, 0x9d, 0x32,
+		0x1d, 0xc1, 0x48, 0xf5, 0x7d, 0x7c, 0xda, 0xdc, 0x62, 0x10, 0x6e, 0x58, 0x2e, 0xfa, 0x20, 0xa1,
+		0x62, 0xa0, 0x2a, 0xb9, 0xa8, 0x45, 0x99, 0x90, 0xc3, 0xa9, 0x9a, 0xc3, 0x72, 0xab, 0x7e, 0x6a,
+		0xf7, 0x11, 0x02, 0x9f, 0xa7, 0x60, 0x9a, 0x61, 0x1d, 0x86, 0x0c, 0xbd, 0x7b, 0x45, 0x51, 0xd8,
+		0xa9, 0xe2, 0x34, 0x01, 0xd0, 0xc2, 0x7b, 0x60, 0xd4, 0x8b, 0x8f, 0x0c, 0x25, 0xcd, 0xb8, 0x78,
+		0x60, 0x8a, 0xf8, 0x20, 0x4c, 0xe8, 0xf8, 0xaa, 0x53, 0xf6, 0xc0, 0x0c, 0x3b, 0x43, 0xb1, 0x11,
+		0x29, 0xbb, 0x14, 0xa4, 0xb8, 0x0b, 0x46, 0x2a, 0xc2, 0xf8, 0x0c, 0x17, 0x28, 0xee, 0xb0, 0x0b,
+		0xa5, 0x68, 0x93, 0x90, 0x56, 0x4d, 0x93, 0x21, 0x0c, 0xf2, 0xf8, 0x68, 0x9a, 0xb4, 0xe8, 0x38,
+		0x8c, 0x51, 0x1d, 0x2d, 0x6c, 0x37, 0xeb, 0x0e, 0x67, 0x32, 0x44, 0x71, 0x46, 0x49, 0x81, 0xc2,
+		0xe0, 0x14, 0xf7, 0x0e, 0x18, 0xc6, 0x97, 0xb5, 0x2a, 0xd6, 0x2b, 0x98, 0xe1, 0x0d, 0x53, 0xbc,
+		0x21, 0x01, 0xa4, 0x48, 0xf7, 0x82, 0x1b, 0xf7, 0xca, 0x22, 0x26, 0x8f, 0x30, 0x7e, 0x02, 0x3e,
+		0xc7, 0xc0, 0x72, 0x0e, 0x52, 0x0b, 0xaa, 0xa3, 0x92, 0x04, 0xc3, 0xb9, 0xca, 0x06, 0x9a, 0x21,
+		0x85, 0xfc, 0x2b, 0x7f, 0x27, 0x01, 0xa9, 0x4b, 0x86, 0x83, 0xd1, 0xc3, 0xbe, 0x04, 0x70, 0x24,
+		0xca, 0x9f, 0x37, 0xb4, 0x9a, 0x8e, 0xab, 0x2b, 0x76, 0xcd, 0xf7, 0x50, 0x82, 0xe7, 0x4e, 0x89,
+		0x80, 0x3b, 0x4d, 0x40, 0x9f, 0x65, 0x34, 0xf5, 0xaa, 0x38, 0x90, 0x4b, 0x3f, 0x50, 0x09, 0xd2,
+		0xae, 0x97, 0xa4, 0x3a, 0x79, 0xc9, 0x28, 0xf1, 0x12, 0xe2, 0xc3, 0x1c, 0xa0, 0x0c, 0x6c, 0x71,
+		0x67, 0x29, 0x42, 0xc6, 0x0d, 0x5e, 0xb9, 0xbe, 0x1e, 0x1c, 0xd6, 0x23, 0x23, 0x83, 0x89, 0xdb,
+		0xf6, 0xae, 0xf1, 0x98, 0xc7, 0x65, 0xdd, 0x02, 0x6e, 0xbd, 0x80, 0x5b, 0xf1, 0x47, 0x1b, 0x06,
+		0xa8, 0x5e, 0x9e, 0x5b, 0xb1, 0x87, 0x1b, 0x8e, 0x90, 0xf3, 0x55, 0x35, 0x5d, 0x75, 0x9a, 0x16,
+		0xe6, 0x9e, 0xe7, 0x01, 0xc8, 0xf5, 0x9b, 0x7e, 0xe6, 0xc9, 0x3e, 0xbb, 0x49, 0xd1, 0x76, 0x4b,
+		0xc4, 0xd9, 0x2d, 0xb9, 0x7f, 0xbb, 0xcd, 0x01, 0xb8, 0xc2, 0xd8, 0xfc, 0x2e, 0x7d, 0x44, 0xc6,
+		0xc0, 0x44, 0xdc, 0xd0, 0x6a, 0xbc, 0xa3, 0xfa, 0x88, 0xe4, 0xff, 0x2c, 0x41, 0xc6, 0x2d, 0x47,
+		0x73, 0x30, 0x2c, 0xe4, 0x2a, 0x6f, 0xd7, 0xd5, 0x1a, 0xf7, 0x9d, 0xa3, 0xb1, 0xc2, 0x9d, 0xab,
+		0xab, 0x35, 0x65, 0x90, 0xcb, 0x43, 0x3e, 0xa2, 0xdb, 0x21, 0x11, 0xd3, 0x0e, 0x81, 0x86, 0x4f,
+		0xee, 0xaf, 0xe1, 0x03, 0x4d, 0x94, 0x0a, 0x37, 0xd1, 0xe7, 0x13, 0x74, 0x32, 0x63, 0x1a, 0xb6,
+		0x5a, 0xff, 0x51, 0xf4, 0x88, 0xc3, 0x90, 0x31, 0x8d, 0x7a, 0x99, 0x95, 0xb0, 0x83, 0xea, 0x69,
+		0xd3, 0xa8, 0x2b, 0x2d, 0xcd, 0xde, 0x77, 0x93, 0xba, 0x4b, 0xff, 0x4d, 0xb0, 0xda, 0x40, 0xd8,
+		0x6a, 0x16, 0x0c, 0x31, 0x53, 0xf0, 0xb1, 0xec, 0x41, 0x62, 0x03, 0xf2, 0x5f, 0x4e, 0x6a, 0x1d,
+		0x7b, 0x99, 0xd8, 0x0c, 0x53, 0xe9, 0xdf, 0x71, 0x29, 0x58, 0xe8, 0xcf, 0x25, 0xe2, 0x28, 0x98,
+		0xdb, 0x29, 0x1c, 0x4f, 0xfe, 0x79, 0x09, 0x60, 0x99, 0x58, 0x96, 0xea, 0x4b, 0x46, 0x21, 0x9b,
+		0x8a, 0x50, 0x0e, 0xd4, 0x3c, 0x15, 0xd7, 0x68, 0xbc, 0xfe, 0x21, 0xdb, 0x2f, 0xf7, 0x3c, 0x0c,
+		0x7b, 0xce, 0x68, 0x63, 0x21, 0xcc, 0x54, 0x9b, 0xac, 0x7a, 0x03, 0x3b, 0xca, 0xd0, 0x65, 0xdf,
+		0x97, 0xfc, 0x5b, 0x12, 0x64, 0xa8, 0x4c, 0xe4, 0x26, 0x70, 0xa0, 0x0d, 0xa5, 0xfd, 0xb7, 0xe1,
+		0x51, 0x00, 0xc6, 0x86, 0xec, 0x36, 0x73, 0xcf, 0xca, 0x50, 0x08, 0xd9, 0x43, 0x46, 0xa7, 0x5d,
+		0x83, 0x27, 0xdb, 0x1b, 0x5c, 0x64, 0xdd, 0xdc, 0xec, 0xb7, 0xc1, 0x00, 0x7d, 0x7b, 0xea, 0xaa,
+		0xcd, 0x13, 0x69, 0xf2, 0xe0, 0xc4, 0xe6, 0x55, 0x5b, 0x7e, 0x01, 0x06, 0x36, 0xaf, 0xb2, 0xb5,
+		0x91, 0xc3, 0x90, 0xb1, 0x0c, 0x83, 0x8f, 0xc9, 0x2c, 0x17, 0x4a, 0x13, 0x00, 0x1d, 0x82, 0xc4,
+		0x7a, 0x40, 0xc2, 0x5b, 0x0f, 0xf0, 0x16, 0x34, 0x92, 0x5d, 0x2d, 0x68, 0x1c, 0xff, 0x8f, 0x12,
+		0x0c, 0xfa, 0xe2, 0x03, 0x7a, 0x08, 0x0e, 0x16, 0x97, 0xd7, 0xe6, 0x9f, 0x2e, 0x2f, 0x2d, 0x94,
+		0xcf, 0x2d, 0xcf, 0x2d, 0x7a, 0x57, 0xb1, 0xf2, 0x87, 0x5e, 0xbd, 0x36, 0x83, 0x7c, 0xb8, 0x17,
+		0x75, 0xba, 0xa2, 0x84, 0x4e, 0xc0, 0x44, 0x90, 0x64, 0xae, 0xb8, 0x41, 0xee, 0x65, 0x49, 0xf9,
+		0x83, 0xaf, 0x5e, 0x9b, 0x19, 0xf3, 0x51, 0xcc, 0x6d, 0xd9, 0x58, 0x77, 0x5a, 0x09, 0xe6, 0xd7,
+		0x56, 0x56, 0x96, 0x36, 0xb3, 0x89, 0x16, 0x02, 0x1e, 0xb0, 0xef, 0x85, 0xb1, 0x20, 0xc1, 0xea,
+		0xd2, 0x72, 0x36, 0x99, 0x47, 0xaf, 0x5e, 0x9b, 0x19, 0xf1, 0x61, 0xaf, 0x6a, 0xf5, 0x7c, 0xfa,
+		0x5d, 0x9f, 0x9c, 0x3a, 0xf0, 0xe9, 0x5f, 0x9a, 0x92, 0x88, 0x66, 0xc3, 0x81, 0x18, 0x81, 0xee,
+		0x87, 0xdb, 0x36, 0x96, 0x16, 0x57, 0x4b, 0x0b, 0xe5, 0x95, 0x8d, 0x45, 0xb1, 0xee, 0x2c, 0xb4,
+		0x1b, 0x7d, 0xf5, 0xda, 0xcc, 0x20, 0x57, 0x29, 0x0e, 0x7b, 0x5d, 0x29, 0x5d, 0x5a, 0x23, 0xab,
+		0xd8, 0x0c, 0x7b, 0xdd, 0xc2, 0x97, 0x0d, 0x87, 0x3d, 0x4e, 0xf7, 0x20, 0x4c, 0x46, 0x60, 0xbb,
+		0x8a, 0x8d, 0xbd, 0x7a, 0x6d, 0x66, 0x78, 0xdd, 0xc2, 0xac, 0xff, 0x50, 0x8a, 0x59, 0xc8, 0xb5,
+		0x52, 0xac, 0xad, 0xaf, 0x6d, 0xcc, 0x2d, 0x67, 0x67, 0xf2, 0xd9, 0x57, 0xaf, 0xcd, 0x0c, 0x89,
+		0x60, 0x48, 0x17, 0xf7, 0x5d, 0xcd, 0x6e, 0xe5, 0x8c, 0xe7, 0x67, 0xcf, 0xc0, 0x9d, 0x31, 0x5b,
+		0x45, 0xfc, 0x7b, 0x7f, 0x9b, 0x45, 0xb1, 0x6b, 0xeb, 0xf9, 0x0e, 0xcb, 0xcf, 0x9d, 0xa7, 0x4e,
+		0xfb, 0xdf, 0x88, 0xca, 0xb7, 0x9d, 0xdc, 0xc9, 0xef, 0x96, 0x60, 0xe4, 0xbc, 0x66, 0x3b, 0x86,
+		0xa5, 0x55, 0xd4, 0x3a, 0xbd, 0x80, 0x75, 0xba, 0xdb, 0xd8, 0x1a, 0xea, 0xea, 0x4f, 0x41, 0xff,
+		0x65, 0xb5, 0xce, 0x82, 0x5a, 0x92, 0xbe, 0x20, 0x13, 0xb3, 0xcd, 0xe3, 0x86, 0x36, 0xc1, 0x80,
+		0x91, 0xc9, 0x9f, 0x4b, 0xc0, 0x28, 0xed, 0x0c, 0x36, 0x7b, 0x5b, 0x8c, 0xcc, 0xb1, 0x8a, 0x90,
+		0xb2, 0x54, 0x87, 0x2f, 0x1a, 0x16, 0x67, 0xf9, 0x26, 0xe2, 0xdd, 0x9d, 0x37, 0x06, 0x67, 0xc9,
+		0x3e, 0x23, 0xa5, 0x45, 0x6f, 0x86, 0x34, 0xd9, 0x73, 0xa3, 0x7c, 0xd8, 0xcc, 0x65, 0xae, 0x37,
+		0x3e, 0x37, 0xae, 0x4f, 0x8f, 0xee, 0xa9, 0x8d, 0x7a, 0x41, 0x16, 0x7c, 0x64, 0x65, 0xa0, 0xa1,
+		0x5e, 0x25, 0x22, 0x22, 0x13, 0x46, 0x09, 0xb4, 0xb2, 0xa3, 0xea, 0x35, 0xcc, 0x2a, 0xa1, 0x4b,
+		0xa0, 0xc5, 0xf3, 0x3d, 0x57, 0x72, 0xc8, 0xab, 0xc4, 0xc7, 0x4e, 0x56, 0x86, 0x1b, 0xea, 0xd5,
+		0x79, 0x0a, 0x20, 0x35, 0x16, 0xd2, 0x1f, 0xf8, 0xf8, 0xf4, 0x01, 0xba, 0x31, 0xfb, 0x0d, 0x09,
+		0xc0, 0xb3, 0x18, 0x7a, 0x33, 0x64, 0x2b, 0xee, 0x17, 0xa5, 0x15, 0x5b, 0x8c, 0xf7, 0xc4, 0xb5,
+		0x45, 0xc8, 0xde, 0x6c, 0x6c, 0xfe, 0xfa, 0xf5, 0x69, 0x49, 0x19, 0xad, 0x84, 0x9a, 0xe2, 0x4d,
+		0x30, 0xd8, 0x34, 0xab, 0xaa, 0x83, 0xcb, 0x74, 0x1e, 0x97, 0xe8, 0x38, 0xce, 0x4f, 0x11, 0x5e,
+		0x37, 0xae, 0x4f, 0x23, 0xa6, 0x96, 0x8f, 0x58, 0xa6, 0xa3, 0x3f, 0x30, 0x08, 0x21, 0xf0, 0xe9,
+		0xf4, 0x55, 0x09, 0x06, 0x17, 0x7c, 0x47, 0x23, 0x73, 0x30, 0xd0, 0x30, 0x74, 0x6d, 0x97, 0xfb,
+		0x63, 0x46, 0x11, 0x9f, 0x64, 0x29, 0x94, 0xdd, 0x49, 0x75, 0xf6, 0xc4, 0x52, 0xa8, 0xf8, 0x26,
+		0x54, 0x57, 0xf0, 0x96, 0xad, 0x89, 0xd6, 0x50, 0xc4, 0x27, 0x3a, 0x47, 0x1e, 0xca, 0xa9, 0x34,
+		0xc9, 0x1a, 0x4e, 0xb9, 0x62, 0xe8, 0x8e, 0x5a, 0x71, 0xd8, 0xed, 0xc6, 0xe2, 0xe1, 0x1b, 0xd7,
+		0xa7, 0x6f, 0x63, 0xb2, 0x86, 0x31, 0x64, 0x65, 0x54, 0x80, 0xe6, 0x19, 0x84, 0xd4, 0x50, 0xc5,
+		0x8e, 0xaa, 0xd5, 0xed, 0x1c, 0x3b, 0x63, 0x20, 0x3e, 0x7d, 0xba, 0x7c, 0x76, 0xc0, 0xbf, 0xb0,
+		0x75, 0x0e, 0xb2, 0x86, 0x89, 0xad, 0x40, 0x22, 0x2a, 0x85, 0x6b, 0x0e, 0x63, 0xc8, 0xca, 0xa8,
+		0x00, 0x89, 0x24, 0xd5, 0x81, 0xac, 0x3b, 0x25, 0x2c, 0x9b, 0xcd, 0x2d, 0x6f, 0x3d, 0x6c, 0xa2,
+		0xa5, 0x35, 0xe6, 0xf4, 0xbd, 0xe2, 0xc3, 0x1e, 0xf7, 0x30, 0x9d, 0xfc, 0xb5, 0x2f, 0x3c, 0x30,
+		0xc1, 0x5d, 0xc3, 0x5b, 0x9f, 0x22, 0x8b, 0x53, 0xa3, 0x2e, 0xea, 0x3a, 0xc5, 0x24, 0x69, 0xe7,
+		0x0b, 0xaa, 0x56, 0x17, 0xb7, 0xf4, 0x15, 0xfe, 0x85, 0x0a, 0xd0, 0x6f, 0x3b, 0xaa, 0xd3, 0xb4,
+		0xf9, 0xee, 0xae, 0x1c, 0xe7, 0x6a, 0x45, 0x43, 0xaf, 0x6e, 0x50, 0x4c, 0x85, 0x53, 0xa0, 0x73,
+		0xd0, 0xcf, 0x77, 0xc2, 0xfb, 0x7a, 0xee, 0xdf, 0xf4, 0xc8, 0x03, 0xa3, 0x26, 0x16, 0xa9, 0xe2,
+		0x3a, 0xae, 0xb1, 0xb4, 0x6a, 0x47, 0x25, 0xb3, 0x0f, 0xfa, 0xa8, 0x5e, 0x71, 0xa9, 0xe7, 0x4e,
+		0xc8, 0x2d, 0x15, 0xe6, 0x27, 0x2b, 0xa3, 0x2e, 0x68, 0x83, 0x42, 0xd0, 0xd3, 0x81, 0x33, 0xbc,
+		0xfc, 0xe5, 0xc9, 0x3b, 0xe2, 0xd4, 0xf7, 0xf9, 0xb4, 0x58, 0x9f, 0xf0, 0x51, 0x13, 0xe7, 0x68,
+		0xea, 0x5b, 0x86, 0x4e, 0xaf, 0xd2, 0xf2, 0xfc, 0x9e, 0xcc, 0xef, 0x92, 0x7e, 0xe7, 0x08, 0x63,
+		0xc8, 0xca, 0xa8, 0x0b, 0x3a, 0x4f, 0x21, 0xa8, 0x0a, 0x23, 0x1e, 0x16, 0xed, 0xa8, 0x99, 0x8e,
+		0x1d, 0xf5, 0x76, 0xde, 0x51, 0x0f, 0x86, 0x6b, 0xf1, 0xfa, 0xea, 0xb0, 0x0b, 0x24, 0x64, 0xe8,
+		0x3c, 0x80, 0x17, 0x1e, 0xe8, 0x3a, 0xc5, 0xe0, 0x49, 0xb9, 0x73, 0x8c, 0x11, 0xf3, 0x3d, 0x8f,
+		0x16, 0xbd, 0x15, 0xc6, 0x1b, 0x9a, 0x5e, 0xb6, 0x71, 0x7d, 0xbb, 0xcc, 0x0d, 0x4c, 0x58, 0xd2,
+		0xb7, 0x91, 0x8a, 0xcb, 0xbd, 0xf9, 0xc3, 0x8d, 0xeb, 0xd3, 0x79, 0x1e, 0x42, 0x5b, 0x59, 0xca,
+		0xca, 0x58, 0x43, 0xd3, 0x37, 0x70, 0x7d, 0x7b, 0xc1, 0x85, 0x15, 0x86, 0xde, 0xf5, 0xf1, 0xe9,
+		0x03, 0xbc, 0xbb, 0x1e, 0x90, 0x4f, 0xd3, 0xb5, 0x73, 0xde, 0xcd, 0xb0, 0x4d, 0xe6, 0x24, 0xaa,
+		0xf8, 0xe0, 0xc7, 0x0b, 0x3c, 0x00, 0xeb, 0xe6, 0xaf, 0xfc, 0xe1, 0x8c, 0x24, 0x7f, 0x56, 0x82,
+		0xfe, 0x85, 0x4b, 0xeb, 0xaa, 0x66, 0xa1, 0x25, 0x18, 0xf3, 0x3c, 0x27, 0xd8, 0xc9, 0x8f, 0xdc,
+		0xb8, 0x3e, 0x9d, 0x0b, 0x3b, 0x97, 0xdb, 0xcb, 0x3d, 0x07, 0x16, 0xdd, 0x7c, 0x29, 0x6e, 0xe2,
+		0x1a, 0x60, 0xd5, 0x82, 0x22, 0xb7, 0x4e, 0x6b, 0x43, 0x6a, 0x96, 0x60, 0x80, 0x49, 0x4b, 0xae,
+		0x6f, 0xf7, 0x99, 0xe4, 0x1f, 0xbe, 0x31, 0x30, 0x15, 0xeb, 0xbc, 0x14, 0xdf, 0x5d, 0xc8, 0x24,
+		0x24, 0xf2, 0xfb, 0x12, 0x00, 0x0b, 0x97, 0x2e, 0x6d, 0x5a, 0x9a, 0x59, 0xc7, 0xce, 0xcd, 0xd4,
+		0x7c, 0x13, 0x0e, 0x7a, 0x6a, 0xd9, 0x56, 0x25, 0xa4, 0xfd, 0xcc, 0x8d, 0xeb, 0xd3, 0x47, 0xc2,
+		0xda, 0xfb, 0xd0, 0x64, 0x65, 0xdc, 0x9b, 0x2f, 0x59, 0x95, 0x48, 0xae, 0x55, 0xdb, 0x71, 0xb9,
+		0x26, 0xe3, 0xb9, 0xfa, 0xd0, 0xfc, 0x5c, 0x17, 0x6c, 0x27, 0xda, 0xb4, 0x1b, 0x30, 0xe8, 0x99,
+		0x84, 0x3c, 0x63, 0x96, 0x76, 0xf8, 0xff, 0xdc, 0xc2, 0x72, 0xbc, 0x85, 0x05, 0x19, 0xb7, 0xb2,
+		0x4b, 0x29, 0xff, 0xb9, 0x04, 0xe0, 0xf9, 0xec, 0x4f, 0xa6, 0x8b, 0x91, 0x50, 0xce, 0x03, 0x6f,
+		0x72, 0x5f, 0xa9, 0x1a, 0xa7, 0x0e, 0xd9, 0xf3, 0x67, 0x12, 0xe4, 0xa5, 0x0b, 0x1e, 0x79, 0x7e,
+		0xe2, 0x6d, 0xb0, 0x0e, 0x03, 0x58, 0x77, 0x2c, 0x8d, 0x1a, 0x81, 0xb4, 0xf6, 0x83, 0x71, 0xad,
+		0x1d, 0xa1, 0x13, 0x7d, 0x1d, 0x4a, 0x2c, 0xba, 0x73, 0x36, 0x21, 0x6b, 0xfc, 0x5c, 0x12, 0x72,
+		0x71, 0x94, 0x68, 0x1e, 0x46, 0x2b, 0x16, 0xa6, 0x80, 0xb2, 0x7f, 0xe5, 0xaf, 0x98, 0xf7, 0x32,
+		0xcb, 0x10, 0x82, 0xac, 0x8c, 0x08, 0x08, 0x1f, 0x3d, 0x6a, 0x40, 0xd2, 0x3e, 0xe2, 0x76, 0x04,
+		0xab, 0xcb, 0x3c, 0x4f, 0xe6, 0xc3, 0x87, 0xa8, 0x24, 0xc8, 0x80, 0x8d, 0x1f, 0x23, 0x1e, 0x94,
+		0x0e, 0x20, 0x2f, 0xc2, 0xa8, 0xa6, 0x6b, 0x8e, 0xa6, 0xd6, 0xcb, 0x5b, 0x6a, 0x5d, 0xd5, 0x2b,
+		0xfb, 0xc9, 0x9a, 0x59, 0xc8, 0xe7, 0xd5, 0x86, 0xd8, 0xc9, 0xca, 0x08, 0x87, 0x14, 0x19, 0x00,
+		0x9d, 0x87, 0x01, 0x51, 0x55, 0x6a, 0x5f, 0xd9, 0x86, 0x20, 0xf7, 0x25, 0x78, 0xef, 0x49, 0xc2,
+		0x98, 0x82, 0xab, 0x7f, 0xdd, 0x14, 0xbd, 0x35, 0xc5, 0x0a, 0x00, 0xeb, 0xee, 0x24, 0xc0, 0xe6,
+		0x52, 0xfb, 0x0a, 0x18, 0x19, 0xc6, 0x61, 0xc1, 0x76, 0x7c, 0xed, 0x71, 0x3d, 0x01, 0x43, 0xfe,
+		0xf6, 0xf8, 0x2b, 0x3a, 0x2a, 0xa1, 0x25, 0x2f, 0x12, 0xa5, 0xf8, 0x9b, 0xba, 0x31, 0x91, 0xa8,
+		0xc5, 0x7b, 0xdb, 0x87, 0xa0, 0xbf, 0x93, 0x86, 0xfe, 0x75, 0xd5, 0x52, 0x1b, 0x36, 0xaa, 0xb4,
+		0x64, 0x9a, 0x62, 0xf9, 0xb1, 0xe5, 0xe5, 0x74, 0xbe, 0xda, 0xd1, 0x21, 0xd1, 0xfc, 0x40, 0x44,
+		0xa2, 0xf9, 0x06, 0x18, 0x21, 0xd3, 0x61, 0xdf, 0x11, 0x06, 0x62, 0xed, 0xe1, 0xe2, 0xa4, 0xc7,
+		0x25, 0x58, 0xce, 0x66, 0xcb, 0x97, 0xfc, 0x67, 0x18, 0x06, 0x09, 0x86, 0x17, 0x98, 0x09, 0xf9,
+		0x21, 0x6f, 0x5a, 0xea, 0x2b, 0x94, 0x15, 0x72, 0x38, 0xb7, 0xc4, 0x3e, 0xd0, 0x32, 0xa0, 0x1d,
+		0x77, 0x65, 0xa4, 0xec, 0x99, 0x93, 0xd0, 0x1f, 0xbd, 0x71, 0x7d, 0x7a, 0x92, 0xd1, 0xb7, 0xe2,
+		0xc8, 0xca, 0x98, 0x07, 0x14, 0xdc, 0x1e, 0x01, 0x20, 0x7a, 0x95, 0xd9, 0x49, 0x6c, 0x36, 0xdd,
+		0x39, 0x78, 0xe3, 0xfa, 0xf4, 0x18, 0xe3, 0xe2, 0x95, 0xc9, 0x4a, 0x86, 0x7c, 0x2c, 0x90, 0xff,
+		0xd1, 0x7b, 0xc8, 0x91, 0xcd, 0xba, 0xb1, 0xa5, 0xd6, 0xcb, 0x75, 0xed, 0xc5, 0xa6, 0x56, 0x2d,
+		0xf3, 0xf6, 0x2b, 0x57, 0x54, 0x93, 0x4f, 0x71, 0x94, 0x9e, 0xa7, 0x38, 0x33, 0xac, 0xce, 0x58,
+		0xc6, 0xb2, 0x72, 0x88, 0x95, 0x2d, 0xd3, 0xa2, 0x0d, 0x56, 0x32, 0xaf, 0x9a, 0xe8, 0xe7, 0x25,
+		0x38, 0xe2, 0xf9, 0x61, 0x84, 0x48, 0xf4, 0x35, 0xf2, 0xe2, 0xc5, 0x9e, 0x45, 0xba, 0x23, 0xec,
+		0xe3, 0x51, 0x52, 0x4d, 0xba, 0xc5, 0x2d, 0x82, 0x55, 0x21, 0xbb, 0x8b, 0xf7, 0xca, 0x16, 0x7f,
+		0xe8, 0xa7, 0xbc, 0x8d, 0x71, 0x2e, 0xdd, 0xe1, 0x74, 0x75, 0x71, 0x9a, 0xbb, 0x23, 0x9f, 0x5d,
+		0x85, 0x19, 0xc8, 0xca, 0xc8, 0x2e, 0xde, 0x53, 0x38, 0xe4, 0x1c, 0xc6, 0x62, 0xb2, 0x12, 0x5a,
+		0x64, 0xc9, 0x65, 0x7a, 0x9e, 0xac, 0x30, 0xa5, 0x7d, 0x93, 0x95, 0x10, 0x4b, 0x36, 0x59, 0x09,
+		0x2e, 0xce, 0xa0, 0x77, 0x4a, 0x90, 0xf7, 0xe1, 0xf1, 0x25, 0xa2, 0x8a, 0x61, 0xd4, 0xab, 0xc6,
+		0x15, 0x31, 0x0b, 0x6b, 0xd3, 0xfb, 0x1e, 0xe0, 0xea, 0xde, 0xee, 0x0e, 0x0e, 0x31, 0xac, 0x58,
+		0x4f, 0xcc, 0x79, 0x08, 0x6c, 0xf1, 0x69, 0x9e, 0x17, 0xfb, 0xe2, 0xed, 0x27, 0x25, 0x40, 0x5e,
+		0x22, 0xa2, 0x60, 0xdb, 0x34, 0x74, 0x9b, 0x4e, 0x0f, 0x7d, 0x73, 0x39, 0xa9, 0xfd, 0xf4, 0xd0,
+		0xa3, 0x17, 0xd3, 0x43, 0x8f, 0x96, 0xbc, 0xf5, 0x2c, 0x06, 0xa5, 0x44, 0xa7, 0xe6, 0xe4, 0x81,
+		0x2b, 0x3c, 0x4a, 0x1f, 0x90, 0xff, 0x8d, 0x04, 0x93, 0x2d, 0x71, 0xce, 0x15, 0xf6, 0x6f, 0x00,
+		0xb2, 0x7c, 0x85, 0xfc, 0xd9, 0x4e, 0x26, 0x74, 0xcf, 0x61, 0x73, 0xcc, 0x0a, 0x17, 0xdc, 0xc4,
+		0xbc, 0x83, 0xdd, 0xc6, 0xf8, 0x17, 0x12, 0x4c, 0xf8, 0xab, 0x77, 0x15, 0x59, 0x85, 0x21, 0x7f,
+		0xed, 0x5c, 0x85, 0x3b, 0xbb, 0x51, 0x81, 0x4b, 0x1f, 0xa0, 0x47, 0xcf, 0x78, 0x83, 0x08, 0x5b,
+		0xd1, 0x7d, 0xa8, 0x6b, 0x6b, 0x08, 0x99, 0xc2, 0x83, 0x49, 0x8a, 0xb6, 0xc7, 0xff, 0x93, 0x20,
+		0xb5, 0x6e, 0x18, 0x75, 0x64, 0xc0, 0x98, 0x6e, 0x38, 0x65, 0x12, 0xef, 0x70, 0xd5, 0x7f, 0x29,
+		0x22, 0x53, 0x9c, 0xef, 0xcd, 0x48, 0xdf, 0xbd, 0x3e, 0xdd, 0xca, 0x4a, 0x19, 0xd5, 0x0d, 0xa7,
+		0x48, 0x21, 0xfc, 0x12, 0xc5, 0x5b, 0x61, 0x38, 0x58, 0x19, 0x1b, 0xbb, 0x9f, 0xed, 0xb9, 0xb2,
+		0x20, 0x9b, 0x1b, 0xd7, 0xa7, 0x27, 0xbc, 0x38, 0xee, 0x82, 0x65, 0x65, 0x68, 0xcb, 0x57, 0x3b,
+		0x3b, 0x74, 0xf8, 0x7d, 0xd2, 0x86, 0xbf, 0x2c, 0xc1, 0x38, 0x05, 0x6a, 0x2f, 0x61, 0xba, 0x9a,
+		0xa4, 0xe0, 0x8a, 0x61, 0x55, 0xd1, 0x08, 0x24, 0xf8, 0x36, 0x5e, 0x4a, 0x49, 0x68, 0x55, 0xb2,
+		0xa7, 0x6b, 0x5c, 0xd1, 0xf9, 0x19, 0xa0, 0x8c, 0xc2, 0x3e, 0xe8, 0xa0, 0x68, 0x54, 0x9b, 0x75,
+		0x4c, 0x1e, 0xb1, 0xa5, 0x57, 0x78, 0x58, 0xb2, 0xe0, 0x1f, 0x14, 0x03, 0xe5, 0x64, 0x50, 0xa4,
+		0x80, 0x39, 0xf6, 0x4d, 0x56, 0x36, 0xdc, 0x58, 0xca, 0xdf, 0x76, 0xf3, 0x00, 0x81, 0x21, 0x5f,
+		0x92, 0x7f, 0x2f, 0x09, 0x93, 0xe4, 0x54, 0x10, 0x5f, 0x18, 0xe4, 0xe1, 0x90, 0x6d, 0x1a, 0xec,
+		0xdd, 0xb4, 0x45, 0x4d, 0x13, 0x46, 0x8d, 0x3a, 0x79, 0x3b, 0x4e, 0xef, 0x6a, 0x4d, 0xf3, 0xa4,
+		0x97, 0x6d, 0x86, 0xc8, 0xe2, 0x97, 0x34, 0x87, 0x8d, 0x7a, 0x95, 0x2b, 0x42, 0x16, 0x34, 0x4d,
+		0x18, 0xd5, 0xf1, 0x95, 0x40, 0x8d, 0xc9, 0xee, 0x6a, 0x0c, 0x91, 0xb5, 0xa9, 0x51, 0xc7, 0x57,
+		0x7c, 0x35, 0x7a, 0x3b, 0xf7, 0xa9, 0xc8, 0xa3, 0x51, 0x7d, 0x3d, 0x1f, 0x8d, 0x7a, 0x08, 0x92,
+		0x64, 0xa4, 0xeb, 0xef, 0x2e, 0x34, 0x12, 0xdc, 0x42, 0xfa, 0x5d, 0x3c, 0x2c, 0x1e, 0xff, 0xa2,
+		0x04, 0xe0, 0x2d, 0xca, 0x92, 0xbd, 0xc0, 0xe2, 0xda, 0xea, 0x42, 0x79, 0x63, 0x73, 0x6e, 0xf3,
+		0xe2, 0x46, 0xf0, 0xca, 0x8b, 0xd8, 0x39, 0xb4, 0x4d, 0x5c, 0x21, 0x4f, 0x40, 0x56, 0xd1, 0xdd,
+		0x30, 0x11, 0xc4, 0x26, 0x5f, 0xe4, 0xd5, 0xe7, 0xfc, 0xd0, 0xab, 0xd7, 0x66, 0xd2, 0x6c, 0x9a,
+		0x8a, 0xc9, 0xb9, 0xab, 0x83, 0xad, 0x78, 0xe4, 0x05, 0xdb, 0x44, 0x7e, 0xf8, 0xd5, 0x6b, 0x33,
+		0x19, 0x77, 0x3e, 0x8b, 0x64, 0x40, 0x7e, 0x4c, 0xce, 0x2f, 0x99, 0x87, 0x57, 0xaf, 0xcd, 0xf4,
+		0xb3, 0x5e, 0x9c, 0x4f, 0x91, 0xfd, 0xc1, 0x9b, 0x7e, 0x31, 0xe6, 0xd5, 0xc1, 0xd8, 0x0d, 0xc1,
+		0x1a, 0xd6, 0xb1, 0xad, 0xd9, 0xfb, 0xda, 0x10, 0xec, 0x6a, 0x93, 0x51, 0xfe, 0x93, 0x34, 0x0c,
+		0x2d, 0xb2, 0x5a, 0x48, 0x43, 0x60, 0xf4, 0x38, 0x79, 0x49, 0x99, 0x64, 0xd8, 0xee, 0x09, 0x83,
+		0x98, 0xa8, 0xcb, 0xf2, 0x70, 0xf7, 0x98, 0x2b, 0xfd, 0x42, 0x36, 0x3f, 0xe7, 0xc6, 0x8e, 0xdf,
+		0x7a, 0x07, 0x4a, 0x87, 0x8a, 0x4b, 0x3d, 0x4f, 0xe7, 0x78, 0xef, 0x0d, 0xf3, 0x93, 0xd9, 0x91,
+		0xb9, 0x4d, 0x02, 0x61, 0x07, 0x67, 0xdf, 0x21, 0xc1, 0x41, 0x8a, 0xe5, 0xe5, 0x6f, 0x14, 0x53,
+		0xac, 0x83, 0x1c, 0x8f, 0x53, 0x61, 0x59, 0xb5, 0xbd, 0x63, 0x70, 0x94, 0x57, 0xf1, 0x4e, 0x9e,
+		0xa5, 0x1c, 0xf1, 0x55, 0x1e, 0x66, 0x2b, 0x2b, 0xe3, 0xf5, 0x16, 0x4a, 0x1b, 0x2d, 0x06, 0xce,
+		0x3a, 0xa7, 0x7a, 0xdb, 0x85, 0xf4, 0x91, 0xa2, 0x0b, 0x30, 0xe8, 0x0d, 0x68, 0x36, 0xff, 0xad,
+		0xab, 0xee, 0x13, 0x18, 0x3f, 0x31, 0xc9, 0xda, 0x0e, 0x7a, 0x13, 0x1d, 0x3f, 0x5b, 0xf6, 0x9b,
+		0x60, 0xf7, 0xf5, 0xb0, 0x46, 0x14, 0x36, 0x4e, 0x24, 0x5f, 0x59, 0x99, 0x70, 0xe1, 0x0b, 0x3e,
+		0x41, 0xd6, 0xc9, 0xaf, 0x91, 0xf8, 0xeb, 0x17, 0x8f, 0xdc, 0x76, 0x9f, 0x1f, 0x04, 0x19, 0xb0,
+		0xdf, 0x29, 0x32, 0x0d, 0xcb, 0xc1, 0xd5, 0x5c, 0x9a, 0xbf, 0xda, 0xc6, 0xbf, 0xd1, 0xbb, 0x24,
+		0x38, 0xe4, 0xf0, 0x11, 0x8e, 0x6d, 0xa1, 0x94, 0x2d, 0x3a, 0xc6, 0xd9, 0xb9, 0x4c, 0x7b, 0xbd,
+		0x23, 0xc6, 0xc5, 0xe2, 0x5d, 0x5c, 0xef, 0xa3, 0x4c, 0xef, 0x68, 0xc6, 0xb2, 0x32, 0xe1, 0xb4,
+		0xd2, 0xda, 0xe8, 0x05, 0x38, 0xca, 0x5d, 0x38, 0x82, 0x8a, 0x1c, 0x9b, 0x21, 0x99, 0x73, 0xaa,
+		0x78, 0xec, 0xc6, 0xf5, 0xe9, 0x3b, 0x03, 0x1e, 0x1f, 0x8d, 0x2e, 0x2b, 0x93, 0xcc, 0xfd, 0x5b,
+		0xaa, 0x5a, 0xaa, 0xa2, 0x8f, 0x49, 0x70, 0xc4, 0x37, 0x34, 0x78, 0xf3, 0x09, 0x36, 0x23, 0x64,
+		0xbf, 0x1d, 0xd8, 0x26, 0x93, 0x8a, 0x1d, 0x68, 0x8b, 0xf7, 0x71, 0x13, 0xdc, 0xe1, 0xed, 0xe4,
+		0xc5, 0x55, 0x22, 0x2b, 0x93, 0x15, 0x77, 0xd4, 0x09, 0xf1, 0x91, 0x57, 0x01, 0xb5, 0xf6, 0xba,
+		0xf0, 0xa1, 0x7b, 0xef, 0x1e, 0x25, 0x49, 0x41, 0xfc, 0xc7, 0xd2, 0xd9, 0x87, 0x37, 0x8a, 0xdc,
+		0xf4, 0x60, 0xfc, 0xaf, 0x12, 0x70, 0xdc, 0x7f, 0xa4, 0xe1, 0xc5, 0x26, 0xb6, 0xf6, 0xdc, 0xd8,
+		0x69, 0xaa, 0x35, 0x4d, 0xf7, 0xdf, 0xdc, 0x9b, 0xf4, 0x8f, 0x79, 0x14, 0x57, 0xd8, 0x52, 0xd6,
+		0x61, 0x70, 0x5d, 0xad, 0x61, 0x05, 0xbf, 0xd8, 0xc4, 0xb6, 0x13, 0x71, 0x31, 0x8a, 0x5c, 0x5a,
+		0xda, 0xde, 0x16, 0xc7, 0xb0, 0x52, 0x0a, 0xff, 0x22, 0x2a, 0xd7, 0x35, 0x72, 0x54, 0x2c, 0x49,
+		0xc1, 0xec, 0x83, 0xbc, 0x11, 0x4b, 0x93, 0x27, 0x16, 0x0a, 0x73, 0x29, 0xf1, 0xca, 0x57, 0x53,
+		0x67, 0xa1, 0x50, 0x7e, 0x0a, 0x86, 0x58, 0x7d, 0x3c, 0x1f, 0x9f, 0x84, 0x34, 0x3d, 0x02, 0xec,
+		0xd5, 0x3a, 0x40, 0xbe, 0x9f, 0x66, 0x97, 0xa8, 0x18, 0x17, 0x56, 0x31, 0xfb, 0x28, 0x16, 0x63,
+		0x4d, 0x79, 0xac, 0x73, 0xcc, 0x66, 0x86, 0x72, 0xcd, 0xf8, 0xdb, 0x7d, 0x70, 0x90, 0xe5, 0x11,
+		0x27, 0x54, 0x53, 0x3b, 0xb1, 0xe3, 0x38, 0xe2, 0x52, 0x1f, 0x30, 0xf0, 0xac, 0x6a, 0x6a, 0xf2,
+		0x1e, 0xa4, 0xce, 0x3b, 0x8e, 0x89, 0x8e, 0x43, 0x9f, 0xd5, 0xac, 0x63, 0xb1, 0x4b, 0xe1, 0x66,
+		0x40, 0xaa, 0xa9, 0xcd, 0x12, 0x04, 0xa5, 0x59, 0xc7, 0x0a, 0x43, 0x41, 0x25, 0x98, 0xde, 0x6e,
+		0xd6, 0xeb, 0x7b, 0xe4, 0x27, 0xfb, 0x8c, 0x2a, 0xe9, 0x18, 0xfc, 0x27, 0x8e, 0xf0, 0x55, 0x53,
+		0x15, 0xcf, 0x22, 0x13, 0xdb, 0x1c, 0xa1, 0x68, 0x0b, 0x14, 0x4b, 0xfc, 0xbc, 0x51, 0x49, 0xe0,
+		0xc8, 0x7f, 0x90, 0x80, 0xb4, 0x60, 0x4d, 0x6f, 0x35, 0xe1, 0x3a, 0xae, 0x90, 0x74, 0x54, 0xe2,
+		0xb7, 0x9a, 0xf8, 0x37, 0x42, 0x90, 0xac, 0xf1, 0x26, 0xca, 0x9c, 0x3f, 0xa0, 0x90, 0x0f, 0x02,
+		0x73, 0xef, 0x9a, 0x11, 0x18, 0xb9, 0x82, 0x36, 0x01, 0x29, 0xd3, 0x10, 0xcb, 0x89, 0xe7, 0x0f,
+		0x28, 0xf4, 0x0b, 0xe5, 0xa0, 0x9f, 0x84, 0x2c, 0x87, 0x65, 0x57, 0x04, 0xce, 0xbf, 0xd1, 0x21,
+		0xb2, 0xf7, 0xe5, 0x54, 0xd8, 0x31, 0x70, 0x52, 0xc0, 0x3e, 0xd1, 0xa3, 0xd0, 0xcf, 0x5e, 0xfd,
+		0x08, 0xff, 0xfa, 0x19, 0x31, 0x06, 0x7b, 0x5e, 0x95, 0xc8, 0xbd, 0xae, 0x3a, 0x0e, 0xb6, 0x74,
+		0xc2, 0x90, 0xa1, 0x93, 0xa3, 0x6a, 0x5b, 0x46, 0x75, 0x8f, 0xff, 0x22, 0x1b, 0xfd, 0x9f, 0xff,
+		0x04, 0x14, 0xf5, 0x87, 0x32, 0x2d, 0x64, 0x3f, 0x44, 0x39, 0x24, 0x80, 0x45, 0x82, 0x54, 0x82,
+		0x71, 0xb5, 0x5a, 0xd5, 0xd8, 0x8f, 0xa3, 0x95, 0xb7, 0x34, 0x1a, 0xba, 0xed, 0xdc, 0x60, 0x9b,
+		0xb6, 0x40, 0x1e, 0x41, 0x91, 0xe3, 0x17, 0x33, 0xe4, 0x07, 0x51, 0xa9, 0x50, 0xf2, 0x59, 0x18,
+		0x6b, 0x91, 0x94, 0xc8, 0xb7, 0xab, 0xe9, 0x55, 0x71, 0x01, 0x8f, 0xfc, 0x4f, 0x60, 0xf4, 0x41,
+		0x64, 0x36, 0xbf, 0xa0, 0xff, 0x17, 0xdf, 0x16, 0x7f, 0x4f, 0x73, 0xc4, 0x77, 0x4f, 0x53, 0x35,
+		0xb5, 0x62, 0x86, 0xf2, 0xe7, 0xb7, 0x33, 0xe7, 0x5a, 0x6f, 0x67, 0xd6, 0xb0, 0x2e, 0xd2, 0x22,
+		0x52, 0xa4, 0x9a, 0x9a, 0x4d, 0xdd, 0xd1, 0x7b, 0xa0, 0xd9, 0x3e, 0xeb, 0xfb, 0x9f, 0x5e, 0xd6,
+		0x4c, 0x2d, 0xce, 0xad, 0x2f, 0xb9, 0x7e, 0xfc, 0xe5, 0x04, 0x1c, 0xf1, 0xf9, 0xb1, 0x0f, 0xb9,
+		0xd5, 0x9d, 0xf3, 0xd1, 0x1e, 0xdf, 0xc5, 0xdb, 0x17, 0x4f, 0x43, 0x8a, 0xe0, 0xa3, 0x0e, 0x3f,
+		0xd0, 0x94, 0xfb, 0xd5, 0xaf, 0xfd, 0x73, 0x79, 0x46, 0x8a, 0x6d, 0x15, 0xca, 0xa4, 0xf8, 0xce,
+		0xee, 0xed, 0x97, 0xf5, 0xde, 0xa6, 0xb6, 0x6f, 0x9e, 0x19, 0xc3, 0x36, 0xfc, 0xc3, 0xb9, 0xd8,
+		0xb7, 0x11, 0x58, 0xc4, 0x6c, 0x9f, 0xdd, 0xf6, 0x10, 0x8e, 0xe3, 0xee, 0xac, 0xb5, 0x6b, 0xc1,
+		0x2e, 0xf3, 0xe4, 0xab, 0x70, 0xe8, 0x19, 0x52, 0xb7, 0xb7, 0xb4, 0x2b, 0x02, 0xfb, 0x21, 0xf7,
+		0x04, 0x8a, 0xc4, 0x7f, 0xe5, 0x55, 0x9c, 0x2e, 0x01, 0x4f, 0x3e, 0x3e, 0x9b, 0xbc, 0x7b, 0x36,
+		0x76, 0xbc, 0x98, 0xf5, 0x0d, 0x16, 0x8a, 0x8f, 0x52, 0xfe, 0x15, 0x09, 0x6e, 0x6b, 0xa9, 0x9a,
+		0xc7, 0xf8, 0xc5, 0x88, 0xeb, 0x75, 0xfb, 0x4a, 0x39, 0x17, 0x23, 0x84, 0xbd, 0xa7, 0xa3, 0xb0,
+		0x4c, 0x8a, 0x80, 0xb4, 0x4f, 0xc2, 0xc1, 0xa0, 0xb0, 0xc2, 0x4c, 0x77, 0xc1, 0x48, 0x70, 0x17,
+		0x93, 0x9b, 0x6b, 0x38, 0xb0, 0x8f, 0x29, 0x97, 0xc3, 0x76, 0x76, 0x75, 0x2d, 0xf9, 0x17, 0x0d,
+		0x24, 0xfe, 0x2b, 0x71, 0x5d, 0xaa, 0xea, 0x51, 0xca, 0xef, 0x93, 0x60, 0x26, 0x58, 0x83, 0x2f,
+		0x4b, 0xed, 0x4d, 0xd8, 0x9b, 0xd6, 0xc4, 0xdf, 0x91, 0xe0, 0xf6, 0x36, 0x32, 0x71, 0x03, 0xbc,
+		0x04, 0x13, 0xbe, 0x75, 0x42, 0x11, 0xc2, 0x45, 0xb3, 0x1f, 0xef, 0x3c, 0x3f, 0x70, 0x97, 0xc5,
+		0x0e, 0x13, 0xa3, 0x7c, 0xe6, 0x8f, 0xa6, 0xc7, 0x5b, 0xcb, 0x6c, 0x65, 0xbc, 0x75, 0x6d, 0xef,
+		0x26, 0xfa, 0xc7, 0x87, 0x24, 0xb8, 0x37, 0xa8, 0x6a, 0xc4, 0x44, 0xe3, 0xc7, 0xd5, 0x0e, 0xff,
+		0x49, 0x82, 0xe3, 0xdd, 0x08, 0xc7, 0x1b, 0x64, 0x0b, 0xc6, 0xbd, 0x29, 0x50, 0xb8, 0x3d, 0x7a,
+		0x9a, 0x58, 0x31, 0x2f, 0x45, 0x2e, 0xb7, 0x5b, 0x60, 0x78, 0x93, 0x77, 0x2c, 0x7f, 0x93, 0xbb,
+		0x46, 0x0e, 0xee, 0x40, 0x0a, 0x23, 0x07, 0xf6, 0x20, 0x23, 0xda, 0x22, 0x11, 0xd1, 0x16, 0x5e,
+		0x6a, 0x2e, 0x5f, 0x86, 0xdb, 0x5a, 0x6a, 0xe4, 0x96, 0x7b, 0x13, 0x8c, 0x47, 0xb8, 0x32, 0xef,
+		0xd5, 0x3d, 0x78, 0xb2, 0x82, 0x5a, 0x9d, 0x55, 0xde, 0x83, 0x69, 0x5a, 0x6f, 0x84, 0xa1, 0x6f,
+		0xb5, 0xca, 0x0d, 0x98, 0x89, 0xaf, 0x9a, 0xeb, 0xbe, 0x04, 0xfd, 0xac, 0x9d, 0xb9, 0xba, 0xfb,
+		0x70, 0x14, 0xce, 0x40, 0xfe, 0xb0, 0x88, 0x65, 0x0b, 0x42, 0xec, 0xe8, 0x3e, 0xd4, 0x8d, 0xae,
+		0x37, 0xa9, 0x0f, 0xf9, 0x8c, 0xf1, 0x0d, 0x11, 0xd5, 0xa2, 0xa5, 0xe3, 0xe6, 0xa8, 0xdc, 0xb4,
+		0xa8, 0xc6, 0x6c, 0x73, 0x6b, 0xc3, 0xd7, 0x2f, 0x89, 0xf0, 0xe5, 0xea, 0xd4, 0x21, 0x7c, 0xfd,
+		0x78, 0x4c, 0xef, 0x06, 0xb2, 0x0e, 0x62, 0xfe, 0x45, 0x0c, 0x64, 0xdf, 0x97, 0x60, 0x92, 0xea,
+		0xe6, 0x5f, 0x21, 0xea, 0xd5, 0xe4, 0xf7, 0x03, 0x22, 0x87, 0x23, 0x22, 0x7b, 0x77, 0xd6, 0xb6,
+		0x2a, 0x97, 0x02, 0xe3, 0xcb, 0xfd, 0x80, 0xaa, 0xb6, 0x13, 0xc6, 0x66, 0x27, 0xbb, 0xb3, 0x55,
+		0xdb, 0x09, 0x62, 0x07, 0x9b, 0x33, 0x75, 0x13, 0x9a, 0xf3, 0xeb, 0x12, 0xe4, 0xa3, 0x54, 0xe6,
+		0xcd, 0xa7, 0xc1, 0xa1, 0xc0, 0x16, 0x62, 0xb8, 0x05, 0xef, 0xef, 0x66, 0x8d, 0x2d, 0xd4, 0x8d,
+		0x0e, 0x5a, 0xf8, 0x56, 0xe7, 0x01, 0xd3, 0x41, 0x0f, 0x6d, 0xcd, 0xac, 0x7f, 0x6c, 0xdd, 0xe7,
+		0x0b, 0x2d, 0x71, 0xf5, 0x2f, 0x44, 0xee, 0x7d, 0x15, 0xa6, 0x62, 0xa4, 0xbe, 0xd5, 0xe3, 0xde,
+		0x4e, 0x6c, 0x63, 0xde, 0xec, 0xf4, 0xfd, 0x11, 0xde, 0x13, 0x82, 0xb7, 0x86, 0x7c, 0x73, 0xb1,
+		0xa8, 0x6b, 0xc7, 0xf2, 0x1b, 0xe1, 0x70, 0x24, 0x15, 0x97, 0xad, 0x00, 0x29, 0xb2, 0x76, 0x99,
+		0x93, 0x82, 0xbe, 0x13, 0x16, 0x2b, 0x44, 0x4d, 0x69, 0x64, 0x04, 0x59, 0xca, 0x9a, 0xec, 0x28,
+		0x73, 0x31, 0xe4, 0xa7, 0x61, 0xcc, 0x07, 0xe3, 0x95, 0x9c, 0x26, 0x0b, 0x44, 0x46, 0xdd, 0x7d,
+		0x9b, 0x23, 0x6e, 0x5b, 0xc5, 0x30, 0xea, 0x5c, 0x6d, 0x8a, 0x2f, 0x4f, 0x00, 0x62, 0xcc, 0xe8,
+		0x0e, 0x8b, 0xa8, 0x62, 0x03, 0xc6, 0x03, 0x50, 0x5e, 0xc9, 0xeb, 0xda, 0xbd, 0x91, 0x4f, 0xc1,
+		0x1d, 0x94, 0x69, 0xd4, 0x1a, 0xf8, 0xde, 0x52, 0x55, 0x58, 0x39, 0xb4, 0x4d, 0x2c, 0xbf, 0x08,
+		0x77, 0xb6, 0x27, 0xf3, 0x32, 0x1f, 0xb6, 0x8c, 0xdd, 0x29, 0xf3, 0x89, 0x62, 0xc4, 0x25, 0x65,
+		0x0c, 0xe4, 0x27, 0xe1, 0xee, 0xf8, 0x2a, 0xe9, 0xe1, 0x25, 0x21, 0x6c, 0xe4, 0xf3, 0x93, 0xb2,
+		0x03, 0xf7, 0x74, 0xa4, 0xbf, 0xf9, 0x52, 0x3f, 0x01, 0x77, 0xc5, 0xd5, 0x6a, 0xaf, 0x5d, 0xd1,
+		0x71, 0xd5, 0x27, 0x34, 0xdb, 0x78, 0x97, 0x7c, 0x1b, 0xef, 0x72, 0x13, 0xee, 0xee, 0x44, 0xce,
+		0x65, 0x7e, 0x1a, 0x06, 0xc4, 0x76, 0x87, 0x34, 0x93, 0xdc, 0x9f, 0xd0, 0x82, 0x83, 0x3c, 0x0d,
+		0x47, 0x79, 0xb5, 0x8e, 0xff, 0x4c, 0x96, 0x2b, 0xad, 0xbc, 0x03, 0x53, 0x71, 0x08, 0x5c, 0x1e,
+		0xef, 0xa6, 0x8d, 0xf4, 0x7a, 0x6e, 0xda, 0x9c, 0xfc, 0xf2, 0x11, 0xe8, 0xa3, 0x55, 0xa1, 0x0f,
+		0x4a, 0x81, 0x87, 0x18, 0x67, 0xe3, 0xf4, 0x8b, 0x5e, 0xb4, 0xc9, 0x9f, 0xe8, 0x1a, 0x9f, 0x4f,
+		0x2a, 0x8e, 0xbf, 0xed, 0xdf, 0x7f, 0xfb, 0xfd, 0x89, 0x3b, 0x91, 0x7c, 0x22, 0x66, 0xb9, 0xc8,
+		0x17, 0xd0, 0x3f, 0x15, 0x78, 0x50, 0xe8, 0x81, 0xee, 0xaa, 0x12, 0x92, 0xcd, 0x76, 0x8b, 0xce,
+		0x05, 0x3b, 0x4b, 0x05, 0x3b, 0x85, 0x1e, 0xee, 0x2c, 0xd8, 0x89, 0xb7, 0x04, 0xa3, 0xfa, 0xcb,
+		0xe8, 0x3f, 0x48, 0x30, 0x11, 0xb5, 0xe6, 0x80, 0xce, 0x74, 0x27, 0x45, 0x6b, 0xce, 0x9b, 0x7f,
+		0x6c, 0x1f, 0x94, 0x5c, 0x95, 0x45, 0xaa, 0xca, 0x1c, 0x7a, 0x6a, 0x1f, 0xaa, 0x9c, 0xf0, 0xef,
+		0x0c, 0xfe, 0x1f, 0x09, 0x8e, 0xb6, 0x9d, 0xc2, 0xa3, 0xb9, 0xee, 0xa4, 0x6c, 0x93, 0xdc, 0xe7,
+		0x8b, 0xaf, 0x87, 0x05, 0xd7, 0xf8, 0x19, 0xaa, 0xf1, 0xd3, 0x68, 0x69, 0x3f, 0x1a, 0x47, 0x6e,
+		0xbf, 0xa2, 0xdf, 0x09, 0x5e, 0xd7, 0x68, 0xef, 0x4e, 0x2d, 0x33, 0xe3, 0xfc, 0x89, 0xae, 0xf1,
+		0xb9, 0x0a, 0xcf, 0x51, 0x15, 0x14, 0xb4, 0xfe, 0x3a, 0x1b, 0xed, 0xc4, 0x5b, 0x82, 0x99, 0xc9,
+		0xcb, 0xe8, 0x7f, 0x4b, 0xd1, 0xb7, 0x2f, 0x1e, 0x6d, 0x2b, 0x62, 0xfc, 0xac, 0x3f, 0x7f, 0xa6,
+		0x77, 0x42, 0xae, 0x64, 0x83, 0x2a, 0x59, 0x43, 0xf8, 0x66, 0x2b, 0x19, 0xd9, 0x88, 0xe8, 0xab,
+		0x12, 0x4c, 0x44, 0x4d, 0x9a, 0x3b, 0x74, 0xcb, 0x36, 0xab, 0x00, 0x1d, 0xba, 0x65, 0xbb, 0x19,
+		0xba, 0xfc, 0x38, 0x55, 0xfe, 0x34, 0x7a, 0x24, 0x4e, 0xf9, 0xb6, 0xad, 0x48, 0xfa, 0x62, 0xdb,
+		0x59, 0x68, 0x87, 0xbe, 0xd8, 0xcd, 0x44, 0xbb, 0x43, 0x5f, 0xec, 0x6a, 0x12, 0xdc, 0xb9, 0x2f,
+		0xba, 0x9a, 0x75, 0xd9, 0x8c, 0x36, 0xfa, 0xb2, 0x04, 0xc3, 0x81, 0x29, 0x1b, 0x7a, 0xa8, 0xad,
+		0xa0, 0x51, 0x33, 0xda, 0xfc, 0xc9, 0x5e, 0x48, 0xb8, 0x2e, 0x4b, 0x54, 0x97, 0x79, 0x34, 0xb7,
+		0x1f, 0x5d, 0x82, 0xa7, 0x2c, 0xbe, 0x2e, 0xc1, 0x78, 0xc4, 0x34, 0xa8, 0x43, 0x2f, 0x8c, 0x9f,
+		0xd5, 0xe5, 0xcf, 0xf4, 0x4e, 0xc8, 0xb5, 0x3a, 0x47, 0xb5, 0x7a, 0x03, 0x7a, 0x72, 0x3f, 0x5a,
+		0xf9, 0xc6, 0xe7, 0xeb, 0xde, 0xb1, 0x61, 0x5f, 0x3d, 0xe8, 0x74, 0x8f, 0x82, 0x09, 0x85, 0x1e,
+		0xed, 0x99, 0x8e, 0xeb, 0xf3, 0x2c, 0xd5, 0xe7, 0x19, 0xb4, 0xf6, 0xfa, 0xf4, 0x69, 0x1d, 0xd6,
+		0x3f, 0xdf, 0xfa, 0xac, 0x42, 0x7b, 0x2f, 0x8a, 0x9c, 0x4d, 0xe5, 0x1f, 0xee, 0x89, 0x86, 0x2b,
+		0x75, 0x86, 0x2a, 0x75, 0x12, 0x3d, 0x18, 0xa7, 0x94, 0xef, 0xc6, 0x82, 0xa6, 0x6f, 0x1b, 0x27,
+		0xde, 0xc2, 0xe6, 0x68, 0x2f, 0xa3, 0x9f, 0x16, 0xe7, 0x72, 0x8f, 0xb5, 0xad, 0xd7, 0x37, 0xd1,
+		0xca, 0xdf, 0xdb, 0x05, 0x26, 0x97, 0xeb, 0x4e, 0x2a, 0xd7, 0x14, 0x3a, 0x12, 0x27, 0x17, 0x99,
+		0x6c, 0xa1, 0x77, 0x4b, 0xee, 0x05, 0x93, 0xe3, 0xed, 0x79, 0xfb, 0x67, 0x63, 0xf9, 0xfb, 0xba,
+		0xc2, 0xe5, 0x92, 0xdc, 0x4d, 0x25, 0x99, 0x41, 0x53, 0xb1, 0x92, 0x30, 0x01, 0xbe, 0x21, 0xc1,
+		0x6d, 0x31, 0x53, 0x2a, 0x74, 0xb6, 0x6d, 0x85, 0xed, 0xe7, 0x6f, 0xf9, 0xc7, 0xf7, 0x47, 0xdc,
+		0x6d, 0xc2, 0x19, 0x7d, 0x3c, 0xea, 0xc4, 0x5b, 0xb4, 0xea, 0xcb, 0xe8, 0x5b, 0x12, 0xe4, 0xe3,
+		0xe7, 0x5c, 0xe8, 0xc9, 0xde, 0x25, 0xf3, 0x4f, 0xf6, 0xf2, 0x4f, 0xed, 0x9b, 0x9e, 0x2b, 0xb7,
+		0x40, 0x95, 0x7b, 0x12, 0x3d, 0xde, 0xa3, 0x72, 0x74, 0x56, 0x49, 0x3a, 0xa9, 0x6e, 0x34, 0x5e,
+		0x46, 0xdf, 0x94, 0x60, 0x32, 0x76, 0x92, 0x86, 0x9e, 0xe8, 0x55, 0xc8, 0xc0, 0xdc, 0x30, 0xff,
+		0xe4, 0x7e, 0xc9, 0x5f, 0xa7, 0x8a, 0x74, 0x0e, 0x7a, 0xe2, 0x2d, 0xf4, 0xcf, 0xcb, 0xe8, 0x37,
+		0x24, 0x18, 0x6b, 0x99, 0xef, 0xa1, 0x53, 0x1d, 0x64, 0x8b, 0x9e, 0x40, 0xe6, 0x4f, 0xf7, 0x4a,
+		0xc6, 0x55, 0x79, 0x98, 0xaa, 0xf2, 0x00, 0xba, 0x2f, 0x5e, 0x15, 0x27, 0x78, 0xc5, 0x08, 0x57,
+		0x6f, 0xfa, 0x89, 0xb1, 0xef, 0xce, 0xc0, 0x74, 0x5c, 0xf5, 0x57, 0x3b, 0x9c, 0x6d, 0x68, 0xf3,
+		0x68, 0x4f, 0xc7, 0x47, 0x79, 0x6e, 0xf6, 0x6f, 0x46, 0x74, 0x79, 0x10, 0xe2, 0xdf, 0xa6, 0x00,
+		0xad, 0xd8, 0xb5, 0x79, 0x0b, 0xb3, 0xdf, 0xaf, 0xe7, 0x83, 0x67, 0xe8, 0x35, 0x0a, 0xe9, 0x75,
+		0xbd, 0x46, 0xb1, 0x12, 0x78, 0xdf, 0x21, 0xd1, 0xdb, 0x1b, 0x32, 0x5d, 0x3f, 0xf2, 0x90, 0xfc,
+		0x91, 0x3c, 0xf2, 0x10, 0x7d, 0x07, 0x34, 0x75, 0xf3, 0x2e, 0x8b, 0xf7, 0xed, 0xf7, 0xc2, 0x3c,
+		0xbf, 0x75, 0xd0, 0xdf, 0xe6, 0xd6, 0x41, 0x2e, 0xf6, 0x6e, 0x01, 0xa7, 0x46, 0xa7, 0xc4, 0xcf,
+		0x2d, 0x0c, 0x74, 0x77, 0x09, 0x80, 0x61, 0xfb, 0x96, 0x8e, 0x8f, 0x40, 0xbe, 0xd5, 0x9d, 0xdc,
+		0x1e, 0xfe, 0xfe, 0x24, 0x64, 0x57, 0xec, 0x5a, 0xa9, 0xaa, 0x39, 0xb7, 0xc8, 0xd7, 0x9e, 0x8a,
+		0xbf, 0x80, 0x8f, 0x6e, 0x5c, 0x9f, 0x1e, 0x61, 0x36, 0x6d, 0x63, 0xc9, 0x06, 0x8c, 0x86, 0x6f,
+		0xe4, 0x31, 0xcf, 0x5a, 0xd8, 0xcf, 0xeb, 0x4b, 0x2d, 0x37, 0xf1, 0x46, 0x82, 0x0f, 0x21, 0xa1,
+		0xab, 0xd1, 0xce, 0xcc, 0x1c, 0xea, 0xfc, 0xad, 0x7c, 0xad, 0xc4, 0x6b, 0xb3, 0x3c, 0xe4, 0xc2,
+		0x8d, 0xe2, 0xb6, 0xd8, 0x1f, 0x4b, 0x30, 0xb8, 0x62, 0x8b, 0x19, 0x16, 0xfe, 0x09, 0x7d, 0x2b,
+		0xe1, 0x51, 0xf7, 0xe7, 0x87, 0x92, 0xdd, 0xf9, 0x2d, 0x47, 0xf7, 0x19, 0xe1, 0x20, 0x8c, 0xfb,
+		0xf4, 0x74, 0xf5, 0xff, 0xdd, 0x04, 0x8d, 0x8f, 0x45, 0x5c, 0xd3, 0x74, 0x77, 0x72, 0x86, 0xff,
+		0xaa, 0xde, 0x04, 0xf7, 0xec, 0x9c, 0xda, 0xaf, 0x9d, 0x77, 0x21, 0xdf, 0x6a, 0x4f, 0x77, 0x65,
+		0x79, 0xa5, 0xf5, 0x9d, 0x02, 0xa9, 0x87, 0x7b, 0x4c, 0xa1, 0xd7, 0x08, 0xc8, 0x49, 0xac, 0xe1,
+		0x15, 0xbb, 0x76, 0x51, 0xaf, 0xfe, 0xa5, 0xf7, 0xdf, 0x6d, 0x38, 0x18, 0xd0, 0xf4, 0x56, 0x99,
+		0xf4, 0xb7, 0x12, 0x30, 0x46, 0x9e, 0x87, 0xf4, 0x27, 0xa6, 0xf6, 0x5f, 0x32, 0xb3, 0x92, 0xde,
+		0x23, 0xd2, 0xe6, 0x2a, 0xcf, 0x9b, 0xd9, 0x06, 0x4e, 0x2a, 0xdc, 0x7b, 0x22, 0xd1, 0x64, 0x65,
+		0xdc, 0x85, 0x53, 0x03, 0x91, 0x94, 0xdd, 0xbf, 0xc1, 0xba, 0x09, 0x93, 0x2d, 0x36, 0x74, 0x1b,
+		0xcc, 0x93, 0x5a, 0xea, 0x49, 0x6a, 0xf9, 0xd3, 0x12, 0x0d, 0xe4, 0xa4, 0x5b, 0xe1, 0x06, 0x65,
+		0x6e, 0x9f, 0x33, 0xac, 0x9b, 0xdf, 0x42, 0x8f, 0x06, 0x7e, 0xec, 0x6d, 0x5f, 0xde, 0xfa, 0x26,
+		0x98, 0x89, 0x93, 0xf4, 0xf5, 0xdb, 0xe1, 0x73, 0x12, 0x8d, 0xe5, 0xf4, 0xae, 0x0a, 0xf6, 0xee,
+		0xc0, 0x44, 0xe7, 0x06, 0x52, 0x0f, 0xb9, 0xc1, 0x0a, 0x00, 0xb9, 0xad, 0xd9, 0xc5, 0x8d, 0xd2,
+		0xf8, 0x4c, 0x2b, 0xa3, 0xe3, 0x2b, 0xec, 0x2e, 0x8d, 0xcf, 0x1c, 0x47, 0xe1, 0x70, 0x84, 0xc0,
+		0xc2, 0x12, 0x27, 0xdf, 0x39, 0x00, 0xc9, 0x15, 0xbb, 0x46, 0x1e, 0x57, 0x09, 0x27, 0xea, 0xb1,
+		0xcb, 0x1a, 0xad, 0x59, 0x58, 0xfe, 0x64, 0xf7, 0xb8, 0x6e, 0x23, 0xec, 0xc2, 0x70, 0x30, 0x5b,
+		0x3b, 0xd6, 0x86, 0x49, 0x00, 0x33, 0xff, 0x60, 0xb7, 0x98, 0x6e, 0x65, 0x6f, 0x26, 0xbf, 0xf7,
+		0xc7, 0x03, 0xf5, 0x1d, 0x6d, 0xa8, 0x05, 0x52, 0xfe, 0xbe, 0x2e, 0x90, 0x5c, 0xee, 0x2f, 0xc2,
+		0x68, 0x78, 0x18, 0x6f, 0x67, 0xbd, 0x10, 0x6e, 0xfe, 0x64, 0xf7, 0xb8, 0xbe, 0x93, 0x58, 0xe0,
+		0x1b, 0x7b, 0xee, 0x6a, 0xc3, 0xc1, 0x43, 0xcb, 0x3f, 0xd0, 0x15, 0x9a, 0x5b, 0x87, 0x0e, 0x23,
+		0xa1, 0x60, 0x7c, 0x6f, 0x1b, 0x06, 0x41, 0xd4, 0xfc, 0x43, 0x5d, 0xa3, 0xba, 0xf5, 0xbd, 0x5d,
+		0x82, 0x83, 0xd1, 0x21, 0xa6, 0x5d, 0x83, 0x47, 0x52, 0xe4, 0xcf, 0xf4, 0x4a, 0xe1, 0x4a, 0xe1,
+		0x40, 0xb6, 0xa5, 0x7f, 0xb7, 0xf3, 0x86, 0x30, 0x72, 0xfe, 0xe1, 0x1e, 0x90, 0x45, 0xad, 0x37,
+		0x7b, 0xb1, 0xe1, 0xff, 0x0f, 0x00, 0x63, 0x23, 0xf7, 0xb1, 0x27, 0xa9, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2026,6 +2235,21 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BondDenom != that1.BondDenom {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
+		return false
+	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
+	if this.CommissionChangeCooldown != that1.CommissionChangeCooldown {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2082,6 +2306,39 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
+}
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2876,6 +3133,54 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CommissionChangeCooldown, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CommissionChangeCooldown):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -2898,12 +3203,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err10 != nil {
-		return 0, err10
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n10
-	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3085,6 +3390,132 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsPubKeyRotationHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsPubKeyRotationHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsPubKeyRotationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintStaking(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -3417,6 +3848,16 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.KeyRotationFee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.CommissionChangeCooldown)
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -3476,30 +3917,82 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
-func sovStaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStaking(x uint64) (n int) {
-	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *ValAddresses) String() string {
-	if this == nil {
-		return "nil"
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ValAddresses{`,
-		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringStaking(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
-}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsPubKeyRotationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
+	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ValAddresses) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValAddresses{`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringStaking(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
 func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5994,6 +6487,174 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyRotationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangeCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.CommissionChangeCooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6498,6 +7159,416 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &types1.Any{}
+			}
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &types1.Any{}
+			}
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the names of the module
+// accounts holding the tokenized delegations.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance. Its
+// delegation is held by a module account named after its id.
+//
+//nolint:interfacer
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, valAddr sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner.String(),
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     valAddr.String(),
+	}
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// tokenized delegation.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetOwner returns the address of the owner of the record.
+func (r TokenizeShareRecord) GetOwner() sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(r.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return owner
+}
+
+// GetValidator returns the operator address of the validator of the tokenized
+// delegation.
+func (r TokenizeShareRecord) GetValidator() sdk.ValAddress {
+	valAddr, err := sdk.ValAddressFromBech32(r.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return valAddr
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record, made
+// of the validator operator address and the record id.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(r.Validator), r.Id)
+}
+
+// String implements the Stringer interface for a TokenizeShareRecord object.
+func (r TokenizeShareRecord) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
+// Validate performs a stateless validation of the record.
+func (r TokenizeShareRecord) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("tokenize share record id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return fmt.Errorf("invalid owner of tokenize share record %d: %w", r.Id, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(r.Validator); err != nil {
+		return fmt.Errorf("invalid validator of tokenize share record %d: %w", r.Id, err)
+	}
+	if r.ModuleAccount != fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, r.Id) {
+		return fmt.Errorf("invalid module account of tokenize share record %d: %s", r.Id, r.ModuleAccount)
+	}
+	return nil
+}
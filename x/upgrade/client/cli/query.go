@@ -21,6 +21,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCurrentPlanCmd(),
 		GetAppliedPlanCmd(),
+		GetModuleVersionsCmd(),
 	)
 
 	return cmd
@@ -113,3 +114,40 @@ func GetAppliedPlanCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetModuleVersionsCmd returns the module consensus versions stored in the
+// upgrade module's state.
+func GetModuleVersionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module_versions [optional module_name]",
+		Short: "get the list of module versions",
+		Long: "Gets a list of module names and their respective consensus versions.\n" +
+			"Following the command with a specific module name will return only\n" +
+			"that module's information.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.QueryModuleVersionsRequest{}
+			if len(args) == 1 {
+				params.ModuleName = args[0]
+			}
+
+			res, err := queryClient.ModuleVersions(context.Background(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -66,10 +66,16 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 				return err
 			}
 
+			expedited, err := cmd.Flags().GetBool(cli.FlagExpedited)
+			if err != nil {
+				return err
+			}
+
 			msg, err := gov.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
 				return err
 			}
+			msg.Expedited = expedited
 
 			if err = msg.ValidateBasic(); err != nil {
 				return err
@@ -82,6 +88,7 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
 	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().Bool(cli.FlagExpedited, false, "submit an expedited proposal")
 	cmd.Flags().Int64(FlagUpgradeHeight, 0, "The height at which the upgrade must happen (not to be used together with --upgrade-time)")
 	cmd.Flags().String(FlagUpgradeTime, "", fmt.Sprintf("The time at which the upgrade must happen (ex. %s) (not to be used together with --upgrade-height)", TimeFormat))
 	cmd.Flags().String(FlagUpgradeInfo, "", "Optional info for the planned upgrade such as commit hash, etc.")
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
@@ -32,3 +35,23 @@ func (k Keeper) AppliedPlan(c context.Context, req *types.QueryAppliedPlanReques
 
 	return &types.QueryAppliedPlanResponse{Height: applied}, nil
 }
+
+// ModuleVersions implements the Query/ModuleVersions gRPC method
+func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersionsRequest) (*types.QueryModuleVersionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	mv := k.GetModuleVersions(ctx)
+
+	// if a module name is specified, only return that module's version
+	if req.ModuleName != "" {
+		for _, v := range mv {
+			if v.Name == req.ModuleName {
+				return &types.QueryModuleVersionsResponse{ModuleVersions: []types.ModuleVersion{v}}, nil
+			}
+		}
+
+		return nil, status.Errorf(codes.NotFound, "x/upgrade: QueryModuleVersions module %s not found", req.ModuleName)
+	}
+
+	return &types.QueryModuleVersionsResponse{ModuleVersions: mv}, nil
+}
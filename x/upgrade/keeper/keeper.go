@@ -19,6 +19,7 @@ import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
 	ibcexported "github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
@@ -53,6 +54,45 @@ func (k Keeper) SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandl
 	k.upgradeHandlers[name] = upgradeHandler
 }
 
+// SetModuleVersionMap saves a given module version map to state.
+func (k Keeper) SetModuleVersionMap(ctx sdk.Context, vm module.VersionMap) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VersionMapByte})
+	for name, version := range vm {
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, version)
+		store.Set([]byte(name), bz)
+	}
+}
+
+// GetModuleVersionMap returns a map of module name to module consensus version
+// as persisted by the last applied upgrade (or at genesis).
+func (k Keeper) GetModuleVersionMap(ctx sdk.Context) module.VersionMap {
+	vm := make(module.VersionMap)
+	for _, mv := range k.GetModuleVersions(ctx) {
+		vm[mv.Name] = mv.Version
+	}
+
+	return vm
+}
+
+// GetModuleVersions returns the module consensus versions stored in state,
+// sorted by module name.
+func (k Keeper) GetModuleVersions(ctx sdk.Context) []types.ModuleVersion {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VersionMapByte})
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	mv := []types.ModuleVersion{}
+	for ; iterator.Valid(); iterator.Next() {
+		mv = append(mv, types.ModuleVersion{
+			Name:    string(iterator.Key()),
+			Version: binary.BigEndian.Uint64(iterator.Value()),
+		})
+	}
+
+	return mv
+}
+
 // ScheduleUpgrade schedules an upgrade based on the specified plan.
 // If there is another Plan already scheduled, it will overwrite it
 // (implicitly cancelling the current plan)
@@ -211,13 +251,20 @@ func (k Keeper) HasHandler(name string) bool {
 }
 
 // ApplyUpgrade will execute the handler associated with the Plan and mark the plan as done.
+// The module version map returned by the handler is persisted to state so
+// that the next upgrade knows which versions the in-place migrations start from.
 func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
 	handler := k.upgradeHandlers[plan.Name]
 	if handler == nil {
 		panic("ApplyUpgrade should never be called without first checking HasHandler")
 	}
 
-	handler(ctx, plan)
+	updatedVM, err := handler(ctx, plan, k.GetModuleVersionMap(ctx))
+	if err != nil {
+		panic(err)
+	}
+
+	k.SetModuleVersionMap(ctx, updatedVM)
 
 	k.ClearUpgradePlan(ctx)
 	k.setDone(ctx, plan.Name)
@@ -21,6 +21,8 @@ const (
 	PlanByte = 0x0
 	// DoneByte is a prefix for to look up completed upgrade plan by name
 	DoneByte = 0x1
+	// VersionMapByte is a prefix to look up module names (key) and versions (value)
+	VersionMapByte = 0x2
 
 	// KeyUpgradedClient is the key under which upgraded client is stored in the upgrade store
 	KeyUpgradedClient = "upgradedClient"
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -207,11 +208,109 @@ func (m *QueryAppliedPlanResponse) GetHeight() int64 {
 	return 0
 }
 
+// QueryModuleVersionsRequest is the request type for the Query/ModuleVersions
+// RPC method.
+type QueryModuleVersionsRequest struct {
+	// module_name is a field to query a specific module consensus version from
+	// state. Leaving this empty will fetch the full list of module versions from
+	// state.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+}
+
+func (m *QueryModuleVersionsRequest) Reset()         { *m = QueryModuleVersionsRequest{} }
+func (m *QueryModuleVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleVersionsRequest) ProtoMessage()    {}
+func (*QueryModuleVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{4}
+}
+func (m *QueryModuleVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleVersionsRequest.Merge(m, src)
+}
+func (m *QueryModuleVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleVersionsRequest proto.InternalMessageInfo
+
+func (m *QueryModuleVersionsRequest) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+// QueryModuleVersionsResponse is the response type for the Query/ModuleVersions
+// RPC method.
+type QueryModuleVersionsResponse struct {
+	// module_versions is a list of module names with their consensus versions.
+	ModuleVersions []ModuleVersion `protobuf:"bytes,1,rep,name=module_versions,json=moduleVersions,proto3" json:"module_versions"`
+}
+
+func (m *QueryModuleVersionsResponse) Reset()         { *m = QueryModuleVersionsResponse{} }
+func (m *QueryModuleVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleVersionsResponse) ProtoMessage()    {}
+func (*QueryModuleVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{5}
+}
+func (m *QueryModuleVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleVersionsResponse.Merge(m, src)
+}
+func (m *QueryModuleVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryModuleVersionsResponse) GetModuleVersions() []ModuleVersion {
+	if m != nil {
+		return m.ModuleVersions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
 	proto.RegisterType((*QueryAppliedPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryAppliedPlanRequest")
 	proto.RegisterType((*QueryAppliedPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryAppliedPlanResponse")
+	proto.RegisterType((*QueryModuleVersionsRequest)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsRequest")
+	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
 }
 
 func init() {
@@ -219,30 +318,37 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 362 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x3d, 0x4f, 0xfa, 0x40,
-	0x1c, 0xc7, 0x39, 0xfe, 0xfc, 0x49, 0x3c, 0xb6, 0x1b, 0x10, 0x09, 0x69, 0xcc, 0x85, 0x18, 0x13,
-	0xa1, 0xc7, 0xc3, 0x2b, 0x50, 0x13, 0x27, 0x07, 0x65, 0x74, 0x31, 0x07, 0xfc, 0x52, 0x1a, 0xcb,
-	0xdd, 0xd1, 0xbb, 0x1a, 0x89, 0x71, 0xf1, 0x15, 0x98, 0xb8, 0xbb, 0xf9, 0x5e, 0x1c, 0x49, 0x5c,
-	0x1c, 0x0d, 0xf8, 0x42, 0x0c, 0xd7, 0x62, 0x6a, 0xa0, 0xc1, 0xa9, 0x6d, 0xfa, 0x7d, 0xf8, 0xdc,
-	0xb7, 0xc5, 0x74, 0x20, 0xf5, 0x58, 0x6a, 0x16, 0x29, 0x2f, 0xe4, 0x43, 0x60, 0xb7, 0xed, 0x3e,
-	0x18, 0xde, 0x66, 0x93, 0x08, 0xc2, 0xa9, 0xab, 0x42, 0x69, 0x24, 0x29, 0xc7, 0x1a, 0x37, 0xd1,
-	0xb8, 0x89, 0xa6, 0x5a, 0xf3, 0xa4, 0xf4, 0x02, 0x60, 0x5c, 0xf9, 0x8c, 0x0b, 0x21, 0x0d, 0x37,
-	0xbe, 0x14, 0x3a, 0x76, 0x55, 0xeb, 0x19, 0xc9, 0xab, 0x14, 0xab, 0xa2, 0x7b, 0x78, 0xf7, 0x72,
-	0x59, 0x75, 0x1a, 0x85, 0x21, 0x08, 0x73, 0x11, 0x70, 0xd1, 0x83, 0x49, 0x04, 0xda, 0xd0, 0x73,
-	0x5c, 0x59, 0x7f, 0xa5, 0x95, 0x14, 0x1a, 0x48, 0x0b, 0x17, 0x54, 0xc0, 0x45, 0x05, 0xed, 0xa3,
-	0xc3, 0x52, 0xa7, 0xe6, 0x6e, 0x26, 0x74, 0xad, 0xc7, 0x2a, 0x69, 0x33, 0x29, 0x3a, 0x56, 0x2a,
-	0xf0, 0x61, 0x98, 0x2a, 0x22, 0x04, 0x17, 0x04, 0x1f, 0x83, 0x0d, 0xdb, 0xe9, 0xd9, 0x7b, 0xda,
-	0x49, 0xca, 0x7f, 0xc9, 0x93, 0xf2, 0x32, 0x2e, 0x8e, 0xc0, 0xf7, 0x46, 0xc6, 0x3a, 0xfe, 0xf5,
-	0x92, 0xa7, 0xce, 0x2c, 0x8f, 0xff, 0x5b, 0x13, 0x79, 0x41, 0xb8, 0x94, 0xc2, 0x26, 0x2c, 0x0b,
-	0x30, 0xe3, 0xec, 0xd5, 0xd6, 0xdf, 0x0d, 0x31, 0x14, 0x6d, 0x3c, 0xbe, 0x7f, 0x3d, 0xe7, 0x0f,
-	0x48, 0x9d, 0x65, 0xec, 0x3e, 0x88, 0x4d, 0xd7, 0xcb, 0x35, 0xc8, 0x2b, 0xc2, 0xa5, 0xd4, 0xd1,
-	0xb6, 0x00, 0xae, 0x6f, 0xb6, 0x05, 0x70, 0xc3, 0x6a, 0xb4, 0x6b, 0x01, 0x9b, 0xe4, 0x28, 0x0b,
-	0x90, 0xc7, 0x26, 0x0b, 0xc8, 0xee, 0x97, 0x5f, 0xe1, 0xe1, 0xe4, 0xec, 0x6d, 0xee, 0xa0, 0xd9,
-	0xdc, 0x41, 0x9f, 0x73, 0x07, 0x3d, 0x2d, 0x9c, 0xdc, 0x6c, 0xe1, 0xe4, 0x3e, 0x16, 0x4e, 0xee,
-	0xaa, 0xe1, 0xf9, 0x66, 0x14, 0xf5, 0xdd, 0x81, 0x1c, 0xaf, 0x02, 0xe3, 0x4b, 0x53, 0x0f, 0x6f,
-	0xd8, 0xdd, 0x4f, 0xba, 0x99, 0x2a, 0xd0, 0xfd, 0xa2, 0xfd, 0xdb, 0xba, 0xdf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xca, 0xa9, 0x39, 0xfe, 0xef, 0x02, 0x00, 0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x6a, 0xd4, 0x40,
+	0x18, 0xdf, 0x71, 0xd7, 0x82, 0x5f, 0xa0, 0xc2, 0x20, 0x75, 0x8d, 0x25, 0x2d, 0xa1, 0x6a, 0xc5,
+	0x6e, 0x66, 0x9b, 0x3d, 0x7b, 0xb0, 0x82, 0x27, 0x15, 0x5d, 0xc4, 0x83, 0x97, 0x32, 0xbb, 0x19,
+	0xb2, 0xc1, 0x64, 0x66, 0x9a, 0x99, 0x14, 0x8b, 0x78, 0xf1, 0x09, 0x04, 0xef, 0xde, 0xbc, 0xf8,
+	0x24, 0x3d, 0x16, 0xbc, 0x78, 0x12, 0xd9, 0xf5, 0x35, 0x04, 0xc9, 0x64, 0x56, 0xb2, 0xee, 0x46,
+	0xdb, 0x53, 0x26, 0xf9, 0x7e, 0xff, 0x66, 0x7e, 0x13, 0xf0, 0xc7, 0x42, 0x65, 0x42, 0x91, 0x42,
+	0xc6, 0x39, 0x8d, 0x18, 0x39, 0xde, 0x1f, 0x31, 0x4d, 0xf7, 0xc9, 0x51, 0xc1, 0xf2, 0x93, 0x40,
+	0xe6, 0x42, 0x0b, 0xbc, 0x51, 0x61, 0x02, 0x8b, 0x09, 0x2c, 0xc6, 0xbd, 0x16, 0x8b, 0x58, 0x18,
+	0x08, 0x29, 0x57, 0x15, 0xda, 0xdd, 0x8c, 0x85, 0x88, 0x53, 0x46, 0xa8, 0x4c, 0x08, 0xe5, 0x5c,
+	0x68, 0xaa, 0x13, 0xc1, 0x95, 0x9d, 0xee, 0x34, 0xf8, 0xcd, 0xb5, 0x0d, 0xca, 0xbf, 0x01, 0xd7,
+	0x9f, 0x97, 0x01, 0x1e, 0x16, 0x79, 0xce, 0xb8, 0x7e, 0x96, 0x52, 0x3e, 0x64, 0x47, 0x05, 0x53,
+	0xda, 0x7f, 0x0c, 0xdd, 0xe5, 0x91, 0x92, 0x82, 0x2b, 0x86, 0xfb, 0xd0, 0x91, 0x29, 0xe5, 0x5d,
+	0xb4, 0x8d, 0x76, 0x9d, 0x70, 0x33, 0x58, 0x9d, 0x3b, 0x30, 0x1c, 0x83, 0xf4, 0x7b, 0xd6, 0xe8,
+	0x81, 0x94, 0x69, 0xc2, 0xa2, 0x9a, 0x11, 0xc6, 0xd0, 0xe1, 0x34, 0x63, 0x46, 0xec, 0xca, 0xd0,
+	0xac, 0xfd, 0x10, 0xba, 0xcb, 0x70, 0x6b, 0xbe, 0x01, 0x6b, 0x13, 0x96, 0xc4, 0x13, 0x6d, 0x18,
+	0xed, 0xa1, 0x7d, 0xf3, 0xef, 0x83, 0x6b, 0x38, 0x4f, 0x44, 0x54, 0xa4, 0xec, 0x25, 0xcb, 0x55,
+	0x79, 0x1c, 0x73, 0x97, 0x2d, 0x70, 0x32, 0x33, 0x38, 0xac, 0x99, 0x41, 0xf5, 0xe9, 0x69, 0x69,
+	0xa9, 0xe0, 0xe6, 0x4a, 0xba, 0x75, 0x7d, 0x01, 0x57, 0x2d, 0xff, 0xd8, 0x8e, 0xba, 0x68, 0xbb,
+	0xbd, 0xeb, 0x84, 0xb7, 0x9a, 0x76, 0xbf, 0x20, 0x74, 0xd0, 0x39, 0xfd, 0xbe, 0xd5, 0x1a, 0xae,
+	0x67, 0x0b, 0xea, 0xe1, 0xaf, 0x36, 0x5c, 0x36, 0xae, 0xf8, 0x13, 0x02, 0xa7, 0x76, 0xd4, 0x98,
+	0x34, 0xc9, 0x36, 0xf4, 0xe5, 0xf6, 0xcf, 0x4f, 0xa8, 0xb6, 0xe4, 0xef, 0xbd, 0xff, 0xfa, 0xf3,
+	0xe3, 0xa5, 0xdb, 0x78, 0x87, 0x34, 0xdc, 0x95, 0x71, 0x45, 0x3a, 0x2c, 0x1b, 0xc4, 0x9f, 0x11,
+	0x38, 0xb5, 0x3a, 0xfe, 0x13, 0x70, 0xb9, 0x67, 0xb7, 0x7f, 0x7e, 0x82, 0x0d, 0x38, 0x30, 0x01,
+	0x7b, 0xf8, 0x5e, 0x53, 0x40, 0x5a, 0x91, 0x4c, 0x40, 0xf2, 0xb6, 0x2c, 0xf6, 0x1d, 0xfe, 0x82,
+	0x60, 0x7d, 0xb1, 0x43, 0x1c, 0xfe, 0xd3, 0x79, 0xe5, 0x7d, 0x71, 0x07, 0x17, 0xe2, 0xd8, 0xc0,
+	0xc4, 0x04, 0xbe, 0x8b, 0xef, 0x34, 0x05, 0xfe, 0xeb, 0x0a, 0x1d, 0x3c, 0x3a, 0x9d, 0x7a, 0xe8,
+	0x6c, 0xea, 0xa1, 0x1f, 0x53, 0x0f, 0x7d, 0x98, 0x79, 0xad, 0xb3, 0x99, 0xd7, 0xfa, 0x36, 0xf3,
+	0x5a, 0xaf, 0xf6, 0xe2, 0x44, 0x4f, 0x8a, 0x51, 0x30, 0x16, 0xd9, 0x5c, 0xac, 0x7a, 0xf4, 0x54,
+	0xf4, 0x9a, 0xbc, 0xf9, 0xa3, 0xac, 0x4f, 0x24, 0x53, 0xa3, 0x35, 0xf3, 0x3b, 0x0f, 0x7e, 0x0f,
+	0x00, 0xe4, 0x44, 0xda, 0x3e, 0x66, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -261,6 +367,9 @@ type QueryClient interface {
 	CurrentPlan(ctx context.Context, in *QueryCurrentPlanRequest, opts ...grpc.CallOption) (*QueryCurrentPlanResponse, error)
 	// AppliedPlan queries a previously applied upgrade plan by its name.
 	AppliedPlan(ctx context.Context, in *QueryAppliedPlanRequest, opts ...grpc.CallOption) (*QueryAppliedPlanResponse, error)
+	// ModuleVersions queries the list of module consensus versions stored in
+	// the upgrade module's state.
+	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
 }
 
 type queryClient struct {
@@ -289,12 +398,24 @@ func (c *queryClient) AppliedPlan(ctx context.Context, in *QueryAppliedPlanReque
 	return out, nil
 }
 
+func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error) {
+	out := new(QueryModuleVersionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/ModuleVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
 	CurrentPlan(context.Context, *QueryCurrentPlanRequest) (*QueryCurrentPlanResponse, error)
 	// AppliedPlan queries a previously applied upgrade plan by its name.
 	AppliedPlan(context.Context, *QueryAppliedPlanRequest) (*QueryAppliedPlanResponse, error)
+	// ModuleVersions queries the list of module consensus versions stored in
+	// the upgrade module's state.
+	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -307,6 +428,9 @@ func (*UnimplementedQueryServer) CurrentPlan(ctx context.Context, req *QueryCurr
 func (*UnimplementedQueryServer) AppliedPlan(ctx context.Context, req *QueryAppliedPlanRequest) (*QueryAppliedPlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppliedPlan not implemented")
 }
+func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -348,6 +472,24 @@ func _Query_AppliedPlan_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/ModuleVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleVersions(ctx, req.(*QueryModuleVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -360,6 +502,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AppliedPlan",
 			Handler:    _Query_AppliedPlan_Handler,
 		},
+		{
+			MethodName: "ModuleVersions",
+			Handler:    _Query_ModuleVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -481,6 +627,73 @@ func (m *QueryAppliedPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryModuleVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleVersions) > 0 {
+		for iNdEx := len(m.ModuleVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -539,6 +752,34 @@ func (m *QueryAppliedPlanResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryModuleVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ModuleVersions) > 0 {
+		for _, e := range m.ModuleVersions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -844,6 +1085,178 @@ func (m *QueryAppliedPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryModuleVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleVersions = append(m.ModuleVersions, ModuleVersion{})
+			if err := m.ModuleVersions[len(m.ModuleVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0